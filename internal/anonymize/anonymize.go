@@ -0,0 +1,196 @@
+package anonymize
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var preservedKeys = map[string]struct{}{
+	"status":    {},
+	"level":     {},
+	"action":    {},
+	"language":  {},
+	"framework": {},
+	"platform":  {},
+	"method":    {},
+}
+
+type Anonymizer struct {
+	key []byte
+}
+
+func New(key []byte) *Anonymizer {
+	return &Anonymizer{key: append([]byte(nil), key...)}
+}
+
+func (a *Anonymizer) Text(value string) string {
+	var out strings.Builder
+	out.Grow(len(value))
+
+	runes := []rune(value)
+	for start := 0; start < len(runes); {
+		if !isWordRune(runes[start]) {
+			out.WriteRune(runes[start])
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		a.writeScrambledWord(&out, runes[start:end])
+		start = end
+	}
+
+	return out.String()
+}
+
+func (a *Anonymizer) writeScrambledWord(out *strings.Builder, word []rune) {
+	stream := a.stream("text", strings.ToLower(string(word)), len(word))
+	for index, r := range word {
+		switch {
+		case unicode.IsDigit(r):
+			out.WriteByte('0' + stream[index]%10)
+		case unicode.IsUpper(r):
+			out.WriteByte('A' + stream[index]%26)
+		default:
+			out.WriteByte('a' + stream[index]%26)
+		}
+	}
+}
+
+func (a *Anonymizer) Number(value uint64) uint64 {
+	if value == 0 || value >= 1e18 {
+		return value
+	}
+
+	low := uint64(1)
+	digits := 1
+	for low*10 <= value {
+		low *= 10
+		digits++
+	}
+	span := 9 * low
+
+	seed := a.stream("number", strconv.Itoa(digits), 16)
+	multiplier := binary.BigEndian.Uint64(seed[:8])%span | 1
+	for gcd(multiplier, span) != 1 {
+		multiplier = (multiplier + 2) % span
+	}
+	offset := binary.BigEndian.Uint64(seed[8:]) % span
+
+	hi, lo := bits.Mul64(multiplier, value-low)
+	_, sum := bits.Div64(hi, lo, span)
+	sum += offset
+	if sum >= span {
+		sum -= span
+	}
+
+	return low + sum
+}
+
+func Apply[T any](a *Anonymizer, value T) (T, error) {
+	var zero T
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return zero, fmt.Errorf("encode for anonymization: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var tree any
+	if err := decoder.Decode(&tree); err != nil {
+		return zero, fmt.Errorf("decode for anonymization: %w", err)
+	}
+
+	scrambled, err := json.Marshal(a.walk("", tree))
+	if err != nil {
+		return zero, fmt.Errorf("encode anonymized value: %w", err)
+	}
+
+	var result T
+	if err := json.Unmarshal(scrambled, &result); err != nil {
+		return zero, fmt.Errorf("decode anonymized value: %w", err)
+	}
+
+	return result, nil
+}
+
+func (a *Anonymizer) walk(key string, value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		for nestedKey, nested := range typed {
+			typed[nestedKey] = a.walk(nestedKey, nested)
+		}
+		return typed
+	case []any:
+		for index := range typed {
+			typed[index] = a.walk(key, typed[index])
+		}
+		return typed
+	case string:
+		return a.scrambleString(key, typed)
+	case json.Number:
+		return a.scrambleNumber(key, typed)
+	default:
+		return value
+	}
+}
+
+func (a *Anonymizer) scrambleString(key string, value string) string {
+	if _, ok := preservedKeys[strings.ToLower(key)]; ok {
+		return value
+	}
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return value
+	}
+
+	return a.Text(value)
+}
+
+func (a *Anonymizer) scrambleNumber(key string, value json.Number) json.Number {
+	key = strings.ToLower(key)
+	if key != "id" && key != "counter" && !strings.HasSuffix(key, "_id") {
+		return value
+	}
+	parsed, err := strconv.ParseUint(value.String(), 10, 64)
+	if err != nil {
+		return value
+	}
+
+	return json.Number(strconv.FormatUint(a.Number(parsed), 10))
+}
+
+func (a *Anonymizer) stream(purpose string, input string, length int) []byte {
+	out := make([]byte, 0, length)
+	for block := uint32(0); len(out) < length; block++ {
+		mac := hmac.New(sha256.New, a.key)
+		_, _ = mac.Write([]byte(purpose))
+		_ = binary.Write(mac, binary.BigEndian, block)
+		_, _ = mac.Write([]byte(input))
+		out = mac.Sum(out)
+	}
+
+	return out[:length]
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func gcd(a uint64, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+
+	return a
+}
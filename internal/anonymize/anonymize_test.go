@@ -0,0 +1,117 @@
+package anonymize
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestTextKeepsShapeAndIsDeterministic(t *testing.T) {
+	t.Parallel()
+
+	anonymizer := New([]byte("key"))
+	input := "TypeError: user alice@acme.io (id 4021) not found"
+	got := anonymizer.Text(input)
+
+	if got == input || len(got) != len(input) {
+		t.Fatalf("Text() = %q", got)
+	}
+	for index, r := range input {
+		g := rune(got[index])
+		switch {
+		case unicode.IsUpper(r) && !unicode.IsUpper(g),
+			unicode.IsLower(r) && !unicode.IsLower(g),
+			unicode.IsDigit(r) && !unicode.IsDigit(g),
+			!isWordRune(r) && r != g:
+			t.Fatalf("Text() changed shape at %d: %q -> %q", index, input, got)
+		}
+	}
+	if strings.Contains(got, "alice") || strings.Contains(got, "acme") {
+		t.Fatalf("expected scrambled email, got %q", got)
+	}
+	if again := anonymizer.Text(input); again != got {
+		t.Fatalf("Text() not deterministic: %q vs %q", got, again)
+	}
+	if other := New([]byte("other")).Text(input); other == got {
+		t.Fatalf("expected key to change output")
+	}
+	if a, b := anonymizer.Text("Timeout"), anonymizer.Text("timeout"); !strings.EqualFold(a, b) {
+		t.Fatalf("expected same word to scramble consistently: %q vs %q", a, b)
+	}
+}
+
+func TestNumberIsBijectiveWithinDigitCount(t *testing.T) {
+	t.Parallel()
+
+	anonymizer := New([]byte("key"))
+	seen := map[uint64]uint64{}
+	for value := uint64(10); value < 100; value++ {
+		got := anonymizer.Number(value)
+		if got < 10 || got > 99 {
+			t.Fatalf("Number(%d) = %d changed digit count", value, got)
+		}
+		if previous, ok := seen[got]; ok {
+			t.Fatalf("Number(%d) and Number(%d) both = %d", previous, value, got)
+		}
+		seen[got] = value
+	}
+
+	if got := anonymizer.Number(0); got != 0 {
+		t.Fatalf("Number(0) = %d", got)
+	}
+	if got := anonymizer.Number(987654321012); got == 987654321012 || got < 1e11 || got >= 1e12 {
+		t.Fatalf("Number(large) = %d", got)
+	}
+}
+
+func TestApplyScramblesIdentifyingFields(t *testing.T) {
+	t.Parallel()
+
+	occurrences := uint64(4821)
+	lastSeen := uint64(1700000000)
+	issue := app.IssueSummary{
+		ItemID:                  domain.ItemID(9001),
+		Counter:                 domain.ItemCounter(274),
+		Title:                   "Payment failed for bob@example.com",
+		Status:                  domain.StatusActive,
+		Environment:             domain.Environment("production"),
+		Level:                   domain.LevelError,
+		Occurrences:             &occurrences,
+		LastOccurrenceTimestamp: &lastSeen,
+		Raw:                     json.RawMessage(`{"person":{"email":"bob@example.com"},"when":"2024-01-02T03:04:05Z"}`),
+	}
+
+	got, err := Apply(New([]byte("key")), []app.IssueSummary{issue})
+	if err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	scrambled := got[0]
+
+	if scrambled.Title == issue.Title || scrambled.Environment == issue.Environment || scrambled.Counter == issue.Counter || scrambled.ItemID == issue.ItemID {
+		t.Fatalf("expected identifying fields scrambled, got %+v", scrambled)
+	}
+	if scrambled.Status != issue.Status || scrambled.Level != issue.Level {
+		t.Fatalf("expected enumerations kept, got %+v", scrambled)
+	}
+	if *scrambled.Occurrences != occurrences || *scrambled.LastOccurrenceTimestamp != lastSeen {
+		t.Fatalf("expected counts and timestamps kept, got %+v", scrambled)
+	}
+	if strings.Contains(string(scrambled.Raw), "bob") || !strings.Contains(string(scrambled.Raw), "2024-01-02T03:04:05Z") {
+		t.Fatalf("unexpected raw payload: %s", scrambled.Raw)
+	}
+	if issue.Title != "Payment failed for bob@example.com" {
+		t.Fatalf("expected input left untouched")
+	}
+}
+
+func TestApplyReportsEncodeErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Apply(New(nil), map[string]any{"bad": make(chan int)}); err == nil {
+		t.Fatalf("expected encode error")
+	}
+}
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type Assignment struct {
+	ItemActionResult
+	Assignee *rollbar.User `json:"assignee,omitempty"`
+}
+
+func (s *Service) Assign(ctx context.Context, counter domain.ItemCounter, user string) (Assignment, error) {
+	assignee, err := s.ResolveUser(ctx, user)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	result, err := s.updateItemAndFetch(ctx, counter, rollbar.ItemPatch{AssignedUserID: &assignee.ID}, "assigned")
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	return Assignment{ItemActionResult: result, Assignee: &assignee}, nil
+}
+
+func (s *Service) Unassign(ctx context.Context, counter domain.ItemCounter) (Assignment, error) {
+	result, err := s.updateItemAndFetch(ctx, counter, rollbar.ItemPatch{Unassign: true}, "unassigned")
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	return Assignment{ItemActionResult: result}, nil
+}
+
+// Project tokens cannot read users, so a numeric ID is used as is when the
+// lookup is refused.
+func (s *Service) ResolveUser(ctx context.Context, query string) (rollbar.User, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return rollbar.User{}, errors.New("user is required")
+	}
+
+	if id, err := strconv.ParseUint(query, 10, 64); err == nil {
+		user, err := s.api.GetUser(ctx, id)
+		switch {
+		case err == nil:
+			return user, nil
+		case isAccessError(err):
+			return rollbar.User{ID: id}, nil
+		default:
+			return rollbar.User{}, fmt.Errorf("get user %d: %w", id, err)
+		}
+	}
+
+	users, err := s.api.ListUsers(ctx)
+	if err != nil {
+		if isAccessError(err) {
+			return rollbar.User{}, fmt.Errorf("list users: %w (finding users by name needs an account read token; pass a numeric user ID instead)", err)
+		}
+		return rollbar.User{}, fmt.Errorf("list users: %w", err)
+	}
+	for _, user := range users {
+		if strings.EqualFold(user.Username, query) || (user.Email != "" && strings.EqualFold(user.Email, query)) {
+			return user, nil
+		}
+	}
+
+	return rollbar.User{}, fmt.Errorf("no Rollbar user matches %q", query)
+}
+
+func (s *Service) assignee(ctx context.Context, userID *uint64) *rollbar.User {
+	if userID == nil {
+		return nil
+	}
+
+	user, err := s.api.GetUser(ctx, *userID)
+	if err != nil {
+		if !isAccessError(err) {
+			s.warn(WarningEnrichmentSkipped, "could not look up assignee %d: %v", *userID, err)
+		}
+		return &rollbar.User{ID: *userID}
+	}
+
+	return &user
+}
+
+func isAccessError(err error) bool {
+	return errors.Is(err, rollbar.ErrUnauthorized) || errors.Is(err, rollbar.ErrForbidden)
+}
@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceResolveUser(t *testing.T) {
+	t.Parallel()
+
+	users := []rollbar.User{{ID: 7, Username: "alice", Email: "alice@example.com"}, {ID: 8, Username: "bob"}}
+	denied := &rollbar.APIError{Op: "users", StatusCode: 403, Message: "insufficient privileges", Kind: rollbar.ErrForbidden}
+	tests := []struct {
+		name    string
+		api     *actionAPI
+		query   string
+		want    rollbar.User
+		wantErr string
+	}{
+		{name: "username", api: &actionAPI{users: users}, query: "Alice", want: users[0]},
+		{name: "email", api: &actionAPI{users: users}, query: "ALICE@example.com", want: users[0]},
+		{name: "numeric id", api: &actionAPI{users: users}, query: "8", want: users[1]},
+		{name: "numeric id without user access", api: &actionAPI{usersErr: denied}, query: "9", want: rollbar.User{ID: 9}},
+		{name: "unknown id", api: &actionAPI{users: users}, query: "9", wantErr: "get user 9"},
+		{name: "unknown name", api: &actionAPI{users: users}, query: "carol", wantErr: `no Rollbar user matches "carol"`},
+		{name: "name without user access", api: &actionAPI{usersErr: denied}, query: "alice", wantErr: "pass a numeric user ID"},
+		{name: "empty", api: &actionAPI{}, query: " ", wantErr: "user is required"},
+	}
+
+	for _, tt := range tests {
+		got, err := NewService(tt.api).ResolveUser(context.Background(), tt.query)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("%s: ResolveUser() error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Fatalf("%s: ResolveUser() = %+v, %v", tt.name, got, err)
+		}
+	}
+}
+
+func TestServiceAssignAndUnassign(t *testing.T) {
+	t.Parallel()
+
+	api := &actionAPI{resolvedID: 9, item: rollbar.Item{ID: 9, Counter: 4}, users: []rollbar.User{{ID: 7, Username: "alice"}}}
+	service := NewService(api)
+
+	got, err := service.Assign(context.Background(), 4, "alice")
+	if err != nil {
+		t.Fatalf("Assign() error = %v", err)
+	}
+	if got.Action != "assigned" || got.Assignee == nil || got.Assignee.Username != "alice" || api.lastPatch.AssignedUserID == nil || *api.lastPatch.AssignedUserID != 7 {
+		t.Fatalf("Assign() = %+v, patch %+v", got, api.lastPatch)
+	}
+
+	got, err = service.Unassign(context.Background(), 4)
+	if err != nil || got.Action != "unassigned" || !api.lastPatch.Unassign {
+		t.Fatalf("Unassign() = %+v, %v, patch %+v", got, err, api.lastPatch)
+	}
+
+	api.updateErr = errors.New("boom")
+	if _, err := service.Assign(context.Background(), 4, "7"); err == nil {
+		t.Fatalf("expected Assign() error")
+	}
+}
+
+func TestServiceShowAssignee(t *testing.T) {
+	t.Parallel()
+
+	userID := uint64(7)
+	item := rollbar.Item{ID: 9, Counter: 4, AssignedUserID: &userID}
+
+	detail, err := NewService(&actionAPI{resolvedID: 9, item: item, users: []rollbar.User{{ID: 7, Username: "alice"}}}).Show(context.Background(), 4)
+	if err != nil || detail.Assignee == nil || detail.Assignee.Username != "alice" {
+		t.Fatalf("Show() assignee = %+v, %v", detail.Assignee, err)
+	}
+
+	denied := &actionAPI{resolvedID: 9, item: item, usersErr: rollbar.ErrUnauthorized}
+	service := NewService(denied)
+	detail, err = service.Show(context.Background(), 4)
+	if err != nil || detail.Assignee == nil || *detail.Assignee != (rollbar.User{ID: 7}) || len(service.Warnings()) != 0 {
+		t.Fatalf("expected the bare assignee id without warnings, got %+v, %v, %+v", detail.Assignee, err, service.Warnings())
+	}
+}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"context"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/parallel"
+)
+
+type BulkActionResult struct {
+	Counter domain.ItemCounter
+	Result  ItemActionResult
+	Err     error
+}
+
+func (s *Service) BulkAction(ctx context.Context, counters []domain.ItemCounter, action func(context.Context, domain.ItemCounter) (ItemActionResult, error)) []BulkActionResult {
+	results := make([]BulkActionResult, len(counters))
+	started := make([]bool, len(counters))
+	_ = parallel.ForEach(ctx, defaultConcurrency, len(counters), func(ctx context.Context, index int) error {
+		started[index] = true
+		result, err := action(ctx, counters[index])
+		results[index] = BulkActionResult{Counter: counters[index], Result: result, Err: err}
+		return nil
+	})
+	for index := range results {
+		if !started[index] {
+			results[index] = BulkActionResult{Counter: counters[index], Err: ctx.Err()}
+		}
+	}
+
+	return results
+}
+
+type BulkUpdateResult struct {
+	Matches []IssueSummary
+	Results []BulkActionResult
+	DryRun  bool
+}
+
+func (s *Service) BulkUpdate(ctx context.Context, filters IssueFilters, action func(context.Context, domain.ItemCounter) (ItemActionResult, error), dryRun bool) (BulkUpdateResult, error) {
+	matches, err := s.RecentAll(ctx, filters)
+	if err != nil {
+		return BulkUpdateResult{}, err
+	}
+	result := BulkUpdateResult{Matches: matches, DryRun: dryRun}
+	if dryRun || len(matches) == 0 {
+		return result, nil
+	}
+
+	counters := make([]domain.ItemCounter, 0, len(matches))
+	for _, issue := range matches {
+		counters = append(counters, issue.Counter)
+	}
+	result.Results = s.BulkAction(ctx, counters, action)
+
+	return result, nil
+}
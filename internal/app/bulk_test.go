@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceBulkAction(t *testing.T) {
+	t.Parallel()
+
+	failed := errors.New("boom")
+	counters := []domain.ItemCounter{5, 6, 7, 8, 9, 10}
+	results := NewService(&actionAPI{}).BulkAction(context.Background(), counters, func(ctx context.Context, counter domain.ItemCounter) (ItemActionResult, error) {
+		if counter%2 == 0 {
+			return ItemActionResult{}, failed
+		}
+		return ItemActionResult{Action: "resolved", Issue: IssueSummary{Counter: counter}}, nil
+	})
+
+	if len(results) != len(counters) {
+		t.Fatalf("expected %d results, got %d", len(counters), len(results))
+	}
+	for index, result := range results {
+		if result.Counter != counters[index] {
+			t.Fatalf("result %d is for %d, want %d", index, result.Counter, counters[index])
+		}
+		if wantErr := result.Counter%2 == 0; errors.Is(result.Err, failed) != wantErr {
+			t.Fatalf("result %d: unexpected error %v", index, result.Err)
+		}
+		if result.Err == nil && result.Result.Issue.Counter != result.Counter {
+			t.Fatalf("result %d: unexpected outcome %+v", index, result.Result)
+		}
+	}
+}
+
+func TestServiceBulkActionCanceled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls := 0
+	results := NewService(&actionAPI{}).BulkAction(ctx, []domain.ItemCounter{1, 2}, func(ctx context.Context, counter domain.ItemCounter) (ItemActionResult, error) {
+		calls++
+		return ItemActionResult{}, nil
+	})
+
+	if calls != 0 {
+		t.Fatalf("expected no actions after cancel, got %d", calls)
+	}
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Fatalf("expected canceled results, got %+v", result)
+		}
+	}
+}
+
+func TestServiceBulkUpdate(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Environment: "staging"},
+		{ID: 2, Counter: 2, Environment: "production"},
+		{ID: 3, Counter: 3, Environment: "staging"},
+	}})
+	var acted []domain.ItemCounter
+	action := func(ctx context.Context, counter domain.ItemCounter) (ItemActionResult, error) {
+		acted = append(acted, counter)
+		return ItemActionResult{Action: "resolved"}, nil
+	}
+	filters := IssueFilters{Environment: "staging"}
+
+	preview, err := service.BulkUpdate(context.Background(), filters, action, true)
+	if err != nil {
+		t.Fatalf("BulkUpdate() dry run error = %v", err)
+	}
+	if len(preview.Matches) != 2 || preview.Results != nil || !preview.DryRun || len(acted) != 0 {
+		t.Fatalf("unexpected dry run %+v, acted on %v", preview, acted)
+	}
+
+	// A single match keeps the unsynchronized acted slice race-free.
+	update, err := NewService(fakeAPI{listItems: []rollbar.Item{{ID: 1, Counter: 1, Environment: "staging"}}}).BulkUpdate(context.Background(), filters, action, false)
+	if err != nil {
+		t.Fatalf("BulkUpdate() error = %v", err)
+	}
+	if len(update.Results) != 1 || update.Results[0].Counter != 1 || len(acted) != 1 {
+		t.Fatalf("unexpected update %+v, acted on %v", update, acted)
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).BulkUpdate(context.Background(), filters, action, false); err == nil {
+		t.Fatalf("expected a listing error")
+	}
+}
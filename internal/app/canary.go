@@ -0,0 +1,179 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+const (
+	defaultCanarySampleItems = 20
+	canaryInstancesPerItem   = 100
+	secondsPerHour           = 3600
+)
+
+type CanaryOptions struct {
+	Baseline     string
+	Candidate    string
+	Environment  string
+	SampleItems  int
+	MaxRateRatio float64
+	MaxNewItems  int
+}
+
+type CanaryVersionStats struct {
+	Version     string  `json:"version"`
+	Occurrences uint64  `json:"occurrences"`
+	Items       int     `json:"items"`
+	NewItems    int     `json:"new_items"`
+	FirstSeen   *uint64 `json:"first_seen,omitempty"`
+	LastSeen    *uint64 `json:"last_seen,omitempty"`
+	RatePerHour float64 `json:"rate_per_hour"`
+}
+
+type CanaryReport struct {
+	Baseline     CanaryVersionStats `json:"baseline"`
+	Candidate    CanaryVersionStats `json:"candidate"`
+	RateRatio    *float64           `json:"rate_ratio,omitempty"`
+	SampledItems int                `json:"sampled_items"`
+	Passed       bool               `json:"passed"`
+	Reasons      []string           `json:"reasons,omitempty"`
+}
+
+type versionTally struct {
+	occurrences uint64
+	timestamped bool
+	firstSeen   uint64
+	lastSeen    uint64
+	items       map[domain.ItemID]struct{}
+}
+
+func (s *Service) Canary(ctx context.Context, options CanaryOptions) (CanaryReport, error) {
+	options.Baseline = strings.TrimSpace(options.Baseline)
+	options.Candidate = strings.TrimSpace(options.Candidate)
+	if options.Baseline == "" || options.Candidate == "" {
+		return CanaryReport{}, errors.New("baseline and candidate versions are required")
+	}
+	if options.Baseline == options.Candidate {
+		return CanaryReport{}, errors.New("baseline and candidate versions must differ")
+	}
+	if options.SampleItems <= 0 {
+		options.SampleItems = defaultCanarySampleItems
+	}
+
+	issues, err := s.Recent(ctx, options.SampleItems, IssueFilters{Environment: domain.Environment(options.Environment)})
+	if err != nil {
+		return CanaryReport{}, err
+	}
+
+	baseline, candidate, err := s.tallyCodeVersions(ctx, issues, options)
+	if err != nil {
+		return CanaryReport{}, err
+	}
+
+	report := CanaryReport{
+		Baseline:     baseline.stats(options.Baseline, candidate),
+		Candidate:    candidate.stats(options.Candidate, baseline),
+		SampledItems: len(issues),
+	}
+	evaluateCanary(&report, options)
+
+	return report, nil
+}
+
+func (s *Service) tallyCodeVersions(ctx context.Context, issues []IssueSummary, options CanaryOptions) (*versionTally, *versionTally, error) {
+	sampled, skipped, err := mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) ([]rollbar.ItemInstance, error) {
+		instances, err := s.api.ListInstances(ctx, issue.ItemID, rollbar.InstanceListOptions{Page: 1, PerPage: canaryInstancesPerItem})
+		if err != nil {
+			return nil, fmt.Errorf("list instances for item %s: %w", issue.Counter.String(), err)
+		}
+		return instances, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	s.warnSkipped(skipped, "could not sample occurrences for %d of %d issues (request budget or rate limit)")
+
+	baseline := &versionTally{items: map[domain.ItemID]struct{}{}}
+	candidate := &versionTally{items: map[domain.ItemID]struct{}{}}
+	for index, issue := range issues {
+		tallyInstances(issue.ItemID, sampled[index], options, baseline, candidate)
+	}
+
+	return baseline, candidate, nil
+}
+
+func tallyInstances(itemID domain.ItemID, instances []rollbar.ItemInstance, options CanaryOptions, baseline *versionTally, candidate *versionTally) {
+	for _, instance := range instances {
+		switch summary.CodeVersion(instance.Data) {
+		case options.Baseline:
+			baseline.add(itemID, instance.Timestamp)
+		case options.Candidate:
+			candidate.add(itemID, instance.Timestamp)
+		}
+	}
+}
+
+func (t *versionTally) add(itemID domain.ItemID, timestamp *uint64) {
+	t.occurrences++
+	t.items[itemID] = struct{}{}
+	if timestamp == nil {
+		return
+	}
+	if !t.timestamped || *timestamp < t.firstSeen {
+		t.firstSeen = *timestamp
+	}
+	if !t.timestamped || *timestamp > t.lastSeen {
+		t.lastSeen = *timestamp
+	}
+	t.timestamped = true
+}
+
+func (t *versionTally) stats(version string, other *versionTally) CanaryVersionStats {
+	stats := CanaryVersionStats{
+		Version:     version,
+		Occurrences: t.occurrences,
+		Items:       len(t.items),
+	}
+	for itemID := range t.items {
+		if _, seen := other.items[itemID]; !seen {
+			stats.NewItems++
+		}
+	}
+	if t.timestamped {
+		firstSeen, lastSeen := t.firstSeen, t.lastSeen
+		stats.FirstSeen = &firstSeen
+		stats.LastSeen = &lastSeen
+	}
+
+	windowHours := float64(t.lastSeen-t.firstSeen) / secondsPerHour
+	if windowHours < 1 {
+		windowHours = 1
+	}
+	stats.RatePerHour = float64(t.occurrences) / windowHours
+
+	return stats
+}
+
+func evaluateCanary(report *CanaryReport, options CanaryOptions) {
+	reasons := make([]string, 0)
+	if report.Candidate.NewItems > options.MaxNewItems {
+		reasons = append(reasons, fmt.Sprintf("candidate introduced %d new items (max %d)", report.Candidate.NewItems, options.MaxNewItems))
+	}
+
+	if report.Baseline.RatePerHour > 0 {
+		ratio := report.Candidate.RatePerHour / report.Baseline.RatePerHour
+		report.RateRatio = &ratio
+		if options.MaxRateRatio > 0 && ratio > options.MaxRateRatio {
+			reasons = append(reasons, fmt.Sprintf("candidate error rate is %.2fx baseline (max %.2fx)", ratio, options.MaxRateRatio))
+		}
+	}
+
+	report.Reasons = reasons
+	report.Passed = len(reasons) == 0
+}
@@ -0,0 +1,94 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceCanaryPasses(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{
+		listItems: []rollbar.Item{{ID: 1, Counter: 1}},
+		instances: []rollbar.ItemInstance{
+			canaryInstance("v1", 0),
+			canaryInstance("v1", 7200),
+			canaryInstance("v2", 0),
+			canaryInstance("other", 0),
+		},
+	})
+
+	report, err := service.Canary(context.Background(), CanaryOptions{Baseline: "v1", Candidate: "v2", MaxRateRatio: 1.5})
+	if err != nil {
+		t.Fatalf("Canary() error = %v", err)
+	}
+	if !report.Passed || len(report.Reasons) != 0 {
+		t.Fatalf("expected pass, got %+v", report)
+	}
+	if report.Baseline.Occurrences != 2 || report.Candidate.Occurrences != 1 {
+		t.Fatalf("unexpected occurrence tallies: %+v", report)
+	}
+	if report.Baseline.RatePerHour != 1 || report.RateRatio == nil || *report.RateRatio != 1 {
+		t.Fatalf("unexpected rates: baseline=%v ratio=%v", report.Baseline.RatePerHour, report.RateRatio)
+	}
+}
+
+func TestServiceCanaryFailsOnNewItemsAndRate(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{
+		listItems: []rollbar.Item{{ID: 1, Counter: 1}},
+		instances: []rollbar.ItemInstance{
+			canaryInstance("v1", 0),
+			canaryInstance("v2", 0),
+			canaryInstance("v2", 10),
+			canaryInstance("v2", 20),
+		},
+	})
+
+	report, err := service.Canary(context.Background(), CanaryOptions{Baseline: "v1", Candidate: "v2", MaxRateRatio: 2})
+	if err != nil {
+		t.Fatalf("Canary() error = %v", err)
+	}
+	if report.Passed || len(report.Reasons) != 1 {
+		t.Fatalf("expected rate failure, got %+v", report)
+	}
+
+	onlyCandidate := NewService(fakeAPI{
+		listItems: []rollbar.Item{{ID: 1, Counter: 1}},
+		instances: []rollbar.ItemInstance{canaryInstance("v2", 0)},
+	})
+	report, err = onlyCandidate.Canary(context.Background(), CanaryOptions{Baseline: "v1", Candidate: "v2"})
+	if err != nil {
+		t.Fatalf("Canary() error = %v", err)
+	}
+	if report.Passed || report.Candidate.NewItems != 1 {
+		t.Fatalf("expected new item failure, got %+v", report)
+	}
+}
+
+func TestServiceCanaryValidation(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{})
+	if _, err := service.Canary(context.Background(), CanaryOptions{Baseline: "v1"}); err == nil {
+		t.Fatalf("expected missing candidate error")
+	}
+	if _, err := service.Canary(context.Background(), CanaryOptions{Baseline: "v1", Candidate: " v1 "}); err == nil {
+		t.Fatalf("expected identical versions error")
+	}
+
+	failing := NewService(fakeAPI{err: errors.New("bad")})
+	if _, err := failing.Canary(context.Background(), CanaryOptions{Baseline: "v1", Candidate: "v2"}); err == nil {
+		t.Fatalf("expected list error")
+	}
+}
+
+func canaryInstance(version string, timestamp uint64) rollbar.ItemInstance {
+	data, _ := json.Marshal(map[string]any{"code_version": version})
+	return rollbar.ItemInstance{Timestamp: &timestamp, Data: data}
+}
@@ -0,0 +1,149 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const (
+	CompatOK      = "ok"
+	CompatChanged = "changed"
+	CompatError   = "error"
+	CompatSkipped = "skipped"
+)
+
+type ShapeReporter interface {
+	ShapeObservations() []rollbar.ShapeObservation
+}
+
+type EndpointCheck struct {
+	Endpoint string                     `json:"endpoint"`
+	Status   string                     `json:"status"`
+	Error    string                     `json:"error,omitempty"`
+	Shapes   []rollbar.ShapeObservation `json:"shapes,omitempty"`
+}
+
+type APICompatReport struct {
+	Endpoints []EndpointCheck `json:"endpoints"`
+	Changed   int             `json:"changed"`
+	Failed    int             `json:"failed"`
+	Passed    bool            `json:"passed"`
+}
+
+type compatProbe struct {
+	endpoint string
+	run      func(context.Context) error
+}
+
+func (s *Service) CheckAPICompat(ctx context.Context) (APICompatReport, error) {
+	var item *rollbar.Item
+	probes := []compatProbe{
+		{endpoint: "items", run: func(ctx context.Context) error {
+			items, err := s.api.ListItems(ctx, "", 1)
+			if err == nil && len(items) > 0 {
+				item = &items[0]
+			}
+			return err
+		}},
+		{endpoint: "top active items", run: func(ctx context.Context) error {
+			_, err := s.api.ListActiveItems(ctx, 1)
+			return err
+		}},
+		{endpoint: "deploys", run: func(ctx context.Context) error {
+			_, err := s.api.ListDeploys(ctx, 1)
+			return err
+		}},
+		{endpoint: "item_by_counter", run: withItem(&item, func(ctx context.Context, item rollbar.Item) error {
+			_, err := s.api.ResolveItemIDByCounter(ctx, domain.ItemCounter(item.Counter))
+			return err
+		})},
+		{endpoint: "item", run: withItem(&item, func(ctx context.Context, item rollbar.Item) error {
+			_, err := s.api.GetItem(ctx, item.ID)
+			return err
+		})},
+		{endpoint: "item instances", run: withItem(&item, func(ctx context.Context, item rollbar.Item) error {
+			_, err := s.api.ListInstances(ctx, item.ID, rollbar.InstanceListOptions{Page: 1, PerPage: 1})
+			return err
+		})},
+		{endpoint: "occurrence counts", run: withItem(&item, func(ctx context.Context, item rollbar.Item) error {
+			now := s.Now().Unix()
+			_, err := s.api.GetOccurrenceCounts(ctx, rollbar.OccurrenceCountsQuery{ItemID: item.ID, MinTimestamp: now - 24*secondsPerHour, MaxTimestamp: now, BucketSize: secondsPerHour})
+			return err
+		})},
+		{endpoint: "project", run: withItem(&item, func(ctx context.Context, item rollbar.Item) error {
+			_, err := s.api.GetProject(ctx, item.ProjectID)
+			return err
+		})},
+	}
+
+	report := APICompatReport{Endpoints: make([]EndpointCheck, 0, len(probes))}
+	for _, probe := range probes {
+		check := EndpointCheck{Endpoint: probe.endpoint, Status: CompatOK}
+		err := probe.run(ctx)
+		switch {
+		case errors.Is(err, errCompatSkipped):
+			check.Status = CompatSkipped
+			check.Error = "no items in the project"
+		case errors.Is(err, rollbar.ErrUnexpectedShape):
+			check.Status = CompatChanged
+			check.Error = err.Error()
+		case err != nil:
+			if ctx.Err() != nil {
+				return APICompatReport{}, err
+			}
+			check.Status = CompatError
+			check.Error = err.Error()
+		}
+		report.Endpoints = append(report.Endpoints, check)
+	}
+
+	s.attachShapes(&report)
+	for _, check := range report.Endpoints {
+		switch check.Status {
+		case CompatChanged:
+			report.Changed++
+		case CompatError:
+			report.Failed++
+		}
+	}
+	report.Passed = report.Changed == 0 && report.Failed == 0
+
+	return report, nil
+}
+
+var errCompatSkipped = errors.New("skipped")
+
+func withItem(item **rollbar.Item, run func(context.Context, rollbar.Item) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		if *item == nil {
+			return errCompatSkipped
+		}
+		return run(ctx, **item)
+	}
+}
+
+func (s *Service) attachShapes(report *APICompatReport) {
+	reporter, ok := s.api.(ShapeReporter)
+	if !ok {
+		return
+	}
+
+	index := map[string]int{}
+	for i, check := range report.Endpoints {
+		index[check.Endpoint] = i
+	}
+	for _, observation := range reporter.ShapeObservations() {
+		i, ok := index[observation.Endpoint]
+		if !ok {
+			continue
+		}
+		check := &report.Endpoints[i]
+		check.Shapes = append(check.Shapes, observation)
+		if !observation.Matches() && check.Status == CompatOK {
+			check.Status = CompatChanged
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type shapeReportingAPI struct {
+	fakeAPI
+	shapes []rollbar.ShapeObservation
+}
+
+func (s shapeReportingAPI) ShapeObservations() []rollbar.ShapeObservation {
+	return s.shapes
+}
+
+func TestCheckAPICompat(t *testing.T) {
+	t.Parallel()
+
+	item := rollbar.Item{ID: 10, Counter: 3, ProjectID: 7}
+	tests := []struct {
+		name    string
+		api     RollbarAPI
+		status  map[string]string
+		changed int
+		failed  int
+	}{
+		{
+			name:   "documented shapes",
+			api:    shapeReportingAPI{fakeAPI: fakeAPI{listItems: []rollbar.Item{item}}, shapes: []rollbar.ShapeObservation{{Endpoint: "items", Aspect: "envelope", Expected: "a", Observed: "a"}}},
+			status: map[string]string{"items": CompatOK, "item": CompatOK, "project": CompatOK},
+		},
+		{
+			name:    "fallback shape",
+			api:     shapeReportingAPI{fakeAPI: fakeAPI{listItems: []rollbar.Item{item}}, shapes: []rollbar.ShapeObservation{{Endpoint: "deploys", Aspect: "envelope", Expected: "a", Observed: "bare list"}}},
+			status:  map[string]string{"deploys": CompatChanged, "items": CompatOK},
+			changed: 1,
+		},
+		{
+			name:   "no items",
+			api:    fakeAPI{},
+			status: map[string]string{"items": CompatOK, "deploys": CompatOK, "item": CompatSkipped, "project": CompatSkipped},
+		},
+		{
+			name:   "errors",
+			api:    fakeAPI{err: errors.New("boom")},
+			status: map[string]string{"items": CompatError, "deploys": CompatError, "item": CompatSkipped},
+			failed: 3,
+		},
+		{
+			name:    "strict decode failure",
+			api:     fakeAPI{err: &rollbar.APIError{Op: "items", Kind: rollbar.ErrUnexpectedShape}},
+			status:  map[string]string{"items": CompatChanged, "item": CompatSkipped},
+			changed: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			report, err := NewService(tt.api).CheckAPICompat(context.Background())
+			if err != nil {
+				t.Fatalf("CheckAPICompat() error = %v", err)
+			}
+			statuses := map[string]string{}
+			for _, check := range report.Endpoints {
+				statuses[check.Endpoint] = check.Status
+			}
+			for endpoint, want := range tt.status {
+				if statuses[endpoint] != want {
+					t.Fatalf("%s status = %q, want %q (report %+v)", endpoint, statuses[endpoint], want, report)
+				}
+			}
+			if report.Changed != tt.changed || report.Failed != tt.failed {
+				t.Fatalf("changed/failed = %d/%d, want %d/%d", report.Changed, report.Failed, tt.changed, tt.failed)
+			}
+			if report.Passed != (tt.changed == 0 && tt.failed == 0) {
+				t.Fatalf("unexpected passed = %v", report.Passed)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type IssueCount struct {
+	Count    int  `json:"count"`
+	Complete bool `json:"complete"`
+}
+
+func (s *Service) Count(ctx context.Context, filters IssueFilters, stopAt int) (IssueCount, error) {
+	s.explainList(filters)
+	count := 0
+	reached := false
+	maxPages := s.itemPageCap(maxExportItemPages)
+	more, err := s.scanItemPages(ctx, recentStatus(filters), maxPages, func(page []rollbar.Item) bool {
+		count += len(s.filterItems(page, filters))
+		reached = stopAt > 0 && count >= stopAt && len(page) >= rollbar.ItemsPageSize
+		return !reached
+	})
+	if err != nil {
+		return IssueCount{}, fmt.Errorf("count items: %w", err)
+	}
+	if more {
+		s.warn(WarningPartialPagination, "stopped after %d pages with %d matching issues; older issues were not counted", maxPages, count)
+	}
+
+	return IssueCount{Count: count, Complete: !more && !reached}, nil
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceCount(t *testing.T) {
+	t.Parallel()
+
+	page := func(environment string, size int, firstID domain.ItemID) []rollbar.Item {
+		items := make([]rollbar.Item, size)
+		for index := range items {
+			id := firstID + domain.ItemID(index)
+			items[index] = rollbar.Item{ID: id, Counter: uint64(id), Environment: environment}
+		}
+		return items
+	}
+	pages := [][]rollbar.Item{
+		append(page("staging", rollbar.ItemsPageSize-2, 1), page("production", 2, 1001)...),
+		page("production", rollbar.ItemsPageSize, 2001),
+		page("production", 1, 3001),
+	}
+
+	tests := []struct {
+		name      string
+		stopAt    int
+		options   []Option
+		want      IssueCount
+		wantCalls int
+	}{
+		{name: "every page", want: IssueCount{Count: 103, Complete: true}, wantCalls: 3},
+		{name: "threshold met early", stopAt: 50, want: IssueCount{Count: 102}, wantCalls: 2},
+		{name: "threshold never met", stopAt: 500, want: IssueCount{Count: 103, Complete: true}, wantCalls: 3},
+		{name: "page cap", options: []Option{WithMaxItemPages(1)}, want: IssueCount{Count: 2}, wantCalls: 1},
+	}
+	for _, tc := range tests {
+		service := NewService(fakeAPI{itemPages: pages}, tc.options...)
+		got, err := service.Count(context.Background(), IssueFilters{Environment: "production"}, tc.stopAt)
+		if err != nil {
+			t.Fatalf("%s: Count() error = %v", tc.name, err)
+		}
+		if got != tc.want {
+			t.Fatalf("%s: Count() = %+v, want %+v", tc.name, got, tc.want)
+		}
+		if calls := len(service.Explanation().Calls); calls != tc.wantCalls {
+			t.Fatalf("%s: expected %d page requests, got %d", tc.name, tc.wantCalls, calls)
+		}
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).Count(context.Background(), IssueFilters{}, 0); err == nil {
+		t.Fatalf("expected Count() error")
+	}
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func (s *Service) Deploys(ctx context.Context, limit int, environment string) ([]rollbar.Deploy, error) {
+	wanted := s.environments.Canonical(environment)
+	deploys := make([]rollbar.Deploy, 0, limit)
+	for page := 1; page <= maxDeployPages && len(deploys) < limit; page++ {
+		batch, err := s.api.ListDeploys(ctx, page)
+		if err != nil {
+			return nil, fmt.Errorf("list deploys: %w", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, deploy := range batch {
+			deploy.Environment = s.environments.Canonical(deploy.Environment).String()
+			if wanted != "" && domain.Environment(deploy.Environment) != wanted {
+				continue
+			}
+			deploys = append(deploys, deploy)
+			if len(deploys) == limit {
+				break
+			}
+		}
+	}
+
+	return deploys, nil
+}
+
+var deployStatuses = []string{"started", "succeeded", "failed", "timed_out"}
+
+func (s *Service) RecordDeploy(ctx context.Context, deploy rollbar.NewDeploy) (rollbar.Deploy, error) {
+	deploy.Environment = strings.TrimSpace(deploy.Environment)
+	deploy.Revision = strings.TrimSpace(deploy.Revision)
+	if deploy.Revision == "" {
+		return rollbar.Deploy{}, errors.New("deploy revision is required")
+	}
+	if deploy.Environment == "" {
+		return rollbar.Deploy{}, errors.New("deploy environment is required")
+	}
+	if deploy.Status != "" && !slices.Contains(deployStatuses, deploy.Status) {
+		return rollbar.Deploy{}, fmt.Errorf("unknown deploy status %q: use %s", deploy.Status, strings.Join(deployStatuses, ", "))
+	}
+
+	id, err := s.api.RecordDeploy(ctx, deploy)
+	if err != nil {
+		return rollbar.Deploy{}, fmt.Errorf("record deploy: %w", err)
+	}
+	started := uint64(max(s.Now().Unix(), 0))
+
+	return rollbar.Deploy{
+		ID:            id,
+		Environment:   deploy.Environment,
+		Revision:      deploy.Revision,
+		LocalUsername: deploy.LocalUsername,
+		Comment:       deploy.Comment,
+		Status:        deploy.Status,
+		StartTime:     &started,
+	}, nil
+}
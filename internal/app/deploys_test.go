@@ -0,0 +1,85 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceDeploys(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{deploys: []rollbar.Deploy{
+		{ID: 3, Environment: "prod", Revision: "c3"},
+		{ID: 2, Environment: "staging", Revision: "b2"},
+		{ID: 1, Environment: "production", Revision: "a1"},
+	}})
+
+	tests := []struct {
+		name        string
+		limit       int
+		environment string
+		want        []uint64
+	}{
+		{name: "all", limit: 10, want: []uint64{3, 2, 1}},
+		{name: "limit", limit: 2, want: []uint64{3, 2}},
+		{name: "environment alias", limit: 10, environment: "prd", want: []uint64{3, 1}},
+	}
+
+	for _, tt := range tests {
+		deploys, err := service.Deploys(context.Background(), tt.limit, tt.environment)
+		if err != nil {
+			t.Fatalf("%s: Deploys() error = %v", tt.name, err)
+		}
+		got := make([]uint64, 0, len(deploys))
+		for _, deploy := range deploys {
+			got = append(got, deploy.ID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("%s: got deploys %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	deploys, _ := service.Deploys(context.Background(), 1, "")
+	if deploys[0].Environment != "production" {
+		t.Fatalf("expected canonical environment, got %q", deploys[0].Environment)
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).Deploys(context.Background(), 5, ""); err == nil || !strings.Contains(err.Error(), "list deploys") {
+		t.Fatalf("expected list error, got %v", err)
+	}
+}
+
+func TestServiceRecordDeploy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	api := &actionAPI{}
+	service := NewService(api, WithClock(func() time.Time { return now }))
+
+	deploy, err := service.RecordDeploy(context.Background(), rollbar.NewDeploy{Environment: " prod ", Revision: " abc123 ", Comment: "hotfix"})
+	if err != nil {
+		t.Fatalf("RecordDeploy() error = %v", err)
+	}
+	if api.lastDeploy.Environment != "prod" || api.lastDeploy.Revision != "abc123" || api.lastDeploy.Comment != "hotfix" {
+		t.Fatalf("unexpected request: %+v", api.lastDeploy)
+	}
+	if deploy.ID != 77 || deploy.StartTime == nil || *deploy.StartTime != 1700000000 {
+		t.Fatalf("unexpected deploy: %+v", deploy)
+	}
+
+	for _, input := range []rollbar.NewDeploy{{Environment: "production"}, {Revision: "abc123"}, {Environment: "production", Revision: "abc123", Status: "done"}} {
+		if _, err := service.RecordDeploy(context.Background(), input); err == nil || !strings.Contains(err.Error(), "deploy") {
+			t.Fatalf("expected required error for %+v, got %v", input, err)
+		}
+	}
+
+	api.updateErr = errors.New("forbidden")
+	if _, err := service.RecordDeploy(context.Background(), rollbar.NewDeploy{Environment: "production", Revision: "abc123"}); err == nil || !strings.Contains(err.Error(), "record deploy") {
+		t.Fatalf("expected record error, got %v", err)
+	}
+}
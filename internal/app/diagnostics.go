@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+type Diagnostic struct {
+	Issue IssueSummary  `json:"issue"`
+	File  string        `json:"file"`
+	Frame summary.Frame `json:"frame"`
+}
+
+type WorkspaceFiles struct {
+	byName map[string][]string
+}
+
+func NewWorkspaceFiles(files []string) WorkspaceFiles {
+	byName := make(map[string][]string, len(files))
+	for _, file := range files {
+		file = cleanFramePath(file)
+		name := path.Base(file)
+		byName[name] = append(byName[name], file)
+	}
+
+	return WorkspaceFiles{byName: byName}
+}
+
+func (w WorkspaceFiles) Resolve(frame string) (string, bool) {
+	frame = cleanFramePath(frame)
+	best, bestCommon := "", 0
+	for _, file := range w.byName[path.Base(frame)] {
+		if !framePathMatches(frame, file) {
+			continue
+		}
+		if common := commonTrailingSegments(frame, file); common > bestCommon {
+			best, bestCommon = file, common
+		}
+	}
+
+	return best, best != ""
+}
+
+func (s *Service) Diagnostics(ctx context.Context, limit int, filters IssueFilters, files WorkspaceFiles) ([]Diagnostic, error) {
+	issues, err := s.Active(ctx, limit, filters)
+	if err != nil {
+		return nil, err
+	}
+	traces, skipped, err := mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) ([]summary.Trace, error) {
+		instance, err := s.api.GetLatestInstance(ctx, issue.ItemID)
+		if err != nil || instance == nil {
+			return nil, err
+		}
+		return summary.Traces(instance.Body, instance.Data), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get latest occurrences: %w", err)
+	}
+	s.warnSkipped(skipped, "could not check stack frames for %d of %d issues (request budget or rate limit)")
+
+	diagnostics := make([]Diagnostic, 0, len(issues))
+	for index, issue := range issues {
+		file, frame, ok := innermostWorkspaceFrame(traces[index], files)
+		if !ok {
+			continue
+		}
+		issue.Raw = nil
+		diagnostics = append(diagnostics, Diagnostic{Issue: issue, File: file, Frame: frame})
+	}
+
+	return diagnostics, nil
+}
+
+func innermostWorkspaceFrame(traces []summary.Trace, files WorkspaceFiles) (string, summary.Frame, bool) {
+	for _, trace := range traces {
+		for index := len(trace.Frames) - 1; index >= 0; index-- {
+			if file, ok := files.Resolve(trace.Frames[index].Filename); ok {
+				return file, trace.Frames[index], true
+			}
+		}
+	}
+
+	return "", summary.Frame{}, false
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestWorkspaceFilesResolve(t *testing.T) {
+	t.Parallel()
+
+	files := NewWorkspaceFiles([]string{"app/billing/invoice.go", "app/web/handler.go", "vendor/web/handler.go", "README.md"})
+
+	tests := []struct {
+		frame string
+		want  string
+	}{
+		{frame: "/srv/shop/app/billing/invoice.go", want: "app/billing/invoice.go"},
+		{frame: "/srv/shop/app/web/handler.go", want: "app/web/handler.go"},
+		{frame: `C:\build\vendor\web\handler.go`, want: "vendor/web/handler.go"},
+		{frame: "README.md", want: "README.md"},
+		{frame: "/usr/lib/go/src/net/http/server.go", want: ""},
+		{frame: "/srv/other/invoice.go", want: ""},
+	}
+
+	for _, tt := range tests {
+		got, ok := files.Resolve(tt.frame)
+		if got != tt.want || ok != (tt.want != "") {
+			t.Fatalf("Resolve(%q) = %q, %v, want %q", tt.frame, got, ok, tt.want)
+		}
+	}
+}
+
+func TestServiceDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	api := &routeAPI{
+		fakeAPI: fakeAPI{activeItems: []rollbar.Item{
+			{ID: 1, Counter: 1, Title: "invoice total is negative", Level: domain.LevelError},
+			{ID: 2, Counter: 2, Title: "library panic"},
+			{ID: 3, Counter: 3, Title: "no occurrence"},
+		}},
+		instances: map[domain.ItemID]*rollbar.ItemInstance{
+			1: {Data: json.RawMessage(`{"body":{"trace":{"frames":[{"filename":"/srv/app/web/handler.go","lineno":7},{"filename":"/srv/app/billing/invoice.go","lineno":42},{"filename":"/usr/lib/go/src/fmt/print.go","lineno":3}]}}}`)},
+			2: {Data: json.RawMessage(`{"body":{"trace":{"frames":[{"filename":"/usr/lib/go/src/fmt/print.go","lineno":3}]}}}`)},
+		},
+	}
+	files := NewWorkspaceFiles([]string{"app/billing/invoice.go", "app/web/handler.go"})
+
+	diagnostics, err := NewService(api).Diagnostics(context.Background(), 10, IssueFilters{}, files)
+	if err != nil {
+		t.Fatalf("Diagnostics() error = %v", err)
+	}
+	if len(diagnostics) != 1 {
+		t.Fatalf("expected one diagnostic, got %+v", diagnostics)
+	}
+	got := diagnostics[0]
+	if got.Issue.Counter != 1 || got.File != "app/billing/invoice.go" || got.Frame.Line != 42 || got.Issue.Raw != nil {
+		t.Fatalf("unexpected diagnostic: %+v", got)
+	}
+}
@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+const (
+	defaultEndpointSampleItems = 20
+	endpointInstancesPerItem   = 100
+)
+
+type EndpointOptions struct {
+	Since       time.Time
+	SampleItems int
+	Filters     IssueFilters
+}
+
+type EndpointStat struct {
+	Method      string               `json:"method,omitempty"`
+	Route       string               `json:"route"`
+	Occurrences int                  `json:"occurrences"`
+	Counters    []domain.ItemCounter `json:"counters"`
+	LastSeen    uint64               `json:"last_seen,omitempty"`
+}
+
+type EndpointReport struct {
+	Since              time.Time      `json:"since"`
+	SampledItems       int            `json:"sampled_items"`
+	SampledOccurrences int            `json:"sampled_occurrences"`
+	WithoutRequest     int            `json:"without_request"`
+	Endpoints          []EndpointStat `json:"endpoints"`
+}
+
+type endpointKey struct {
+	method string
+	route  string
+}
+
+type endpointTally struct {
+	stat  EndpointStat
+	items map[domain.ItemCounter]int
+}
+
+func (s *Service) Endpoints(ctx context.Context, options EndpointOptions) (EndpointReport, error) {
+	if options.SampleItems <= 0 {
+		options.SampleItems = defaultEndpointSampleItems
+	}
+	filters := options.Filters
+	filters.Since = &options.Since
+
+	issues, err := s.Recent(ctx, options.SampleItems, filters)
+	if err != nil {
+		return EndpointReport{}, err
+	}
+	sampled, skipped, err := mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) ([]rollbar.ItemInstance, error) {
+		instances, err := s.api.ListInstances(ctx, issue.ItemID, rollbar.InstanceListOptions{Page: 1, PerPage: endpointInstancesPerItem})
+		if err != nil {
+			return nil, fmt.Errorf("list instances for item %s: %w", issue.Counter.String(), err)
+		}
+		return instances, nil
+	})
+	if err != nil {
+		return EndpointReport{}, err
+	}
+	s.warnSkipped(skipped, "could not sample occurrences for %d of %d issues (request budget or rate limit)")
+
+	report := EndpointReport{Since: options.Since, SampledItems: len(issues)}
+	since := uint64(max(options.Since.Unix(), 0))
+	tallies := map[endpointKey]*endpointTally{}
+	for index, issue := range issues {
+		for _, instance := range sampled[index] {
+			if instance.Timestamp != nil && *instance.Timestamp < since {
+				continue
+			}
+			report.SampledOccurrences++
+			method, route := summary.Request(instance.Data)
+			if route == "" {
+				report.WithoutRequest++
+				continue
+			}
+			tallyEndpoint(tallies, endpointKey{method: method, route: route}, issue.Counter, instance.Timestamp)
+		}
+	}
+	report.Endpoints = sortEndpoints(tallies)
+
+	return report, nil
+}
+
+func tallyEndpoint(tallies map[endpointKey]*endpointTally, key endpointKey, counter domain.ItemCounter, timestamp *uint64) {
+	tally, ok := tallies[key]
+	if !ok {
+		tally = &endpointTally{stat: EndpointStat{Method: key.method, Route: key.route}, items: map[domain.ItemCounter]int{}}
+		tallies[key] = tally
+	}
+	tally.stat.Occurrences++
+	tally.items[counter]++
+	if timestamp != nil && *timestamp > tally.stat.LastSeen {
+		tally.stat.LastSeen = *timestamp
+	}
+}
+
+func sortEndpoints(tallies map[endpointKey]*endpointTally) []EndpointStat {
+	endpoints := make([]EndpointStat, 0, len(tallies))
+	for _, tally := range tallies {
+		stat := tally.stat
+		for counter := range tally.items {
+			stat.Counters = append(stat.Counters, counter)
+		}
+		sort.Slice(stat.Counters, func(i int, j int) bool {
+			left, right := tally.items[stat.Counters[i]], tally.items[stat.Counters[j]]
+			if left != right {
+				return left > right
+			}
+			return stat.Counters[i] < stat.Counters[j]
+		})
+		endpoints = append(endpoints, stat)
+	}
+	sort.Slice(endpoints, func(i int, j int) bool {
+		if endpoints[i].Occurrences != endpoints[j].Occurrences {
+			return endpoints[i].Occurrences > endpoints[j].Occurrences
+		}
+		if endpoints[i].Route != endpoints[j].Route {
+			return endpoints[i].Route < endpoints[j].Route
+		}
+		return endpoints[i].Method < endpoints[j].Method
+	})
+
+	return endpoints
+}
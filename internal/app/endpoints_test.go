@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type endpointsAPI struct {
+	fakeAPI
+	instances map[domain.ItemID][]rollbar.ItemInstance
+}
+
+func (a endpointsAPI) ListInstances(ctx context.Context, itemID domain.ItemID, opts rollbar.InstanceListOptions) ([]rollbar.ItemInstance, error) {
+	return a.instances[itemID], nil
+}
+
+func requestInstance(method string, url string, timestamp uint64) rollbar.ItemInstance {
+	data := `{"environment":"production"}`
+	if url != "" {
+		data = fmt.Sprintf(`{"request":{"method":%q,"url":%q}}`, method, url)
+	}
+
+	return rollbar.ItemInstance{Timestamp: &timestamp, Data: json.RawMessage(data)}
+}
+
+func TestServiceEndpoints(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_100_000, 0)
+	recent := uint64(now.Add(-time.Hour).Unix())
+	old := uint64(now.Add(-48 * time.Hour).Unix())
+	api := endpointsAPI{
+		fakeAPI: fakeAPI{listItems: []rollbar.Item{
+			{ID: 1, Counter: 11, LastOccurrenceTimestamp: &recent},
+			{ID: 2, Counter: 12, LastOccurrenceTimestamp: &recent},
+			{ID: 3, Counter: 13, LastOccurrenceTimestamp: &old},
+		}},
+		instances: map[domain.ItemID][]rollbar.ItemInstance{
+			1: {
+				requestInstance("POST", "https://shop.example.com/orders/17", recent),
+				requestInstance("POST", "https://shop.example.com/orders/42?retry=1", recent),
+				requestInstance("GET", "https://shop.example.com/search", recent),
+				requestInstance("POST", "https://shop.example.com/orders/9", old),
+			},
+			2: {
+				requestInstance("POST", "https://shop.example.com/orders/5", recent),
+				requestInstance("", "", recent),
+			},
+			3: {requestInstance("GET", "https://shop.example.com/search", old)},
+		},
+	}
+
+	service := NewService(api, WithClock(func() time.Time { return now }))
+	report, err := service.Endpoints(context.Background(), EndpointOptions{Since: now.Add(-24 * time.Hour)})
+	if err != nil {
+		t.Fatalf("Endpoints() error = %v", err)
+	}
+
+	want := []EndpointStat{
+		{Method: "POST", Route: "/orders/:id", Occurrences: 3, Counters: []domain.ItemCounter{11, 12}, LastSeen: recent},
+		{Method: "GET", Route: "/search", Occurrences: 1, Counters: []domain.ItemCounter{11}, LastSeen: recent},
+	}
+	if !reflect.DeepEqual(report.Endpoints, want) {
+		t.Fatalf("Endpoints() = %+v, want %+v", report.Endpoints, want)
+	}
+	if report.SampledItems != 2 || report.SampledOccurrences != 5 || report.WithoutRequest != 1 {
+		t.Fatalf("unexpected sample counts: %+v", report)
+	}
+}
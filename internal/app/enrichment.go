@@ -0,0 +1,41 @@
+package app
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kevinsheth/rollbaz/internal/parallel"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func mapEnrichment[T any, R any](ctx context.Context, inputs []T, fn func(context.Context, T) (R, error)) ([]R, []bool, error) {
+	results := make([]R, len(inputs))
+	skipped := make([]bool, len(inputs))
+	errs := make([]error, len(inputs))
+	cancelErr := parallel.ForEach(ctx, defaultConcurrency, len(inputs), func(ctx context.Context, index int) error {
+		result, err := fn(ctx, inputs[index])
+		switch {
+		case errors.Is(err, rollbar.ErrRequestBudgetExhausted), errors.Is(err, rollbar.ErrRateLimited):
+			skipped[index] = true
+		case err != nil:
+			errs[index] = err
+		default:
+			results[index] = result
+		}
+		return nil
+	})
+
+	return results, skipped, errors.Join(append(errs, cancelErr)...)
+}
+
+func (s *Service) warnSkipped(skipped []bool, format string) {
+	count := 0
+	for _, skip := range skipped {
+		if skip {
+			count++
+		}
+	}
+	if count > 0 {
+		s.warn(WarningEnrichmentSkipped, format, count, len(skipped))
+	}
+}
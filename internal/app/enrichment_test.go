@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestMapEnrichment(t *testing.T) {
+	t.Parallel()
+
+	boom := errors.New("boom")
+	results, skipped, err := mapEnrichment(context.Background(), []int{1, 2, 3, 4}, func(ctx context.Context, value int) (int, error) {
+		switch value {
+		case 2:
+			return 0, fmt.Errorf("item 2: %w", rollbar.ErrRequestBudgetExhausted)
+		case 4:
+			return 0, rollbar.ErrRateLimited
+		}
+		return value * 10, nil
+	})
+	if err != nil {
+		t.Fatalf("mapEnrichment() error = %v", err)
+	}
+	if results[0] != 10 || results[2] != 30 || skipped[0] || !skipped[1] || skipped[2] || !skipped[3] {
+		t.Fatalf("unexpected results %v skipped %v", results, skipped)
+	}
+
+	_, _, err = mapEnrichment(context.Background(), []int{1, 2}, func(ctx context.Context, value int) (int, error) {
+		if value == 1 {
+			return 0, boom
+		}
+		return 0, rollbar.ErrRequestBudgetExhausted
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected other failures to surface, got %v", err)
+	}
+}
+
+type budgetRateAPI struct {
+	rateAPI
+	refused domain.ItemID
+}
+
+func (b budgetRateAPI) GetOccurrenceCounts(ctx context.Context, query rollbar.OccurrenceCountsQuery) ([]rollbar.OccurrenceCount, error) {
+	if query.ItemID == b.refused {
+		return nil, rollbar.ErrRequestBudgetExhausted
+	}
+	return b.rateAPI.GetOccurrenceCounts(ctx, query)
+}
+
+func TestFilterByRateSkipsUnenrichedItems(t *testing.T) {
+	t.Parallel()
+
+	api := budgetRateAPI{rateAPI: rateAPI{counts: map[domain.ItemID]uint64{1: 12, 2: 30}}, refused: 2}
+	service := NewService(api, WithClock(func() time.Time { return time.Unix(1700000000, 0) }))
+
+	hot, err := service.FilterByRate(context.Background(), []IssueSummary{{ItemID: 1}, {ItemID: 2}}, &OccurrenceRate{Count: 10, Per: time.Hour})
+	if err != nil {
+		t.Fatalf("FilterByRate() error = %v", err)
+	}
+	if len(hot) != 1 || hot[0].ItemID != 1 {
+		t.Fatalf("expected only the enriched item, got %+v", hot)
+	}
+	if warnings := service.Warnings(); len(warnings) != 1 || warnings[0].Code != WarningEnrichmentSkipped || !strings.Contains(warnings[0].Message, "1 of 2 issues") {
+		t.Fatalf("expected an enrichment warning, got %+v", warnings)
+	}
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type Escalation struct {
+	Issue IssueSummary `json:"issue"`
+	From  domain.Level `json:"from"`
+	To    domain.Level `json:"to"`
+}
+
+func (s *Service) Escalate(ctx context.Context, counter domain.ItemCounter) (Escalation, error) {
+	itemID, err := s.api.ResolveItemIDByCounter(ctx, counter)
+	if err != nil {
+		return Escalation{}, fmt.Errorf("resolve item id: %w", err)
+	}
+	item, err := s.api.GetItem(ctx, itemID)
+	if err != nil {
+		return Escalation{}, fmt.Errorf("get item: %w", err)
+	}
+
+	next, ok := item.Level.Next()
+	if !ok {
+		return Escalation{}, fmt.Errorf("cannot escalate issue %s beyond level %q", counter.String(), item.Level)
+	}
+	if err := s.api.UpdateItem(ctx, itemID, rollbar.ItemPatch{Level: next}); err != nil {
+		return Escalation{}, fmt.Errorf("update item: %w", err)
+	}
+
+	updated, err := s.api.GetItem(ctx, itemID)
+	if err != nil {
+		return Escalation{}, fmt.Errorf("get item: %w", err)
+	}
+
+	return Escalation{Issue: s.mapSummary(updated), From: item.Level, To: next}, nil
+}
+
+type RateEscalator struct {
+	threshold OccurrenceRate
+	last      time.Time
+	escalated map[domain.ItemID]struct{}
+}
+
+func NewRateEscalator(threshold OccurrenceRate) *RateEscalator {
+	return &RateEscalator{threshold: threshold, escalated: map[domain.ItemID]struct{}{}}
+}
+
+func (e *RateEscalator) Due(deltas []IssueDelta, now time.Time) []IssueSummary {
+	elapsed := now.Sub(e.last)
+	first := e.last.IsZero()
+	e.last = now
+	if first || elapsed <= 0 || e.threshold.Count <= 0 {
+		return nil
+	}
+
+	due := make([]IssueSummary, 0)
+	for _, delta := range deltas {
+		if _, done := e.escalated[delta.ItemID]; done || delta.Delta == 0 {
+			continue
+		}
+		rate := float64(delta.Delta) / elapsed.Seconds() * e.threshold.Per.Seconds()
+		if rate >= e.threshold.Count {
+			e.escalated[delta.ItemID] = struct{}{}
+			due = append(due, delta.IssueSummary)
+		}
+	}
+
+	return due
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceEscalate(t *testing.T) {
+	t.Parallel()
+
+	api := &actionAPI{resolvedID: 9, item: rollbar.Item{ID: 9, Counter: 4, Level: domain.LevelWarning}}
+	got, err := NewService(api).Escalate(context.Background(), 4)
+	if err != nil {
+		t.Fatalf("Escalate() error = %v", err)
+	}
+	if got.From != domain.LevelWarning || got.To != domain.LevelError || api.lastPatch.Level != domain.LevelError || got.Issue.Counter != 4 {
+		t.Fatalf("Escalate() = %+v, patch %+v", got, api.lastPatch)
+	}
+
+	api = &actionAPI{resolvedID: 9, item: rollbar.Item{ID: 9, Counter: 4, Level: domain.LevelCritical}}
+	if _, err := NewService(api).Escalate(context.Background(), 4); err == nil || !strings.Contains(err.Error(), `beyond level "critical"`) {
+		t.Fatalf("Escalate() error = %v", err)
+	}
+	if api.updateCalls != 0 {
+		t.Fatalf("expected no update for a critical item")
+	}
+}
+
+func TestRateEscalatorDue(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(1_700_000_000, 0)
+	escalator := NewRateEscalator(OccurrenceRate{Count: 60, Per: time.Hour})
+	hot := IssueDelta{IssueSummary: IssueSummary{ItemID: 1, Counter: 1}, Delta: 1}
+	cold := IssueDelta{IssueSummary: IssueSummary{ItemID: 2, Counter: 2}}
+
+	if due := escalator.Due([]IssueDelta{hot, cold}, start); len(due) != 0 {
+		t.Fatalf("first refresh should only start the clock, got %+v", due)
+	}
+	// One new occurrence in 30s is 120/h, above the 60/h threshold.
+	due := escalator.Due([]IssueDelta{hot, cold}, start.Add(30*time.Second))
+	if len(due) != 1 || due[0].Counter != 1 {
+		t.Fatalf("Due() = %+v, want issue 1", due)
+	}
+	if due := escalator.Due([]IssueDelta{hot}, start.Add(time.Minute)); len(due) != 0 {
+		t.Fatalf("issue escalated twice: %+v", due)
+	}
+	// One occurrence in 2m is 30/h, below the threshold.
+	slow := IssueDelta{IssueSummary: IssueSummary{ItemID: 3, Counter: 3}, Delta: 1}
+	if due := escalator.Due([]IssueDelta{slow}, start.Add(3*time.Minute)); len(due) != 0 {
+		t.Fatalf("slow issue escalated: %+v", due)
+	}
+}
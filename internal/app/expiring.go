@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+const maxMutedItemPages = 5
+
+func (s *Service) Expiring(ctx context.Context, within time.Duration, limit int, filters IssueFilters) ([]IssueSummary, error) {
+	if within <= 0 {
+		return nil, errors.New("expiry window must be positive")
+	}
+
+	items, err := s.listItemPages(ctx, domain.StatusMuted, maxMutedItemPages)
+	if err != nil {
+		return nil, fmt.Errorf("list muted items: %w", err)
+	}
+	items = s.filterItems(items, filters)
+
+	now := s.Now()
+	windowStart := clampUnix(uint64(now.Unix()))
+	windowEnd := now.Add(within).Unix()
+
+	expiring := make([]IssueSummary, 0)
+	for _, item := range items {
+		issue := s.mapSummary(item)
+		if issue.SnoozeExpiresAt == nil {
+			continue
+		}
+		expiresAt := clampUnix(*issue.SnoozeExpiresAt)
+		if expiresAt < windowStart || expiresAt > windowEnd {
+			continue
+		}
+		expiring = append(expiring, issue)
+	}
+
+	sort.SliceStable(expiring, func(i int, j int) bool {
+		return *expiring[i].SnoozeExpiresAt < *expiring[j].SnoozeExpiresAt
+	})
+	if limit > 0 && len(expiring) > limit {
+		expiring = expiring[:limit]
+	}
+
+	return expiring, nil
+}
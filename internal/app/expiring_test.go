@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceExpiring(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(10_000, 0)
+	mutedAt := uint64(9_000)
+	soon := uint64(2_000)
+	later := uint64(1_500)
+	tooLate := uint64(100_000)
+	expired := uint64(500)
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 1, SnoozeEnabled: true, SnoozeEnabledTimestamp: &mutedAt, SnoozeExpirationInSeconds: &soon},
+		{ID: 2, Counter: 2, SnoozeEnabled: true, SnoozeEnabledTimestamp: &mutedAt, SnoozeExpirationInSeconds: &later},
+		{ID: 3, Counter: 3, SnoozeEnabled: true, SnoozeEnabledTimestamp: &mutedAt, SnoozeExpirationInSeconds: &tooLate},
+		{ID: 4, Counter: 4, SnoozeEnabled: true, SnoozeEnabledTimestamp: &mutedAt, SnoozeExpirationInSeconds: &expired},
+		{ID: 5, Counter: 5, SnoozeEnabled: false, SnoozeEnabledTimestamp: &mutedAt, SnoozeExpirationInSeconds: &soon},
+	}}, WithClock(func() time.Time { return now }))
+
+	issues, err := service.Expiring(context.Background(), time.Hour, 10, IssueFilters{})
+	if err != nil {
+		t.Fatalf("Expiring() error = %v", err)
+	}
+	if len(issues) != 2 || issues[0].Counter != 2 || issues[1].Counter != 1 {
+		t.Fatalf("unexpected expiring issues: %+v", issues)
+	}
+	if issues[0].SnoozeExpiresAt == nil || *issues[0].SnoozeExpiresAt != 10_500 || !issues[0].SnoozeEnabled {
+		t.Fatalf("unexpected snooze fields: %+v", issues[0])
+	}
+
+	limited, err := service.Expiring(context.Background(), time.Hour, 1, IssueFilters{})
+	if err != nil || len(limited) != 1 {
+		t.Fatalf("Expiring(limit) = %+v, err=%v", limited, err)
+	}
+}
+
+func TestServiceExpiringErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewService(fakeAPI{}).Expiring(context.Background(), 0, 10, IssueFilters{}); err == nil {
+		t.Fatalf("expected invalid window error")
+	}
+	if _, err := NewService(fakeAPI{err: errors.New("bad")}).Expiring(context.Background(), time.Hour, 10, IssueFilters{}); err == nil {
+		t.Fatalf("expected list error")
+	}
+}
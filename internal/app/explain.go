@@ -0,0 +1,143 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Explanation struct {
+	ServerFilters []ExplainFilter `json:"server_filters"`
+	ClientFilters []ExplainFilter `json:"client_filters"`
+	Calls         []ExplainCall   `json:"calls"`
+	Stages        []ExplainStage  `json:"stages"`
+}
+
+type ExplainFilter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type ExplainCall struct {
+	Endpoint string            `json:"endpoint"`
+	Params   map[string]string `json:"params,omitempty"`
+	Results  int               `json:"results"`
+}
+
+type ExplainStage struct {
+	Stage   string `json:"stage"`
+	In      int    `json:"in"`
+	Out     int    `json:"out"`
+	Dropped int    `json:"dropped"`
+}
+
+func (e Explanation) Empty() bool {
+	return len(e.ServerFilters) == 0 && len(e.ClientFilters) == 0 && len(e.Calls) == 0 && len(e.Stages) == 0
+}
+
+type explainLog struct {
+	mu            sync.Mutex
+	serverFilters []ExplainFilter
+	clientFilters []ExplainFilter
+	calls         []ExplainCall
+	stages        []ExplainStage
+}
+
+func (s *Service) explainFilters(server []ExplainFilter, client []ExplainFilter) {
+	s.explain.mu.Lock()
+	defer s.explain.mu.Unlock()
+
+	s.explain.serverFilters = server
+	s.explain.clientFilters = client
+}
+
+func (s *Service) explainClientFilter(name string, value string) {
+	s.explain.mu.Lock()
+	defer s.explain.mu.Unlock()
+
+	s.explain.clientFilters = append(s.explain.clientFilters, ExplainFilter{Name: name, Value: value})
+}
+
+func (s *Service) explainCall(endpoint string, params map[string]string, results int) {
+	s.explain.mu.Lock()
+	defer s.explain.mu.Unlock()
+
+	s.explain.calls = append(s.explain.calls, ExplainCall{Endpoint: endpoint, Params: params, Results: results})
+}
+
+func (s *Service) ExplainStage(stage string, in int, out int) {
+	s.explain.mu.Lock()
+	defer s.explain.mu.Unlock()
+
+	for index := range s.explain.stages {
+		if s.explain.stages[index].Stage == stage {
+			s.explain.stages[index].In += in
+			s.explain.stages[index].Out += out
+			s.explain.stages[index].Dropped += in - out
+			return
+		}
+	}
+	s.explain.stages = append(s.explain.stages, ExplainStage{Stage: stage, In: in, Out: out, Dropped: in - out})
+}
+
+func (s *Service) Explanation() Explanation {
+	s.explain.mu.Lock()
+	defer s.explain.mu.Unlock()
+
+	return Explanation{
+		ServerFilters: append([]ExplainFilter(nil), s.explain.serverFilters...),
+		ClientFilters: append([]ExplainFilter(nil), s.explain.clientFilters...),
+		Calls:         append([]ExplainCall(nil), s.explain.calls...),
+		Stages:        append([]ExplainStage(nil), s.explain.stages...),
+	}
+}
+
+func describeClientFilters(filters IssueFilters) []ExplainFilter {
+	described := make([]ExplainFilter, 0)
+	add := func(name string, value string) {
+		described = append(described, ExplainFilter{Name: name, Value: value})
+	}
+	if filters.Environment != "" {
+		add("env", filters.Environment.String())
+	} else if len(filters.HiddenEnvironments) > 0 {
+		hidden := make([]string, 0, len(filters.HiddenEnvironments))
+		for _, environment := range filters.HiddenEnvironments {
+			hidden = append(hidden, environment.String())
+		}
+		add("hidden_environments", strings.Join(hidden, ","))
+	}
+	if filters.Status != "" {
+		add("status", filters.Status.String())
+	}
+	if filters.Since != nil {
+		add("since", filters.Since.UTC().Format(time.RFC3339))
+	}
+	if filters.Until != nil {
+		add("until", filters.Until.UTC().Format(time.RFC3339))
+	}
+	if filters.MinOccurrences != nil {
+		add("min_occurrences", fmt.Sprint(*filters.MinOccurrences))
+	}
+	if filters.MaxOccurrences != nil {
+		add("max_occurrences", fmt.Sprint(*filters.MaxOccurrences))
+	}
+	if filters.MinAge != nil {
+		add("min_age", filters.MinAge.String())
+	}
+	if filters.MaxAge != nil {
+		add("max_age", filters.MaxAge.String())
+	}
+	if filters.MinLevel != "" {
+		add("min_level", filters.MinLevel.String())
+	}
+	if len(filters.Levels) > 0 {
+		names := make([]string, 0, len(filters.Levels))
+		for _, level := range filters.Levels {
+			names = append(names, level.String())
+		}
+		add("levels", strings.Join(names, ","))
+	}
+
+	return described
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceRecentExplanation(t *testing.T) {
+	t.Parallel()
+
+	occurrences := func(value uint64) *uint64 { return &value }
+	minOccurrences := uint64(10)
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Environment: "prod", TotalOccurrences: occurrences(50)},
+		{ID: 2, Counter: 2, Environment: "prod", TotalOccurrences: occurrences(20)},
+		{ID: 3, Counter: 3, Environment: "prod", TotalOccurrences: occurrences(2)},
+		{ID: 4, Counter: 4, Environment: "staging", TotalOccurrences: occurrences(90)},
+	}})
+
+	issues, err := service.Recent(context.Background(), 1, IssueFilters{Environment: "production", MinOccurrences: &minOccurrences})
+	if err != nil || len(issues) != 1 {
+		t.Fatalf("Recent() = %+v, %v", issues, err)
+	}
+
+	want := Explanation{
+		ServerFilters: []ExplainFilter{{Name: "status", Value: "active"}},
+		ClientFilters: []ExplainFilter{{Name: "env", Value: "production"}, {Name: "min_occurrences", Value: "10"}},
+		Calls:         []ExplainCall{{Endpoint: "/items", Params: map[string]string{"page": "1", "status": "active"}, Results: 4}},
+		Stages: []ExplainStage{
+			{Stage: "env", In: 4, Out: 3, Dropped: 1},
+			{Stage: "occurrences", In: 3, Out: 2, Dropped: 1},
+			{Stage: "limit", In: 2, Out: 1, Dropped: 1},
+		},
+	}
+	if got := service.Explanation(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Explanation() = %+v, want %+v", got, want)
+	}
+}
+
+func TestServiceActiveExplanationHiddenEnvironments(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{activeItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Environment: "production"},
+		{ID: 2, Counter: 2, Environment: "dev"},
+	}})
+	if !NewService(fakeAPI{}).Explanation().Empty() {
+		t.Fatalf("expected a fresh service to have an empty explanation")
+	}
+
+	if _, err := service.Active(context.Background(), 10, IssueFilters{HiddenEnvironments: []domain.Environment{"development", "ci"}}); err != nil {
+		t.Fatalf("Active() error = %v", err)
+	}
+	got := service.Explanation()
+	if len(got.ServerFilters) != 0 || !reflect.DeepEqual(got.ClientFilters, []ExplainFilter{{Name: "hidden_environments", Value: "development,ci"}}) {
+		t.Fatalf("unexpected filters: %+v", got)
+	}
+	if !reflect.DeepEqual(got.Calls, []ExplainCall{{Endpoint: "/reports/top_active_items", Results: 2}}) {
+		t.Fatalf("unexpected calls: %+v", got.Calls)
+	}
+	if !reflect.DeepEqual(got.Stages, []ExplainStage{{Stage: "hidden_environments", In: 2, Out: 1, Dropped: 1}}) {
+		t.Fatalf("unexpected stages: %+v", got.Stages)
+	}
+}
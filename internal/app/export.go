@@ -0,0 +1,53 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type ExportData struct {
+	Issues      []IssueSummary
+	Occurrences []ExportedOccurrence
+}
+
+type ExportedOccurrence struct {
+	ItemID   domain.ItemID
+	Instance rollbar.ItemInstance
+}
+
+func (s *Service) Export(ctx context.Context, filters IssueFilters, perIssue int) (ExportData, error) {
+	issues, err := s.RecentAll(ctx, filters)
+	if err != nil {
+		return ExportData{}, err
+	}
+	if perIssue <= 0 {
+		return ExportData{Issues: issues}, nil
+	}
+
+	perPage := min(perIssue, maxOccurrencesPerPage)
+	pages, skipped, err := mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) ([]ExportedOccurrence, error) {
+		instances, err := s.api.ListInstances(ctx, issue.ItemID, rollbar.InstanceListOptions{Page: 1, PerPage: perPage})
+		if err != nil {
+			return nil, fmt.Errorf("list occurrences for issue %s: %w", issue.Counter.String(), err)
+		}
+		exported := make([]ExportedOccurrence, 0, len(instances))
+		for _, instance := range instances {
+			exported = append(exported, ExportedOccurrence{ItemID: issue.ItemID, Instance: instance})
+		}
+		return exported, nil
+	})
+	if err != nil {
+		return ExportData{}, err
+	}
+	s.warnSkipped(skipped, "exported no occurrences for %d of %d issues (request budget or rate limit)")
+
+	data := ExportData{Issues: issues}
+	for _, page := range pages {
+		data.Occurrences = append(data.Occurrences, page...)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceExport(t *testing.T) {
+	t.Parallel()
+
+	api := fakeAPI{
+		listItems: []rollbar.Item{{ID: 1, Counter: 1}, {ID: 2, Counter: 2}},
+		instances: []rollbar.ItemInstance{{ID: 10}, {ID: 11}},
+	}
+
+	data, err := NewService(api).Export(context.Background(), IssueFilters{}, 2)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(data.Issues) != 2 || len(data.Occurrences) != 4 {
+		t.Fatalf("unexpected export: %+v", data)
+	}
+	if data.Occurrences[0].ItemID != 1 || data.Occurrences[2].ItemID != 2 || data.Occurrences[3].Instance.ID != 11 {
+		t.Fatalf("occurrences not attributed to their issues: %+v", data.Occurrences)
+	}
+
+	issuesOnly, err := NewService(api).Export(context.Background(), IssueFilters{}, 0)
+	if err != nil || len(issuesOnly.Issues) != 2 || len(issuesOnly.Occurrences) != 0 {
+		t.Fatalf("Export(0) = %+v, %v", issuesOnly, err)
+	}
+}
+
+func TestServiceExportErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).Export(context.Background(), IssueFilters{}, 1); err == nil {
+		t.Fatalf("expected list error")
+	}
+	api := instancesErrorAPI{fakeAPI{listItems: []rollbar.Item{{ID: 1, Counter: 1}}}}
+	if _, err := NewService(api).Export(context.Background(), IssueFilters{}, 1); err == nil {
+		t.Fatalf("expected occurrences error")
+	}
+}
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+const (
+	defaultFileScanItems = 50
+	minMatchingSegments  = 2
+)
+
+type FileIssue struct {
+	Issue  IssueSummary    `json:"issue"`
+	Frames []summary.Frame `json:"frames"`
+}
+
+func (s *Service) IssuesForFile(ctx context.Context, path string, scan int, filters IssueFilters) ([]FileIssue, error) {
+	path = cleanFramePath(path)
+	if path == "" {
+		return nil, fmt.Errorf("file path is required")
+	}
+	if scan <= 0 {
+		scan = defaultFileScanItems
+	}
+
+	issues, err := s.Recent(ctx, scan, filters)
+	if err != nil {
+		return nil, err
+	}
+	traces, skipped, err := mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) ([]summary.Trace, error) {
+		instance, err := s.api.GetLatestInstance(ctx, issue.ItemID)
+		if err != nil || instance == nil {
+			return nil, err
+		}
+		return summary.Traces(instance.Body, instance.Data), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get latest occurrences: %w", err)
+	}
+	s.warnSkipped(skipped, "could not check stack frames for %d of %d issues (request budget or rate limit)")
+
+	matches := make([]FileIssue, 0)
+	for index, issue := range issues {
+		var frames []summary.Frame
+		for _, trace := range traces[index] {
+			for _, frame := range trace.Frames {
+				if framePathMatches(cleanFramePath(frame.Filename), path) {
+					frames = append(frames, frame)
+				}
+			}
+		}
+		if len(frames) > 0 {
+			issue.Raw = nil
+			matches = append(matches, FileIssue{Issue: issue, Frames: frames})
+		}
+	}
+
+	return matches, nil
+}
+
+func cleanFramePath(path string) string {
+	return strings.TrimPrefix(strings.ReplaceAll(strings.TrimSpace(path), `\`, "/"), "./")
+}
+
+func framePathMatches(frame string, path string) bool {
+	if frame == "" {
+		return false
+	}
+	left := strings.Split(strings.Trim(frame, "/"), "/")
+	right := strings.Split(strings.Trim(path, "/"), "/")
+
+	return commonTrailingSegments(frame, path) >= min(len(left), len(right), minMatchingSegments)
+}
+
+func commonTrailingSegments(left string, right string) int {
+	leftSegments := strings.Split(strings.Trim(left, "/"), "/")
+	rightSegments := strings.Split(strings.Trim(right, "/"), "/")
+	common := 0
+	for common < len(leftSegments) && common < len(rightSegments) && leftSegments[len(leftSegments)-1-common] == rightSegments[len(rightSegments)-1-common] {
+		common++
+	}
+
+	return common
+}
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+func TestServiceIssuesForFile(t *testing.T) {
+	t.Parallel()
+
+	api := &routeAPI{
+		fakeAPI: fakeAPI{listItems: []rollbar.Item{
+			{ID: 1, Counter: 1, Title: "invoice total is negative"},
+			{ID: 2, Counter: 2, Title: "handler panic"},
+			{ID: 3, Counter: 3, Title: "no occurrence"},
+		}},
+		instances: map[domain.ItemID]*rollbar.ItemInstance{
+			1: {Data: json.RawMessage(`{"body":{"trace":{"frames":[{"filename":"app/billing/invoice.go","lineno":42,"method":"Total"},{"filename":"app/web/handler.go","lineno":7}]}}}`)},
+			2: {Data: json.RawMessage(`{"body":{"trace":{"frames":[{"filename":"/srv/app/web/handler.go","lineno":9}]}}}`)},
+		},
+	}
+	service := NewService(api)
+
+	tests := []struct {
+		name string
+		path string
+		want map[domain.ItemCounter][]int
+	}{
+		{name: "relative path", path: "app/billing/invoice.go", want: map[domain.ItemCounter][]int{1: {42}}},
+		{name: "absolute editor path", path: "/home/dev/shop/app/web/handler.go", want: map[domain.ItemCounter][]int{1: {7}, 2: {9}}},
+		{name: "windows separators", path: `.\app\billing\invoice.go`, want: map[domain.ItemCounter][]int{1: {42}}},
+		{name: "partial name", path: "voice.go", want: map[domain.ItemCounter][]int{}},
+	}
+
+	for _, tt := range tests {
+		got, err := service.IssuesForFile(context.Background(), tt.path, 0, IssueFilters{})
+		if err != nil {
+			t.Fatalf("%s: IssuesForFile() error = %v", tt.name, err)
+		}
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: IssuesForFile() = %+v", tt.name, got)
+		}
+		for _, match := range got {
+			if lines := tt.want[match.Issue.Counter]; !sameFrameLines(match.Frames, lines) {
+				t.Fatalf("%s: issue %d frames = %+v, want lines %v", tt.name, match.Issue.Counter, match.Frames, lines)
+			}
+		}
+	}
+
+	if _, err := service.IssuesForFile(context.Background(), " ", 0, IssueFilters{}); err == nil {
+		t.Fatalf("expected an error for an empty path")
+	}
+}
+
+func sameFrameLines(frames []summary.Frame, lines []int) bool {
+	if len(frames) != len(lines) {
+		return false
+	}
+	for index, frame := range frames {
+		if frame.Line != lines[index] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const defaultFindLimit = 50
+
+var customKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+var findColumns = []string{"item.counter", "item.title", "timestamp", "environment", "request.url", "person.id"}
+
+type FindQuery struct {
+	URL         string
+	User        string
+	CustomKey   string
+	CustomValue string
+	Environment string
+	Since       *time.Time
+	Limit       int
+}
+
+func BuildFindRQL(q FindQuery) (string, error) {
+	conditions := make([]string, 0, 5)
+	if q.URL != "" {
+		conditions = append(conditions, "request.url LIKE "+rqlString("%"+likeEscaper.Replace(q.URL)+"%")+" ESCAPE '!'")
+	}
+	if q.User != "" {
+		user := rqlString(q.User)
+		conditions = append(conditions, fmt.Sprintf("(person.id = %s OR person.username = %s OR person.email = %s)", user, user, user))
+	}
+	if q.CustomKey != "" {
+		if !customKeyPattern.MatchString(q.CustomKey) {
+			return "", fmt.Errorf("invalid custom key %q: use letters, digits, underscores, and dots", q.CustomKey)
+		}
+		conditions = append(conditions, fmt.Sprintf("custom.%s = %s", q.CustomKey, rqlString(q.CustomValue)))
+	}
+	if len(conditions) == 0 {
+		return "", errors.New("at least one search criterion is required")
+	}
+	if q.Environment != "" {
+		conditions = append(conditions, "environment = "+rqlString(q.Environment))
+	}
+	if q.Since != nil {
+		conditions = append(conditions, fmt.Sprintf("timestamp >= %d", q.Since.Unix()))
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultFindLimit
+	}
+
+	return fmt.Sprintf("SELECT %s FROM item_occurrence WHERE %s ORDER BY timestamp DESC LIMIT %d",
+		strings.Join(findColumns, ", "), strings.Join(conditions, " AND "), limit), nil
+}
+
+// ! is the ESCAPE character so it cannot collide with string-literal escapes.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+func rqlString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+func (s *Service) Find(ctx context.Context, q FindQuery) (RQLResult, error) {
+	query, err := BuildFindRQL(q)
+	if err != nil {
+		return RQLResult{}, err
+	}
+
+	return s.runRQL(ctx, query, nil)
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestBuildFindRQL(t *testing.T) {
+	t.Parallel()
+
+	since := time.Unix(1_700_000_000, 0)
+	tests := []struct {
+		name    string
+		query   FindQuery
+		want    string
+		wantErr string
+	}{
+		{
+			name:  "url since",
+			query: FindQuery{URL: "/checkout", Since: &since},
+			want:  "SELECT item.counter, item.title, timestamp, environment, request.url, person.id FROM item_occurrence WHERE request.url LIKE '%/checkout%' ESCAPE '!' AND timestamp >= 1700000000 ORDER BY timestamp DESC LIMIT 50",
+		},
+		{
+			name:  "url wildcards match literally",
+			query: FindQuery{URL: `/a_b%c!d's`},
+			want:  "SELECT item.counter, item.title, timestamp, environment, request.url, person.id FROM item_occurrence WHERE request.url LIKE '%/a!_b!%c!!d\\'s%' ESCAPE '!' ORDER BY timestamp DESC LIMIT 50",
+		},
+		{
+			name:  "user with environment and limit",
+			query: FindQuery{User: "o'brien", Environment: "production", Limit: 5},
+			want:  "SELECT item.counter, item.title, timestamp, environment, request.url, person.id FROM item_occurrence WHERE (person.id = 'o\\'brien' OR person.username = 'o\\'brien' OR person.email = 'o\\'brien') AND environment = 'production' ORDER BY timestamp DESC LIMIT 5",
+		},
+		{
+			name:  "custom key",
+			query: FindQuery{CustomKey: "tenant.id", CustomValue: `a\b`},
+			want:  "SELECT item.counter, item.title, timestamp, environment, request.url, person.id FROM item_occurrence WHERE custom.tenant.id = 'a\\\\b' ORDER BY timestamp DESC LIMIT 50",
+		},
+		{name: "invalid key", query: FindQuery{CustomKey: "x = 1 OR 1", CustomValue: "y"}, wantErr: "invalid custom key"},
+		{name: "no criteria", query: FindQuery{Environment: "production"}, wantErr: "at least one search criterion"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := BuildFindRQL(tc.query)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("BuildFindRQL() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("BuildFindRQL() error = %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("BuildFindRQL() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+type pollingRQLAPI struct {
+	fakeAPI
+	statuses []string
+	polls    *int
+}
+
+func (p pollingRQLAPI) CreateRQLJob(ctx context.Context, query string) (rollbar.RQLJob, error) {
+	return rollbar.RQLJob{ID: 7, Status: "new", QueryString: query}, nil
+}
+
+func (p pollingRQLAPI) GetRQLJob(ctx context.Context, jobID uint64) (rollbar.RQLJob, error) {
+	status := p.statuses[min(*p.polls, len(p.statuses)-1)]
+	*p.polls++
+
+	return rollbar.RQLJob{ID: jobID, Status: status}, nil
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	result := rollbar.RQLResult{Columns: []string{"item.counter"}, Rows: [][]any{{"42"}}}
+	tests := []struct {
+		name      string
+		statuses  []string
+		wantPolls int
+		wantErr   string
+	}{
+		{name: "waits for success", statuses: []string{"running", "running", rollbar.RQLJobSuccess}, wantPolls: 3},
+		{name: "failed job", statuses: []string{rollbar.RQLJobFailed}, wantPolls: 1, wantErr: "ended with status failed"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			polls := 0
+			service := NewService(pollingRQLAPI{fakeAPI: fakeAPI{rqlResult: result}, statuses: tc.statuses, polls: &polls})
+			service.rqlPollPeriod = time.Millisecond
+
+			got, err := service.Find(context.Background(), FindQuery{URL: "/checkout"})
+			if polls != tc.wantPolls {
+				t.Fatalf("polls = %d, want %d", polls, tc.wantPolls)
+			}
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("Find() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Find() error = %v", err)
+			}
+			if !strings.Contains(got.Query, "request.url LIKE '%/checkout%'") || len(got.Rows) != 1 || got.Columns[0] != "item.counter" {
+				t.Fatalf("Find() = %#v", got)
+			}
+		})
+	}
+}
+
+func TestFindErrors(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{err: errors.New("boom")})
+	if _, err := service.Find(context.Background(), FindQuery{URL: "/x"}); err == nil || !strings.Contains(err.Error(), "create rql job") {
+		t.Fatalf("Find() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	polls := 0
+	service = NewService(pollingRQLAPI{statuses: []string{"running"}, polls: &polls})
+	if _, err := service.Find(ctx, FindQuery{URL: "/x"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Find() error = %v, want context.Canceled", err)
+	}
+}
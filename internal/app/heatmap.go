@@ -0,0 +1,51 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const heatmapWeeks = 4
+
+// Cells[time.Monday][9] is Monday 09:00-09:59 in the service clock's zone.
+type OccurrenceHeatmap struct {
+	Weeks    int           `json:"weeks"`
+	TimeZone string        `json:"time_zone"`
+	Cells    [7][24]uint64 `json:"cells"`
+	Peak     uint64        `json:"peak"`
+}
+
+func (s *Service) OccurrenceHeatmap(ctx context.Context, itemID domain.ItemID) (OccurrenceHeatmap, error) {
+	now := s.Now()
+	counts, err := s.api.GetOccurrenceCounts(ctx, rollbar.OccurrenceCountsQuery{
+		ItemID:       itemID,
+		MinTimestamp: now.Add(-heatmapWeeks * 7 * 24 * time.Hour).Unix(),
+		MaxTimestamp: now.Unix(),
+		BucketSize:   releaseBucketSize,
+	})
+	if err != nil {
+		return OccurrenceHeatmap{}, fmt.Errorf("get occurrence counts: %w", err)
+	}
+
+	return buildOccurrenceHeatmap(counts, s.now().Location()), nil
+}
+
+func buildOccurrenceHeatmap(counts []rollbar.OccurrenceCount, location *time.Location) OccurrenceHeatmap {
+	heatmap := OccurrenceHeatmap{Weeks: heatmapWeeks, TimeZone: location.String()}
+	for _, count := range counts {
+		if count.Timestamp > math.MaxInt64 {
+			continue
+		}
+		moment := time.Unix(int64(count.Timestamp), 0).In(location)
+		cell := &heatmap.Cells[moment.Weekday()][moment.Hour()]
+		*cell += count.Count
+		heatmap.Peak = max(heatmap.Peak, *cell)
+	}
+
+	return heatmap
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceOccurrenceHeatmap(t *testing.T) {
+	t.Parallel()
+
+	monday9 := time.Date(2024, 2, 26, 9, 30, 0, 0, time.UTC)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewService(fakeAPI{
+		counts: []rollbar.OccurrenceCount{
+			{Timestamp: uint64(monday9.Unix()), Count: 4},
+			{Timestamp: uint64(monday9.Add(7 * 24 * time.Hour).Unix()), Count: 6},
+			{Timestamp: uint64(monday9.Add(3 * time.Hour).Unix()), Count: 2},
+		},
+	}, WithClock(func() time.Time { return now }))
+
+	heatmap, err := service.OccurrenceHeatmap(context.Background(), 123)
+	if err != nil {
+		t.Fatalf("OccurrenceHeatmap() error = %v", err)
+	}
+	if heatmap.Cells[time.Monday][9] != 10 || heatmap.Cells[time.Monday][12] != 2 || heatmap.Peak != 10 {
+		t.Fatalf("unexpected heatmap: %+v", heatmap)
+	}
+	if heatmap.Weeks != heatmapWeeks || heatmap.TimeZone != "UTC" {
+		t.Fatalf("unexpected heatmap window: %+v", heatmap)
+	}
+
+	local := time.FixedZone("UTC+2", 2*60*60)
+	service = NewService(fakeAPI{counts: []rollbar.OccurrenceCount{{Timestamp: uint64(monday9.Unix()), Count: 1}}},
+		WithClock(func() time.Time { return now.In(local) }))
+	if heatmap, err = service.OccurrenceHeatmap(context.Background(), 123); err != nil {
+		t.Fatalf("OccurrenceHeatmap() error = %v", err)
+	}
+	if heatmap.Cells[time.Monday][11] != 1 || heatmap.TimeZone != "UTC+2" {
+		t.Fatalf("expected clock time zone to be kept, got %+v", heatmap)
+	}
+}
+
+func TestBuildOccurrenceHeatmapUsesLocation(t *testing.T) {
+	t.Parallel()
+
+	location := time.FixedZone("UTC-5", -5*60*60)
+	moment := time.Date(2024, 2, 27, 2, 0, 0, 0, time.UTC)
+	heatmap := buildOccurrenceHeatmap([]rollbar.OccurrenceCount{{Timestamp: uint64(moment.Unix()), Count: 1}}, location)
+
+	if heatmap.Cells[time.Monday][21] != 1 {
+		t.Fatalf("expected Monday 21:00 in UTC-5, got %+v", heatmap.Cells)
+	}
+}
+
+func TestServiceOccurrenceHeatmapError(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{err: errors.New("boom")})
+	if _, err := service.OccurrenceHeatmap(context.Background(), 123); err == nil {
+		t.Fatalf("expected api error")
+	}
+}
@@ -0,0 +1,73 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const (
+	maxMatchItemPages     = 5
+	maxMatchCandidateList = 10
+)
+
+type AmbiguousMatchError struct {
+	Pattern    string
+	Candidates []IssueSummary
+}
+
+func (e *AmbiguousMatchError) Error() string {
+	lines := []string{fmt.Sprintf("%d issues match %q; rerun with one of these counters:", len(e.Candidates), e.Pattern)}
+	for index, candidate := range e.Candidates {
+		if index == maxMatchCandidateList {
+			lines = append(lines, fmt.Sprintf("  ... and %d more", len(e.Candidates)-index))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("  #%s  %s  %s", candidate.Counter.String(), candidate.Status.String(), candidate.Title))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (s *Service) FindIssueByTitle(ctx context.Context, pattern string) (IssueSummary, error) {
+	terms := strings.Fields(strings.ToLower(pattern))
+	if len(terms) == 0 {
+		return IssueSummary{}, errors.New("match pattern is required")
+	}
+
+	matches := make([]rollbar.Item, 0)
+	_, err := s.scanItemPages(ctx, "", maxMatchItemPages, func(items []rollbar.Item) bool {
+		for _, item := range items {
+			if titleMatches(item.Title, terms) {
+				matches = append(matches, item)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return IssueSummary{}, fmt.Errorf("list items: %w", err)
+	}
+
+	switch len(matches) {
+	case 0:
+		return IssueSummary{}, fmt.Errorf("no issue title matches %q", pattern)
+	case 1:
+		return s.mapSummary(matches[0]), nil
+	default:
+		return IssueSummary{}, &AmbiguousMatchError{Pattern: pattern, Candidates: s.mapSummaries(sortRecentItems(matches))}
+	}
+}
+
+func titleMatches(title string, terms []string) bool {
+	title = strings.ToLower(title)
+	for _, term := range terms {
+		if !strings.Contains(title, term) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,64 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceFindIssueByTitle(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 11, Title: "ECONNRESET talking to payments-api", Status: "active"},
+		{ID: 2, Counter: 12, Title: "ECONNRESET talking to search", Status: "resolved"},
+		{ID: 3, Counter: 13, Title: "Timeout in payments webhook", Status: "active"},
+	}})
+
+	tests := []struct {
+		pattern     string
+		wantCounter domain.ItemCounter
+		wantErr     string
+	}{
+		{pattern: "econnreset PAYMENTS", wantCounter: 11},
+		{pattern: "search", wantCounter: 12},
+		{pattern: "econnreset", wantErr: "2 issues match"},
+		{pattern: "deadlock", wantErr: "no issue title matches"},
+		{pattern: "  ", wantErr: "pattern is required"},
+	}
+
+	for _, tt := range tests {
+		issue, err := service.FindIssueByTitle(context.Background(), tt.pattern)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("FindIssueByTitle(%q) error = %v, want %q", tt.pattern, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || issue.Counter != tt.wantCounter {
+			t.Fatalf("FindIssueByTitle(%q) = %+v, %v", tt.pattern, issue, err)
+		}
+	}
+}
+
+func TestServiceFindIssueByTitleListsCandidates(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 11, Title: "ECONNRESET to payments", Status: "active"},
+		{ID: 2, Counter: 12, Title: "ECONNRESET to payments (retry)", Status: "muted"},
+	}})
+
+	_, err := service.FindIssueByTitle(context.Background(), "econnreset payments")
+	var ambiguous *AmbiguousMatchError
+	if !errors.As(err, &ambiguous) || len(ambiguous.Candidates) != 2 {
+		t.Fatalf("expected ambiguous match error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "#12  muted  ECONNRESET to payments (retry)") {
+		t.Fatalf("expected candidates in error, got %q", err.Error())
+	}
+}
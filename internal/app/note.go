@@ -0,0 +1,22 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func (s *Service) AddItemNote(ctx context.Context, itemID domain.ItemID, text string) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return errors.New("note text is required")
+	}
+	if err := s.api.AddItemComment(ctx, itemID, text); err != nil {
+		return fmt.Errorf("add note to item %s: %w", itemID.String(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestServiceAddItemNote(t *testing.T) {
+	t.Parallel()
+
+	api := &actionAPI{}
+	service := NewService(api)
+	if err := service.AddItemNote(context.Background(), 70, "  GitHub issue: https://github.com/acme/api/issues/42\n"); err != nil {
+		t.Fatalf("AddItemNote() error = %v", err)
+	}
+	if !reflect.DeepEqual(api.comments, []string{"GitHub issue: https://github.com/acme/api/issues/42"}) {
+		t.Fatalf("comments = %q", api.comments)
+	}
+
+	if err := service.AddItemNote(context.Background(), 70, " "); err == nil {
+		t.Fatalf("expected empty note error")
+	}
+	api.commentErr = errors.New("denied")
+	if err := service.AddItemNote(context.Background(), 70, "note"); err == nil {
+		t.Fatalf("expected comment error")
+	}
+}
@@ -0,0 +1,175 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+var volatileOccurrencePaths = []string{"timestamp", "uuid", "metadata"}
+
+var notableOccurrencePaths = []string{"request", "code_version", "server", "client", "environment", "context"}
+
+type OccurrenceChange struct {
+	Path    string `json:"path"`
+	Kind    string `json:"kind"`
+	Left    any    `json:"left"`
+	Right   any    `json:"right"`
+	Notable bool   `json:"notable"`
+}
+
+type OccurrenceDiff struct {
+	Left       domain.OccurrenceID `json:"left"`
+	Right      domain.OccurrenceID `json:"right"`
+	LeftError  string              `json:"left_error"`
+	RightError string              `json:"right_error"`
+	Changes    []OccurrenceChange  `json:"changes"`
+	Ignored    []string            `json:"ignored"`
+}
+
+func (d OccurrenceDiff) SameError() bool {
+	return d.LeftError == d.RightError
+}
+
+func (s *Service) GetOccurrences(ctx context.Context, ids ...domain.OccurrenceID) ([]rollbar.ItemInstance, error) {
+	occurrences := make([]rollbar.ItemInstance, 0, len(ids))
+	for _, id := range ids {
+		occurrence, err := s.api.GetInstance(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("get occurrence %s: %w", id.String(), err)
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+
+	return occurrences, nil
+}
+
+func DiffOccurrences(left rollbar.ItemInstance, right rollbar.ItemInstance, all bool) (OccurrenceDiff, error) {
+	leftData, err := decodePayload(left.Data)
+	if err != nil {
+		return OccurrenceDiff{}, fmt.Errorf("decode occurrence %s: %w", left.ID.String(), err)
+	}
+	rightData, err := decodePayload(right.Data)
+	if err != nil {
+		return OccurrenceDiff{}, fmt.Errorf("decode occurrence %s: %w", right.ID.String(), err)
+	}
+
+	diff := OccurrenceDiff{
+		Left:       left.ID,
+		Right:      right.ID,
+		LeftError:  summary.MainError(left.Body, left.Data),
+		RightError: summary.MainError(right.Body, right.Data),
+		Changes:    []OccurrenceChange{},
+		Ignored:    []string{},
+	}
+	if !all {
+		diff.Ignored = append(diff.Ignored, volatileOccurrencePaths...)
+	}
+	diffPayloads(&diff, "", leftData, rightData)
+
+	return diff, nil
+}
+
+func decodePayload(raw json.RawMessage) (any, error) {
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return map[string]any{}, nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func diffPayloads(diff *OccurrenceDiff, path string, left any, right any) {
+	leftMap, leftIsMap := left.(map[string]any)
+	rightMap, rightIsMap := right.(map[string]any)
+	if leftIsMap && rightIsMap {
+		keys := make([]string, 0, len(leftMap)+len(rightMap))
+		for key := range leftMap {
+			keys = append(keys, key)
+		}
+		for key := range rightMap {
+			if _, ok := leftMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			diffMember(diff, joinPayloadPath(path, key), leftMap, rightMap, key)
+		}
+		return
+	}
+
+	leftList, leftIsList := left.([]any)
+	rightList, rightIsList := right.([]any)
+	if leftIsList && rightIsList {
+		for index := range max(len(leftList), len(rightList)) {
+			elementPath := path + "[" + strconv.Itoa(index) + "]"
+			switch {
+			case index >= len(leftList):
+				diff.add(elementPath, "added", nil, rightList[index])
+			case index >= len(rightList):
+				diff.add(elementPath, "removed", leftList[index], nil)
+			default:
+				diffPayloads(diff, elementPath, leftList[index], rightList[index])
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(left, right) {
+		diff.add(path, "changed", left, right)
+	}
+}
+
+func diffMember(diff *OccurrenceDiff, path string, left map[string]any, right map[string]any, key string) {
+	if slices.Contains(diff.Ignored, path) {
+		return
+	}
+	leftValue, inLeft := left[key]
+	rightValue, inRight := right[key]
+	switch {
+	case !inLeft:
+		diff.add(path, "added", nil, rightValue)
+	case !inRight:
+		diff.add(path, "removed", leftValue, nil)
+	default:
+		diffPayloads(diff, path, leftValue, rightValue)
+	}
+}
+
+func (d *OccurrenceDiff) add(path string, kind string, left any, right any) {
+	d.Changes = append(d.Changes, OccurrenceChange{Path: path, Kind: kind, Left: left, Right: right, Notable: notablePayloadPath(path)})
+}
+
+func joinPayloadPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func notablePayloadPath(path string) bool {
+	for _, prefix := range notableOccurrencePaths {
+		if path == prefix || strings.HasPrefix(path, prefix+".") || strings.HasPrefix(path, prefix+"[") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestDiffOccurrences(t *testing.T) {
+	t.Parallel()
+
+	left := rollbar.ItemInstance{ID: 1, Data: json.RawMessage(`{
+		"timestamp": 1700000000, "uuid": "a",
+		"code_version": "v1",
+		"server": {"host": "web-1"},
+		"request": {"params": {"id": 5, "debug": true}},
+		"trace": {"exception": {"class": "Timeout", "message": "read timeout"}, "frames": [{"lineno": 10}, {"lineno": 20}]}
+	}`)}
+	right := rollbar.ItemInstance{ID: 2, Data: json.RawMessage(`{
+		"timestamp": 1700000100, "uuid": "b",
+		"code_version": "v2",
+		"server": {"host": "web-1"},
+		"request": {"params": {"id": 5, "page": 2}},
+		"trace": {"exception": {"class": "Timeout", "message": "read timeout"}, "frames": [{"lineno": 11}]}
+	}`)}
+
+	diff, err := DiffOccurrences(left, right, false)
+	if err != nil {
+		t.Fatalf("DiffOccurrences() error = %v", err)
+	}
+	want := []OccurrenceChange{
+		{Path: "code_version", Kind: "changed", Left: "v1", Right: "v2", Notable: true},
+		{Path: "request.params.debug", Kind: "removed", Left: true, Notable: true},
+		{Path: "request.params.page", Kind: "added", Right: json.Number("2"), Notable: true},
+		{Path: "trace.frames[0].lineno", Kind: "changed", Left: json.Number("10"), Right: json.Number("11")},
+		{Path: "trace.frames[1]", Kind: "removed", Left: map[string]any{"lineno": json.Number("20")}},
+	}
+	if len(diff.Changes) != len(want) {
+		t.Fatalf("unexpected changes: %+v", diff.Changes)
+	}
+	for index, change := range want {
+		got := diff.Changes[index]
+		if got.Path != change.Path || got.Kind != change.Kind || got.Notable != change.Notable || !jsonEqual(t, got.Left, change.Left) || !jsonEqual(t, got.Right, change.Right) {
+			t.Fatalf("change %d = %+v, want %+v", index, got, change)
+		}
+	}
+	if !diff.SameError() || diff.LeftError != "read timeout" {
+		t.Fatalf("expected the same main error, got %q and %q", diff.LeftError, diff.RightError)
+	}
+
+	all, err := DiffOccurrences(left, right, true)
+	if err != nil {
+		t.Fatalf("DiffOccurrences() error = %v", err)
+	}
+	if len(all.Ignored) != 0 || len(all.Changes) != len(want)+2 {
+		t.Fatalf("expected timestamp and uuid changes with all, got %+v", all.Changes)
+	}
+
+	if _, err := DiffOccurrences(rollbar.ItemInstance{Data: json.RawMessage(`{`)}, right, false); err == nil {
+		t.Fatal("expected an invalid payload to fail")
+	}
+}
+
+func jsonEqual(t *testing.T, left any, right any) bool {
+	t.Helper()
+	leftJSON, err := json.Marshal(left)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rightJSON, err := json.Marshal(right)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(leftJSON) == string(rightJSON)
+}
+
+func TestServiceGetOccurrences(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{instances: []rollbar.ItemInstance{{ID: 7}, {ID: 8}}})
+	occurrences, err := service.GetOccurrences(context.Background(), 8, 7)
+	if err != nil {
+		t.Fatalf("GetOccurrences() error = %v", err)
+	}
+	if len(occurrences) != 2 || occurrences[0].ID != 8 || occurrences[1].ID != 7 {
+		t.Fatalf("unexpected occurrences: %+v", occurrences)
+	}
+	if _, err := service.GetOccurrences(context.Background(), 7, 9); !errors.Is(err, rollbar.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/parallel"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const maxOccurrencesPerPage = 100
+
+func (s *Service) RecentOccurrences(ctx context.Context, counter domain.ItemCounter, last int, fetched func(int)) ([]rollbar.ItemInstance, error) {
+	if last <= 0 {
+		return nil, fmt.Errorf("occurrence count must be positive")
+	}
+
+	itemID, err := s.api.ResolveItemIDByCounter(ctx, counter)
+	if err != nil {
+		return nil, fmt.Errorf("resolve item id: %w", err)
+	}
+
+	perPage := min(last, maxOccurrencesPerPage)
+	pages := make([]int, 0, (last+perPage-1)/perPage)
+	for page := 1; len(pages)*perPage < last; page++ {
+		pages = append(pages, page)
+	}
+
+	results, err := parallel.Map(ctx, defaultConcurrency, pages, func(ctx context.Context, page int) ([]rollbar.ItemInstance, error) {
+		instances, err := s.api.ListInstances(ctx, itemID, rollbar.InstanceListOptions{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, fmt.Errorf("list occurrences page %d: %w", page, err)
+		}
+		if fetched != nil {
+			fetched(len(instances))
+		}
+		return instances, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make([]rollbar.ItemInstance, 0, last)
+	for _, page := range results {
+		occurrences = append(occurrences, page...)
+	}
+	if len(occurrences) > last {
+		occurrences = occurrences[:last]
+	}
+
+	return occurrences, nil
+}
+
+type OccurrenceSummary struct {
+	ID          domain.OccurrenceID `json:"id"`
+	Timestamp   *uint64             `json:"timestamp"`
+	UUID        string              `json:"uuid,omitempty"`
+	Environment string              `json:"environment,omitempty"`
+	CodeVersion string              `json:"code_version,omitempty"`
+	MainError   string              `json:"main_error"`
+}
+
+type OccurrencePage struct {
+	ItemID      domain.ItemID       `json:"item_id"`
+	Page        int                 `json:"page"`
+	PerPage     int                 `json:"per_page"`
+	HasMore     bool                `json:"has_more"`
+	Occurrences []OccurrenceSummary `json:"occurrences"`
+}
+
+func (s *Service) ListOccurrences(ctx context.Context, counter domain.ItemCounter, page int, perPage int) (OccurrencePage, error) {
+	if page <= 0 {
+		return OccurrencePage{}, fmt.Errorf("page must be positive")
+	}
+	if perPage <= 0 || perPage > maxOccurrencesPerPage {
+		return OccurrencePage{}, fmt.Errorf("occurrences per page must be between 1 and %d", maxOccurrencesPerPage)
+	}
+
+	itemID, err := s.api.ResolveItemIDByCounter(ctx, counter)
+	if err != nil {
+		return OccurrencePage{}, fmt.Errorf("resolve item id: %w", err)
+	}
+
+	instances, err := s.api.ListInstances(ctx, itemID, rollbar.InstanceListOptions{Page: page, PerPage: perPage})
+	if err != nil {
+		return OccurrencePage{}, fmt.Errorf("list occurrences page %d: %w", page, err)
+	}
+
+	result := OccurrencePage{
+		ItemID:      itemID,
+		Page:        page,
+		PerPage:     perPage,
+		HasMore:     len(instances) >= perPage,
+		Occurrences: make([]OccurrenceSummary, 0, len(instances)),
+	}
+	for _, instance := range instances {
+		sampled := sampleInstance(instance)
+		result.Occurrences = append(result.Occurrences, OccurrenceSummary{
+			ID:          instance.ID,
+			Timestamp:   instance.Timestamp,
+			UUID:        sampled.UUID,
+			Environment: sampled.Environment,
+			CodeVersion: sampled.CodeVersion,
+			MainError:   sampled.MainError,
+		})
+	}
+
+	return result, nil
+}
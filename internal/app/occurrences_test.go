@@ -0,0 +1,128 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type pagedInstancesAPI struct {
+	fakeAPI
+	total int
+	mu    *sync.Mutex
+	seen  map[int]int
+}
+
+func (p pagedInstancesAPI) ListInstances(ctx context.Context, itemID domain.ItemID, opts rollbar.InstanceListOptions) ([]rollbar.ItemInstance, error) {
+	p.mu.Lock()
+	p.seen[opts.Page] = opts.PerPage
+	p.mu.Unlock()
+
+	instances := make([]rollbar.ItemInstance, 0, opts.PerPage)
+	for index := (opts.Page - 1) * opts.PerPage; index < min(opts.Page*opts.PerPage, p.total); index++ {
+		instances = append(instances, rollbar.ItemInstance{ID: domain.OccurrenceID(1000 - index)})
+	}
+
+	return instances, nil
+}
+
+func TestServiceRecentOccurrences(t *testing.T) {
+	t.Parallel()
+
+	api := pagedInstancesAPI{total: 250, mu: &sync.Mutex{}, seen: map[int]int{}}
+	var mu sync.Mutex
+	fetched := 0
+	occurrences, err := NewService(api).RecentOccurrences(context.Background(), 7, 230, func(count int) {
+		mu.Lock()
+		fetched += count
+		mu.Unlock()
+	})
+	if err != nil {
+		t.Fatalf("RecentOccurrences() error = %v", err)
+	}
+	if len(occurrences) != 230 || occurrences[0].ID != 1000 || occurrences[229].ID != 771 {
+		t.Fatalf("unexpected occurrences: len=%d", len(occurrences))
+	}
+	if len(api.seen) != 3 || api.seen[3] != maxOccurrencesPerPage || fetched != 250 {
+		t.Fatalf("unexpected pages requested: %+v, fetched=%d", api.seen, fetched)
+	}
+
+	short, err := NewService(pagedInstancesAPI{total: 3, mu: &sync.Mutex{}, seen: map[int]int{}}).RecentOccurrences(context.Background(), 7, 50, nil)
+	if err != nil || len(short) != 3 {
+		t.Fatalf("expected every available occurrence, got %d, %v", len(short), err)
+	}
+}
+
+func TestServiceRecentOccurrencesErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewService(fakeAPI{}).RecentOccurrences(context.Background(), 7, 0, nil); err == nil {
+		t.Fatalf("expected validation error")
+	}
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).RecentOccurrences(context.Background(), 7, 5, nil); err == nil {
+		t.Fatalf("expected resolve error")
+	}
+
+	if _, err := NewService(instancesErrorAPI{}).RecentOccurrences(context.Background(), 7, 5, nil); err == nil {
+		t.Fatalf("expected list error")
+	}
+}
+
+type instancesErrorAPI struct {
+	fakeAPI
+}
+
+func (instancesErrorAPI) ListInstances(ctx context.Context, itemID domain.ItemID, opts rollbar.InstanceListOptions) ([]rollbar.ItemInstance, error) {
+	return nil, errors.New("boom")
+}
+
+func TestServiceListOccurrences(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(pagedInstancesAPI{total: 45, mu: &sync.Mutex{}, seen: map[int]int{}})
+	tests := []struct {
+		name      string
+		page      int
+		perPage   int
+		wantLen   int
+		wantFirst domain.OccurrenceID
+		wantMore  bool
+		wantErr   bool
+	}{
+		{name: "full page", page: 1, perPage: 20, wantLen: 20, wantFirst: 1000, wantMore: true},
+		{name: "last page", page: 3, perPage: 20, wantLen: 5, wantFirst: 960},
+		{name: "bad page", page: 0, perPage: 20, wantErr: true},
+		{name: "bad per page", page: 1, perPage: maxOccurrencesPerPage + 1, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := service.ListOccurrences(context.Background(), 7, tc.page, tc.perPage)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListOccurrences() error = %v", err)
+			}
+			if len(got.Occurrences) != tc.wantLen || got.Occurrences[0].ID != tc.wantFirst || got.HasMore != tc.wantMore || got.ItemID != 123 {
+				t.Fatalf("unexpected page: %+v", got)
+			}
+			if got.Occurrences[0].MainError != "unknown" {
+				t.Fatalf("expected summary fields, got %+v", got.Occurrences[0])
+			}
+		})
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).ListOccurrences(context.Background(), 7, 1, 20); err == nil {
+		t.Fatalf("expected API error")
+	}
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const (
+	overviewTopItems     = 5
+	overviewWindow       = 24 * time.Hour
+	maxOverviewItemPages = 10
+)
+
+type ProjectOverview struct {
+	ProjectID       domain.ProjectID `json:"project_id,omitempty"`
+	ActiveItems     int              `json:"active_items"`
+	MoreActiveItems bool             `json:"more_active_items,omitempty"`
+	Occurrences24h  uint64           `json:"occurrences_24h"`
+	TopItems        []IssueSummary   `json:"top_items"`
+	NewestItem      *IssueSummary    `json:"newest_item,omitempty"`
+	Reactivated24h  []IssueSummary   `json:"reactivated_24h"`
+}
+
+func (s *Service) ProjectOverview(ctx context.Context) (ProjectOverview, error) {
+	now := s.Now()
+	since := uint64(now.Add(-overviewWindow).Unix())
+
+	active := make([]rollbar.Item, 0)
+	more, err := s.scanItemPages(ctx, domain.StatusActive, maxOverviewItemPages, func(items []rollbar.Item) bool {
+		active = append(active, items...)
+		return true
+	})
+	if err != nil {
+		return ProjectOverview{}, fmt.Errorf("list active items: %w", err)
+	}
+
+	top, err := s.api.ListActiveItems(ctx, overviewTopItems)
+	if err != nil {
+		return ProjectOverview{}, fmt.Errorf("list top active items: %w", err)
+	}
+
+	counts, err := s.api.GetOccurrenceCounts(ctx, rollbar.OccurrenceCountsQuery{
+		MinTimestamp: clampUnix(since),
+		MaxTimestamp: now.Unix(),
+		BucketSize:   releaseBucketSize,
+	})
+	if err != nil {
+		return ProjectOverview{}, fmt.Errorf("get occurrence counts: %w", err)
+	}
+
+	_, reactivated := partitionReleaseItems(active, since)
+	overview := ProjectOverview{
+		ActiveItems:     len(active),
+		MoreActiveItems: more,
+		Occurrences24h:  sumOccurrenceCounts(counts),
+		TopItems:        s.mapSummaries(top),
+		Reactivated24h:  s.mapSummaries(sortRecentItems(reactivated)),
+	}
+	overview.ProjectID = itemsProjectID(active, top)
+	if newest, ok := newestItem(active); ok {
+		summary := s.mapSummary(newest)
+		overview.NewestItem = &summary
+	}
+
+	return overview, nil
+}
+
+func itemsProjectID(lists ...[]rollbar.Item) domain.ProjectID {
+	for _, items := range lists {
+		for _, item := range items {
+			if item.ProjectID != 0 {
+				return item.ProjectID
+			}
+		}
+	}
+
+	return 0
+}
+
+func newestItem(items []rollbar.Item) (rollbar.Item, bool) {
+	var newest rollbar.Item
+	found := false
+	for _, item := range items {
+		if item.FirstOccurrenceTimestamp == nil {
+			continue
+		}
+		if !found || *item.FirstOccurrenceTimestamp > *newest.FirstOccurrenceTimestamp {
+			newest = item
+			found = true
+		}
+	}
+
+	return newest, found
+}
@@ -0,0 +1,50 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceProjectOverview(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	recent := uint64(now.Add(-2 * time.Hour).Unix())
+	old := uint64(now.Add(-30 * 24 * time.Hour).Unix())
+	older := uint64(now.Add(-60 * 24 * time.Hour).Unix())
+	service := NewService(fakeAPI{
+		listItems: []rollbar.Item{
+			{ID: 1, Counter: 1, Title: "old steady", FirstOccurrenceTimestamp: &older},
+			{ID: 2, Counter: 2, Title: "came back", FirstOccurrenceTimestamp: &old, LastActivatedTimestamp: &recent},
+			{ID: 3, Counter: 3, Title: "brand new", FirstOccurrenceTimestamp: &recent},
+		},
+		activeItems: []rollbar.Item{{ID: 2, ProjectID: 766510, Counter: 2, Title: "came back"}},
+		counts:      []rollbar.OccurrenceCount{{Timestamp: 1, Count: 40}, {Timestamp: 2, Count: 2}},
+	}, WithClock(func() time.Time { return now }))
+
+	overview, err := service.ProjectOverview(context.Background())
+	if err != nil {
+		t.Fatalf("ProjectOverview() error = %v", err)
+	}
+	if overview.ActiveItems != 3 || overview.MoreActiveItems || overview.Occurrences24h != 42 || overview.ProjectID != 766510 {
+		t.Fatalf("unexpected totals: %+v", overview)
+	}
+	if len(overview.TopItems) != 1 || overview.NewestItem == nil || overview.NewestItem.Counter != 3 {
+		t.Fatalf("unexpected top or newest items: %+v", overview)
+	}
+	if len(overview.Reactivated24h) != 1 || overview.Reactivated24h[0].Counter != 2 {
+		t.Fatalf("unexpected reactivations: %+v", overview.Reactivated24h)
+	}
+}
+
+func TestServiceProjectOverviewError(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).ProjectOverview(context.Background()); err == nil {
+		t.Fatalf("expected api error")
+	}
+}
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type FilterPreset func(now time.Time) IssueFilters
+
+const (
+	noisyWindow      = 24 * time.Hour
+	noisyOccurrences = 1000
+	newIssueWindow   = 7 * 24 * time.Hour
+)
+
+var builtinPresets = []struct {
+	name   string
+	preset FilterPreset
+}{
+	{name: "noisy", preset: ComposeFilters(ActiveIssues(), SeenWithin(noisyWindow), AtLeastOccurrences(noisyOccurrences))},
+	{name: "new-this-week", preset: ComposeFilters(ActiveIssues(), FirstSeenWithin(newIssueWindow))},
+	{name: "critical-prod", preset: ComposeFilters(ActiveIssues(), InEnvironment("production"), AtLeastLevel(domain.LevelCritical))},
+}
+
+func ActiveIssues() FilterPreset {
+	return func(time.Time) IssueFilters {
+		return IssueFilters{Status: domain.StatusActive}
+	}
+}
+
+func InEnvironment(environment domain.Environment) FilterPreset {
+	return func(time.Time) IssueFilters {
+		return IssueFilters{Environment: environment}
+	}
+}
+
+func SeenWithin(window time.Duration) FilterPreset {
+	return func(now time.Time) IssueFilters {
+		since := now.Add(-window).UTC()
+		return IssueFilters{Since: &since}
+	}
+}
+
+func FirstSeenWithin(window time.Duration) FilterPreset {
+	return func(time.Time) IssueFilters {
+		return IssueFilters{MaxAge: &window}
+	}
+}
+
+func AtLeastOccurrences(count uint64) FilterPreset {
+	return func(time.Time) IssueFilters {
+		return IssueFilters{MinOccurrences: &count}
+	}
+}
+
+func AtLeastLevel(level domain.Level) FilterPreset {
+	return func(time.Time) IssueFilters {
+		return IssueFilters{MinLevel: level}
+	}
+}
+
+func ComposeFilters(presets ...FilterPreset) FilterPreset {
+	return func(now time.Time) IssueFilters {
+		composed := IssueFilters{}
+		for _, preset := range presets {
+			composed = FillIssueFilters(composed, preset(now))
+		}
+
+		return composed
+	}
+}
+
+func FillIssueFilters(filters IssueFilters, defaults IssueFilters) IssueFilters {
+	if filters.Environment == "" {
+		filters.Environment = defaults.Environment
+	}
+	if filters.Status == "" && !filters.AllStatuses {
+		filters.Status = defaults.Status
+		filters.AllStatuses = defaults.AllStatuses
+	}
+	if filters.Since == nil {
+		filters.Since = defaults.Since
+	}
+	if filters.Until == nil {
+		filters.Until = defaults.Until
+	}
+	if filters.MinOccurrences == nil {
+		filters.MinOccurrences = defaults.MinOccurrences
+	}
+	if filters.MaxOccurrences == nil {
+		filters.MaxOccurrences = defaults.MaxOccurrences
+	}
+	if filters.MinAge == nil {
+		filters.MinAge = defaults.MinAge
+	}
+	if filters.MaxAge == nil {
+		filters.MaxAge = defaults.MaxAge
+	}
+	if filters.MinLevel == "" {
+		filters.MinLevel = defaults.MinLevel
+	}
+	if len(filters.Levels) == 0 {
+		filters.Levels = defaults.Levels
+	}
+	if len(filters.HiddenEnvironments) == 0 {
+		filters.HiddenEnvironments = defaults.HiddenEnvironments
+	}
+
+	return filters
+}
+
+func FilterPresetNames() []string {
+	names := make([]string, 0, len(builtinPresets))
+	for _, builtin := range builtinPresets {
+		names = append(names, "@"+builtin.name)
+	}
+
+	return names
+}
+
+func LookupFilterPreset(name string) (FilterPreset, error) {
+	normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), "@"))
+	for _, builtin := range builtinPresets {
+		if builtin.name == normalized {
+			return builtin.preset, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown preset %q: use %s", name, strings.Join(FilterPresetNames(), ", "))
+}
@@ -0,0 +1,112 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestFilterPresets(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC)
+	noisy, err := LookupFilterPreset("@noisy")
+	if err != nil {
+		t.Fatalf("LookupFilterPreset(@noisy) error = %v", err)
+	}
+	filters := noisy(now)
+	if filters.Status != domain.StatusActive || filters.Since == nil || !filters.Since.Equal(now.Add(-24*time.Hour)) ||
+		filters.MinOccurrences == nil || *filters.MinOccurrences != 1000 {
+		t.Fatalf("unexpected @noisy filters: %+v", filters)
+	}
+
+	fresh, err := LookupFilterPreset(" New-This-Week ")
+	if err != nil {
+		t.Fatalf("LookupFilterPreset(new-this-week) error = %v", err)
+	}
+	if filters := fresh(now); filters.MaxAge == nil || *filters.MaxAge != 7*24*time.Hour || filters.Since != nil {
+		t.Fatalf("unexpected @new-this-week filters: %+v", filters)
+	}
+
+	critical, err := LookupFilterPreset("critical-prod")
+	if err != nil {
+		t.Fatalf("LookupFilterPreset(critical-prod) error = %v", err)
+	}
+	if filters := critical(now); filters.Environment != "production" || filters.MinLevel != domain.LevelCritical {
+		t.Fatalf("unexpected @critical-prod filters: %+v", filters)
+	}
+
+	if _, err := LookupFilterPreset("@loud"); err == nil {
+		t.Fatalf("expected unknown preset error")
+	}
+	if names := FilterPresetNames(); len(names) != 3 || names[0] != "@noisy" {
+		t.Fatalf("unexpected preset names %v", names)
+	}
+}
+
+func TestFillIssueFilters(t *testing.T) {
+	t.Parallel()
+
+	ten := uint64(10)
+	day := 24 * time.Hour
+	defaults := IssueFilters{Environment: "production", Status: domain.StatusActive, MinOccurrences: &ten, MaxAge: &day, MinLevel: domain.LevelError}
+
+	filled := FillIssueFilters(IssueFilters{Environment: "staging", AllStatuses: true}, defaults)
+	if filled.Environment != "staging" || filled.Status != "" || !filled.AllStatuses {
+		t.Fatalf("expected explicit filters to win, got %+v", filled)
+	}
+	if filled.MinOccurrences != &ten || filled.MaxAge != &day || filled.MinLevel != domain.LevelError {
+		t.Fatalf("expected unset filters filled, got %+v", filled)
+	}
+
+	composed := ComposeFilters(AtLeastLevel(domain.LevelCritical), AtLeastLevel(domain.LevelDebug), InEnvironment("production"))(time.Now())
+	if composed.MinLevel != domain.LevelCritical || composed.Environment != "production" {
+		t.Fatalf("expected the first preset to win, got %+v", composed)
+	}
+}
+
+func TestServiceActiveLevelFilter(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{activeItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Level: domain.LevelError},
+		{ID: 2, Counter: 2, Level: domain.LevelCritical},
+		{ID: 3, Counter: 3},
+	}})
+
+	issues, err := service.Active(context.Background(), 10, IssueFilters{MinLevel: domain.LevelCritical})
+	if err != nil {
+		t.Fatalf("Active() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Counter != 2 {
+		t.Fatalf("expected only the critical issue, got %+v", issues)
+	}
+	if stages := service.Explanation().Stages; len(stages) != 1 || stages[0].Stage != "level" || stages[0].Dropped != 2 {
+		t.Fatalf("unexpected stages %+v", stages)
+	}
+}
+
+func TestServiceActiveLevelsFilter(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{activeItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Level: domain.LevelError},
+		{ID: 2, Counter: 2, Level: domain.LevelCritical},
+		{ID: 3, Counter: 3, Level: domain.LevelWarning},
+		{ID: 4, Counter: 4, Level: "ERROR"},
+	}})
+
+	issues, err := service.Active(context.Background(), 10, IssueFilters{Levels: []domain.Level{domain.LevelError, domain.LevelWarning}})
+	if err != nil {
+		t.Fatalf("Active() error = %v", err)
+	}
+	if len(issues) != 3 || issues[0].Counter != 1 || issues[1].Counter != 3 || issues[2].Counter != 4 {
+		t.Fatalf("expected the error and warning issues, got %+v", issues)
+	}
+	if stages := service.Explanation().Stages; len(stages) != 1 || stages[0].Stage != "levels" || stages[0].Dropped != 1 {
+		t.Fatalf("unexpected stages %+v", stages)
+	}
+}
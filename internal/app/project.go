@@ -0,0 +1,29 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type ProjectInfo struct {
+	ID          domain.ProjectID   `json:"id"`
+	Slug        domain.ProjectSlug `json:"slug"`
+	AccountID   uint64             `json:"account_id"`
+	AccountSlug string             `json:"account_slug,omitempty"`
+}
+
+func (s *Service) Project(ctx context.Context, projectID domain.ProjectID) (ProjectInfo, error) {
+	project, err := s.api.GetProject(ctx, projectID)
+	if err != nil {
+		return ProjectInfo{}, fmt.Errorf("get project: %w", err)
+	}
+
+	return ProjectInfo{
+		ID:          project.ID,
+		Slug:        domain.ProjectSlug(project.Name),
+		AccountID:   project.AccountID,
+		AccountSlug: project.AccountSlug,
+	}, nil
+}
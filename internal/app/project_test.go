@@ -0,0 +1,26 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceProject(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{project: rollbar.Project{ID: 42, Name: "checkout", AccountID: 7, AccountSlug: "acme"}})
+	project, err := service.Project(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("Project() error = %v", err)
+	}
+	if project != (ProjectInfo{ID: 42, Slug: "checkout", AccountID: 7, AccountSlug: "acme"}) {
+		t.Fatalf("unexpected project: %+v", project)
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).Project(context.Background(), 42); err == nil {
+		t.Fatalf("expected Project() error")
+	}
+}
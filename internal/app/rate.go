@@ -0,0 +1,151 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const minRateWindow = time.Hour
+
+var rateUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+}
+
+type OccurrenceRate struct {
+	Count float64
+	Per   time.Duration
+}
+
+func ParseOccurrenceRate(value string) (*OccurrenceRate, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	countText, unit, ok := strings.Cut(value, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid rate %q: use <count>/<s|m|h|d>, e.g. 10/h", value)
+	}
+	count, err := strconv.ParseFloat(strings.TrimSpace(countText), 64)
+	if err != nil || count < 0 {
+		return nil, fmt.Errorf("invalid rate count %q", countText)
+	}
+	per, ok := rateUnits[strings.ToLower(strings.TrimSpace(unit))]
+	if !ok {
+		return nil, fmt.Errorf("invalid rate unit %q: use s, m, h, or d", unit)
+	}
+
+	return &OccurrenceRate{Count: count, Per: per}, nil
+}
+
+func (r OccurrenceRate) window() time.Duration {
+	return max(r.Per, minRateWindow)
+}
+
+func (s *Service) FilterByRate(ctx context.Context, issues []IssueSummary, rate *OccurrenceRate) ([]IssueSummary, error) {
+	if rate == nil || len(issues) == 0 {
+		return issues, nil
+	}
+	filter := s.newRateFilter(rate)
+	filtered, err := filter.summaries(ctx, issues)
+	if err != nil {
+		return nil, err
+	}
+	filter.finish()
+
+	return filtered, nil
+}
+
+type rateFilter struct {
+	service *Service
+	rate    *OccurrenceRate
+	skipped []bool
+	kept    int
+}
+
+func (s *Service) newRateFilter(rate *OccurrenceRate) *rateFilter {
+	if rate == nil {
+		return nil
+	}
+	s.explainClientFilter("min_rate", fmt.Sprintf("%g per %s", rate.Count, rate.Per))
+
+	return &rateFilter{service: s, rate: rate}
+}
+
+func (f *rateFilter) items(ctx context.Context, items []rollbar.Item) ([]rollbar.Item, error) {
+	if f == nil || len(items) == 0 {
+		return items, nil
+	}
+	summaries, err := f.summaries(ctx, f.service.mapSummaries(items))
+	if err != nil {
+		return nil, err
+	}
+	kept := make(map[domain.ItemID]bool, len(summaries))
+	for _, issue := range summaries {
+		kept[issue.ItemID] = true
+	}
+
+	return slices.DeleteFunc(items, func(item rollbar.Item) bool { return !kept[item.ID] }), nil
+}
+
+func (f *rateFilter) summaries(ctx context.Context, issues []IssueSummary) ([]IssueSummary, error) {
+	if f.rate.Per <= 0 {
+		return nil, errors.New("rate period must be positive")
+	}
+	s := f.service
+	window := f.rate.window()
+	now := s.Now()
+	query := rollbar.OccurrenceCountsQuery{
+		MinTimestamp: now.Add(-window).Unix(),
+		MaxTimestamp: now.Unix(),
+		BucketSize:   releaseBucketSize,
+	}
+
+	counts, skipped, err := mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) (uint64, error) {
+		itemQuery := query
+		itemQuery.ItemID = issue.ItemID
+		buckets, err := s.api.GetOccurrenceCounts(ctx, itemQuery)
+		if err != nil {
+			return 0, fmt.Errorf("get occurrence counts for item %s: %w", issue.Counter.String(), err)
+		}
+		s.explainCall("/reports/occurrence_counts", itemQuery.Params(), len(buckets))
+		return sumOccurrenceCounts(buckets), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	f.skipped = append(f.skipped, skipped...)
+
+	filtered := make([]IssueSummary, 0, len(issues))
+	for index, issue := range issues {
+		if skipped[index] {
+			continue
+		}
+		observed := float64(counts[index]) * float64(f.rate.Per) / float64(window)
+		if observed >= f.rate.Count {
+			filtered = append(filtered, issue)
+		}
+	}
+	f.kept += len(filtered)
+
+	return filtered, nil
+}
+
+func (f *rateFilter) finish() {
+	if f == nil {
+		return
+	}
+	f.service.warnSkipped(f.skipped, "left out %d of %d issues whose occurrence rate could not be fetched (request budget or rate limit)")
+	f.service.ExplainStage("min_rate", len(f.skipped), f.kept)
+}
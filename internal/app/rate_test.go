@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type rateAPI struct {
+	fakeAPI
+	counts map[domain.ItemID]uint64
+}
+
+func (r rateAPI) GetOccurrenceCounts(ctx context.Context, query rollbar.OccurrenceCountsQuery) ([]rollbar.OccurrenceCount, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	if query.MaxTimestamp-query.MinTimestamp != int64(time.Hour/time.Second) {
+		return nil, errors.New("unexpected window")
+	}
+	return []rollbar.OccurrenceCount{{Timestamp: uint64(query.MinTimestamp), Count: r.counts[query.ItemID]}}, nil
+}
+
+func TestParseOccurrenceRate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    *OccurrenceRate
+		wantErr bool
+	}{
+		{input: "", want: nil},
+		{input: "10/h", want: &OccurrenceRate{Count: 10, Per: time.Hour}},
+		{input: " 0.5 / M ", want: &OccurrenceRate{Count: 0.5, Per: time.Minute}},
+		{input: "10", wantErr: true},
+		{input: "x/h", wantErr: true},
+		{input: "-1/h", wantErr: true},
+		{input: "10/w", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseOccurrenceRate(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("ParseOccurrenceRate(%q) error = %v", tt.input, err)
+		}
+		if tt.want == nil && got != nil || tt.want != nil && (got == nil || *got != *tt.want) {
+			t.Fatalf("ParseOccurrenceRate(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestServiceFilterByRate(t *testing.T) {
+	t.Parallel()
+
+	api := rateAPI{counts: map[domain.ItemID]uint64{1: 12, 2: 3, 3: 60}}
+	service := NewService(api, WithClock(func() time.Time { return time.Unix(1700000000, 0) }))
+	issues := []IssueSummary{{ItemID: 1, Counter: 1}, {ItemID: 2, Counter: 2}, {ItemID: 3, Counter: 3}}
+
+	hot, err := service.FilterByRate(context.Background(), issues, &OccurrenceRate{Count: 10, Per: time.Hour})
+	if err != nil {
+		t.Fatalf("FilterByRate() error = %v", err)
+	}
+	if len(hot) != 2 || hot[0].ItemID != 1 || hot[1].ItemID != 3 {
+		t.Fatalf("unexpected hourly filter result: %+v", hot)
+	}
+
+	perMinute, err := service.FilterByRate(context.Background(), issues, &OccurrenceRate{Count: 1, Per: time.Minute})
+	if err != nil {
+		t.Fatalf("FilterByRate() error = %v", err)
+	}
+	if len(perMinute) != 1 || perMinute[0].ItemID != 3 {
+		t.Fatalf("unexpected per-minute filter result: %+v", perMinute)
+	}
+
+	unchanged, err := service.FilterByRate(context.Background(), issues, nil)
+	if err != nil || len(unchanged) != 3 {
+		t.Fatalf("FilterByRate(nil) = %+v, %v", unchanged, err)
+	}
+
+	failing := NewService(rateAPI{fakeAPI: fakeAPI{err: errors.New("boom")}})
+	if _, err := failing.FilterByRate(context.Background(), issues, &OccurrenceRate{Count: 1, Per: time.Hour}); err == nil {
+		t.Fatalf("expected error from occurrence counts")
+	}
+	if _, err := failing.FilterByRate(context.Background(), issues, &OccurrenceRate{Count: 1}); err == nil {
+		t.Fatalf("expected invalid period error")
+	}
+}
+
+func TestRecentAppliesMinRateBeforeLimit(t *testing.T) {
+	t.Parallel()
+
+	pages := [][]rollbar.Item{make([]rollbar.Item, 0, rollbar.ItemsPageSize), {}}
+	for id := 1; id <= rollbar.ItemsPageSize; id++ {
+		pages[0] = append(pages[0], rollbar.Item{ID: domain.ItemID(id), Counter: uint64(id)})
+	}
+	pages[1] = append(pages[1], rollbar.Item{ID: 101, Counter: 101}, rollbar.Item{ID: 102, Counter: 102})
+	api := rateAPI{fakeAPI: fakeAPI{itemPages: pages}, counts: map[domain.ItemID]uint64{7: 20, 50: 20, 102: 20}}
+	service := NewService(api, WithClock(func() time.Time { return time.Unix(1700000000, 0) }))
+
+	issues, err := service.Recent(context.Background(), 3, IssueFilters{MinRate: &OccurrenceRate{Count: 10, Per: time.Hour}})
+	if err != nil {
+		t.Fatalf("Recent() error = %v", err)
+	}
+	got := map[domain.ItemID]bool{}
+	for _, issue := range issues {
+		got[issue.ItemID] = true
+	}
+	if len(issues) != 3 || !got[7] || !got[50] || !got[102] {
+		t.Fatalf("expected the limit met by hot issues across pages, got %+v", issues)
+	}
+}
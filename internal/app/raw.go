@@ -0,0 +1,31 @@
+package app
+
+func WithoutRaw(issues []IssueSummary) []IssueSummary {
+	stripped := make([]IssueSummary, len(issues))
+	for index, issue := range issues {
+		issue.Raw = nil
+		stripped[index] = issue
+	}
+
+	return stripped
+}
+
+func (d IssueDetail) WithoutRaw() IssueDetail {
+	d.IssueSummary.Raw = nil
+	d.ItemRaw = nil
+	d.InstanceRaw = nil
+	if d.Instance != nil {
+		instance := *d.Instance
+		instance.Raw = nil
+		d.Instance = &instance
+	}
+
+	return d
+}
+
+func (h ReleaseHealth) WithoutRaw() ReleaseHealth {
+	h.NewItems = WithoutRaw(h.NewItems)
+	h.ReactivatedItems = WithoutRaw(h.ReactivatedItems)
+
+	return h
+}
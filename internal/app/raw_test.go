@@ -0,0 +1,36 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestWithoutRaw(t *testing.T) {
+	t.Parallel()
+
+	raw := json.RawMessage(`{"id":1}`)
+	issues := []IssueSummary{{Title: "a", Raw: raw}}
+	stripped := WithoutRaw(issues)
+	if stripped[0].Raw != nil || stripped[0].Title != "a" {
+		t.Fatalf("unexpected stripped issue: %+v", stripped[0])
+	}
+	if issues[0].Raw == nil {
+		t.Fatalf("WithoutRaw() must not modify its input")
+	}
+
+	instance := &rollbar.ItemInstance{ID: 2, Raw: raw}
+	detail := IssueDetail{IssueSummary: issues[0], ItemRaw: raw, Instance: instance, InstanceRaw: raw}.WithoutRaw()
+	if detail.Raw != nil || detail.ItemRaw != nil || detail.InstanceRaw != nil || detail.Instance.Raw != nil || detail.Instance.ID != 2 {
+		t.Fatalf("unexpected stripped detail: %+v", detail)
+	}
+	if instance.Raw == nil {
+		t.Fatalf("WithoutRaw() must not modify the original instance")
+	}
+
+	health := ReleaseHealth{NewItems: issues, ReactivatedItems: issues}.WithoutRaw()
+	if health.NewItems[0].Raw != nil || health.ReactivatedItems[0].Raw != nil {
+		t.Fatalf("unexpected stripped release health: %+v", health)
+	}
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const (
+	maxDeployPages      = 5
+	maxReleaseItemPages = 5
+	releaseBucketSize   = 3600
+)
+
+type ReleaseHealth struct {
+	Version                string         `json:"version"`
+	Deploy                 rollbar.Deploy `json:"deploy"`
+	NewItems               []IssueSummary `json:"new_items"`
+	ReactivatedItems       []IssueSummary `json:"reactivated_items"`
+	OccurrencesSinceDeploy uint64         `json:"occurrences_since_deploy"`
+}
+
+func (s *Service) ReleaseHealth(ctx context.Context, version string, environment string) (ReleaseHealth, error) {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return ReleaseHealth{}, errors.New("release version is required")
+	}
+
+	deploy, err := s.findDeploy(ctx, version, s.environments.Canonical(environment))
+	if err != nil {
+		return ReleaseHealth{}, err
+	}
+	deployedAt := uint64Value(deploy.StartTime)
+
+	items, err := s.listItemPages(ctx, "", maxReleaseItemPages)
+	if err != nil {
+		return ReleaseHealth{}, fmt.Errorf("list items: %w", err)
+	}
+	items = s.filterItems(items, IssueFilters{Environment: domain.Environment(deploy.Environment)})
+
+	counts, err := s.api.GetOccurrenceCounts(ctx, rollbar.OccurrenceCountsQuery{
+		Environment:  deploy.Environment,
+		MinTimestamp: clampUnix(deployedAt),
+		MaxTimestamp: s.Now().Unix(),
+		BucketSize:   releaseBucketSize,
+	})
+	if err != nil {
+		return ReleaseHealth{}, fmt.Errorf("get occurrence counts: %w", err)
+	}
+
+	newItems, reactivated := partitionReleaseItems(items, deployedAt)
+
+	return ReleaseHealth{
+		Version:                version,
+		Deploy:                 deploy,
+		NewItems:               s.mapSummaries(newItems),
+		ReactivatedItems:       s.mapSummaries(reactivated),
+		OccurrencesSinceDeploy: sumOccurrenceCounts(counts),
+	}, nil
+}
+
+func (s *Service) findDeploy(ctx context.Context, version string, environment domain.Environment) (rollbar.Deploy, error) {
+	for page := 1; page <= maxDeployPages; page++ {
+		deploys, err := s.api.ListDeploys(ctx, page)
+		if err != nil {
+			return rollbar.Deploy{}, fmt.Errorf("list deploys: %w", err)
+		}
+		if len(deploys) == 0 {
+			break
+		}
+
+		for _, deploy := range deploys {
+			if strings.TrimSpace(deploy.Revision) != version {
+				continue
+			}
+			if environment != "" && s.environments.Canonical(deploy.Environment) != environment {
+				continue
+			}
+			if deploy.StartTime == nil {
+				continue
+			}
+			return deploy, nil
+		}
+	}
+
+	return rollbar.Deploy{}, fmt.Errorf("no deploy found for version %q", version)
+}
+
+func partitionReleaseItems(items []rollbar.Item, deployedAt uint64) ([]rollbar.Item, []rollbar.Item) {
+	newItems := make([]rollbar.Item, 0)
+	reactivated := make([]rollbar.Item, 0)
+	for _, item := range items {
+		firstSeen := item.FirstOccurrenceTimestamp
+		if firstSeen != nil && *firstSeen >= deployedAt {
+			newItems = append(newItems, item)
+			continue
+		}
+		if item.LastActivatedTimestamp != nil && *item.LastActivatedTimestamp >= deployedAt {
+			reactivated = append(reactivated, item)
+		}
+	}
+
+	return newItems, reactivated
+}
+
+func sumOccurrenceCounts(counts []rollbar.OccurrenceCount) uint64 {
+	var total uint64
+	for _, count := range counts {
+		total += count.Count
+	}
+
+	return total
+}
+
+func clampUnix(value uint64) int64 {
+	if value > math.MaxInt64 {
+		return math.MaxInt64
+	}
+
+	return int64(value)
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceReleaseHealth(t *testing.T) {
+	t.Parallel()
+
+	deployedAt := uint64(1000)
+	before := uint64(500)
+	after := uint64(1500)
+	service := NewService(fakeAPI{
+		deploys: []rollbar.Deploy{
+			{ID: 1, Revision: "v1.2.2", Environment: "production", StartTime: &before},
+			{ID: 2, Revision: "v1.2.3", Environment: "production", StartTime: &deployedAt},
+		},
+		listItems: []rollbar.Item{
+			{ID: 1, Counter: 1, Environment: "production", FirstOccurrenceTimestamp: &after},
+			{ID: 2, Counter: 2, Environment: "production", FirstOccurrenceTimestamp: &before, LastActivatedTimestamp: &after},
+			{ID: 3, Counter: 3, Environment: "production", FirstOccurrenceTimestamp: &before, LastActivatedTimestamp: &before},
+			{ID: 4, Counter: 4, Environment: "staging", FirstOccurrenceTimestamp: &after},
+		},
+		counts: []rollbar.OccurrenceCount{{Timestamp: 1000, Count: 4}, {Timestamp: 4600, Count: 6}},
+	})
+
+	health, err := service.ReleaseHealth(context.Background(), " v1.2.3 ", "")
+	if err != nil {
+		t.Fatalf("ReleaseHealth() error = %v", err)
+	}
+	if health.Deploy.ID != 2 || health.Version != "v1.2.3" {
+		t.Fatalf("unexpected deploy: %+v", health.Deploy)
+	}
+	if len(health.NewItems) != 1 || health.NewItems[0].Counter != 1 {
+		t.Fatalf("unexpected new items: %+v", health.NewItems)
+	}
+	if len(health.ReactivatedItems) != 1 || health.ReactivatedItems[0].Counter != 2 {
+		t.Fatalf("unexpected reactivated items: %+v", health.ReactivatedItems)
+	}
+	if health.OccurrencesSinceDeploy != 10 {
+		t.Fatalf("OccurrencesSinceDeploy = %d, want 10", health.OccurrencesSinceDeploy)
+	}
+}
+
+func TestServiceReleaseHealthErrors(t *testing.T) {
+	t.Parallel()
+
+	deployedAt := uint64(1000)
+	service := NewService(fakeAPI{deploys: []rollbar.Deploy{{Revision: "v1", Environment: "production", StartTime: &deployedAt}}})
+
+	if _, err := service.ReleaseHealth(context.Background(), " ", ""); err == nil {
+		t.Fatalf("expected missing version error")
+	}
+	if _, err := service.ReleaseHealth(context.Background(), "v2", ""); err == nil {
+		t.Fatalf("expected missing deploy error")
+	}
+	if _, err := service.ReleaseHealth(context.Background(), "v1", "staging"); err == nil {
+		t.Fatalf("expected environment mismatch error")
+	}
+
+	failing := NewService(fakeAPI{err: errors.New("bad")})
+	if _, err := failing.ReleaseHealth(context.Background(), "v1", ""); err == nil {
+		t.Fatalf("expected list deploys error")
+	}
+}
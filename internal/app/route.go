@@ -0,0 +1,157 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/kevinsheth/rollbaz/internal/parallel"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+type RouteRule struct {
+	Title    string
+	Path     string
+	Assignee string
+	UserID   uint64
+}
+
+type compiledRoute struct {
+	RouteRule
+	title *regexp.Regexp
+	path  *regexp.Regexp
+}
+
+type Router struct {
+	routes    []compiledRoute
+	needsPath bool
+}
+
+func NewRouter(rules []RouteRule) (*Router, error) {
+	router := &Router{routes: make([]compiledRoute, 0, len(rules))}
+	for index, rule := range rules {
+		route := compiledRoute{RouteRule: rule}
+		var err error
+		if rule.Title != "" {
+			if route.title, err = regexp.Compile(rule.Title); err != nil {
+				return nil, fmt.Errorf("route %d: title: %w", index+1, err)
+			}
+		}
+		if rule.Path != "" {
+			if route.path, err = regexp.Compile(rule.Path); err != nil {
+				return nil, fmt.Errorf("route %d: path: %w", index+1, err)
+			}
+			router.needsPath = true
+		}
+		router.routes = append(router.routes, route)
+	}
+
+	return router, nil
+}
+
+func (r *Router) match(issue IssueSummary, traces []summary.Trace) int {
+	for index, route := range r.routes {
+		if route.title != nil && !route.title.MatchString(issue.Title) {
+			continue
+		}
+		if route.path != nil && !anyFrameMatches(traces, route.path) {
+			continue
+		}
+		return index + 1
+	}
+
+	return 0
+}
+
+func anyFrameMatches(traces []summary.Trace, pattern *regexp.Regexp) bool {
+	for _, trace := range traces {
+		for _, frame := range trace.Frames {
+			if pattern.MatchString(frame.Filename) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type RouteChange struct {
+	Issue    IssueSummary `json:"issue"`
+	Rule     int          `json:"rule"`
+	Assignee string       `json:"assignee"`
+	From     *uint64      `json:"from_user_id"`
+	To       uint64       `json:"to_user_id"`
+}
+
+type RoutePlan struct {
+	Changes   []RouteChange `json:"changes"`
+	Unchanged int           `json:"unchanged"`
+	Unmatched int           `json:"unmatched"`
+}
+
+func (s *Service) PlanRoutes(ctx context.Context, router *Router, filters IssueFilters) (RoutePlan, error) {
+	issues, err := s.RecentAll(ctx, filters)
+	if err != nil {
+		return RoutePlan{}, err
+	}
+
+	traces := make([][]summary.Trace, len(issues))
+	if router.needsPath {
+		var skipped []bool
+		traces, skipped, err = mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) ([]summary.Trace, error) {
+			instance, err := s.api.GetLatestInstance(ctx, issue.ItemID)
+			if err != nil || instance == nil {
+				return nil, err
+			}
+			return summary.Traces(instance.Body, instance.Data), nil
+		})
+		if err != nil {
+			return RoutePlan{}, fmt.Errorf("get latest occurrences: %w", err)
+		}
+		s.warnSkipped(skipped, "checked path rules without stack frames for %d of %d issues (request budget or rate limit)")
+	}
+
+	plan := RoutePlan{Changes: make([]RouteChange, 0)}
+	for index, issue := range issues {
+		rule := router.match(issue, traces[index])
+		if rule == 0 {
+			plan.Unmatched++
+			continue
+		}
+		route := router.routes[rule-1]
+		if issue.AssignedUserID != nil && *issue.AssignedUserID == route.UserID {
+			plan.Unchanged++
+			continue
+		}
+		issue.Raw = nil
+		plan.Changes = append(plan.Changes, RouteChange{Issue: issue, Rule: rule, Assignee: route.Assignee, From: issue.AssignedUserID, To: route.UserID})
+	}
+
+	return plan, nil
+}
+
+func (s *Service) ApplyRoutes(ctx context.Context, changes []RouteChange) (int, error) {
+	errs := make([]error, len(changes))
+	assigned := make([]bool, len(changes))
+	cancelErr := parallel.ForEach(ctx, defaultConcurrency, len(changes), func(ctx context.Context, index int) error {
+		change := changes[index]
+		userID := change.To
+		if err := s.api.UpdateItem(ctx, change.Issue.ItemID, rollbar.ItemPatch{AssignedUserID: &userID}); err != nil {
+			errs[index] = fmt.Errorf("assign issue %s: %w", change.Issue.Counter.String(), err)
+			return nil
+		}
+		assigned[index] = true
+		return nil
+	})
+
+	applied := 0
+	for _, ok := range assigned {
+		if ok {
+			applied++
+		}
+	}
+
+	return applied, errors.Join(append(errs, cancelErr)...)
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type routeAPI struct {
+	fakeAPI
+	instances map[domain.ItemID]*rollbar.ItemInstance
+	failItem  domain.ItemID
+
+	mu      sync.Mutex
+	patches map[domain.ItemID]rollbar.ItemPatch
+}
+
+func (a *routeAPI) GetLatestInstance(ctx context.Context, itemID domain.ItemID) (*rollbar.ItemInstance, error) {
+	return a.instances[itemID], nil
+}
+
+func (a *routeAPI) UpdateItem(ctx context.Context, itemID domain.ItemID, patch rollbar.ItemPatch) error {
+	if itemID == a.failItem {
+		return errors.New("forbidden")
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.patches[itemID] = patch
+
+	return nil
+}
+
+func TestNewRouterRejectsBadPatterns(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewRouter([]RouteRule{{Title: "(", UserID: 1}}); err == nil {
+		t.Fatalf("expected title pattern error")
+	}
+	if _, err := NewRouter([]RouteRule{{Title: "ok", UserID: 1}, {Path: "[", UserID: 2}}); err == nil || err.Error()[:7] != "route 2" {
+		t.Fatalf("expected path pattern error for route 2, got %v", err)
+	}
+}
+
+func TestPlanAndApplyRoutes(t *testing.T) {
+	t.Parallel()
+
+	alice := uint64(11)
+	api := &routeAPI{
+		fakeAPI: fakeAPI{listItems: []rollbar.Item{
+			{ID: 1, Counter: 1, Title: "DB timeout in checkout"},
+			{ID: 2, Counter: 2, Title: "nil pointer"},
+			{ID: 3, Counter: 3, Title: "Timeout talking to search", AssignedUserID: &alice},
+			{ID: 4, Counter: 4, Title: "unrelated"},
+		}},
+		instances: map[domain.ItemID]*rollbar.ItemInstance{
+			2: {Data: json.RawMessage(`{"body":{"trace":{"frames":[{"filename":"app/billing/invoice.go"}]}}}`)},
+			4: {Data: json.RawMessage(`{"body":{"trace":{"frames":[{"filename":"app/web/handler.go"}]}}}`)},
+		},
+		patches: map[domain.ItemID]rollbar.ItemPatch{},
+	}
+	router, err := NewRouter([]RouteRule{
+		{Title: "(?i)timeout", Assignee: "alice", UserID: alice},
+		{Path: "^app/billing/", Assignee: "bob", UserID: 22},
+	})
+	if err != nil {
+		t.Fatalf("NewRouter() error = %v", err)
+	}
+	service := NewService(api)
+
+	plan, err := service.PlanRoutes(context.Background(), router, IssueFilters{})
+	if err != nil {
+		t.Fatalf("PlanRoutes() error = %v", err)
+	}
+	if plan.Unchanged != 1 || plan.Unmatched != 1 || len(plan.Changes) != 2 {
+		t.Fatalf("PlanRoutes() = %+v", plan)
+	}
+	byCounter := map[domain.ItemCounter]RouteChange{}
+	for _, change := range plan.Changes {
+		byCounter[change.Issue.Counter] = change
+	}
+	if change := byCounter[1]; change.Rule != 1 || change.To != alice || change.From != nil {
+		t.Fatalf("issue 1 change = %+v", change)
+	}
+	if change := byCounter[2]; change.Rule != 2 || change.Assignee != "bob" || change.To != 22 {
+		t.Fatalf("issue 2 change = %+v", change)
+	}
+
+	api.failItem = 2
+	applied, err := service.ApplyRoutes(context.Background(), plan.Changes)
+	if applied != 1 || err == nil {
+		t.Fatalf("ApplyRoutes() = %d, %v; want 1 and an error", applied, err)
+	}
+	if patch := api.patches[1]; patch.AssignedUserID == nil || *patch.AssignedUserID != alice {
+		t.Fatalf("unexpected patch for issue 1: %+v", patch)
+	}
+}
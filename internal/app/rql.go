@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const defaultRQLPollPeriod = time.Second
+
+type RQLResult struct {
+	Query   string   `json:"query"`
+	JobID   uint64   `json:"job_id"`
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+func (s *Service) RunRQL(ctx context.Context, query string, observe func(rollbar.RQLJob)) (RQLResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return RQLResult{}, errors.New("rql query is empty")
+	}
+
+	return s.runRQL(ctx, query, observe)
+}
+
+func (s *Service) runRQL(ctx context.Context, query string, observe func(rollbar.RQLJob)) (RQLResult, error) {
+	if observe == nil {
+		observe = func(rollbar.RQLJob) {}
+	}
+
+	job, err := s.api.CreateRQLJob(ctx, query)
+	if err != nil {
+		return RQLResult{}, fmt.Errorf("create rql job: %w", err)
+	}
+	observe(job)
+	for !job.Done() {
+		timer := time.NewTimer(s.rqlPollPeriod)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return RQLResult{}, fmt.Errorf("wait for rql job %d: %w", job.ID, ctx.Err())
+		case <-timer.C:
+		}
+		if job, err = s.api.GetRQLJob(ctx, job.ID); err != nil {
+			return RQLResult{}, fmt.Errorf("get rql job: %w", err)
+		}
+		observe(job)
+	}
+	if job.Status != rollbar.RQLJobSuccess {
+		return RQLResult{}, fmt.Errorf("rql job %d ended with status %s", job.ID, job.Status)
+	}
+
+	result, err := s.api.GetRQLJobResult(ctx, job.ID)
+	if err != nil {
+		return RQLResult{}, fmt.Errorf("get rql job result: %w", err)
+	}
+
+	return RQLResult{Query: query, JobID: job.ID, Columns: result.Columns, Rows: result.Rows, Errors: result.Errors}, nil
+}
+
+func RenderRQLTemplate(name string, query string, params map[string]string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap{"quote": rqlString}).Parse(query)
+	if err != nil {
+		return "", fmt.Errorf("parse rql query %s: %w", name, err)
+	}
+
+	var rendered strings.Builder
+	if err := tmpl.Execute(&rendered, params); err != nil {
+		return "", fmt.Errorf("render rql query %s: %w", name, err)
+	}
+
+	return rendered.String(), nil
+}
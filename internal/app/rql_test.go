@@ -0,0 +1,68 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestRunRQL(t *testing.T) {
+	t.Parallel()
+
+	polls := 0
+	result := rollbar.RQLResult{Columns: []string{"count(*)"}, Rows: [][]any{{"3"}}}
+	service := NewService(pollingRQLAPI{fakeAPI: fakeAPI{rqlResult: result}, statuses: []string{"running", rollbar.RQLJobSuccess}, polls: &polls})
+	service.rqlPollPeriod = time.Millisecond
+
+	var seen []string
+	got, err := service.RunRQL(context.Background(), "  SELECT count(*) FROM item_occurrence  ", func(job rollbar.RQLJob) {
+		seen = append(seen, job.Status)
+	})
+	if err != nil {
+		t.Fatalf("RunRQL() error = %v", err)
+	}
+	if got.Query != "SELECT count(*) FROM item_occurrence" || got.JobID != 7 || len(got.Rows) != 1 {
+		t.Fatalf("RunRQL() = %#v", got)
+	}
+	if strings.Join(seen, ",") != "new,running,success" {
+		t.Fatalf("observed statuses = %v", seen)
+	}
+
+	if _, err := service.RunRQL(context.Background(), " ", nil); err == nil || !strings.Contains(err.Error(), "empty") {
+		t.Fatalf("RunRQL() empty query error = %v", err)
+	}
+}
+
+func TestRenderRQLTemplate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		query   string
+		params  map[string]string
+		want    string
+		wantErr string
+	}{
+		{name: "raw value", query: "SELECT * FROM item_occurrence WHERE person.id = {{.user}}", params: map[string]string{"user": "123"}, want: "SELECT * FROM item_occurrence WHERE person.id = 123"},
+		{name: "quoted value", query: "WHERE request.url LIKE {{quote .url}}", params: map[string]string{"url": "it's"}, want: `WHERE request.url LIKE 'it\'s'`},
+		{name: "no placeholders", query: "SELECT 1", want: "SELECT 1"},
+		{name: "missing param", query: "WHERE person.id = {{.user}}", wantErr: `"user"`},
+		{name: "bad template", query: "WHERE person.id = {{.user", wantErr: "parse rql query"},
+	}
+
+	for _, tc := range tests {
+		got, err := RenderRQLTemplate("by_user", tc.query, tc.params)
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("%s: RenderRQLTemplate() error = %v", tc.name, err)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Fatalf("%s: RenderRQLTemplate() = %q, %v", tc.name, got, err)
+		}
+	}
+}
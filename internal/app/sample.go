@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/parallel"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+type SampledInstance struct {
+	Instance    rollbar.ItemInstance `json:"instance"`
+	MainError   string               `json:"main_error"`
+	UUID        string               `json:"uuid,omitempty"`
+	CodeVersion string               `json:"code_version,omitempty"`
+	Environment string               `json:"environment,omitempty"`
+}
+
+type InstanceSample struct {
+	ItemID      domain.ItemID     `json:"item_id"`
+	Instances   []SampledInstance `json:"instances"`
+	FailedPages int               `json:"failed_pages,omitempty"`
+	Errors      []error           `json:"-"`
+}
+
+func (s *Service) SampleInstances(ctx context.Context, counter domain.ItemCounter, n int) (InstanceSample, error) {
+	if n <= 0 {
+		return InstanceSample{}, errors.New("sample size must be positive")
+	}
+
+	itemID, err := s.api.ResolveItemIDByCounter(ctx, counter)
+	if err != nil {
+		return InstanceSample{}, fmt.Errorf("resolve item id: %w", err)
+	}
+
+	perPage := min(n, maxOccurrencesPerPage)
+	pageCount := (n + perPage - 1) / perPage
+	pages := make([][]rollbar.ItemInstance, pageCount)
+	pageErrors := make([]error, pageCount)
+	_ = parallel.ForEach(ctx, defaultConcurrency, pageCount, func(ctx context.Context, index int) error {
+		instances, err := s.api.ListInstances(ctx, itemID, rollbar.InstanceListOptions{Page: index + 1, PerPage: perPage})
+		if err != nil {
+			pageErrors[index] = fmt.Errorf("list occurrences page %d: %w", index+1, err)
+			return nil
+		}
+		pages[index] = instances
+		return nil
+	})
+
+	sample := InstanceSample{ItemID: itemID, Instances: make([]SampledInstance, 0, n)}
+	for index, page := range pages {
+		if pageErrors[index] != nil {
+			sample.FailedPages++
+			sample.Errors = append(sample.Errors, pageErrors[index])
+			continue
+		}
+		for _, instance := range page {
+			if len(sample.Instances) == n {
+				break
+			}
+			sample.Instances = append(sample.Instances, sampleInstance(instance))
+		}
+	}
+	if sample.FailedPages == pageCount {
+		return InstanceSample{}, errors.Join(sample.Errors...)
+	}
+	if sample.FailedPages > 0 {
+		s.warn(WarningOccurrencePages, "skipped %d of %d occurrence pages that failed to download", sample.FailedPages, pageCount)
+	}
+	if unparsed := countUnparsed(sample.Instances); unparsed > 0 {
+		s.warn(WarningUnparsedOccurrences, "%d of %d sampled occurrences had no recognizable error message", unparsed, len(sample.Instances))
+	}
+
+	return sample, nil
+}
+
+func countUnparsed(instances []SampledInstance) int {
+	count := 0
+	for _, instance := range instances {
+		if instance.MainError == "unknown" {
+			count++
+		}
+	}
+
+	return count
+}
+
+func sampleInstance(instance rollbar.ItemInstance) SampledInstance {
+	return SampledInstance{
+		Instance:    instance,
+		MainError:   summary.MainError(instance.Body, instance.Data),
+		UUID:        summary.OccurrenceUUID(instance.Data),
+		CodeVersion: summary.CodeVersion(instance.Data),
+		Environment: summary.StringAt(instance.Data, "environment"),
+	}
+}
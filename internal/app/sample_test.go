@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type failingPagesAPI struct {
+	pagedInstancesAPI
+	failPages map[int]bool
+}
+
+func (f failingPagesAPI) ListInstances(ctx context.Context, itemID domain.ItemID, opts rollbar.InstanceListOptions) ([]rollbar.ItemInstance, error) {
+	if f.failPages[opts.Page] {
+		return nil, errors.New("boom")
+	}
+
+	return f.pagedInstancesAPI.ListInstances(ctx, itemID, opts)
+}
+
+func newFailingPagesAPI(total int, failPages ...int) failingPagesAPI {
+	api := failingPagesAPI{
+		pagedInstancesAPI: pagedInstancesAPI{total: total, mu: &sync.Mutex{}, seen: map[int]int{}},
+		failPages:         map[int]bool{},
+	}
+	for _, page := range failPages {
+		api.failPages[page] = true
+	}
+
+	return api
+}
+
+func TestServiceSampleInstances(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(newFailingPagesAPI(500))
+	sample, err := service.SampleInstances(context.Background(), 7, 250)
+	if err != nil {
+		t.Fatalf("SampleInstances() error = %v", err)
+	}
+	if sample.ItemID != 123 || len(sample.Instances) != 250 || sample.FailedPages != 0 {
+		t.Fatalf("unexpected sample: id=%d len=%d failed=%d", sample.ItemID, len(sample.Instances), sample.FailedPages)
+	}
+	if sample.Instances[0].Instance.ID != 1000 || sample.Instances[249].Instance.ID != 751 {
+		t.Fatalf("expected newest-first order, got %d..%d", sample.Instances[0].Instance.ID, sample.Instances[249].Instance.ID)
+	}
+}
+
+func TestServiceSampleInstancesToleratesFailedPages(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(newFailingPagesAPI(500, 2))
+	sample, err := service.SampleInstances(context.Background(), 7, 300)
+	if err != nil {
+		t.Fatalf("SampleInstances() error = %v", err)
+	}
+	if len(sample.Instances) != 200 || sample.FailedPages != 1 || len(sample.Errors) != 1 {
+		t.Fatalf("expected a partial sample, got len=%d failed=%d errors=%v", len(sample.Instances), sample.FailedPages, sample.Errors)
+	}
+	warnings := service.Warnings()
+	if len(warnings) != 2 || warnings[0].Code != WarningOccurrencePages || warnings[1].Code != WarningUnparsedOccurrences {
+		t.Fatalf("unexpected warnings: %+v", warnings)
+	}
+
+	if _, err := NewService(newFailingPagesAPI(500, 1)).SampleInstances(context.Background(), 7, 50); err == nil {
+		t.Fatalf("expected error when every page fails")
+	}
+	if _, err := service.SampleInstances(context.Background(), 7, 0); err == nil {
+		t.Fatalf("expected sample size error")
+	}
+}
+
+func TestSampleInstanceExtractsSummary(t *testing.T) {
+	t.Parallel()
+
+	sampled := sampleInstance(rollbar.ItemInstance{ID: 1, Data: json.RawMessage(`{
+		"uuid": "abc",
+		"code_version": "v2",
+		"environment": "production",
+		"trace": {"exception": {"message": "boom"}}
+	}`)})
+	if sampled.MainError != "boom" || sampled.UUID != "abc" || sampled.CodeVersion != "v2" || sampled.Environment != "production" {
+		t.Fatalf("unexpected summary: %+v", sampled)
+	}
+}
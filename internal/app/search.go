@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const maxSearchRQLRows = 1000
+
+type SearchQuery struct {
+	Text    string
+	Regex   bool
+	Filters IssueFilters
+	Limit   int
+}
+
+func BuildSearchRQL(text string) string {
+	like := rqlString("%" + text + "%")
+
+	return fmt.Sprintf("SELECT item.counter FROM item_occurrence WHERE item.title LIKE %s OR body.trace.exception.message LIKE %s OR body.message.body LIKE %s GROUP BY item.counter LIMIT %d",
+		like, like, like, maxSearchRQLRows)
+}
+
+func (s *Service) Search(ctx context.Context, q SearchQuery) ([]IssueSummary, error) {
+	matches, err := newTitleMatcher(q.Text, q.Regex)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentioned map[uint64]bool
+	if !q.Regex {
+		if mentioned, err = s.searchRQL(ctx, q.Text); err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			s.warn(WarningSearchFallback, "server-side search unavailable, matched titles only: %v", err)
+		}
+	}
+
+	s.explainList(q.Filters)
+	s.explainClientFilter("text", q.Text)
+	items := make([]rollbar.Item, 0)
+	maxPages := s.itemPageCap(maxExportItemPages)
+	rate := s.newRateFilter(q.Filters.MinRate)
+	var rateErr error
+	more, err := s.scanItemPages(ctx, recentStatus(q.Filters), maxPages, func(page []rollbar.Item) bool {
+		found := make([]rollbar.Item, 0)
+		for _, item := range s.filterItems(page, q.Filters) {
+			if mentioned[item.Counter] || matches(item.Title) {
+				found = append(found, item)
+			}
+		}
+		found, rateErr = rate.items(ctx, found)
+		items = append(items, found...)
+		return rateErr == nil && (q.Limit <= 0 || len(items) < q.Limit)
+	})
+	if err = errors.Join(err, rateErr); err != nil {
+		return nil, fmt.Errorf("search items: %w", err)
+	}
+	rate.finish()
+	if more {
+		s.warn(WarningPartialPagination, "stopped after %d pages with %d matching issues; older issues were not searched", maxPages, len(items))
+	}
+	items = sortRecentItems(items)
+	if q.Limit > 0 && len(items) > q.Limit {
+		s.ExplainStage("limit", len(items), q.Limit)
+		items = items[:q.Limit]
+	}
+
+	return s.mapSummaries(items), nil
+}
+
+func (s *Service) searchRQL(ctx context.Context, text string) (map[uint64]bool, error) {
+	result, err := s.runRQL(ctx, BuildSearchRQL(text), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, fmt.Errorf("rql: %s", strings.Join(result.Errors, "; "))
+	}
+
+	counters := make(map[uint64]bool, len(result.Rows))
+	for _, row := range result.Rows {
+		if len(row) == 0 {
+			continue
+		}
+		if counter, ok := rqlCounter(row[0]); ok {
+			counters[counter] = true
+		}
+	}
+
+	return counters, nil
+}
+
+func rqlCounter(value any) (uint64, bool) {
+	counter, err := domain.ParseItemCounter(fmt.Sprint(value))
+
+	return uint64(counter), err == nil
+}
+
+func newTitleMatcher(text string, regex bool) (func(string) bool, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("search text is required")
+	}
+	if !regex {
+		needle := strings.ToLower(text)
+		return func(title string) bool {
+			return strings.Contains(strings.ToLower(title), needle)
+		}, nil
+	}
+
+	pattern, err := regexp.Compile(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search regex: %w", err)
+	}
+
+	return pattern.MatchString, nil
+}
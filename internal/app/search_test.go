@@ -0,0 +1,72 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestBuildSearchRQL(t *testing.T) {
+	t.Parallel()
+
+	got := BuildSearchRQL("it's slow")
+	want := `SELECT item.counter FROM item_occurrence WHERE item.title LIKE '%it\'s slow%' OR body.trace.exception.message LIKE '%it\'s slow%' OR body.message.body LIKE '%it\'s slow%' GROUP BY item.counter LIMIT 1000`
+	if got != want {
+		t.Fatalf("BuildSearchRQL() = %q, want %q", got, want)
+	}
+}
+
+func TestServiceSearch(t *testing.T) {
+	t.Parallel()
+
+	ts1, ts2 := uint64(100), uint64(200)
+	items := []rollbar.Item{
+		{ID: 1, Counter: 1, Title: "Read Timeout in checkout", Environment: "production", LastOccurrenceTimestamp: &ts1},
+		{ID: 2, Counter: 2, Title: "NoMethodError", Environment: "production", LastOccurrenceTimestamp: &ts2},
+		{ID: 3, Counter: 3, Title: "timeout talking to redis", Environment: "staging", LastOccurrenceTimestamp: &ts2},
+		{ID: 4, Counter: 4, Title: "KeyError", Environment: "production"},
+	}
+	mentioned := rollbar.RQLResult{Columns: []string{"item.counter"}, Rows: [][]any{{json.Number("2")}}}
+	unavailable := rollbar.RQLResult{Errors: []string{"RQL is not enabled for this project"}}
+
+	tests := []struct {
+		name         string
+		query        SearchQuery
+		rql          rollbar.RQLResult
+		want         []uint64
+		wantFallback bool
+	}{
+		{name: "title or main error", query: SearchQuery{Text: "TIMEOUT"}, rql: mentioned, want: []uint64{2, 3, 1}},
+		{name: "with filters", query: SearchQuery{Text: "timeout", Filters: IssueFilters{Environment: "production"}}, rql: mentioned, want: []uint64{2, 1}},
+		{name: "limited", query: SearchQuery{Text: "timeout", Limit: 1}, rql: mentioned, want: []uint64{2}},
+		{name: "titles when rql fails", query: SearchQuery{Text: "timeout"}, rql: unavailable, want: []uint64{3, 1}, wantFallback: true},
+		{name: "regex", query: SearchQuery{Text: `^[A-Z][a-z]+Error$`, Regex: true}, rql: mentioned, want: []uint64{4}},
+	}
+	for _, tc := range tests {
+		service := NewService(fakeAPI{itemPages: [][]rollbar.Item{items}, rqlResult: tc.rql})
+		issues, err := service.Search(context.Background(), tc.query)
+		if err != nil {
+			t.Fatalf("%s: Search() error = %v", tc.name, err)
+		}
+		got := make([]uint64, 0, len(issues))
+		for _, issue := range issues {
+			got = append(got, uint64(issue.Counter))
+		}
+		if !slices.Equal(got, tc.want) {
+			t.Fatalf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+		warnings := service.Warnings()
+		if fellBack := len(warnings) == 1 && warnings[0].Code == WarningSearchFallback; fellBack != tc.wantFallback {
+			t.Fatalf("%s: unexpected warnings %+v", tc.name, warnings)
+		}
+	}
+
+	for _, query := range []SearchQuery{{Text: " "}, {Text: "(", Regex: true}} {
+		if _, err := NewService(fakeAPI{}).Search(context.Background(), query); err == nil {
+			t.Fatalf("expected an error for %+v", query)
+		}
+	}
+}
@@ -3,13 +3,17 @@ package app
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/parallel"
 	"github.com/kevinsheth/rollbaz/internal/rollbar"
 	"github.com/kevinsheth/rollbaz/internal/summary"
 )
@@ -18,77 +22,237 @@ type RollbarAPI interface {
 	ResolveItemIDByCounter(ctx context.Context, counter domain.ItemCounter) (domain.ItemID, error)
 	GetItem(ctx context.Context, itemID domain.ItemID) (rollbar.Item, error)
 	UpdateItem(ctx context.Context, itemID domain.ItemID, patch rollbar.ItemPatch) error
+	AddItemComment(ctx context.Context, itemID domain.ItemID, text string) error
 	GetLatestInstance(ctx context.Context, itemID domain.ItemID) (*rollbar.ItemInstance, error)
+	ListInstances(ctx context.Context, itemID domain.ItemID, opts rollbar.InstanceListOptions) ([]rollbar.ItemInstance, error)
+	GetInstance(ctx context.Context, occurrenceID domain.OccurrenceID) (rollbar.ItemInstance, error)
 	ListActiveItems(ctx context.Context, limit int) ([]rollbar.Item, error)
-	ListItems(ctx context.Context, status string, page int) ([]rollbar.Item, error)
+	GetTopActiveItems(ctx context.Context, query rollbar.TopActiveQuery) ([]rollbar.TopActiveItem, error)
+	ListItems(ctx context.Context, status domain.Status, page int) ([]rollbar.Item, error)
+	ListDeploys(ctx context.Context, page int) ([]rollbar.Deploy, error)
+	RecordDeploy(ctx context.Context, deploy rollbar.NewDeploy) (uint64, error)
+	GetOccurrenceCounts(ctx context.Context, query rollbar.OccurrenceCountsQuery) ([]rollbar.OccurrenceCount, error)
+	GetProject(ctx context.Context, projectID domain.ProjectID) (rollbar.Project, error)
+	CreateRQLJob(ctx context.Context, query string) (rollbar.RQLJob, error)
+	GetRQLJob(ctx context.Context, jobID uint64) (rollbar.RQLJob, error)
+	GetRQLJobResult(ctx context.Context, jobID uint64) (rollbar.RQLResult, error)
+	ListUsers(ctx context.Context) ([]rollbar.User, error)
+	GetUser(ctx context.Context, userID uint64) (rollbar.User, error)
 }
 
 type Service struct {
-	api RollbarAPI
+	api           RollbarAPI
+	now           func() time.Time
+	environments  domain.EnvironmentAliases
+	rqlPollPeriod time.Duration
+	warnings      *warningLog
+	explain       *explainLog
+	maxItemPages  int
 }
 
-func NewService(api RollbarAPI) *Service {
-	return &Service{api: api}
+type Option func(*Service)
+
+func WithClock(now func() time.Time) Option {
+	return func(s *Service) {
+		if now != nil {
+			s.now = now
+		}
+	}
+}
+
+func WithEnvironmentAliases(aliases domain.EnvironmentAliases) Option {
+	return func(s *Service) {
+		if aliases != nil {
+			s.environments = aliases
+		}
+	}
+}
+
+func WithMaxItemPages(pages int) Option {
+	return func(s *Service) {
+		if pages > 0 {
+			s.maxItemPages = pages
+		}
+	}
+}
+
+func NewService(api RollbarAPI, options ...Option) *Service {
+	service := &Service{api: api, now: time.Now, environments: domain.DefaultEnvironmentAliases(), rqlPollPeriod: defaultRQLPollPeriod, warnings: &warningLog{}, explain: &explainLog{}}
+	for _, option := range options {
+		option(service)
+	}
+
+	return service
+}
+
+func (s *Service) Now() time.Time {
+	return s.now().UTC()
 }
 
 type IssueSummary struct {
-	ItemID                  domain.ItemID      `json:"item_id"`
-	Counter                 domain.ItemCounter `json:"counter"`
-	Title                   string             `json:"title"`
-	Status                  string             `json:"status"`
-	Environment             string             `json:"environment"`
-	LastOccurrenceTimestamp *uint64            `json:"last_occurrence_timestamp,omitempty"`
-	Occurrences             *uint64            `json:"occurrences,omitempty"`
-	Raw                     json.RawMessage    `json:"raw,omitempty"`
+	ItemID                    domain.ItemID      `json:"item_id"`
+	ProjectID                 domain.ProjectID   `json:"project_id,omitempty"`
+	Counter                   domain.ItemCounter `json:"counter"`
+	Title                     string             `json:"title"`
+	Status                    domain.Status      `json:"status"`
+	Environment               domain.Environment `json:"environment"`
+	Level                     domain.Level       `json:"level,omitempty"`
+	LastOccurrenceTimestamp   *uint64            `json:"last_occurrence_timestamp,omitempty"`
+	FirstOccurrenceTimestamp  *uint64            `json:"first_occurrence_timestamp,omitempty"`
+	Occurrences               *uint64            `json:"occurrences,omitempty"`
+	SnoozeEnabled             bool               `json:"snooze_enabled,omitempty"`
+	SnoozeExpirationInSeconds *uint64            `json:"snooze_expiration_in_seconds,omitempty"`
+	SnoozeExpiresAt           *uint64            `json:"snooze_expires_at,omitempty"`
+	AssignedUserID            *uint64            `json:"assigned_user_id,omitempty"`
+	Trend                     *IssueTrend        `json:"trend,omitempty"`
+	Raw                       json.RawMessage    `json:"raw,omitempty"`
 }
 
 type IssueDetail struct {
 	IssueSummary
 	MainError   string                `json:"main_error"`
+	Traces      []summary.Trace       `json:"traces,omitempty"`
+	Assignee    *rollbar.User         `json:"assignee,omitempty"`
 	ItemRaw     json.RawMessage       `json:"item_raw,omitempty"`
 	Instance    *rollbar.ItemInstance `json:"instance,omitempty"`
 	InstanceRaw json.RawMessage       `json:"instance_raw,omitempty"`
 }
 
 type IssueFilters struct {
-	Environment    string
-	Status         string
-	Since          *time.Time
-	Until          *time.Time
-	MinOccurrences *uint64
-	MaxOccurrences *uint64
+	Environment        domain.Environment
+	Status             domain.Status
+	AllStatuses        bool
+	Since              *time.Time
+	Until              *time.Time
+	MinOccurrences     *uint64
+	MaxOccurrences     *uint64
+	MinAge             *time.Duration
+	MaxAge             *time.Duration
+	MinLevel           domain.Level
+	Levels             []domain.Level
+	HiddenEnvironments []domain.Environment
+	MinRate            *OccurrenceRate
 }
 
-const maxResolvedVersionLength = 40
+const (
+	maxResolvedVersionLength = 40
+	defaultConcurrency       = 4
+	maxExportItemPages       = 200
+	maxRecentItemPages       = 20
+)
 
 type ItemActionResult struct {
-	Action string       `json:"action"`
-	Issue  IssueSummary `json:"issue"`
+	Action    string       `json:"action"`
+	Issue     IssueSummary `json:"issue"`
+	Unchanged bool         `json:"unchanged,omitempty"`
 }
 
 func (s *Service) Active(ctx context.Context, limit int, filters IssueFilters) ([]IssueSummary, error) {
+	s.explainFilters(nil, describeClientFilters(s.normalizeIssueFilters(filters)))
 	items, err := s.api.ListActiveItems(ctx, limit)
 	if err != nil {
 		return nil, fmt.Errorf("list active items: %w", err)
 	}
-	items = filterItems(items, filters)
+	s.explainCall("/reports/top_active_items", nil, len(items))
+	rate := s.newRateFilter(filters.MinRate)
+	items, err = rate.items(ctx, s.filterItems(items, filters))
+	if err != nil {
+		return nil, err
+	}
+	rate.finish()
 
-	return mapSummaries(items), nil
+	return s.mapSummaries(items), nil
 }
 
 func (s *Service) Recent(ctx context.Context, limit int, filters IssueFilters) ([]IssueSummary, error) {
-	items, err := s.api.ListItems(ctx, "active", 1)
-	if err != nil {
+	s.explainList(filters)
+	items := make([]rollbar.Item, 0)
+	maxPages := s.itemPageCap(maxRecentItemPages)
+	rate := s.newRateFilter(filters.MinRate)
+	var rateErr error
+	more, err := s.scanItemPages(ctx, recentStatus(filters), maxPages, func(page []rollbar.Item) bool {
+		matched, err := rate.items(ctx, s.filterItems(page, filters))
+		if err != nil {
+			rateErr = err
+			return false
+		}
+		items = append(items, matched...)
+		return limit > 0 && len(items) < limit
+	})
+	if err = errors.Join(err, rateErr); err != nil {
 		return nil, fmt.Errorf("list recent items: %w", err)
 	}
-	items = filterItems(items, filters)
+	rate.finish()
+	if more {
+		s.warn(WarningPartialPagination, "stopped after %d pages with %d of %d issues matching; older issues were not searched", maxPages, len(items), limit)
+	}
+	items = sortRecentItems(items)
 
+	if limit > 0 && len(items) > limit {
+		s.ExplainStage("limit", len(items), limit)
+		items = items[:limit]
+	}
+
+	return s.mapSummaries(items), nil
+}
+
+func (s *Service) RecentAll(ctx context.Context, filters IssueFilters) ([]IssueSummary, error) {
+	s.explainList(filters)
+	items, err := s.listItemPages(ctx, recentStatus(filters), maxExportItemPages)
+	if err != nil {
+		return nil, err
+	}
+	rate := s.newRateFilter(filters.MinRate)
+	items, err = rate.items(ctx, s.filterItems(items, filters))
+	if err != nil {
+		return nil, err
+	}
+	rate.finish()
+
+	return s.mapSummaries(sortRecentItems(items)), nil
+}
+
+func IssuePage(issues []IssueSummary, page int, size int) []IssueSummary {
+	if size <= 0 {
+		return issues
+	}
+	start := (max(page, 1) - 1) * size
+	if start >= len(issues) {
+		return []IssueSummary{}
+	}
+
+	return issues[start:min(start+size, len(issues))]
+}
+
+func (s *Service) explainList(filters IssueFilters) {
+	var server []ExplainFilter
+	if status := recentStatus(filters); status != "" {
+		server = append(server, ExplainFilter{Name: "status", Value: status.String()})
+	}
+	s.explainFilters(server, describeClientFilters(s.normalizeIssueFilters(filters)))
+}
+
+func recentStatus(filters IssueFilters) domain.Status {
+	switch {
+	case filters.AllStatuses:
+		return ""
+	case filters.Status != "":
+		return filters.Status
+	default:
+		return domain.StatusActive
+	}
+}
+
+func sortRecentItems(items []rollbar.Item) []rollbar.Item {
 	sort.SliceStable(items, func(i int, j int) bool {
 		leftTS := uint64Value(items[i].LastOccurrenceTimestamp)
 		rightTS := uint64Value(items[j].LastOccurrenceTimestamp)
 		if leftTS != rightTS {
 			return leftTS > rightTS
 		}
+		if severity := domain.CompareLevels(items[i].Level, items[j].Level); severity != 0 {
+			return severity > 0
+		}
 
 		leftOccurrence := totalOccurrences(items[i])
 		rightOccurrence := totalOccurrences(items[j])
@@ -96,11 +260,15 @@ func (s *Service) Recent(ctx context.Context, limit int, filters IssueFilters) (
 		return leftOccurrence > rightOccurrence
 	})
 
-	if limit > 0 && len(items) > limit {
-		items = items[:limit]
+	return items
+}
+
+func (s *Service) VerifyAccess(ctx context.Context) error {
+	if _, err := s.api.ListItems(ctx, domain.StatusActive, 1); err != nil {
+		return fmt.Errorf("verify access: %w", err)
 	}
 
-	return mapSummaries(items), nil
+	return nil
 }
 
 func (s *Service) Show(ctx context.Context, counter domain.ItemCounter) (IssueDetail, error) {
@@ -121,8 +289,10 @@ func (s *Service) Show(ctx context.Context, counter domain.ItemCounter) (IssueDe
 
 	mainError := "unknown"
 	instanceRaw := json.RawMessage(nil)
+	var traces []summary.Trace
 	if instance != nil {
 		mainError = summary.MainError(instance.Body, instance.Data)
+		traces = summary.Traces(instance.Body, instance.Data)
 		instanceRaw = instance.Raw
 	}
 	if mainError == "unknown" && strings.TrimSpace(item.Title) != "" {
@@ -130,8 +300,10 @@ func (s *Service) Show(ctx context.Context, counter domain.ItemCounter) (IssueDe
 	}
 
 	return IssueDetail{
-		IssueSummary: mapSummary(item),
+		IssueSummary: s.mapSummary(item),
 		MainError:    mainError,
+		Traces:       traces,
+		Assignee:     s.assignee(ctx, item.AssignedUserID),
 		ItemRaw:      item.Raw,
 		Instance:     instance,
 		InstanceRaw:  instanceRaw,
@@ -144,18 +316,18 @@ func (s *Service) Resolve(ctx context.Context, counter domain.ItemCounter, resol
 		return ItemActionResult{}, fmt.Errorf("resolved_in_version must be <= %d characters", maxResolvedVersionLength)
 	}
 
-	patch := rollbar.ItemPatch{Status: "resolved", ResolvedInVersion: trimmedVersion}
+	patch := rollbar.ItemPatch{Status: domain.StatusResolved, ResolvedInVersion: trimmedVersion}
 
 	return s.updateItemAndFetch(ctx, counter, patch, "resolved")
 }
 
 func (s *Service) Reopen(ctx context.Context, counter domain.ItemCounter) (ItemActionResult, error) {
-	return s.updateItemAndFetch(ctx, counter, rollbar.ItemPatch{Status: "active"}, "reopened")
+	return s.updateItemAndFetch(ctx, counter, rollbar.ItemPatch{Status: domain.StatusActive}, "reopened")
 }
 
 func (s *Service) Mute(ctx context.Context, counter domain.ItemCounter, durationSeconds *int64) (ItemActionResult, error) {
 	snoozeEnabled := true
-	patch := rollbar.ItemPatch{Status: "muted", SnoozeEnabled: &snoozeEnabled, SnoozeExpirationInSeconds: durationSeconds}
+	patch := rollbar.ItemPatch{Status: domain.StatusMuted, SnoozeEnabled: &snoozeEnabled, SnoozeExpirationInSeconds: durationSeconds}
 
 	return s.updateItemAndFetch(ctx, counter, patch, "muted")
 }
@@ -166,6 +338,14 @@ func (s *Service) updateItemAndFetch(ctx context.Context, counter domain.ItemCou
 		return ItemActionResult{}, fmt.Errorf("resolve item id: %w", err)
 	}
 
+	current, err := s.api.GetItem(rollbar.WithoutResponseCache(ctx), itemID)
+	if err != nil {
+		return ItemActionResult{}, fmt.Errorf("get item: %w", err)
+	}
+	if alreadyApplied(current, patch) {
+		return ItemActionResult{Action: action, Issue: s.mapSummary(current), Unchanged: true}, nil
+	}
+
 	if err := s.api.UpdateItem(ctx, itemID, patch); err != nil {
 		return ItemActionResult{}, fmt.Errorf("update item: %w", err)
 	}
@@ -175,53 +355,190 @@ func (s *Service) updateItemAndFetch(ctx context.Context, counter domain.ItemCou
 		return ItemActionResult{}, fmt.Errorf("get item: %w", err)
 	}
 
-	return ItemActionResult{Action: action, Issue: mapSummary(item)}, nil
+	return ItemActionResult{Action: action, Issue: s.mapSummary(item)}, nil
 }
 
-func mapSummaries(items []rollbar.Item) []IssueSummary {
+func alreadyApplied(item rollbar.Item, patch rollbar.ItemPatch) bool {
+	if patch.Status == "" || item.Status != patch.Status {
+		return false
+	}
+	if patch.Level != "" || patch.ResolvedInVersion != "" || patch.SnoozeExpirationInSeconds != nil || patch.AssignedUserID != nil || patch.Unassign {
+		return false
+	}
+
+	return item.SnoozeExpiresAt() == nil
+}
+
+func (s *Service) listItemPages(ctx context.Context, status domain.Status, maxPages int) ([]rollbar.Item, error) {
+	maxPages = s.itemPageCap(maxPages)
+	all := make([]rollbar.Item, 0)
+	var last []rollbar.Item
+	for start := 1; start <= maxPages; {
+		window := 1
+		if len(last) >= rollbar.ItemsPageSize {
+			window = defaultConcurrency
+		}
+		pages := make([]int, 0, window)
+		for page := start; page < start+window && page <= maxPages; page++ {
+			pages = append(pages, page)
+		}
+		results, err := parallel.Map(ctx, window, pages, func(ctx context.Context, page int) ([]rollbar.Item, error) {
+			items, err := s.api.ListItems(ctx, status, page)
+			if err != nil {
+				return nil, fmt.Errorf("list items page %d: %w", page, err)
+			}
+			s.explainCall("/items", itemsParams(status, page), len(items))
+			return items, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, items := range results {
+			if last = items; len(items) == 0 {
+				break
+			}
+			all = append(all, items...)
+		}
+		if len(last) == 0 {
+			break
+		}
+		start += len(pages)
+	}
+	if len(last) >= rollbar.ItemsPageSize {
+		s.warn(WarningPartialPagination, "stopped after %d pages (%d issues); older issues were not fetched", maxPages, len(all))
+	}
+
+	return all, nil
+}
+
+func (s *Service) itemPageCap(defaultPages int) int {
+	if s.maxItemPages > 0 {
+		return s.maxItemPages
+	}
+
+	return defaultPages
+}
+
+func (s *Service) scanItemPages(ctx context.Context, status domain.Status, maxPages int, visit func([]rollbar.Item) bool) (bool, error) {
+	for page := 1; page <= maxPages; page++ {
+		items, err := s.api.ListItems(ctx, status, page)
+		if err != nil {
+			return false, fmt.Errorf("page %d: %w", page, err)
+		}
+		s.explainCall("/items", itemsParams(status, page), len(items))
+		if !visit(items) || len(items) < rollbar.ItemsPageSize {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func itemsParams(status domain.Status, page int) map[string]string {
+	params := map[string]string{"page": strconv.Itoa(page)}
+	if status != "" {
+		params["status"] = status.String()
+	}
+
+	return params
+}
+
+func (s *Service) mapSummaries(items []rollbar.Item) []IssueSummary {
 	summaries := make([]IssueSummary, 0, len(items))
 	for _, item := range items {
-		summaries = append(summaries, mapSummary(item))
+		summaries = append(summaries, s.mapSummary(item))
 	}
 
 	return summaries
 }
 
-func filterItems(items []rollbar.Item, filters IssueFilters) []rollbar.Item {
-	normalized := normalizeIssueFilters(filters)
+type itemStage struct {
+	name string
+	keep func(rollbar.Item) bool
+}
+
+func (s *Service) filterItems(items []rollbar.Item, filters IssueFilters) []rollbar.Item {
+	normalized := s.normalizeIssueFilters(filters)
 	if !hasIssueFilters(normalized) {
 		return items
 	}
 
-	sinceUnix, untilUnix := unixBounds(normalized)
-
-	filtered := make([]rollbar.Item, 0, len(items))
-	for _, item := range items {
-		if !matchesTextFilter(strings.TrimSpace(item.Environment), normalized.Environment) {
-			continue
-		}
-		if !matchesTextFilter(strings.TrimSpace(item.Status), normalized.Status) {
-			continue
+	filtered := items
+	for _, stage := range s.itemStages(normalized) {
+		kept := make([]rollbar.Item, 0, len(filtered))
+		for _, item := range filtered {
+			if stage.keep(item) {
+				kept = append(kept, item)
+			}
 		}
-		if !matchesTimeFilter(item.LastOccurrenceTimestamp, sinceUnix, untilUnix) {
-			continue
-		}
-		if !matchesOccurrenceFilter(item, normalized.MinOccurrences, normalized.MaxOccurrences) {
-			continue
-		}
-		filtered = append(filtered, item)
+		s.ExplainStage(stage.name, len(filtered), len(kept))
+		filtered = kept
 	}
 
 	return filtered
 }
 
+func (s *Service) itemStages(filters IssueFilters) []itemStage {
+	stages := make([]itemStage, 0, 7)
+	if filters.Environment != "" {
+		stages = append(stages, itemStage{name: "env", keep: func(item rollbar.Item) bool {
+			return s.environments.Canonical(item.Environment) == filters.Environment
+		}})
+	} else if len(filters.HiddenEnvironments) > 0 {
+		stages = append(stages, itemStage{name: "hidden_environments", keep: func(item rollbar.Item) bool {
+			return !slices.Contains(filters.HiddenEnvironments, s.environments.Canonical(item.Environment))
+		}})
+	}
+	if filters.Status != "" {
+		stages = append(stages, itemStage{name: "status", keep: func(item rollbar.Item) bool {
+			return item.Status == filters.Status
+		}})
+	}
+	if filters.Since != nil || filters.Until != nil {
+		sinceUnix, untilUnix := unixBounds(filters)
+		stages = append(stages, itemStage{name: "last_seen", keep: func(item rollbar.Item) bool {
+			return matchesTimeFilter(item.LastOccurrenceTimestamp, sinceUnix, untilUnix)
+		}})
+	}
+	if filters.MinOccurrences != nil || filters.MaxOccurrences != nil {
+		stages = append(stages, itemStage{name: "occurrences", keep: func(item rollbar.Item) bool {
+			return matchesOccurrenceFilter(item, filters.MinOccurrences, filters.MaxOccurrences)
+		}})
+	}
+	if filters.MinAge != nil || filters.MaxAge != nil {
+		now := s.Now()
+		stages = append(stages, itemStage{name: "age", keep: func(item rollbar.Item) bool {
+			return matchesAgeFilter(item.FirstOccurrenceTimestamp, filters.MinAge, filters.MaxAge, now)
+		}})
+	}
+	if filters.MinLevel != "" {
+		stages = append(stages, itemStage{name: "level", keep: func(item rollbar.Item) bool {
+			return item.Level.AtLeast(filters.MinLevel)
+		}})
+	}
+	if len(filters.Levels) > 0 {
+		stages = append(stages, itemStage{name: "levels", keep: func(item rollbar.Item) bool {
+			return slices.ContainsFunc(filters.Levels, func(level domain.Level) bool {
+				return strings.EqualFold(item.Level.String(), level.String())
+			})
+		}})
+	}
+
+	return stages
+}
+
 func hasIssueFilters(filters IssueFilters) bool {
-	return filters.Environment != "" || filters.Status != "" || filters.Since != nil || filters.Until != nil || filters.MinOccurrences != nil || filters.MaxOccurrences != nil
+	return filters.Environment != "" || filters.Status != "" || filters.Since != nil || filters.Until != nil || filters.MinOccurrences != nil || filters.MaxOccurrences != nil ||
+		filters.MinAge != nil || filters.MaxAge != nil || filters.MinLevel != "" || len(filters.Levels) > 0 || len(filters.HiddenEnvironments) > 0
 }
 
-func normalizeIssueFilters(filters IssueFilters) IssueFilters {
-	filters.Environment = strings.TrimSpace(filters.Environment)
-	filters.Status = strings.TrimSpace(filters.Status)
+func (s *Service) normalizeIssueFilters(filters IssueFilters) IssueFilters {
+	filters.Environment = s.environments.Canonical(filters.Environment.String())
+	hidden := make([]domain.Environment, 0, len(filters.HiddenEnvironments))
+	for _, environment := range filters.HiddenEnvironments {
+		hidden = append(hidden, s.environments.Canonical(environment.String()))
+	}
+	filters.HiddenEnvironments = hidden
 
 	return filters
 }
@@ -242,14 +559,6 @@ func unixBounds(filters IssueFilters) (*int64, *int64) {
 	return sinceUnix, untilUnix
 }
 
-func matchesTextFilter(value string, filter string) bool {
-	if filter == "" {
-		return true
-	}
-
-	return strings.EqualFold(value, filter)
-}
-
 func matchesTimeFilter(timestamp *uint64, sinceUnix *int64, untilUnix *int64) bool {
 	if sinceUnix == nil && untilUnix == nil {
 		return true
@@ -284,21 +593,47 @@ func matchesOccurrenceFilter(item rollbar.Item, minOccurrences *uint64, maxOccur
 	return true
 }
 
-func mapSummary(item rollbar.Item) IssueSummary {
+func matchesAgeFilter(firstSeen *uint64, minAge *time.Duration, maxAge *time.Duration, now time.Time) bool {
+	if minAge == nil && maxAge == nil {
+		return true
+	}
+	if firstSeen == nil {
+		return false
+	}
+
+	age := now.Sub(time.Unix(clampUnix(*firstSeen), 0))
+	if minAge != nil && age < *minAge {
+		return false
+	}
+	if maxAge != nil && age > *maxAge {
+		return false
+	}
+
+	return true
+}
+
+func (s *Service) mapSummary(item rollbar.Item) IssueSummary {
 	occurrences := item.TotalOccurrences
 	if occurrences == nil {
 		occurrences = item.Occurrences
 	}
 
 	return IssueSummary{
-		ItemID:                  item.ID,
-		Counter:                 domain.ItemCounter(item.Counter),
-		Title:                   item.Title,
-		Status:                  item.Status,
-		Environment:             item.Environment,
-		LastOccurrenceTimestamp: item.LastOccurrenceTimestamp,
-		Occurrences:             occurrences,
-		Raw:                     item.Raw,
+		ItemID:                    item.ID,
+		ProjectID:                 item.ProjectID,
+		Counter:                   domain.ItemCounter(item.Counter),
+		Title:                     item.Title,
+		Status:                    item.Status,
+		Environment:               s.environments.Canonical(item.Environment),
+		Level:                     item.Level,
+		LastOccurrenceTimestamp:   item.LastOccurrenceTimestamp,
+		FirstOccurrenceTimestamp:  item.FirstOccurrenceTimestamp,
+		Occurrences:               occurrences,
+		SnoozeEnabled:             item.SnoozeEnabled,
+		SnoozeExpirationInSeconds: item.SnoozeExpirationInSeconds,
+		SnoozeExpiresAt:           item.SnoozeExpiresAt(),
+		AssignedUserID:            item.AssignedUserID,
+		Raw:                       item.Raw,
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"math"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -18,9 +19,70 @@ type fakeAPI struct {
 	listItems   []rollbar.Item
 	item        rollbar.Item
 	instance    *rollbar.ItemInstance
+	instances   []rollbar.ItemInstance
+	deploys     []rollbar.Deploy
+	counts      []rollbar.OccurrenceCount
+	project     rollbar.Project
+	itemPages   [][]rollbar.Item
+	rqlResult   rollbar.RQLResult
+	topActive   []rollbar.TopActiveItem
+	users       []rollbar.User
 	err         error
 }
 
+func (f fakeAPI) ListUsers(ctx context.Context) ([]rollbar.User, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return f.users, nil
+}
+
+func (f fakeAPI) GetUser(ctx context.Context, userID uint64) (rollbar.User, error) {
+	if f.err != nil {
+		return rollbar.User{}, f.err
+	}
+	for _, user := range f.users {
+		if user.ID == userID {
+			return user, nil
+		}
+	}
+
+	return rollbar.User{}, rollbar.ErrNotFound
+}
+
+func (f fakeAPI) GetProject(ctx context.Context, projectID domain.ProjectID) (rollbar.Project, error) {
+	if f.err != nil {
+		return rollbar.Project{}, f.err
+	}
+
+	return f.project, nil
+}
+
+func (f fakeAPI) CreateRQLJob(ctx context.Context, query string) (rollbar.RQLJob, error) {
+	if f.err != nil {
+		return rollbar.RQLJob{}, f.err
+	}
+
+	return rollbar.RQLJob{ID: 1, Status: rollbar.RQLJobSuccess, QueryString: query}, nil
+}
+
+func (f fakeAPI) GetRQLJob(ctx context.Context, jobID uint64) (rollbar.RQLJob, error) {
+	if f.err != nil {
+		return rollbar.RQLJob{}, f.err
+	}
+
+	return rollbar.RQLJob{ID: jobID, Status: rollbar.RQLJobSuccess}, nil
+}
+
+func (f fakeAPI) GetRQLJobResult(ctx context.Context, jobID uint64) (rollbar.RQLResult, error) {
+	if f.err != nil {
+		return rollbar.RQLResult{}, f.err
+	}
+
+	return f.rqlResult, nil
+}
+
 func (f fakeAPI) ResolveItemIDByCounter(ctx context.Context, counter domain.ItemCounter) (domain.ItemID, error) {
 	if f.err != nil {
 		return 0, f.err
@@ -43,6 +105,10 @@ func (f fakeAPI) UpdateItem(ctx context.Context, itemID domain.ItemID, patch rol
 	return nil
 }
 
+func (f fakeAPI) AddItemComment(ctx context.Context, itemID domain.ItemID, text string) error {
+	return f.err
+}
+
 func (f fakeAPI) GetLatestInstance(ctx context.Context, itemID domain.ItemID) (*rollbar.ItemInstance, error) {
 	if f.err != nil {
 		return nil, f.err
@@ -50,6 +116,33 @@ func (f fakeAPI) GetLatestInstance(ctx context.Context, itemID domain.ItemID) (*
 	return f.instance, nil
 }
 
+func (f fakeAPI) ListInstances(ctx context.Context, itemID domain.ItemID, opts rollbar.InstanceListOptions) ([]rollbar.ItemInstance, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.instances, nil
+}
+
+func (f fakeAPI) GetInstance(ctx context.Context, occurrenceID domain.OccurrenceID) (rollbar.ItemInstance, error) {
+	if f.err != nil {
+		return rollbar.ItemInstance{}, f.err
+	}
+	for _, instance := range f.instances {
+		if instance.ID == occurrenceID {
+			return instance, nil
+		}
+	}
+
+	return rollbar.ItemInstance{}, rollbar.ErrNotFound
+}
+
+func (f fakeAPI) GetTopActiveItems(ctx context.Context, query rollbar.TopActiveQuery) ([]rollbar.TopActiveItem, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.topActive, nil
+}
+
 func (f fakeAPI) ListActiveItems(ctx context.Context, limit int) ([]rollbar.Item, error) {
 	if f.err != nil {
 		return nil, f.err
@@ -57,13 +150,47 @@ func (f fakeAPI) ListActiveItems(ctx context.Context, limit int) ([]rollbar.Item
 	return f.activeItems, nil
 }
 
-func (f fakeAPI) ListItems(ctx context.Context, status string, page int) ([]rollbar.Item, error) {
+func (f fakeAPI) ListItems(ctx context.Context, status domain.Status, page int) ([]rollbar.Item, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
+	if f.itemPages != nil {
+		if page > len(f.itemPages) {
+			return nil, nil
+		}
+		return f.itemPages[page-1], nil
+	}
+	if page > 1 {
+		return nil, nil
+	}
 	return f.listItems, nil
 }
 
+func (f fakeAPI) ListDeploys(ctx context.Context, page int) ([]rollbar.Deploy, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if page > 1 {
+		return nil, nil
+	}
+	return f.deploys, nil
+}
+
+func (f fakeAPI) RecordDeploy(ctx context.Context, deploy rollbar.NewDeploy) (uint64, error) {
+	if f.err != nil {
+		return 0, f.err
+	}
+
+	return 1, nil
+}
+
+func (f fakeAPI) GetOccurrenceCounts(ctx context.Context, query rollbar.OccurrenceCountsQuery) ([]rollbar.OccurrenceCount, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.counts, nil
+}
+
 func TestServiceActive(t *testing.T) {
 	t.Parallel()
 
@@ -79,6 +206,17 @@ func TestServiceActive(t *testing.T) {
 	}
 }
 
+func TestServiceVerifyAccess(t *testing.T) {
+	t.Parallel()
+
+	if err := NewService(fakeAPI{}).VerifyAccess(context.Background()); err != nil {
+		t.Fatalf("VerifyAccess() error = %v", err)
+	}
+	if err := NewService(fakeAPI{err: rollbar.ErrUnauthorized}).VerifyAccess(context.Background()); !errors.Is(err, rollbar.ErrUnauthorized) {
+		t.Fatalf("VerifyAccess() error = %v", err)
+	}
+}
+
 func TestServiceRecentSortsByTimestamp(t *testing.T) {
 	t.Parallel()
 
@@ -99,6 +237,179 @@ func TestServiceRecentSortsByTimestamp(t *testing.T) {
 	}
 }
 
+func TestServiceCanonicalizesEnvironments(t *testing.T) {
+	t.Parallel()
+
+	api := fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Environment: "prod"},
+		{ID: 2, Counter: 2, Environment: " Production "},
+		{ID: 3, Counter: 3, Environment: "live"},
+		{ID: 4, Counter: 4, Environment: "staging"},
+	}}
+
+	issues, err := NewService(api).RecentAll(context.Background(), IssueFilters{Environment: "PRODUCTION"})
+	if err != nil {
+		t.Fatalf("RecentAll() error = %v", err)
+	}
+	if len(issues) != 2 || issues[0].Environment != "production" || issues[1].Environment != "production" {
+		t.Fatalf("unexpected default alias filtering: %+v", issues)
+	}
+
+	aliased := NewService(api, WithEnvironmentAliases(domain.DefaultEnvironmentAliases().With(map[string]string{"live": "production"})))
+	issues, err = aliased.RecentAll(context.Background(), IssueFilters{Environment: "prod"})
+	if err != nil || len(issues) != 3 {
+		t.Fatalf("configured alias filtering = %+v, %v", issues, err)
+	}
+}
+
+func TestServiceRecentAll(t *testing.T) {
+	t.Parallel()
+
+	ts1 := uint64(100)
+	ts2 := uint64(200)
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 1, LastOccurrenceTimestamp: &ts1, Environment: "production"},
+		{ID: 2, Counter: 2, LastOccurrenceTimestamp: &ts2, Environment: "production"},
+		{ID: 3, Counter: 3, LastOccurrenceTimestamp: &ts2, Environment: "staging"},
+	}})
+
+	issues, err := service.RecentAll(context.Background(), IssueFilters{Environment: "production"})
+	if err != nil {
+		t.Fatalf("RecentAll() error = %v", err)
+	}
+	if len(issues) != 2 || issues[0].Counter != 2 || issues[1].Counter != 1 {
+		t.Fatalf("unexpected issues: %+v", issues)
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).RecentAll(context.Background(), IssueFilters{}); err == nil {
+		t.Fatalf("expected RecentAll() error")
+	}
+}
+
+func TestServiceRecentAllFetchesPagesUpToCap(t *testing.T) {
+	t.Parallel()
+
+	pages := make([][]rollbar.Item, 7)
+	for index := range pages {
+		pages[index] = make([]rollbar.Item, rollbar.ItemsPageSize)
+		for offset := range pages[index] {
+			id := domain.ItemID(index*rollbar.ItemsPageSize + offset + 1)
+			pages[index][offset] = rollbar.Item{ID: id, Counter: uint64(id)}
+		}
+	}
+	pages[6] = pages[6][:3]
+
+	issues, err := NewService(fakeAPI{itemPages: pages}).RecentAll(context.Background(), IssueFilters{AllStatuses: true})
+	if err != nil || len(issues) != 6*rollbar.ItemsPageSize+3 {
+		t.Fatalf("expected every page, got %d issues, %v", len(issues), err)
+	}
+
+	capped := NewService(fakeAPI{itemPages: pages}, WithMaxItemPages(2))
+	issues, err = capped.RecentAll(context.Background(), IssueFilters{AllStatuses: true})
+	if err != nil || len(issues) != 2*rollbar.ItemsPageSize {
+		t.Fatalf("expected two pages, got %d issues, %v", len(issues), err)
+	}
+	if warnings := capped.Warnings(); len(warnings) != 1 || warnings[0].Code != WarningPartialPagination {
+		t.Fatalf("expected a partial pagination warning, got %+v", warnings)
+	}
+}
+
+func TestIssuePage(t *testing.T) {
+	t.Parallel()
+
+	issues := []IssueSummary{{Counter: 1}, {Counter: 2}, {Counter: 3}, {Counter: 4}, {Counter: 5}}
+	tests := []struct {
+		name string
+		page int
+		size int
+		want []domain.ItemCounter
+	}{
+		{name: "first page", page: 1, size: 2, want: []domain.ItemCounter{1, 2}},
+		{name: "middle page", page: 2, size: 2, want: []domain.ItemCounter{3, 4}},
+		{name: "short last page", page: 3, size: 2, want: []domain.ItemCounter{5}},
+		{name: "past the end", page: 4, size: 2, want: []domain.ItemCounter{}},
+		{name: "no limit", page: 3, size: 0, want: []domain.ItemCounter{1, 2, 3, 4, 5}},
+	}
+
+	for _, tt := range tests {
+		got := make([]domain.ItemCounter, 0)
+		for _, issue := range IssuePage(issues, tt.page, tt.size) {
+			got = append(got, issue.Counter)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("%s: IssuePage() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestServiceRecentFetchesPagesUntilLimit(t *testing.T) {
+	t.Parallel()
+
+	fullPage := func(environment string, firstID domain.ItemID) []rollbar.Item {
+		items := make([]rollbar.Item, rollbar.ItemsPageSize)
+		for index := range items {
+			id := firstID + domain.ItemID(index)
+			items[index] = rollbar.Item{ID: id, Counter: uint64(id), Environment: environment}
+		}
+		return items
+	}
+	pages := [][]rollbar.Item{
+		append(fullPage("staging", 1)[:rollbar.ItemsPageSize-2], rollbar.Item{ID: 1001, Counter: 1001, Environment: "production"}, rollbar.Item{ID: 1002, Counter: 1002, Environment: "production"}),
+		fullPage("production", 2001),
+		fullPage("production", 3001),
+	}
+	service := NewService(fakeAPI{itemPages: pages})
+
+	issues, err := service.Recent(context.Background(), 5, IssueFilters{Environment: "production"})
+	if err != nil {
+		t.Fatalf("Recent() error = %v", err)
+	}
+	if len(issues) != 5 {
+		t.Fatalf("expected 5 production issues across pages, got %d", len(issues))
+	}
+
+	short := NewService(fakeAPI{itemPages: [][]rollbar.Item{{{ID: 1, Counter: 1, Environment: "staging"}}, fullPage("production", 10)}})
+	issues, err = short.Recent(context.Background(), 5, IssueFilters{Environment: "production"})
+	if err != nil || len(issues) != 0 {
+		t.Fatalf("expected a short page to end paging, got %+v, %v", issues, err)
+	}
+	if warnings := service.Warnings(); len(warnings) != 0 {
+		t.Fatalf("expected no warnings when the limit was met, got %+v", warnings)
+	}
+
+	staging := make([][]rollbar.Item, maxRecentItemPages)
+	for index := range staging {
+		staging[index] = fullPage("staging", domain.ItemID(index*rollbar.ItemsPageSize+1))
+	}
+	capped := NewService(fakeAPI{itemPages: staging})
+	if _, err := capped.Recent(context.Background(), 5, IssueFilters{Environment: "production"}); err != nil {
+		t.Fatalf("Recent() error = %v", err)
+	}
+	if warnings := capped.Warnings(); len(warnings) != 1 || warnings[0].Code != WarningPartialPagination {
+		t.Fatalf("expected a partial pagination warning, got %+v", warnings)
+	}
+}
+
+func TestRecentStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		filters IssueFilters
+		want    domain.Status
+	}{
+		{name: "default active", want: domain.StatusActive},
+		{name: "named status", filters: IssueFilters{Status: domain.StatusResolved}, want: domain.StatusResolved},
+		{name: "all statuses", filters: IssueFilters{AllStatuses: true}, want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := recentStatus(tt.filters); got != tt.want {
+			t.Fatalf("%s: recentStatus() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
 func TestServiceRecentSortsByTimestampThenTotalOccurrences(t *testing.T) {
 	t.Parallel()
 
@@ -120,6 +431,28 @@ func TestServiceRecentSortsByTimestampThenTotalOccurrences(t *testing.T) {
 	}
 }
 
+func TestServiceRecentSortsTiesBySeverity(t *testing.T) {
+	t.Parallel()
+
+	ts := uint64(100)
+	total := uint64(50)
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Level: "warning", LastOccurrenceTimestamp: &ts, TotalOccurrences: &total},
+		{ID: 2, Counter: 2, Level: "critical", LastOccurrenceTimestamp: &ts},
+		{ID: 3, Counter: 3, Level: "error", LastOccurrenceTimestamp: &ts},
+	}})
+
+	issues, err := service.Recent(context.Background(), 10, IssueFilters{})
+	if err != nil {
+		t.Fatalf("Recent() error = %v", err)
+	}
+	for index, want := range []domain.ItemCounter{2, 3, 1} {
+		if issues[index].Counter != want {
+			t.Fatalf("unexpected sort order: %+v", issues)
+		}
+	}
+}
+
 func TestServiceRecentLimitAndOccurrenceFallback(t *testing.T) {
 	t.Parallel()
 
@@ -146,7 +479,7 @@ func TestServiceShow(t *testing.T) {
 	t.Parallel()
 
 	occurrences := uint64(4)
-	instance := &rollbar.ItemInstance{Data: json.RawMessage(`{"trace":{"exception":{"description":"boom"}}}`), Raw: json.RawMessage(`{"id":1}`)}
+	instance := &rollbar.ItemInstance{Data: json.RawMessage(`{"trace":{"frames":[{"filename":"app.go","lineno":7,"method":"run"}],"exception":{"description":"boom"}}}`), Raw: json.RawMessage(`{"id":1}`)}
 	item := rollbar.Item{ID: 123, Counter: 9, Title: "title", TotalOccurrences: &occurrences, Raw: json.RawMessage(`{"id":123}`)}
 
 	service := NewService(fakeAPI{item: item, instance: instance})
@@ -158,6 +491,9 @@ func TestServiceShow(t *testing.T) {
 	if detail.MainError != "boom" {
 		t.Fatalf("Show() main error = %q", detail.MainError)
 	}
+	if len(detail.Traces) != 1 || len(detail.Traces[0].Frames) != 1 || detail.Traces[0].Frames[0].Method != "run" {
+		t.Fatalf("Show() traces = %+v", detail.Traces)
+	}
 }
 
 func TestServiceErrors(t *testing.T) {
@@ -209,6 +545,23 @@ type actionAPI struct {
 	getItemErr  error
 	updateCalls int
 	lastPatch   rollbar.ItemPatch
+	users       []rollbar.User
+	usersErr    error
+	lastDeploy  rollbar.NewDeploy
+	comments    []string
+	commentErr  error
+}
+
+func (a *actionAPI) ListUsers(ctx context.Context) ([]rollbar.User, error) {
+	return a.users, a.usersErr
+}
+
+func (a *actionAPI) GetUser(ctx context.Context, userID uint64) (rollbar.User, error) {
+	if a.usersErr != nil {
+		return rollbar.User{}, a.usersErr
+	}
+
+	return fakeAPI{users: a.users}.GetUser(ctx, userID)
 }
 
 func (a *actionAPI) ResolveItemIDByCounter(ctx context.Context, counter domain.ItemCounter) (domain.ItemID, error) {
@@ -233,6 +586,18 @@ func (a *actionAPI) UpdateItem(ctx context.Context, itemID domain.ItemID, patch
 	if a.updateErr != nil {
 		return a.updateErr
 	}
+	if patch.Status != "" {
+		a.item.Status = patch.Status
+	}
+
+	return nil
+}
+
+func (a *actionAPI) AddItemComment(ctx context.Context, itemID domain.ItemID, text string) error {
+	if a.commentErr != nil {
+		return a.commentErr
+	}
+	a.comments = append(a.comments, text)
 
 	return nil
 }
@@ -241,11 +606,56 @@ func (a *actionAPI) GetLatestInstance(ctx context.Context, itemID domain.ItemID)
 	return nil, nil
 }
 
+func (a *actionAPI) ListInstances(ctx context.Context, itemID domain.ItemID, opts rollbar.InstanceListOptions) ([]rollbar.ItemInstance, error) {
+	return nil, nil
+}
+
+func (a *actionAPI) GetInstance(ctx context.Context, occurrenceID domain.OccurrenceID) (rollbar.ItemInstance, error) {
+	return rollbar.ItemInstance{}, rollbar.ErrNotFound
+}
+
+func (a *actionAPI) GetTopActiveItems(ctx context.Context, query rollbar.TopActiveQuery) ([]rollbar.TopActiveItem, error) {
+	return nil, nil
+}
+
+func (a *actionAPI) GetProject(ctx context.Context, projectID domain.ProjectID) (rollbar.Project, error) {
+	return rollbar.Project{}, nil
+}
+
+func (a *actionAPI) CreateRQLJob(ctx context.Context, query string) (rollbar.RQLJob, error) {
+	return rollbar.RQLJob{}, nil
+}
+
+func (a *actionAPI) GetRQLJob(ctx context.Context, jobID uint64) (rollbar.RQLJob, error) {
+	return rollbar.RQLJob{}, nil
+}
+
+func (a *actionAPI) GetRQLJobResult(ctx context.Context, jobID uint64) (rollbar.RQLResult, error) {
+	return rollbar.RQLResult{}, nil
+}
+
 func (a *actionAPI) ListActiveItems(ctx context.Context, limit int) ([]rollbar.Item, error) {
 	return nil, nil
 }
 
-func (a *actionAPI) ListItems(ctx context.Context, status string, page int) ([]rollbar.Item, error) {
+func (a *actionAPI) ListItems(ctx context.Context, status domain.Status, page int) ([]rollbar.Item, error) {
+	return nil, nil
+}
+
+func (a *actionAPI) ListDeploys(ctx context.Context, page int) ([]rollbar.Deploy, error) {
+	return nil, nil
+}
+
+func (a *actionAPI) RecordDeploy(ctx context.Context, deploy rollbar.NewDeploy) (uint64, error) {
+	a.lastDeploy = deploy
+	if a.updateErr != nil {
+		return 0, a.updateErr
+	}
+
+	return 77, nil
+}
+
+func (a *actionAPI) GetOccurrenceCounts(ctx context.Context, query rollbar.OccurrenceCountsQuery) ([]rollbar.OccurrenceCount, error) {
 	return nil, nil
 }
 
@@ -293,7 +703,7 @@ func TestServiceReopen(t *testing.T) {
 
 	api := &actionAPI{
 		resolvedID: 99,
-		item:       rollbar.Item{ID: 99, Counter: 8, Status: "active", Title: "x"},
+		item:       rollbar.Item{ID: 99, Counter: 8, Status: "resolved", Title: "x"},
 	}
 	service := NewService(api)
 
@@ -334,6 +744,51 @@ func TestServiceMute(t *testing.T) {
 	}
 }
 
+func TestServiceItemActionsSkipAppliedState(t *testing.T) {
+	t.Parallel()
+
+	snoozedAt := uint64(1700000000)
+	snoozeFor := uint64(3600)
+	duration := int64(60)
+	tests := []struct {
+		name        string
+		item        rollbar.Item
+		run         func(*Service) (ItemActionResult, error)
+		wantUpdates int
+	}{
+		{name: "already resolved", item: rollbar.Item{Status: "resolved"}, run: func(s *Service) (ItemActionResult, error) {
+			return s.Resolve(context.Background(), 7, "")
+		}},
+		{name: "already active", item: rollbar.Item{Status: "active"}, run: func(s *Service) (ItemActionResult, error) {
+			return s.Reopen(context.Background(), 7)
+		}},
+		{name: "already muted", item: rollbar.Item{Status: "muted"}, run: func(s *Service) (ItemActionResult, error) {
+			return s.Mute(context.Background(), 7, nil)
+		}},
+		{name: "resolved in another version", item: rollbar.Item{Status: "resolved"}, wantUpdates: 1, run: func(s *Service) (ItemActionResult, error) {
+			return s.Resolve(context.Background(), 7, "v2")
+		}},
+		{name: "new snooze length", item: rollbar.Item{Status: "muted"}, wantUpdates: 1, run: func(s *Service) (ItemActionResult, error) {
+			return s.Mute(context.Background(), 7, &duration)
+		}},
+		{name: "snoozed muted forever", wantUpdates: 1, item: rollbar.Item{Status: "muted", SnoozeEnabled: true, SnoozeEnabledTimestamp: &snoozedAt, SnoozeExpirationInSeconds: &snoozeFor}, run: func(s *Service) (ItemActionResult, error) {
+			return s.Mute(context.Background(), 7, nil)
+		}},
+	}
+
+	for _, tc := range tests {
+		tc.item.ID, tc.item.Counter = 99, 7
+		api := &actionAPI{resolvedID: 99, item: tc.item}
+		result, err := tc.run(NewService(api))
+		if err != nil {
+			t.Fatalf("%s: error = %v", tc.name, err)
+		}
+		if api.updateCalls != tc.wantUpdates || result.Unchanged != (tc.wantUpdates == 0) {
+			t.Fatalf("%s: got %d updates, unchanged %v", tc.name, api.updateCalls, result.Unchanged)
+		}
+	}
+}
+
 func TestServiceItemActionsErrors(t *testing.T) {
 	t.Parallel()
 
@@ -391,6 +846,82 @@ func TestServiceActiveFilters(t *testing.T) {
 	}
 }
 
+func TestServiceActiveAgeFilters(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	fresh := uint64(now.Add(-2 * time.Hour).Unix())
+	ancient := uint64(now.Add(-60 * 24 * time.Hour).Unix())
+	service := NewService(fakeAPI{activeItems: []rollbar.Item{
+		{ID: 1, Counter: 1, FirstOccurrenceTimestamp: &fresh},
+		{ID: 2, Counter: 2, FirstOccurrenceTimestamp: &ancient},
+		{ID: 3, Counter: 3},
+	}}, WithClock(func() time.Time { return now }))
+
+	week := 7 * 24 * time.Hour
+	month := 30 * 24 * time.Hour
+	tests := []struct {
+		name    string
+		filters IssueFilters
+		want    []domain.ItemCounter
+	}{
+		{name: "max age", filters: IssueFilters{MaxAge: &week}, want: []domain.ItemCounter{1}},
+		{name: "min age", filters: IssueFilters{MinAge: &month}, want: []domain.ItemCounter{2}},
+		{name: "window", filters: IssueFilters{MinAge: &week, MaxAge: &month}, want: nil},
+	}
+
+	for _, tt := range tests {
+		issues, err := service.Active(context.Background(), 10, tt.filters)
+		if err != nil {
+			t.Fatalf("%s: Active() error = %v", tt.name, err)
+		}
+		if len(issues) != len(tt.want) {
+			t.Fatalf("%s: got %+v, want %v", tt.name, issues, tt.want)
+		}
+		for index, counter := range tt.want {
+			if issues[index].Counter != counter {
+				t.Fatalf("%s: got %+v, want %v", tt.name, issues, tt.want)
+			}
+		}
+	}
+}
+
+func TestServiceActiveHiddenEnvironments(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{activeItems: []rollbar.Item{
+		{ID: 1, Counter: 1, Environment: "production"},
+		{ID: 2, Counter: 2, Environment: "dev"},
+		{ID: 3, Counter: 3, Environment: "test"},
+	}})
+
+	hidden := []domain.Environment{"development", "test"}
+	tests := []struct {
+		name    string
+		filters IssueFilters
+		want    []domain.ItemCounter
+	}{
+		{name: "hidden by default", filters: IssueFilters{HiddenEnvironments: hidden}, want: []domain.ItemCounter{1}},
+		{name: "explicit env wins", filters: IssueFilters{Environment: "dev", HiddenEnvironments: hidden}, want: []domain.ItemCounter{2}},
+		{name: "nothing hidden", filters: IssueFilters{}, want: []domain.ItemCounter{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		issues, err := service.Active(context.Background(), 10, tt.filters)
+		if err != nil {
+			t.Fatalf("%s: Active() error = %v", tt.name, err)
+		}
+		if len(issues) != len(tt.want) {
+			t.Fatalf("%s: got %+v, want %v", tt.name, issues, tt.want)
+		}
+		for index, counter := range tt.want {
+			if issues[index].Counter != counter {
+				t.Fatalf("%s: got %+v, want %v", tt.name, issues, tt.want)
+			}
+		}
+	}
+}
+
 func TestServiceActiveFiltersRejectOverflowTimestamp(t *testing.T) {
 	t.Parallel()
 
@@ -412,3 +943,40 @@ func TestServiceActiveFiltersRejectOverflowTimestamp(t *testing.T) {
 		t.Fatalf("expected overflow timestamp to be filtered out, got %d issues", len(issues))
 	}
 }
+
+func TestServiceWithClock(t *testing.T) {
+	t.Parallel()
+
+	fixed := time.Date(2026, 2, 19, 12, 0, 0, 0, time.FixedZone("EST", -5*3600))
+	service := NewService(fakeAPI{}, WithClock(func() time.Time { return fixed }))
+	if got := service.Now(); !got.Equal(fixed) || got.Location() != time.UTC {
+		t.Fatalf("Now() = %v, want %v in UTC", got, fixed)
+	}
+
+	defaulted := NewService(fakeAPI{}, WithClock(nil))
+	if defaulted.Now().IsZero() {
+		t.Fatalf("expected wall clock fallback")
+	}
+}
+
+func TestMapSummaryExposesSnoozeFields(t *testing.T) {
+	t.Parallel()
+
+	mutedAt := uint64(1000)
+	duration := uint64(600)
+	summary := NewService(fakeAPI{}).mapSummary(rollbar.Item{ID: 1, Status: "muted", SnoozeEnabled: true, SnoozeEnabledTimestamp: &mutedAt, SnoozeExpirationInSeconds: &duration})
+	if !summary.SnoozeEnabled || summary.SnoozeExpirationInSeconds == nil || *summary.SnoozeExpirationInSeconds != 600 {
+		t.Fatalf("unexpected snooze fields: %+v", summary)
+	}
+	if summary.SnoozeExpiresAt == nil || *summary.SnoozeExpiresAt != 1600 {
+		t.Fatalf("SnoozeExpiresAt = %v, want 1600", summary.SnoozeExpiresAt)
+	}
+
+	encoded, err := json.Marshal(NewService(fakeAPI{}).mapSummary(rollbar.Item{ID: 2, Status: "active"}))
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if strings.Contains(string(encoded), "snooze") {
+		t.Fatalf("expected snooze fields omitted for unmuted item, got %s", encoded)
+	}
+}
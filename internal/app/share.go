@@ -0,0 +1,63 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+const shareTrendBucket = 24 * 60 * 60
+
+type ShareBundle struct {
+	Issue          IssueDetail               `json:"issue"`
+	OccurrenceUUID string                    `json:"occurrence_uuid,omitempty"`
+	CodeVersion    string                    `json:"code_version,omitempty"`
+	Trend          []rollbar.OccurrenceCount `json:"trend"`
+	TrendDays      int                       `json:"trend_days"`
+	GeneratedAt    time.Time                 `json:"generated_at"`
+	ExpiresAt      time.Time                 `json:"expires_at"`
+}
+
+func (s *Service) ShareBundle(ctx context.Context, counter domain.ItemCounter, trendDays int, ttl time.Duration) (ShareBundle, error) {
+	if trendDays <= 0 {
+		return ShareBundle{}, errors.New("trend days must be positive")
+	}
+	if ttl <= 0 {
+		return ShareBundle{}, errors.New("bundle lifetime must be positive")
+	}
+
+	detail, err := s.Show(ctx, counter)
+	if err != nil {
+		return ShareBundle{}, err
+	}
+
+	now := s.Now()
+	trend, err := s.api.GetOccurrenceCounts(ctx, rollbar.OccurrenceCountsQuery{
+		ItemID:       detail.ItemID,
+		MinTimestamp: now.Add(-time.Duration(trendDays) * 24 * time.Hour).Unix(),
+		MaxTimestamp: now.Unix(),
+		BucketSize:   shareTrendBucket,
+	})
+	if err != nil {
+		return ShareBundle{}, fmt.Errorf("get occurrence counts: %w", err)
+	}
+
+	bundle := ShareBundle{
+		Issue:       detail,
+		Trend:       trend,
+		TrendDays:   trendDays,
+		GeneratedAt: now,
+		ExpiresAt:   now.Add(ttl),
+	}
+	if detail.Instance != nil {
+		bundle.OccurrenceUUID = summary.OccurrenceUUID(detail.Instance.Data)
+		bundle.CodeVersion = summary.CodeVersion(detail.Instance.Data)
+	}
+
+	return bundle, nil
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceShareBundle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewService(fakeAPI{
+		item:     rollbar.Item{ID: 123, Counter: 7, Title: "boom"},
+		instance: &rollbar.ItemInstance{ID: 9, Data: json.RawMessage(`{"uuid":"abc-123","code_version":"v1.4.0"}`)},
+		counts:   []rollbar.OccurrenceCount{{Timestamp: 1, Count: 3}, {Timestamp: 2, Count: 5}},
+	}, WithClock(func() time.Time { return now }))
+
+	bundle, err := service.ShareBundle(context.Background(), domain.ItemCounter(7), 14, 72*time.Hour)
+	if err != nil {
+		t.Fatalf("ShareBundle() error = %v", err)
+	}
+	if bundle.Issue.Title != "boom" || bundle.Issue.Instance == nil || len(bundle.Trend) != 2 {
+		t.Fatalf("unexpected bundle: %+v", bundle)
+	}
+	if bundle.OccurrenceUUID != "abc-123" || bundle.CodeVersion != "v1.4.0" || bundle.TrendDays != 14 {
+		t.Fatalf("unexpected occurrence fields: %+v", bundle)
+	}
+	if !bundle.GeneratedAt.Equal(now) || !bundle.ExpiresAt.Equal(now.Add(72*time.Hour)) {
+		t.Fatalf("unexpected bundle times: %s %s", bundle.GeneratedAt, bundle.ExpiresAt)
+	}
+}
+
+func TestServiceShareBundleErrors(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{})
+	if _, err := service.ShareBundle(context.Background(), 1, 0, time.Hour); err == nil {
+		t.Fatalf("expected trend days error")
+	}
+	if _, err := service.ShareBundle(context.Background(), 1, 7, 0); err == nil {
+		t.Fatalf("expected lifetime error")
+	}
+
+	failing := NewService(fakeAPI{err: errors.New("boom")})
+	if _, err := failing.ShareBundle(context.Background(), 1, 7, time.Hour); err == nil {
+		t.Fatalf("expected api error")
+	}
+}
@@ -0,0 +1,82 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+const (
+	SortRecent      = "recent"
+	SortOccurrences = "occurrences"
+	SortPriority    = "priority"
+)
+
+func ValidateSortOrder(order string) error {
+	switch strings.ToLower(strings.TrimSpace(order)) {
+	case "", SortRecent, SortOccurrences, SortPriority:
+		return nil
+	default:
+		return fmt.Errorf("unsupported sort %q: use recent, occurrences, or priority", order)
+	}
+}
+
+func SortIssues(issues []IssueSummary, order string) error {
+	if err := ValidateSortOrder(order); err != nil {
+		return err
+	}
+
+	var compare func(left IssueSummary, right IssueSummary) int
+	switch strings.ToLower(strings.TrimSpace(order)) {
+	case SortRecent:
+		compare = compareRecent
+	case SortOccurrences:
+		compare = compareOccurrences
+	case SortPriority:
+		compare = comparePriority
+	default:
+		return nil
+	}
+
+	sort.SliceStable(issues, func(i int, j int) bool {
+		return compare(issues[i], issues[j]) > 0
+	})
+
+	return nil
+}
+
+func compareRecent(left IssueSummary, right IssueSummary) int {
+	if result := compareUint64(uint64Value(left.LastOccurrenceTimestamp), uint64Value(right.LastOccurrenceTimestamp)); result != 0 {
+		return result
+	}
+
+	return domain.CompareLevels(left.Level, right.Level)
+}
+
+func compareOccurrences(left IssueSummary, right IssueSummary) int {
+	return compareUint64(uint64Value(left.Occurrences), uint64Value(right.Occurrences))
+}
+
+func comparePriority(left IssueSummary, right IssueSummary) int {
+	if result := domain.CompareLevels(left.Level, right.Level); result != 0 {
+		return result
+	}
+	if result := compareOccurrences(left, right); result != 0 {
+		return result
+	}
+
+	return compareRecent(left, right)
+}
+
+func compareUint64(left uint64, right uint64) int {
+	switch {
+	case left > right:
+		return 1
+	case left < right:
+		return -1
+	default:
+		return 0
+	}
+}
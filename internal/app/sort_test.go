@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestSortIssues(t *testing.T) {
+	t.Parallel()
+
+	ts := func(value uint64) *uint64 { return &value }
+	issues := []IssueSummary{
+		{Counter: 1, Level: "warning", Occurrences: ts(50), LastOccurrenceTimestamp: ts(300)},
+		{Counter: 2, Level: "error", Occurrences: ts(5), LastOccurrenceTimestamp: ts(100)},
+		{Counter: 3, Level: "error", Occurrences: ts(9), LastOccurrenceTimestamp: ts(200)},
+		{Counter: 4, Level: "critical", Occurrences: ts(1), LastOccurrenceTimestamp: ts(200)},
+	}
+
+	tests := []struct {
+		order string
+		want  []domain.ItemCounter
+	}{
+		{order: "", want: []domain.ItemCounter{1, 2, 3, 4}},
+		{order: SortRecent, want: []domain.ItemCounter{1, 4, 3, 2}},
+		{order: SortOccurrences, want: []domain.ItemCounter{1, 3, 2, 4}},
+		{order: "Priority", want: []domain.ItemCounter{4, 3, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		sorted := append([]IssueSummary(nil), issues...)
+		if err := SortIssues(sorted, tt.order); err != nil {
+			t.Fatalf("SortIssues(%q) error = %v", tt.order, err)
+		}
+		for index, want := range tt.want {
+			if sorted[index].Counter != want {
+				t.Fatalf("SortIssues(%q) order = %+v", tt.order, sorted)
+			}
+		}
+	}
+
+	if err := SortIssues(issues, "severity"); err == nil {
+		t.Fatalf("expected unsupported sort error")
+	}
+}
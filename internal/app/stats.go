@@ -0,0 +1,107 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const maxStatsBuckets = 24 * 31
+
+type StatsPoint struct {
+	Time  time.Time `json:"time"`
+	Count uint64    `json:"count"`
+}
+
+type ItemStats struct {
+	Counter domain.ItemCounter `json:"counter"`
+	ItemID  domain.ItemID      `json:"item_id"`
+	Bucket  string             `json:"bucket"`
+	Since   time.Time          `json:"since"`
+	Until   time.Time          `json:"until"`
+	Total   uint64             `json:"total"`
+	Peak    uint64             `json:"peak"`
+	Series  []StatsPoint       `json:"series"`
+}
+
+func statsBucketSize(bucket string) (time.Duration, error) {
+	switch bucket {
+	case "hour":
+		return time.Hour, nil
+	case "day":
+		return 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("unsupported bucket %q: use hour or day", bucket)
+	}
+}
+
+func (s *Service) ItemStats(ctx context.Context, counter domain.ItemCounter, bucket string, window time.Duration) (ItemStats, error) {
+	size, err := statsBucketSize(bucket)
+	if err != nil {
+		return ItemStats{}, err
+	}
+	if window < size {
+		return ItemStats{}, fmt.Errorf("window must be at least one %s", bucket)
+	}
+	buckets := int((window + size - 1) / size)
+	if buckets > maxStatsBuckets {
+		return ItemStats{}, errors.New("window has too many buckets; use a shorter window or --by day")
+	}
+
+	itemID, err := s.api.ResolveItemIDByCounter(ctx, counter)
+	if err != nil {
+		return ItemStats{}, fmt.Errorf("resolve item id: %w", err)
+	}
+
+	now := s.Now()
+	until := now.UTC().Truncate(size).Add(size)
+	since := until.Add(-time.Duration(buckets) * size)
+	query := rollbar.OccurrenceCountsQuery{
+		ItemID:       itemID,
+		MinTimestamp: since.Unix(),
+		MaxTimestamp: now.Unix(),
+		BucketSize:   int(size / time.Second),
+	}
+	counts, err := s.api.GetOccurrenceCounts(ctx, query)
+	if err != nil {
+		return ItemStats{}, fmt.Errorf("get occurrence counts: %w", err)
+	}
+	s.explainCall("/reports/occurrence_counts", query.Params(), len(counts))
+
+	stats := buildItemStats(counts, since, size, buckets)
+	stats.Counter = counter
+	stats.ItemID = itemID
+	stats.Bucket = bucket
+
+	return stats, nil
+}
+
+func buildItemStats(counts []rollbar.OccurrenceCount, since time.Time, size time.Duration, buckets int) ItemStats {
+	stats := ItemStats{Since: since, Until: since.Add(time.Duration(buckets) * size), Series: make([]StatsPoint, buckets)}
+	for index := range stats.Series {
+		stats.Series[index].Time = since.Add(time.Duration(index) * size)
+	}
+
+	seconds := int64(size / time.Second)
+	for _, count := range counts {
+		if count.Timestamp > math.MaxInt64 {
+			continue
+		}
+		index := (int64(count.Timestamp) - since.Unix()) / seconds
+		if int64(count.Timestamp) < since.Unix() || index >= int64(buckets) {
+			continue
+		}
+		stats.Series[index].Count += count.Count
+		stats.Total += count.Count
+	}
+	for _, point := range stats.Series {
+		stats.Peak = max(stats.Peak, point.Count)
+	}
+
+	return stats
+}
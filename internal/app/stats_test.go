@@ -0,0 +1,75 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceItemStats(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	service := NewService(fakeAPI{
+		counts: []rollbar.OccurrenceCount{
+			{Timestamp: uint64(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC).Unix()), Count: 4},
+			{Timestamp: uint64(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC).Unix()), Count: 1},
+			{Timestamp: uint64(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC).Unix()), Count: 99},
+		},
+	}, WithClock(func() time.Time { return now }))
+
+	stats, err := service.ItemStats(context.Background(), 274, "hour", 3*time.Hour)
+	if err != nil {
+		t.Fatalf("ItemStats() error = %v", err)
+	}
+	if stats.Counter != 274 || stats.ItemID != 123 || stats.Bucket != "hour" {
+		t.Fatalf("unexpected stats identity: %+v", stats)
+	}
+	if !stats.Since.Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)) || !stats.Until.Equal(time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected window: %s to %s", stats.Since, stats.Until)
+	}
+	want := []uint64{4, 0, 1}
+	if len(stats.Series) != len(want) {
+		t.Fatalf("expected %d buckets, got %+v", len(want), stats.Series)
+	}
+	for index, count := range want {
+		if stats.Series[index].Count != count {
+			t.Fatalf("bucket %d: got %d, want %d", index, stats.Series[index].Count, count)
+		}
+	}
+	if stats.Total != 5 || stats.Peak != 4 {
+		t.Fatalf("unexpected totals: %+v", stats)
+	}
+
+	days, err := service.ItemStats(context.Background(), 274, "day", 7*24*time.Hour)
+	if err != nil {
+		t.Fatalf("ItemStats() error = %v", err)
+	}
+	if len(days.Series) != 7 || days.Series[6].Count != 5 || !days.Series[6].Time.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected daily series: %+v", days.Series)
+	}
+}
+
+func TestServiceItemStatsErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		api    fakeAPI
+		bucket string
+		window time.Duration
+	}{
+		{name: "bucket", bucket: "minute", window: time.Hour},
+		{name: "short window", bucket: "day", window: time.Hour},
+		{name: "too many buckets", bucket: "hour", window: 365 * 24 * time.Hour},
+		{name: "api", api: fakeAPI{err: errors.New("boom")}, bucket: "hour", window: time.Hour},
+	}
+	for _, tc := range tests {
+		if _, err := NewService(tc.api).ItemStats(context.Background(), 1, tc.bucket, tc.window); err == nil {
+			t.Fatalf("%s: expected error", tc.name)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const maxSyncItemPages = 20
+
+type SyncResult struct {
+	Since  uint64         `json:"since"`
+	Cursor uint64         `json:"cursor"`
+	Pages  int            `json:"pages"`
+	Issues []IssueSummary `json:"issues"`
+}
+
+// When the page cap stops paging first, Cursor stays at since: older
+// newer-than-since items were never read.
+func (s *Service) SyncSince(ctx context.Context, since uint64, filters IssueFilters) (SyncResult, error) {
+	result := SyncResult{Since: since, Cursor: since}
+	newer := make([]rollbar.Item, 0)
+	capped := true
+	for page := 1; page <= maxSyncItemPages; page++ {
+		items, err := s.api.ListItems(ctx, "", page)
+		if err != nil {
+			return SyncResult{}, fmt.Errorf("list items page %d: %w", page, err)
+		}
+		result.Pages = page
+
+		found := 0
+		for _, item := range items {
+			lastSeen := uint64Value(item.LastOccurrenceTimestamp)
+			if lastSeen <= since {
+				continue
+			}
+			found++
+			newer = append(newer, item)
+			result.Cursor = max(result.Cursor, lastSeen)
+		}
+		if found == 0 {
+			capped = false
+			break
+		}
+	}
+	if capped {
+		result.Cursor = since
+		s.warn(WarningPartialPagination, "stopped after %d pages of issues newer than the sync cursor; the cursor was not advanced", maxSyncItemPages)
+	}
+
+	result.Issues = s.mapSummaries(sortRecentItems(s.filterItems(newer, filters)))
+
+	return result, nil
+}
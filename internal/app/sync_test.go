@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceSyncSince(t *testing.T) {
+	t.Parallel()
+
+	ts := func(value uint64) *uint64 { return &value }
+	service := NewService(fakeAPI{listItems: []rollbar.Item{
+		{ID: 1, Counter: 1, LastOccurrenceTimestamp: ts(100), Environment: "production"},
+		{ID: 2, Counter: 2, LastOccurrenceTimestamp: ts(300), Environment: "staging"},
+		{ID: 3, Counter: 3, LastOccurrenceTimestamp: ts(200), Environment: "production"},
+	}})
+
+	result, err := service.SyncSince(context.Background(), 150, IssueFilters{})
+	if err != nil {
+		t.Fatalf("SyncSince() error = %v", err)
+	}
+	if result.Since != 150 || result.Cursor != 300 || result.Pages != 2 {
+		t.Fatalf("unexpected sync cursor: %+v", result)
+	}
+	if len(result.Issues) != 2 || result.Issues[0].Counter != 2 || result.Issues[1].Counter != 3 {
+		t.Fatalf("unexpected synced issues: %+v", result.Issues)
+	}
+
+	filtered, err := service.SyncSince(context.Background(), 150, IssueFilters{Environment: "production"})
+	if err != nil || len(filtered.Issues) != 1 || filtered.Cursor != 300 {
+		t.Fatalf("filters must narrow issues but not hold back the cursor: %+v, %v", filtered, err)
+	}
+
+	unchanged, err := service.SyncSince(context.Background(), 300, IssueFilters{})
+	if err != nil || len(unchanged.Issues) != 0 || unchanged.Cursor != 300 || unchanged.Pages != 1 {
+		t.Fatalf("expected no new issues: %+v, %v", unchanged, err)
+	}
+
+	pages := make([][]rollbar.Item, maxSyncItemPages+1)
+	for index := range pages {
+		pages[index] = []rollbar.Item{{ID: domain.ItemID(index + 1), Counter: uint64(index + 1), LastOccurrenceTimestamp: ts(uint64(1000 - index))}}
+	}
+	capped := NewService(fakeAPI{itemPages: pages})
+	result, err = capped.SyncSince(context.Background(), 150, IssueFilters{})
+	if err != nil || result.Cursor != 150 || result.Pages != maxSyncItemPages {
+		t.Fatalf("expected the cursor to stay put at the page cap: %+v, %v", result, err)
+	}
+	if warnings := capped.Warnings(); len(warnings) != 1 || warnings[0].Code != WarningPartialPagination {
+		t.Fatalf("expected a partial pagination warning, got %+v", warnings)
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).SyncSince(context.Background(), 0, IssueFilters{}); err == nil {
+		t.Fatalf("expected SyncSince() error")
+	}
+}
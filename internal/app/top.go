@@ -0,0 +1,89 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const maxTopWindow = 7 * 24 * time.Hour
+
+type TopIssue struct {
+	IssueSummary
+	WindowOccurrences   uint64 `json:"window_occurrences"`
+	PreviousOccurrences uint64 `json:"previous_occurrences"`
+	Delta               int64  `json:"delta"`
+}
+
+type TopReport struct {
+	WindowHours int        `json:"window_hours"`
+	Issues      []TopIssue `json:"issues"`
+}
+
+func (s *Service) Top(ctx context.Context, window time.Duration, limit int, filters IssueFilters) (TopReport, error) {
+	if window < time.Hour {
+		return TopReport{}, errors.New("window must be at least 1h")
+	}
+	if window > maxTopWindow {
+		return TopReport{}, fmt.Errorf("window must be at most %s", maxTopWindow)
+	}
+	hours := int((window + time.Hour - 1) / time.Hour)
+
+	s.explainFilters(nil, describeClientFilters(s.normalizeIssueFilters(filters)))
+	query := rollbar.TopActiveQuery{Hours: 2 * hours}
+	entries, err := s.api.GetTopActiveItems(ctx, query)
+	if err != nil {
+		return TopReport{}, fmt.Errorf("get top active items: %w", err)
+	}
+	s.explainCall("/reports/top_active_items", map[string]string{"hours": strconv.Itoa(query.Hours)}, len(entries))
+
+	counts := make(map[uint64][]uint64, len(entries))
+	items := make([]rollbar.Item, 0, len(entries))
+	for _, entry := range entries {
+		counts[entry.Item.Counter] = entry.Counts
+		items = append(items, entry.Item)
+	}
+
+	report := TopReport{WindowHours: hours, Issues: []TopIssue{}}
+	for _, item := range s.filterItems(items, filters) {
+		current, previous := splitWindowCounts(counts[item.Counter], hours)
+		if current == 0 && previous == 0 {
+			continue
+		}
+		report.Issues = append(report.Issues, TopIssue{
+			IssueSummary:        s.mapSummary(item),
+			WindowOccurrences:   current,
+			PreviousOccurrences: previous,
+			Delta:               int64(min(current, math.MaxInt64)) - int64(min(previous, math.MaxInt64)),
+		})
+	}
+	sort.SliceStable(report.Issues, func(i int, j int) bool {
+		return report.Issues[i].WindowOccurrences > report.Issues[j].WindowOccurrences
+	})
+	if limit > 0 && len(report.Issues) > limit {
+		s.ExplainStage("limit", len(report.Issues), limit)
+		report.Issues = report.Issues[:limit]
+	}
+
+	return report, nil
+}
+
+func splitWindowCounts(counts []uint64, hours int) (uint64, uint64) {
+	split := max(len(counts)-hours, 0)
+	var current, previous uint64
+	for index, count := range counts {
+		if index >= split {
+			current += count
+		} else if index >= split-hours {
+			previous += count
+		}
+	}
+
+	return current, previous
+}
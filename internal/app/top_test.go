@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceTop(t *testing.T) {
+	t.Parallel()
+
+	service := NewService(fakeAPI{topActive: []rollbar.TopActiveItem{
+		{Item: rollbar.Item{Counter: 1, Title: "steady", Environment: "production"}, Counts: []uint64{5, 5, 5, 5}},
+		{Item: rollbar.Item{Counter: 2, Title: "spiking", Environment: "production"}, Counts: []uint64{0, 1, 10, 20}},
+		{Item: rollbar.Item{Counter: 3, Title: "quiet now", Environment: "production"}, Counts: []uint64{9, 9, 0, 0}},
+		{Item: rollbar.Item{Counter: 4, Title: "staging", Environment: "staging"}, Counts: []uint64{0, 0, 50, 50}},
+		{Item: rollbar.Item{Counter: 5, Title: "idle", Environment: "production"}, Counts: []uint64{0, 0, 0, 0}},
+	}})
+
+	report, err := service.Top(context.Background(), 2*time.Hour, 0, IssueFilters{Environment: "production"})
+	if err != nil {
+		t.Fatalf("Top() error = %v", err)
+	}
+	if report.WindowHours != 2 {
+		t.Fatalf("unexpected window: %d", report.WindowHours)
+	}
+	want := []TopIssue{
+		{WindowOccurrences: 30, PreviousOccurrences: 1, Delta: 29},
+		{WindowOccurrences: 10, PreviousOccurrences: 10, Delta: 0},
+		{WindowOccurrences: 0, PreviousOccurrences: 18, Delta: -18},
+	}
+	counters := []uint64{2, 1, 3}
+	if len(report.Issues) != len(want) {
+		t.Fatalf("unexpected issues: %+v", report.Issues)
+	}
+	for index, issue := range report.Issues {
+		if uint64(issue.Counter) != counters[index] || issue.WindowOccurrences != want[index].WindowOccurrences ||
+			issue.PreviousOccurrences != want[index].PreviousOccurrences || issue.Delta != want[index].Delta {
+			t.Fatalf("issue %d = %+v", index, issue)
+		}
+	}
+
+	limited, err := service.Top(context.Background(), 90*time.Minute, 1, IssueFilters{})
+	if err != nil {
+		t.Fatalf("Top() error = %v", err)
+	}
+	if len(limited.Issues) != 1 || limited.Issues[0].Counter != 4 || limited.WindowHours != 2 {
+		t.Fatalf("expected the busiest issue in a rounded-up window, got %+v", limited)
+	}
+}
+
+func TestServiceTopErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, window := range []time.Duration{time.Minute, 8 * 24 * time.Hour} {
+		if _, err := NewService(fakeAPI{}).Top(context.Background(), window, 0, IssueFilters{}); err == nil {
+			t.Fatalf("expected window %s to be rejected", window)
+		}
+	}
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).Top(context.Background(), time.Hour, 0, IssueFilters{}); err == nil {
+		t.Fatal("expected the API error")
+	}
+}
+
+func TestSplitWindowCounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		counts   []uint64
+		hours    int
+		current  uint64
+		previous uint64
+	}{
+		{counts: []uint64{1, 2, 3, 4}, hours: 2, current: 7, previous: 3},
+		{counts: []uint64{1, 2, 3}, hours: 2, current: 5, previous: 1},
+		{counts: []uint64{4}, hours: 2, current: 4},
+		{counts: []uint64{9, 1, 2, 3}, hours: 1, current: 3, previous: 2},
+	}
+	for _, tc := range tests {
+		current, previous := splitWindowCounts(tc.counts, tc.hours)
+		if current != tc.current || previous != tc.previous {
+			t.Fatalf("splitWindowCounts(%v, %d) = %d, %d", tc.counts, tc.hours, current, previous)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const TrendLastWeek = "last-week"
+
+const trendWeek = 7 * 24 * time.Hour
+
+const (
+	TrendUp   = "up"
+	TrendDown = "down"
+	TrendFlat = "flat"
+)
+
+type IssueTrend struct {
+	Current   uint64 `json:"current"`
+	Previous  uint64 `json:"previous"`
+	Direction string `json:"direction"`
+}
+
+func ParseTrendBaseline(value string) (string, error) {
+	switch baseline := strings.ToLower(strings.TrimSpace(value)); baseline {
+	case "", TrendLastWeek:
+		return baseline, nil
+	default:
+		return "", fmt.Errorf("unsupported trend baseline %q: use %s", value, TrendLastWeek)
+	}
+}
+
+func (s *Service) AnnotateTrends(ctx context.Context, issues []IssueSummary, baseline string) ([]IssueSummary, error) {
+	if baseline == "" || len(issues) == 0 {
+		return issues, nil
+	}
+
+	now := s.Now()
+	windows := []rollbar.OccurrenceCountsQuery{
+		{MinTimestamp: now.Add(-trendWeek).Unix(), MaxTimestamp: now.Unix(), BucketSize: releaseBucketSize},
+		{MinTimestamp: now.Add(-2 * trendWeek).Unix(), MaxTimestamp: now.Add(-trendWeek).Unix() - 1, BucketSize: releaseBucketSize},
+	}
+	trends, skipped, err := mapEnrichment(ctx, issues, func(ctx context.Context, issue IssueSummary) (IssueTrend, error) {
+		totals := make([]uint64, len(windows))
+		for index, window := range windows {
+			window.ItemID = issue.ItemID
+			buckets, err := s.api.GetOccurrenceCounts(ctx, window)
+			if err != nil {
+				return IssueTrend{}, fmt.Errorf("get occurrence counts for item %s: %w", issue.Counter.String(), err)
+			}
+			s.explainCall("/reports/occurrence_counts", window.Params(), len(buckets))
+			totals[index] = sumOccurrenceCounts(buckets)
+		}
+		return newIssueTrend(totals[0], totals[1]), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.warnSkipped(skipped, "left %d of %d issues without a trend because their occurrence counts could not be fetched (request budget or rate limit)")
+
+	annotated := make([]IssueSummary, len(issues))
+	for index, issue := range issues {
+		if !skipped[index] {
+			trend := trends[index]
+			issue.Trend = &trend
+		}
+		annotated[index] = issue
+	}
+
+	return annotated, nil
+}
+
+func newIssueTrend(current uint64, previous uint64) IssueTrend {
+	trend := IssueTrend{Current: current, Previous: previous, Direction: TrendFlat}
+	switch {
+	case current > previous:
+		trend.Direction = TrendUp
+	case current < previous:
+		trend.Direction = TrendDown
+	}
+
+	return trend
+}
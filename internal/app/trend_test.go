@@ -0,0 +1,83 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+// trendAPI reports weekly[item][0] occurrences for windows ending at now
+// and weekly[item][1] for the week before.
+type trendAPI struct {
+	fakeAPI
+	now    int64
+	weekly map[domain.ItemID][2]uint64
+	limit  domain.ItemID
+}
+
+func (a trendAPI) GetOccurrenceCounts(ctx context.Context, query rollbar.OccurrenceCountsQuery) ([]rollbar.OccurrenceCount, error) {
+	if a.limit != 0 && query.ItemID == a.limit {
+		return nil, rollbar.ErrRateLimited
+	}
+	if query.MaxTimestamp-query.MinTimestamp > int64(trendWeek/time.Second) {
+		return nil, errors.New("window longer than a week")
+	}
+	week := 1
+	if query.MaxTimestamp == a.now {
+		week = 0
+	}
+	return []rollbar.OccurrenceCount{{Timestamp: uint64(query.MinTimestamp), Count: a.weekly[query.ItemID][week]}}, nil
+}
+
+func TestParseTrendBaseline(t *testing.T) {
+	t.Parallel()
+
+	for input, want := range map[string]string{"": "", "last-week": TrendLastWeek, " Last-Week ": TrendLastWeek} {
+		if got, err := ParseTrendBaseline(input); err != nil || got != want {
+			t.Fatalf("ParseTrendBaseline(%q) = %q, %v", input, got, err)
+		}
+	}
+	if _, err := ParseTrendBaseline("yesterday"); err == nil {
+		t.Fatalf("expected unsupported baseline error")
+	}
+}
+
+func TestServiceAnnotateTrends(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	api := trendAPI{now: now.Unix(), limit: 4, weekly: map[domain.ItemID][2]uint64{1: {9, 3}, 2: {1, 5}, 3: {2, 2}}}
+	service := NewService(api, WithClock(func() time.Time { return now }))
+	issues := []IssueSummary{{ItemID: 1, Counter: 1}, {ItemID: 2, Counter: 2}, {ItemID: 3, Counter: 3}, {ItemID: 4, Counter: 4}}
+
+	got, err := service.AnnotateTrends(context.Background(), issues, TrendLastWeek)
+	if err != nil {
+		t.Fatalf("AnnotateTrends() error = %v", err)
+	}
+	want := []*IssueTrend{
+		{Current: 9, Previous: 3, Direction: TrendUp},
+		{Current: 1, Previous: 5, Direction: TrendDown},
+		{Current: 2, Previous: 2, Direction: TrendFlat},
+		nil,
+	}
+	for index, issue := range got {
+		if (issue.Trend == nil) != (want[index] == nil) || issue.Trend != nil && *issue.Trend != *want[index] {
+			t.Fatalf("issue %d trend = %+v, want %+v", index, issue.Trend, want[index])
+		}
+	}
+	if warnings := service.Warnings(); len(warnings) != 1 || warnings[0].Code != WarningEnrichmentSkipped {
+		t.Fatalf("warnings = %+v", warnings)
+	}
+	if issues[0].Trend != nil {
+		t.Fatalf("AnnotateTrends() modified its input")
+	}
+
+	unchanged, err := service.AnnotateTrends(context.Background(), issues, "")
+	if err != nil || unchanged[0].Trend != nil {
+		t.Fatalf("AnnotateTrends() without a baseline = %+v, %v", unchanged, err)
+	}
+}
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type WaitCondition struct {
+	Status         domain.Status
+	MinOccurrences *uint64
+}
+
+func (c WaitCondition) Validate() error {
+	if c.Status == "" && c.MinOccurrences == nil {
+		return errors.New("nothing to wait for: give a status or an occurrence count")
+	}
+	if c.Status != "" && !c.Status.Valid() {
+		return fmt.Errorf("unsupported status %q", c.Status)
+	}
+
+	return nil
+}
+
+func (c WaitCondition) Met(issue IssueSummary) bool {
+	if c.Status != "" && issue.Status != c.Status {
+		return false
+	}
+	if c.MinOccurrences != nil && (issue.Occurrences == nil || *issue.Occurrences < *c.MinOccurrences) {
+		return false
+	}
+
+	return true
+}
+
+func (c WaitCondition) String() string {
+	parts := make([]string, 0, 2)
+	if c.Status != "" {
+		parts = append(parts, "status "+c.Status.String())
+	}
+	if c.MinOccurrences != nil {
+		parts = append(parts, fmt.Sprintf("at least %d occurrences", *c.MinOccurrences))
+	}
+
+	return strings.Join(parts, " and ")
+}
+
+func (s *Service) Wait(ctx context.Context, counter domain.ItemCounter, condition WaitCondition, interval time.Duration, poll func(IssueSummary)) (IssueSummary, error) {
+	if err := condition.Validate(); err != nil {
+		return IssueSummary{}, err
+	}
+	if interval <= 0 {
+		return IssueSummary{}, errors.New("poll interval must be positive")
+	}
+
+	itemID, err := s.api.ResolveItemIDByCounter(ctx, counter)
+	if err != nil {
+		return IssueSummary{}, fmt.Errorf("resolve item id: %w", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var issue IssueSummary
+	for {
+		item, err := s.api.GetItem(rollbar.WithoutResponseCache(ctx), itemID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return issue, ctx.Err()
+			}
+			return issue, fmt.Errorf("get item: %w", err)
+		}
+		issue = s.mapSummary(item)
+		if poll != nil {
+			poll(issue)
+		}
+		if condition.Met(issue) {
+			return issue, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return issue, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+// waitAPI resolves its item on the resolveOn-th read.
+type waitAPI struct {
+	*actionAPI
+	reads     int
+	resolveOn int
+}
+
+func (w *waitAPI) GetItem(ctx context.Context, itemID domain.ItemID) (rollbar.Item, error) {
+	w.reads++
+	if w.reads == w.resolveOn {
+		w.item.Status = domain.StatusResolved
+	}
+
+	return w.actionAPI.GetItem(ctx, itemID)
+}
+
+func TestServiceWait(t *testing.T) {
+	t.Parallel()
+
+	api := &waitAPI{actionAPI: &actionAPI{resolvedID: 9, item: rollbar.Item{ID: 9, Counter: 274, Status: domain.StatusActive}}, resolveOn: 3}
+	var seen []domain.Status
+	issue, err := NewService(api).Wait(context.Background(), 274, WaitCondition{Status: domain.StatusResolved}, time.Millisecond, func(issue IssueSummary) {
+		seen = append(seen, issue.Status)
+	})
+	if err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if issue.Status != domain.StatusResolved || api.reads != 3 || len(seen) != 3 || seen[0] != domain.StatusActive {
+		t.Fatalf("unexpected wait: issue %+v, reads %d, seen %v", issue, api.reads, seen)
+	}
+}
+
+func TestServiceWaitTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	api := &waitAPI{actionAPI: &actionAPI{resolvedID: 9, item: rollbar.Item{ID: 9, Counter: 274, Status: domain.StatusActive}}}
+	issue, err := NewService(api).Wait(ctx, 274, WaitCondition{Status: domain.StatusResolved}, time.Millisecond, nil)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline error, got %v", err)
+	}
+	if issue.Status != domain.StatusActive || api.reads < 2 {
+		t.Fatalf("expected the last reading, got %+v after %d reads", issue, api.reads)
+	}
+}
+
+func TestWaitCondition(t *testing.T) {
+	t.Parallel()
+
+	ten, twenty := uint64(10), uint64(20)
+	resolved := IssueSummary{Status: domain.StatusResolved, Occurrences: &ten}
+	tests := []struct {
+		name      string
+		condition WaitCondition
+		met       bool
+		text      string
+	}{
+		{name: "status", condition: WaitCondition{Status: domain.StatusResolved}, met: true, text: "status resolved"},
+		{name: "other status", condition: WaitCondition{Status: domain.StatusMuted}, text: "status muted"},
+		{name: "occurrences reached", condition: WaitCondition{MinOccurrences: &ten}, met: true, text: "at least 10 occurrences"},
+		{name: "both", condition: WaitCondition{Status: domain.StatusResolved, MinOccurrences: &twenty}, text: "status resolved and at least 20 occurrences"},
+	}
+	for _, tc := range tests {
+		if got := tc.condition.Met(resolved); got != tc.met {
+			t.Fatalf("%s: Met() = %v, want %v", tc.name, got, tc.met)
+		}
+		if got := tc.condition.String(); got != tc.text {
+			t.Fatalf("%s: String() = %q, want %q", tc.name, got, tc.text)
+		}
+	}
+
+	if err := (WaitCondition{}).Validate(); err == nil {
+		t.Fatal("expected an empty condition to be rejected")
+	}
+	if _, err := NewService(&actionAPI{}).Wait(context.Background(), 1, WaitCondition{Status: domain.StatusResolved}, 0, nil); err == nil {
+		t.Fatal("expected a zero interval to be rejected")
+	}
+}
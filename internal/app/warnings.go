@@ -0,0 +1,38 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+)
+
+const (
+	WarningPartialPagination   = "partial_pagination"
+	WarningEnrichmentSkipped   = "enrichment_skipped"
+	WarningOccurrencePages     = "occurrence_pages_failed"
+	WarningUnparsedOccurrences = "unparsed_occurrences"
+	WarningSearchFallback      = "search_fallback"
+)
+
+type Warning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type warningLog struct {
+	mu      sync.Mutex
+	entries []Warning
+}
+
+func (s *Service) warn(code string, format string, args ...any) {
+	s.warnings.mu.Lock()
+	defer s.warnings.mu.Unlock()
+
+	s.warnings.entries = append(s.warnings.entries, Warning{Code: code, Message: fmt.Sprintf(format, args...)})
+}
+
+func (s *Service) Warnings() []Warning {
+	s.warnings.mu.Lock()
+	defer s.warnings.mu.Unlock()
+
+	return append([]Warning(nil), s.warnings.entries...)
+}
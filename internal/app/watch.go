@@ -0,0 +1,84 @@
+package app
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type IssueDelta struct {
+	IssueSummary
+	Delta   uint64 `json:"delta"`
+	Changed bool   `json:"changed"`
+	New     bool   `json:"new"`
+}
+
+type OccurrenceTracker struct {
+	previous map[domain.ItemID]uint64
+	order    []domain.ItemID
+	started  bool
+	stable   bool
+}
+
+func NewOccurrenceTracker() *OccurrenceTracker {
+	return &OccurrenceTracker{previous: map[domain.ItemID]uint64{}}
+}
+
+func (t *OccurrenceTracker) Update(issues []IssueSummary) []IssueDelta {
+	deltas := make([]IssueDelta, 0, len(issues))
+	current := make(map[domain.ItemID]uint64, len(issues))
+	order := make([]domain.ItemID, 0, len(issues))
+	for _, issue := range issues {
+		if _, duplicate := current[issue.ItemID]; duplicate {
+			continue
+		}
+		count := uint64Value(issue.Occurrences)
+		current[issue.ItemID] = count
+		order = append(order, issue.ItemID)
+
+		delta := IssueDelta{IssueSummary: issue}
+		previous, seen := t.previous[issue.ItemID]
+		switch {
+		case !seen && t.started:
+			delta.New = true
+			delta.Changed = true
+		case seen && count > previous:
+			delta.Delta = count - previous
+			delta.Changed = true
+		}
+		deltas = append(deltas, delta)
+	}
+
+	t.stable = t.started && slices.Equal(order, t.order) && !anyChanged(deltas)
+	maps.Copy(t.previous, current)
+	t.order = order
+	t.started = true
+
+	return deltas
+}
+
+func (t *OccurrenceTracker) Stable() bool {
+	return t.stable
+}
+
+func anyChanged(deltas []IssueDelta) bool {
+	for _, delta := range deltas {
+		if delta.Changed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func Changes(deltas []IssueDelta) []IssueDelta {
+	changes := make([]IssueDelta, 0)
+	for _, delta := range deltas {
+		if delta.Changed {
+			changes = append(changes, delta)
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,68 @@
+package app
+
+import "testing"
+
+func TestOccurrenceTrackerUpdate(t *testing.T) {
+	t.Parallel()
+
+	count := func(value uint64) *uint64 { return &value }
+	tracker := NewOccurrenceTracker()
+
+	first := tracker.Update([]IssueSummary{{ItemID: 1, Occurrences: count(5)}})
+	if first[0].Changed || first[0].New || first[0].Delta != 0 {
+		t.Fatalf("first refresh should not report changes: %+v", first)
+	}
+
+	second := tracker.Update([]IssueSummary{
+		{ItemID: 1, Occurrences: count(8)},
+		{ItemID: 2, Occurrences: count(1)},
+	})
+	if !second[0].Changed || second[0].Delta != 3 {
+		t.Fatalf("expected +3 delta, got %+v", second[0])
+	}
+	if !second[1].New || !second[1].Changed {
+		t.Fatalf("expected new item, got %+v", second[1])
+	}
+
+	if tracker.Stable() {
+		t.Fatalf("refresh with changes must not be stable")
+	}
+
+	third := tracker.Update([]IssueSummary{{ItemID: 1, Occurrences: count(8)}})
+	if third[0].Changed || third[0].Delta != 0 {
+		t.Fatalf("expected unchanged item, got %+v", third[0])
+	}
+	if tracker.Stable() {
+		t.Fatalf("dropping an issue must not be stable")
+	}
+
+	tracker.Update([]IssueSummary{{ItemID: 1, Occurrences: count(8)}})
+	if !tracker.Stable() {
+		t.Fatalf("identical refresh should be stable")
+	}
+}
+
+func TestOccurrenceTrackerDeduplicatesByItemID(t *testing.T) {
+	t.Parallel()
+
+	count := func(value uint64) *uint64 { return &value }
+	tracker := NewOccurrenceTracker()
+	tracker.Update([]IssueSummary{{ItemID: 1, Occurrences: count(5)}, {ItemID: 2, Occurrences: count(1)}})
+	tracker.Update([]IssueSummary{{ItemID: 2, Occurrences: count(1)}})
+
+	back := tracker.Update([]IssueSummary{
+		{ItemID: 1, Occurrences: count(7)},
+		{ItemID: 1, Occurrences: count(7)},
+		{ItemID: 3, Occurrences: count(2)},
+	})
+	if len(back) != 2 {
+		t.Fatalf("expected duplicate item to be dropped, got %+v", back)
+	}
+	if back[0].New || back[0].Delta != 2 {
+		t.Fatalf("returning issue should be updated by +2, got %+v", back[0])
+	}
+	changes := Changes(back)
+	if len(changes) != 2 || !changes[1].New {
+		t.Fatalf("Changes() = %+v", changes)
+	}
+}
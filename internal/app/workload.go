@@ -0,0 +1,90 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type WorkloadEntry struct {
+	Assignee    *rollbar.User `json:"assignee"`
+	Items       int           `json:"items"`
+	Occurrences uint64        `json:"occurrences"`
+}
+
+type Workload struct {
+	Entries     []WorkloadEntry `json:"entries"`
+	Items       int             `json:"items"`
+	Occurrences uint64          `json:"occurrences"`
+	Complete    bool            `json:"complete"`
+}
+
+func (s *Service) Workload(ctx context.Context, filters IssueFilters) (Workload, error) {
+	s.explainList(filters)
+	entries := map[uint64]*WorkloadEntry{}
+	var unassigned *WorkloadEntry
+	workload := Workload{Entries: []WorkloadEntry{}}
+	maxPages := s.itemPageCap(maxExportItemPages)
+	more, err := s.scanItemPages(ctx, recentStatus(filters), maxPages, func(page []rollbar.Item) bool {
+		for _, item := range s.filterItems(page, filters) {
+			var entry *WorkloadEntry
+			switch {
+			case item.AssignedUserID == nil:
+				if unassigned == nil {
+					unassigned = &WorkloadEntry{}
+				}
+				entry = unassigned
+			case entries[*item.AssignedUserID] == nil:
+				entry = &WorkloadEntry{Assignee: &rollbar.User{ID: *item.AssignedUserID}}
+				entries[*item.AssignedUserID] = entry
+			default:
+				entry = entries[*item.AssignedUserID]
+			}
+			occurrences := uint64(0)
+			if item.TotalOccurrences != nil {
+				occurrences = *item.TotalOccurrences
+			}
+			entry.Items++
+			entry.Occurrences += occurrences
+			workload.Items++
+			workload.Occurrences += occurrences
+		}
+		return true
+	})
+	if err != nil {
+		return Workload{}, fmt.Errorf("list items: %w", err)
+	}
+	if more {
+		s.warn(WarningPartialPagination, "stopped after %d pages with %d matching issues; older issues were not counted", maxPages, workload.Items)
+	}
+
+	for userID, entry := range entries {
+		entry.Assignee = s.assignee(ctx, &userID)
+		workload.Entries = append(workload.Entries, *entry)
+	}
+	if unassigned != nil {
+		workload.Entries = append(workload.Entries, *unassigned)
+	}
+	sortWorkload(workload.Entries)
+	workload.Complete = !more
+
+	return workload, nil
+}
+
+func sortWorkload(entries []WorkloadEntry) {
+	sort.Slice(entries, func(i int, j int) bool {
+		left, right := entries[i], entries[j]
+		switch {
+		case left.Items != right.Items:
+			return left.Items > right.Items
+		case left.Occurrences != right.Occurrences:
+			return left.Occurrences > right.Occurrences
+		case left.Assignee == nil || right.Assignee == nil:
+			return right.Assignee == nil && left.Assignee != nil
+		default:
+			return left.Assignee.ID < right.Assignee.ID
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestServiceWorkload(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := uint64(1), uint64(2)
+	count := func(value uint64) *uint64 { return &value }
+	api := fakeAPI{
+		listItems: []rollbar.Item{
+			{ID: 1, Counter: 1, Environment: "production", AssignedUserID: &alice, TotalOccurrences: count(10)},
+			{ID: 2, Counter: 2, Environment: "production", AssignedUserID: &bob, TotalOccurrences: count(400)},
+			{ID: 3, Counter: 3, Environment: "production", AssignedUserID: &alice, TotalOccurrences: count(5)},
+			{ID: 4, Counter: 4, Environment: "production", TotalOccurrences: count(7)},
+			{ID: 5, Counter: 5, Environment: "production"},
+			{ID: 6, Counter: 6, Environment: "staging", AssignedUserID: &bob, TotalOccurrences: count(1)},
+		},
+		users: []rollbar.User{{ID: 1, Username: "alice"}},
+	}
+	service := NewService(api)
+
+	got, err := service.Workload(context.Background(), IssueFilters{Environment: "production"})
+	if err != nil {
+		t.Fatalf("Workload() error = %v", err)
+	}
+	want := Workload{
+		Entries: []WorkloadEntry{
+			{Assignee: &rollbar.User{ID: 1, Username: "alice"}, Items: 2, Occurrences: 15},
+			{Items: 2, Occurrences: 7},
+			{Assignee: &rollbar.User{ID: 2}, Items: 1, Occurrences: 400},
+		},
+		Items:       5,
+		Occurrences: 422,
+		Complete:    true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Workload() = %+v, want %+v", got, want)
+	}
+	if warnings := service.Warnings(); len(warnings) != 1 || warnings[0].Code != WarningEnrichmentSkipped {
+		t.Fatalf("expected a warning for the unknown assignee, got %+v", warnings)
+	}
+
+	if _, err := NewService(fakeAPI{err: errors.New("boom")}).Workload(context.Background(), IssueFilters{}); err == nil {
+		t.Fatalf("expected list error")
+	}
+}
+
+func TestSortWorkload(t *testing.T) {
+	t.Parallel()
+
+	entries := []WorkloadEntry{
+		{Items: 3, Occurrences: 1},
+		{Assignee: &rollbar.User{ID: 9}, Items: 3, Occurrences: 1},
+		{Assignee: &rollbar.User{ID: 4}, Items: 3, Occurrences: 1},
+		{Assignee: &rollbar.User{ID: 5}, Items: 3, Occurrences: 8},
+	}
+	sortWorkload(entries)
+
+	order := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Assignee == nil {
+			order = append(order, 0)
+			continue
+		}
+		order = append(order, entry.Assignee.ID)
+	}
+	if !reflect.DeepEqual(order, []uint64{5, 4, 9, 0}) {
+		t.Fatalf("sortWorkload() order = %v", order)
+	}
+}
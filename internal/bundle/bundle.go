@@ -0,0 +1,121 @@
+package bundle
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const SchemaVersion = 1
+
+const ManifestName = "manifest.json"
+
+type Manifest struct {
+	SchemaVersion int       `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Files         []File    `json:"files"`
+}
+
+type File struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+type Report struct {
+	Manifest Manifest `json:"manifest"`
+	Problems []string `json:"problems"`
+}
+
+func (r Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+func Write(dir string, files map[string][]byte, now time.Time) (Manifest, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return Manifest{}, fmt.Errorf("create bundle dir: %w", err)
+	}
+
+	manifest := Manifest{SchemaVersion: SchemaVersion, CreatedAt: now.UTC(), Files: make([]File, 0, len(files))}
+	for name, content := range files {
+		if name == ManifestName || filepath.Base(name) != name {
+			return Manifest{}, fmt.Errorf("invalid bundle file name %q", name)
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o600); err != nil {
+			return Manifest{}, fmt.Errorf("write bundle file: %w", err)
+		}
+		manifest.Files = append(manifest.Files, File{Name: name, Size: int64(len(content)), SHA256: checksum(content)})
+	}
+	sort.Slice(manifest.Files, func(i int, j int) bool {
+		return manifest.Files[i].Name < manifest.Files[j].Name
+	})
+
+	encoded, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, fmt.Errorf("encode bundle manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ManifestName), append(encoded, '\n'), 0o600); err != nil {
+		return Manifest{}, fmt.Errorf("write bundle manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func Verify(dir string) (Report, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, ManifestName)) //nolint:gosec // dir is the user's own bundle path
+	if err != nil {
+		return Report{}, fmt.Errorf("read bundle manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return Report{}, fmt.Errorf("decode bundle manifest: %w", err)
+	}
+
+	report := Report{Manifest: manifest, Problems: []string{}}
+	switch {
+	case manifest.SchemaVersion == 0:
+		report.Problems = append(report.Problems, "manifest has no schema_version")
+	case manifest.SchemaVersion > SchemaVersion:
+		report.Problems = append(report.Problems, fmt.Sprintf("schema version %d is newer than this rollbaz reads (%d); upgrade rollbaz", manifest.SchemaVersion, SchemaVersion))
+	}
+	if len(manifest.Files) == 0 {
+		report.Problems = append(report.Problems, "manifest lists no files")
+	}
+	for _, file := range manifest.Files {
+		if problem := verifyFile(dir, file); problem != "" {
+			report.Problems = append(report.Problems, problem)
+		}
+	}
+
+	return report, nil
+}
+
+func verifyFile(dir string, file File) string {
+	if file.Name == ManifestName || filepath.Base(file.Name) != file.Name {
+		return fmt.Sprintf("%s: not a bundle file name", file.Name)
+	}
+	content, err := os.ReadFile(filepath.Join(dir, file.Name)) //nolint:gosec // name is checked to stay inside dir
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return fmt.Sprintf("%s: missing", file.Name)
+	case err != nil:
+		return fmt.Sprintf("%s: %v", file.Name, err)
+	case int64(len(content)) != file.Size:
+		return fmt.Sprintf("%s: size %d, manifest says %d", file.Name, len(content), file.Size)
+	case checksum(content) != file.SHA256:
+		return fmt.Sprintf("%s: checksum mismatch", file.Name)
+	}
+
+	return ""
+}
+
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,108 @@
+package bundle
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteAndVerify(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		tamper func(t *testing.T, dir string)
+		want   string
+	}{
+		{name: "intact"},
+		{name: "changed file", want: "issues.json: checksum mismatch", tamper: func(t *testing.T, dir string) {
+			writeFile(t, filepath.Join(dir, "issues.json"), `[{"counter":2}]`)
+		}},
+		{name: "truncated file", want: "occurrences.json: size 1, manifest says 2", tamper: func(t *testing.T, dir string) {
+			writeFile(t, filepath.Join(dir, "occurrences.json"), `[`)
+		}},
+		{name: "missing file", want: "occurrences.json: missing", tamper: func(t *testing.T, dir string) {
+			if err := os.Remove(filepath.Join(dir, "occurrences.json")); err != nil {
+				t.Fatalf("Remove() error = %v", err)
+			}
+		}},
+		{name: "newer schema", want: "schema version 99 is newer", tamper: func(t *testing.T, dir string) {
+			rewriteManifest(t, dir, func(manifest *Manifest) { manifest.SchemaVersion = 99 })
+		}},
+		{name: "escaping name", want: "../issues.json: not a bundle file name", tamper: func(t *testing.T, dir string) {
+			rewriteManifest(t, dir, func(manifest *Manifest) { manifest.Files[0].Name = "../issues.json" })
+		}},
+	}
+	for _, tc := range tests {
+		dir := filepath.Join(t.TempDir(), "bundle")
+		files := map[string][]byte{"issues.json": []byte(`[{"counter":1}]`), "occurrences.json": []byte(`[]`)}
+		manifest, err := Write(dir, files, time.Unix(1_700_000_000, 0))
+		if err != nil {
+			t.Fatalf("%s: Write() error = %v", tc.name, err)
+		}
+		if manifest.SchemaVersion != SchemaVersion || len(manifest.Files) != 2 || manifest.Files[0].Name != "issues.json" {
+			t.Fatalf("%s: unexpected manifest %+v", tc.name, manifest)
+		}
+		if tc.tamper != nil {
+			tc.tamper(t, dir)
+		}
+
+		report, err := Verify(dir)
+		if err != nil {
+			t.Fatalf("%s: Verify() error = %v", tc.name, err)
+		}
+		if tc.want == "" {
+			if !report.OK() {
+				t.Fatalf("%s: unexpected problems %v", tc.name, report.Problems)
+			}
+			continue
+		}
+		if report.OK() || !strings.Contains(strings.Join(report.Problems, "\n"), tc.want) {
+			t.Fatalf("%s: expected %q, got %v", tc.name, tc.want, report.Problems)
+		}
+	}
+}
+
+func TestWriteAndVerifyErrors(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Write(t.TempDir(), map[string][]byte{ManifestName: nil}, time.Now()); err == nil {
+		t.Fatalf("expected the manifest name to be rejected as a data file")
+	}
+	if _, err := Verify(t.TempDir()); err == nil || !strings.Contains(err.Error(), "read bundle manifest") {
+		t.Fatalf("expected a missing manifest error, got %v", err)
+	}
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, ManifestName), "{")
+	if _, err := Verify(dir); err == nil || !strings.Contains(err.Error(), "decode bundle manifest") {
+		t.Fatalf("expected a decode error, got %v", err)
+	}
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+}
+
+func rewriteManifest(t *testing.T, dir string, change func(*Manifest)) {
+	t.Helper()
+	raw, err := os.ReadFile(filepath.Join(dir, ManifestName))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	change(&manifest)
+	encoded, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	writeFile(t, filepath.Join(dir, ManifestName), string(encoded))
+}
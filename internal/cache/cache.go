@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const DefaultTTL = time.Minute
+
+const entrySuffix = ".json"
+
+type entry struct {
+	FetchedAt time.Time       `json:"fetched_at"`
+	Body      json.RawMessage `json:"body"`
+}
+
+type Store struct {
+	dir string
+	ttl time.Duration
+}
+
+func New(ttl time.Duration) (*Store, error) {
+	cacheRoot, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve cache dir: %w", err)
+	}
+
+	return NewAtDir(filepath.Join(cacheRoot, "rollbaz", "responses"), ttl), nil
+}
+
+func NewAtDir(dir string, ttl time.Duration) *Store {
+	return &Store{dir: dir, ttl: ttl}
+}
+
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+func (s *Store) Get(key string, now time.Time) ([]byte, bool) {
+	cached, err := s.read(s.path(key))
+	if err != nil || s.expired(cached, now) {
+		return nil, false
+	}
+
+	return cached.Body, true
+}
+
+func (s *Store) Put(key string, body []byte, now time.Time) error {
+	if !json.Valid(body) {
+		return errors.New("cache response: body is not JSON")
+	}
+	encoded, err := json.Marshal(entry{FetchedAt: now.UTC(), Body: body})
+	if err != nil {
+		return fmt.Errorf("encode cached response: %w", err)
+	}
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return fmt.Errorf("create cache directory: %w", err)
+	}
+
+	// Write then rename so concurrent readers never see a partial entry.
+	temp, err := os.CreateTemp(s.dir, "entry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("write cached response: %w", err)
+	}
+	if _, err := temp.Write(encoded); err != nil {
+		_ = temp.Close()
+		_ = os.Remove(temp.Name())
+		return fmt.Errorf("write cached response: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		_ = os.Remove(temp.Name())
+		return fmt.Errorf("write cached response: %w", err)
+	}
+	if err := os.Rename(temp.Name(), s.path(key)); err != nil {
+		_ = os.Remove(temp.Name())
+		return fmt.Errorf("write cached response: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) Clear() (int, error) {
+	return s.remove(func(string) bool { return true })
+}
+
+func (s *Store) Prune(now time.Time) (int, error) {
+	return s.remove(func(path string) bool {
+		cached, err := s.read(path)
+		return err != nil || s.expired(cached, now)
+	})
+}
+
+func (s *Store) remove(match func(path string) bool) (int, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("read cache directory: %w", err)
+	}
+
+	removed := 0
+	for _, dirEntry := range entries {
+		if !dirEntry.Type().IsRegular() || !strings.HasSuffix(dirEntry.Name(), entrySuffix) {
+			continue
+		}
+		path := filepath.Join(s.dir, dirEntry.Name())
+		if !match(path) {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return removed, fmt.Errorf("remove cached response: %w", err)
+		}
+		removed++
+	}
+
+	return removed, nil
+}
+
+func (s *Store) read(path string) (entry, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return entry{}, fmt.Errorf("read cached response: %w", err)
+	}
+
+	var cached entry
+	if err := json.Unmarshal(body, &cached); err != nil {
+		return entry{}, fmt.Errorf("decode cached response: %w", err)
+	}
+
+	return cached, nil
+}
+
+func (s *Store) expired(cached entry, now time.Time) bool {
+	return now.Sub(cached.FetchedAt) > s.ttl
+}
+
+// path hashes the key so tokens and URLs never appear in file names.
+func (s *Store) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(s.dir, hex.EncodeToString(sum[:])+entrySuffix)
+}
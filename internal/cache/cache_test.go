@@ -0,0 +1,108 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStoreGetPut(t *testing.T) {
+	t.Parallel()
+
+	store := NewAtDir(t.TempDir(), time.Minute)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	if _, ok := store.Get("items", now); ok {
+		t.Fatalf("expected a miss before Put")
+	}
+	if err := store.Put("items", []byte(`{"err":0}`), now); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		key  string
+		at   time.Time
+		want bool
+	}{
+		{name: "fresh", key: "items", at: now.Add(30 * time.Second), want: true},
+		{name: "at ttl", key: "items", at: now.Add(time.Minute), want: true},
+		{name: "expired", key: "items", at: now.Add(time.Minute + time.Second)},
+		{name: "other key", key: "item", at: now},
+	}
+	for _, tc := range tests {
+		body, ok := store.Get(tc.key, tc.at)
+		if ok != tc.want {
+			t.Fatalf("%s: Get() ok = %v", tc.name, ok)
+		}
+		if ok && string(body) != `{"err":0}` {
+			t.Fatalf("%s: Get() body = %s", tc.name, body)
+		}
+	}
+}
+
+func TestStoreFilesHideKeysAndStayPrivate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store := NewAtDir(filepath.Join(dir, "responses"), time.Minute)
+	if err := store.Put("secret-token https://api.rollbar.com/api/1/items", []byte(`{}`), time.Now()); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	entries, err := os.ReadDir(store.Dir())
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("expected one entry, got %v (%v)", entries, err)
+	}
+	if strings.Contains(entries[0].Name(), "secret") || strings.Contains(entries[0].Name(), "rollbar") {
+		t.Fatalf("key leaked into file name %q", entries[0].Name())
+	}
+	info, err := entries[0].Info()
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected 0600 entry, got %v (%v)", info.Mode().Perm(), err)
+	}
+}
+
+func TestStoreRejectsNonJSON(t *testing.T) {
+	t.Parallel()
+
+	store := NewAtDir(t.TempDir(), time.Minute)
+	if err := store.Put("items", []byte("<html>"), time.Now()); err == nil {
+		t.Fatalf("expected error for a non-JSON body")
+	}
+}
+
+func TestStoreClearAndPrune(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	store := NewAtDir(dir, time.Minute)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	for key, fetchedAt := range map[string]time.Time{"old": now.Add(-time.Hour), "new": now} {
+		if err := store.Put(key, []byte(`{}`), fetchedAt); err != nil {
+			t.Fatalf("Put() error = %v", err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("keep"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if removed, err := store.Prune(now); err != nil || removed != 1 {
+		t.Fatalf("Prune() = %d, %v", removed, err)
+	}
+	if _, ok := store.Get("new", now); !ok {
+		t.Fatalf("expected fresh entry to survive Prune")
+	}
+	if removed, err := store.Clear(); err != nil || removed != 1 {
+		t.Fatalf("Clear() = %d, %v", removed, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "notes.txt")); err != nil {
+		t.Fatalf("expected unrelated file to survive Clear: %v", err)
+	}
+
+	missing := NewAtDir(filepath.Join(dir, "missing"), time.Minute)
+	if removed, err := missing.Clear(); err != nil || removed != 0 {
+		t.Fatalf("Clear() on missing dir = %d, %v", removed, err)
+	}
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantNil bool
+		wantErr bool
+	}{
+		{input: "", wantNil: true},
+		{input: "7d", want: 7 * 24 * time.Hour},
+		{input: "2w", want: 14 * 24 * time.Hour},
+		{input: "90m", want: 90 * time.Minute},
+		{input: "xd", wantErr: true},
+		{input: "-1h", wantErr: true},
+		{input: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseAge(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("parseAge(%q) error = %v", tt.input, err)
+		}
+		if tt.wantErr {
+			continue
+		}
+		if tt.wantNil != (got == nil) || got != nil && *got != tt.want {
+			t.Fatalf("parseAge(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRecentMaxAgeWithAgeColumn(t *testing.T) {
+	fresh := time.Now().Add(-3 * time.Hour).Unix()
+	ancient := time.Now().Add(-90 * 24 * time.Hour).Unix()
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[
+			{"id":1,"counter":1,"title":"brand new","status":"active","first_occurrence_timestamp":%d},
+			{"id":2,"counter":2,"title":"ancient","status":"active","first_occurrence_timestamp":%d}
+		]}}`, fresh, ancient)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--max-age", "7d", "--columns", "counter,age,title")
+
+	got := stdout.String()
+	if !strings.Contains(got, "AGE") || !strings.Contains(got, "3h") || !strings.Contains(got, "brand new") || strings.Contains(got, "ancient") {
+		t.Fatalf("unexpected age-filtered output: %q", got)
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"recent", "--min-age", "30d", "--max-age", "7d"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("expected min/max age validation error")
+	}
+}
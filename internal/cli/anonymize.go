@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"github.com/kevinsheth/rollbaz/internal/anonymize"
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+var newAnonymizeKeyStore = config.NewAnonymizeKeyStore
+
+// Runs after the API calls so follow-up requests still use the real counters.
+func anonymized[T any](flags rootFlags, value T) (T, error) {
+	if !flags.Anonymize {
+		return value, nil
+	}
+
+	store, err := newAnonymizeKeyStore()
+	if err != nil {
+		return value, err
+	}
+	key, err := store.Key()
+	if err != nil {
+		return value, err
+	}
+
+	return anonymize.Apply(anonymize.New(key), value)
+}
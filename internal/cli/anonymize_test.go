@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+func setAnonymizeKeyPath(t *testing.T) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "anonymize.key")
+	if err := os.WriteFile(path, []byte(strings.Repeat("k", 32)), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	original := newAnonymizeKeyStore
+	newAnonymizeKeyStore = func() (*config.AnonymizeKeyStore, error) {
+		return config.NewAnonymizeKeyStoreAtPath(path), nil
+	}
+	t.Cleanup(func() { newAnonymizeKeyStore = original })
+}
+
+func TestAnonymizeScramblesListOutput(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":274,"title":"Checkout failed for acme-corp","status":"active","environment":"production","total_occurrences":4821}]}}`)
+	}))
+	setNoConfigStore(t)
+	setAnonymizeKeyPath(t)
+
+	runRootCommand(t, "recent", "--anonymize")
+	first := stdout.String()
+	for _, leaked := range []string{"Checkout", "acme", "production", "274"} {
+		if strings.Contains(first, leaked) {
+			t.Fatalf("expected %q scrambled, got %q", leaked, first)
+		}
+	}
+	if !strings.Contains(first, "4821") || !strings.Contains(first, "active") {
+		t.Fatalf("expected counts and status kept, got %q", first)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--anonymize")
+	if stdout.String() != first {
+		t.Fatalf("expected deterministic output:\n%s\n%s", first, stdout.String())
+	}
+}
+
+func TestAnonymizedKeyStoreError(t *testing.T) {
+	original := newAnonymizeKeyStore
+	newAnonymizeKeyStore = func() (*config.AnonymizeKeyStore, error) {
+		return nil, errors.New("no config dir")
+	}
+	t.Cleanup(func() { newAnonymizeKeyStore = original })
+
+	if _, err := anonymized(rootFlags{Anonymize: true}, "title"); err == nil {
+		t.Fatalf("expected key store error")
+	}
+	if got, err := anonymized(rootFlags{}, "title"); err != nil || got != "title" {
+		t.Fatalf("anonymized(disabled) = %q, %v", got, err)
+	}
+}
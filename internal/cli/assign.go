@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newAssignCmd(flags *rootFlags) *cobra.Command {
+	match := ""
+	assignCmd := &cobra.Command{
+		Use:   "assign <item-counter> <user>",
+		Short: "Assign an issue to a Rollbar user by username, email, or user ID",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			user := args[len(args)-1]
+			counter, err := resolveItemArg(cmd.Context(), *flags, args[:len(args)-1], match)
+			if err != nil {
+				return err
+			}
+
+			return runAssignment(cmd.Context(), *flags, "assign", counter, func(ctx context.Context, service *app.Service) (app.Assignment, error) {
+				return service.Assign(ctx, counter, user)
+			})
+		},
+	}
+	addMatchFlag(assignCmd, &match)
+
+	return assignCmd
+}
+
+func newUnassignCmd(flags *rootFlags) *cobra.Command {
+	match := ""
+	unassignCmd := &cobra.Command{
+		Use:   "unassign <item-counter>",
+		Short: "Remove an issue's assignee",
+		Args:  itemArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			counter, err := resolveItemArg(cmd.Context(), *flags, args, match)
+			if err != nil {
+				return err
+			}
+
+			return runAssignment(cmd.Context(), *flags, "unassign", counter, func(ctx context.Context, service *app.Service) (app.Assignment, error) {
+				return service.Unassign(ctx, counter)
+			})
+		},
+	}
+	addMatchFlag(unassignCmd, &match)
+
+	return unassignCmd
+}
+
+func runAssignment(parent context.Context, flags rootFlags, action string, counter domain.ItemCounter, execute func(context.Context, *app.Service) (app.Assignment, error)) error {
+	if err := confirmWrite(flags, action, counter); err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	assignment, token, err := runServiceOperation(parent, flags, "Updating issue", func(service *app.Service) (app.Assignment, error) {
+		return execute(ctx, service)
+	})
+	if err != nil {
+		return err
+	}
+	rememberLast(token, counter)
+	if assignment, err = anonymized(flags, assignment); err != nil {
+		return err
+	}
+
+	human := fmt.Sprintf("%s issue %s", assignment.Action, assignment.Issue.Counter.String())
+	if assignment.Assignee != nil {
+		human += " to " + output.UserName(*assignment.Assignee)
+	}
+	assignment.Issue.Raw = nil
+	jsonPayload := redact.Value(map[string]any{"action": assignment.Action, "issue": assignment.Issue, "assignee": assignment.Assignee}, token)
+
+	return printOutput(flags.Format, human, jsonPayload)
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newAssignHandler(t *testing.T, patches *[]string) http.Handler {
+	t.Helper()
+	assignee := "null"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/1/item_by_counter/7":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"itemId":70}}`)
+		case r.URL.Path == "/api/1/users":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"users":[{"id":5,"username":"alice","email":"alice@example.com"}]}}`)
+		case r.URL.Path == "/api/1/user/5":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(w, `{"err":1,"message":"insufficient privileges"}`)
+		case r.URL.Path == "/api/1/item/70" && r.Method == http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			*patches = append(*patches, string(body))
+			assignee = "5"
+			if strings.Contains(string(body), "null") {
+				assignee = "null"
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{}}`)
+		case r.URL.Path == "/api/1/item/70/":
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":{"id":70,"counter":7,"title":"checkout failed","status":"active","assigned_user_id":%s}}`, assignee)
+		case r.URL.Path == "/api/1/item/70/instances":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"instances":[]}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+func TestAssignCommands(t *testing.T) {
+	var patches []string
+	stdout := setupServerAndStdout(t, newAssignHandler(t, &patches))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "assign", "7", "Alice", "--yes")
+	if len(patches) != 1 || patches[0] != `{"assigned_user_id":5}` {
+		t.Fatalf("unexpected patches: %v", patches)
+	}
+	if got := stdout.String(); !strings.Contains(got, "assigned issue 7 to alice (user 5)") {
+		t.Fatalf("unexpected output: %q", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "show", "7")
+	if got := stdout.String(); !strings.Contains(got, "Assignee") || !strings.Contains(got, "user 5") {
+		t.Fatalf("expected show to include the assignee, got %q", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "unassign", "7", "--yes", "--format", "json")
+	if len(patches) != 2 || patches[1] != `{"assigned_user_id":null}` {
+		t.Fatalf("unexpected patches: %v", patches)
+	}
+	if got := stdout.String(); !strings.Contains(got, `"action": "unassigned"`) || !strings.Contains(got, `"assignee": null`) {
+		t.Fatalf("unexpected output: %q", got)
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"assign", "7", "carol", "--yes"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), `no Rollbar user matches "carol"`) {
+		t.Fatalf("expected an unknown user error, got %v", err)
+	}
+}
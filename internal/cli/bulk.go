@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+var errPartialFailure = errors.New("bulk action partly failed")
+
+const exitPartialFailure = 2
+
+const bulkActionWorkers = 4
+
+type issueAction func(context.Context, *app.Service, domain.ItemCounter) (app.ItemActionResult, error)
+
+var bulkItemArgs = cobra.ArbitraryArgs
+
+func resolveItemArgs(parent context.Context, flags rootFlags, args []string, match string) ([]domain.ItemCounter, error) {
+	if len(args) <= 1 {
+		counter, err := resolveItemArg(parent, flags, args, match)
+		if err != nil {
+			return nil, err
+		}
+		return []domain.ItemCounter{counter}, nil
+	}
+	if strings.TrimSpace(match) != "" {
+		return nil, errors.New("pass item counters or --match, not both")
+	}
+
+	counters := make([]domain.ItemCounter, 0, len(args))
+	seen := map[domain.ItemCounter]bool{}
+	for _, arg := range args {
+		var counter domain.ItemCounter
+		var err error
+		if isContextReference(arg) {
+			counter, err = resolveContextReference(flags, arg)
+		} else {
+			counter, err = domain.ParseItemReference(arg)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("item %q: %w", arg, err)
+		}
+		if !seen[counter] {
+			seen[counter] = true
+			counters = append(counters, counter)
+		}
+	}
+
+	return counters, nil
+}
+
+func runIssueActions(parent context.Context, flags rootFlags, action string, counters []domain.ItemCounter, execute issueAction) error {
+	if len(counters) == 1 {
+		return runIssueAction(parent, flags, action, counters[0], func(ctx context.Context, service *app.Service) (app.ItemActionResult, error) {
+			return execute(ctx, service, counters[0])
+		})
+	}
+
+	labels := make([]string, 0, len(counters))
+	for _, counter := range counters {
+		labels = append(labels, "#"+counter.String())
+	}
+	if err := confirmPrompt(flags, fmt.Sprintf("Confirm %s %d issues (%s)?", action, len(counters), strings.Join(labels, ", "))); err != nil {
+		return err
+	}
+
+	batches := (len(counters) + bulkActionWorkers - 1) / bulkActionWorkers
+	ctx, cancel := commandContext(parent, flags, time.Duration(batches)*10*time.Second)
+	defer cancel()
+
+	results, token, err := runServiceOperation(parent, flags, "Updating issues", func(service *app.Service) ([]app.BulkActionResult, error) {
+		return service.BulkAction(ctx, counters, func(ctx context.Context, counter domain.ItemCounter) (app.ItemActionResult, error) {
+			return execute(ctx, service, counter)
+		}), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return printBulkResults(flags, action, results, token)
+}
+
+func printBulkResults(flags rootFlags, action string, results []app.BulkActionResult, token string) error {
+	lines := make([]string, 0, len(results)+1)
+	entries := make([]map[string]any, 0, len(results))
+	var failures []error
+	for _, result := range results {
+		if result.Err != nil {
+			err := sanitizeError(result.Err, token)
+			failures = append(failures, fmt.Errorf("issue %s: %w", result.Counter.String(), err))
+			lines = append(lines, fmt.Sprintf("failed to %s issue %s: %s", action, result.Counter.String(), err))
+			entries = append(entries, map[string]any{"counter": result.Counter, "error": err.Error()})
+			continue
+		}
+
+		outcome, err := anonymized(flags, result.Result)
+		if err != nil {
+			return err
+		}
+		rememberLast(token, result.Counter)
+		lines = append(lines, actionHeadline(outcome))
+		issue := outcome.Issue
+		if !includeRaw(flags, false) {
+			issue.Raw = nil
+		}
+		entries = append(entries, map[string]any{"counter": result.Counter, "action": outcome.Action, "unchanged": outcome.Unchanged, "issue": issue})
+	}
+	succeeded := len(results) - len(failures)
+	lines = append(lines, "", fmt.Sprintf("%s: %d of %d issues succeeded, %d failed", action, succeeded, len(results), len(failures)))
+
+	payload := redact.Value(map[string]any{"action": action, "results": entries, "succeeded": succeeded, "failed": len(failures)}, token)
+	if err := printOutput(flags.Format, strings.Join(lines, "\n"), payload); err != nil {
+		return err
+	}
+
+	switch {
+	case len(failures) == 0:
+		return nil
+	case succeeded == 0:
+		return fmt.Errorf("%s failed for every issue: %w", action, errors.Join(failures...))
+	default:
+		return fmt.Errorf("%w: %s failed for %d of %d issues", errPartialFailure, action, len(failures), len(results))
+	}
+}
+
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, errPartialFailure):
+		return exitPartialFailure
+	case errors.Is(err, errWaitTimeout):
+		return exitWaitTimeout
+	default:
+		return 1
+	}
+}
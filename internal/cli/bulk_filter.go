@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+var filterSelectionFlags = []string{"env", "status", "since", "until", "min-occurrences", "max-occurrences", "min-age", "max-age", "level", "preset", "min-rate"}
+
+type filterSelection struct {
+	olderThan string
+	dryRun    bool
+}
+
+func addFilterSelectionFlags(cmd *cobra.Command, selection *filterSelection) {
+	cmd.Flags().StringVar(&selection.olderThan, "older-than", "", "With filters instead of counters: only issues last seen longer ago than this, e.g. 30d")
+	cmd.Flags().BoolVar(&selection.dryRun, "dry-run", false, "With filters instead of counters: list the matching issues without changing them")
+}
+
+func (s filterSelection) byFilter(cmd *cobra.Command, args []string, match string) (bool, error) {
+	explicit := len(args) > 0 || strings.TrimSpace(match) != ""
+	if explicit && (s.olderThan != "" || s.dryRun) {
+		return false, errors.New("--older-than and --dry-run select issues by filter; drop the item counters and --match")
+	}
+	if explicit || s.olderThan != "" || s.dryRun {
+		return !explicit, nil
+	}
+	for _, name := range filterSelectionFlags {
+		if cmd.Flags().Changed(name) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s filterSelection) filters(flags rootFlags, now time.Time) (app.IssueFilters, error) {
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return app.IssueFilters{}, err
+	}
+	age, err := parseAge(s.olderThan)
+	if err != nil {
+		return app.IssueFilters{}, fmt.Errorf("parse --older-than: %w", err)
+	}
+	if age == nil {
+		return filters, nil
+	}
+	if filters.Until != nil {
+		return app.IssueFilters{}, errors.New("pass --until or --older-than, not both")
+	}
+	until := now.Add(-*age)
+	filters.Until = &until
+
+	return filters, validateIssueFilters(filters)
+}
+
+func rejectListOnlyFilters(flags rootFlags, command string) error {
+	if strings.TrimSpace(flags.MinRate) != "" {
+		return fmt.Errorf("--min-rate only filters issue lists; %s does not apply it, so drop it (or clear it from the active view)", command)
+	}
+
+	return nil
+}
+
+func runFilteredAction(parent context.Context, flags rootFlags, action string, selection filterSelection, execute issueAction) error {
+	if err := rejectListOnlyFilters(flags, action+" by filter"); err != nil {
+		return err
+	}
+	filters, err := selection.filters(flags, time.Now())
+	if err != nil {
+		return err
+	}
+	if action == "reopen" && filters.Status != domain.StatusResolved && filters.Status != domain.StatusMuted {
+		return errors.New("reopen by filter needs --status resolved or --status muted")
+	}
+
+	ctx, cancel := commandContext(parent, flags, 5*time.Minute)
+	defer cancel()
+
+	dryRun := selection.dryRun || !flags.Yes
+	var now time.Time
+	update, token, err := runServiceOperation(parent, flags, "Finding matching issues", func(service *app.Service) (app.BulkUpdateResult, error) {
+		now = service.Now()
+		return service.BulkUpdate(ctx, filters, func(ctx context.Context, counter domain.ItemCounter) (app.ItemActionResult, error) {
+			return execute(ctx, service, counter)
+		}, dryRun)
+	})
+	if err != nil {
+		return err
+	}
+	if len(update.Matches) == 0 {
+		return printOutput(flags.Format, "no issues match the filters", map[string]any{"action": action, "matches": []app.IssueSummary{}, "dry_run": selection.dryRun})
+	}
+	if !dryRun {
+		return printBulkResults(flags, action, update.Results, token)
+	}
+
+	matches, err := anonymized(flags, update.Matches)
+	if err != nil {
+		return err
+	}
+	human := fmt.Sprintf("%d issues match:\n\n%s", len(matches), renderIssueList(flags, matches, output.DefaultListColumns, now))
+	if selection.dryRun {
+		for index := range matches {
+			matches[index].Raw = nil
+		}
+		return printOutput(flags.Format, human+"\n\ndry run: no issues were changed", redact.Value(map[string]any{"action": action, "matches": matches, "dry_run": true}, token))
+	}
+
+	if isHumanFormat(flags.Format) {
+		_, _ = fmt.Fprintln(stdoutWriter, human)
+	}
+	if err := confirmPrompt(flags, fmt.Sprintf("Confirm %s %d matching issues?", action, len(matches))); err != nil {
+		return err
+	}
+	counters := make([]domain.ItemCounter, 0, len(update.Matches))
+	for _, issue := range update.Matches {
+		counters = append(counters, issue.Counter)
+	}
+
+	results, token, err := runServiceOperation(parent, flags, "Updating issues", func(service *app.Service) ([]app.BulkActionResult, error) {
+		return service.BulkAction(ctx, counters, func(ctx context.Context, counter domain.ItemCounter) (app.ItemActionResult, error) {
+			return execute(ctx, service, counter)
+		}), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return printBulkResults(flags, action, results, token)
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newFilteredActionHandler(t *testing.T, capturedPatch *rollbar.ItemPatch) http.Handler {
+	t.Helper()
+	actions := newActionSuccessHandler(t, capturedPatch)
+	recent := time.Now().Unix()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/items" {
+			actions.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[
+			{"id":1755568172,"counter":269,"title":"RST_STREAM","status":"active","environment":"staging","total_occurrences":1,"last_occurrence_timestamp":1600000000},
+			{"id":2,"counter":270,"title":"noisy","status":"active","environment":"staging","total_occurrences":50,"last_occurrence_timestamp":1600000000},
+			{"id":3,"counter":271,"title":"fresh","status":"active","environment":"staging","total_occurrences":1,"last_occurrence_timestamp":%d},
+			{"id":4,"counter":272,"title":"prod","status":"active","environment":"production","total_occurrences":1,"last_occurrence_timestamp":1600000000}
+		]}}`, recent)
+	})
+}
+
+func TestResolveCommandByFilter(t *testing.T) {
+	var patchPayload rollbar.ItemPatch
+	stdout := setupServerAndStdout(t, newFilteredActionHandler(t, &patchPayload))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "resolve", "--env", "staging", "--status", "active", "--max-occurrences", "2", "--older-than", "30d", "--yes")
+	if patchPayload.Status != "resolved" {
+		t.Fatalf("expected a resolve patch, got %+v", patchPayload)
+	}
+	for _, want := range []string{"resolved issue 269", "resolve: 1 of 1 issues succeeded, 0 failed"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output %q", want, stdout.String())
+		}
+	}
+}
+
+func TestResolveCommandByFilterPreviews(t *testing.T) {
+	var patchPayload rollbar.ItemPatch
+	stdout := setupServerAndStdout(t, newFilteredActionHandler(t, &patchPayload))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "resolve", "--env", "staging", "--older-than", "30d", "--dry-run")
+	if !strings.Contains(stdout.String(), "2 issues match") || !strings.Contains(stdout.String(), "dry run: no issues were changed") {
+		t.Fatalf("unexpected dry run output %q", stdout.String())
+	}
+
+	stdout.Reset()
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"mute", "--env", "staging", "--max-occurrences", "2"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "rerun with --yes") {
+		t.Fatalf("expected a confirmation error, got %v", err)
+	}
+	if !strings.Contains(stdout.String(), "2 issues match") || patchPayload.Status != "" {
+		t.Fatalf("expected a preview and no writes, got %q and %+v", stdout.String(), patchPayload)
+	}
+}
+
+func TestFilteredActionErrors(t *testing.T) {
+	setupServerAndStdout(t, newFilteredActionHandler(t, nil))
+	setNoConfigStore(t)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"reopen", "--env", "staging", "--yes"}, want: "needs --status resolved"},
+		{args: []string{"resolve", "269", "--dry-run"}, want: "drop the item counters"},
+		{args: []string{"resolve", "--older-than", "30d", "--until", "1600000000"}, want: "--until or --older-than"},
+		{args: []string{"resolve", "--older-than", "soon"}, want: "parse --older-than"},
+		{args: []string{"resolve", "--env", "staging", "--min-rate", "100000/s", "--dry-run"}, want: "--min-rate only filters issue lists"},
+		{args: []string{"mute", "--min-rate", "10/h", "--yes"}, want: "--min-rate only filters issue lists"},
+		{args: []string{"resolve", "--env", "nowhere", "--yes"}, want: ""},
+	}
+	for _, tc := range tests {
+		cmd := NewRootCmd()
+		cmd.SetArgs(tc.args)
+		err := cmd.Execute()
+		if tc.want == "" {
+			if err != nil {
+				t.Fatalf("%v: error = %v", tc.args, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Fatalf("%v: expected %q, got %v", tc.args, tc.want, err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newBulkActionHandler(t *testing.T, capturedPatch *rollbar.ItemPatch) http.Handler {
+	t.Helper()
+	actions := newActionSuccessHandler(t, capturedPatch)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/item_by_counter/404" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = fmt.Fprint(w, `{"err":1,"message":"Not found"}`)
+			return
+		}
+		actions.ServeHTTP(w, r)
+	})
+}
+
+func TestResolveCommandSeveralIssues(t *testing.T) {
+	stdout := setupServerAndStdout(t, newBulkActionHandler(t, nil))
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"resolve", "269", "404", "269", "--yes"})
+	err := cmd.Execute()
+	if !errors.Is(err, errPartialFailure) || exitCode(err) != exitPartialFailure {
+		t.Fatalf("expected a partial failure, got %v", err)
+	}
+	for _, want := range []string{"resolved issue 269", "failed to resolve issue 404:", "resolve: 1 of 2 issues succeeded, 1 failed"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output %q", want, stdout.String())
+		}
+	}
+}
+
+func TestMuteCommandSeveralIssuesJSON(t *testing.T) {
+	var patchPayload rollbar.ItemPatch
+	stdout := setupServerAndStdout(t, newBulkActionHandler(t, &patchPayload))
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"mute", "269", "404", "--for", "2h", "--yes", "--format", "json"})
+	if err := cmd.Execute(); !errors.Is(err, errPartialFailure) {
+		t.Fatalf("expected a partial failure, got %v", err)
+	}
+	var payload struct {
+		Action  string           `json:"action"`
+		Results []map[string]any `json:"results"`
+		Failed  int              `json:"failed"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if payload.Action != "mute" || len(payload.Results) != 2 || payload.Failed != 1 || patchPayload.Status != "muted" {
+		t.Fatalf("unexpected payload %+v", payload)
+	}
+	if payload.Results[0]["action"] != "muted" || payload.Results[1]["error"] == nil {
+		t.Fatalf("unexpected results %+v", payload.Results)
+	}
+}
+
+func TestResolveItemArgsRejectsMatchWithSeveralCounters(t *testing.T) {
+	if _, err := resolveItemArgs(t.Context(), rootFlags{}, []string{"1", "2"}, "timeout"); err == nil {
+		t.Fatalf("expected an error for counters and --match together")
+	}
+	if _, err := resolveItemArgs(t.Context(), rootFlags{}, []string{"1", "nope"}, ""); err == nil || !strings.Contains(err.Error(), `item "nope"`) {
+		t.Fatalf("expected the bad reference named, got %v", err)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{want: 0},
+		{err: errors.New("boom"), want: 1},
+		{err: fmt.Errorf("%w: resolve failed for 1 of 2 issues", errPartialFailure), want: exitPartialFailure},
+	}
+	for _, tc := range tests {
+		if got := exitCode(tc.err); got != tc.want {
+			t.Fatalf("exitCode(%v) = %d, want %d", tc.err, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/cache"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const autoGCInterval = 24 * time.Hour
+
+var (
+	newGCStamp       = config.NewGCStamp
+	newResponseCache = func() (*cache.Store, error) { return cache.New(cache.DefaultTTL) }
+)
+
+type gcReport struct {
+	HistoryEntries int   `json:"history_entries"`
+	CachedProjects int   `json:"cached_projects"`
+	DumpFiles      int   `json:"dump_files"`
+	DumpBytes      int64 `json:"dump_bytes"`
+	Responses      int   `json:"cached_responses"`
+}
+
+func newCacheCmd(flags *rootFlags) *cobra.Command {
+	cacheCmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage locally stored history, caches, and payload dumps",
+	}
+	cacheCmd.AddCommand(&cobra.Command{
+		Use:   "gc",
+		Short: "Apply the retention policy to local data now",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCacheGC(*flags, time.Now())
+		},
+	})
+	cacheCmd.AddCommand(&cobra.Command{
+		Use:   "clear",
+		Short: "Delete cached Rollbar API responses",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCacheClear(*flags)
+		},
+	})
+
+	return cacheCmd
+}
+
+func runCacheClear(flags rootFlags) error {
+	responses, err := newResponseCache()
+	if err != nil {
+		return err
+	}
+	removed, err := responses.Clear()
+	if err != nil {
+		return err
+	}
+
+	return printOutput(flags.Format, fmt.Sprintf("removed %d cached responses", removed), map[string]int{"cached_responses": removed})
+}
+
+func runCacheGC(flags rootFlags, now time.Time) error {
+	report, err := collectGarbage(loadRetention(), now)
+	if err != nil {
+		return err
+	}
+	if stamp, stampErr := newGCStamp(); stampErr == nil {
+		_ = stamp.Touch(now)
+	}
+
+	human := fmt.Sprintf("removed %d history entries, %d cached projects, %d payload files (%d bytes), %d cached responses",
+		report.HistoryEntries, report.CachedProjects, report.DumpFiles, report.DumpBytes, report.Responses)
+	return printOutput(flags.Format, human, report)
+}
+
+func autoCollectGarbage(now time.Time) {
+	retention := loadRetention()
+	if !retention.Enabled() {
+		return
+	}
+	stamp, err := newGCStamp()
+	if err != nil || !stamp.Due(now, autoGCInterval) {
+		return
+	}
+	_, _ = collectGarbage(retention, now)
+	_ = stamp.Touch(now)
+}
+
+func loadRetention() config.Retention {
+	store, err := newConfigStore()
+	if err != nil {
+		return config.Retention{}
+	}
+	file, err := store.Load()
+	if err != nil || file.Retention == nil {
+		return config.Retention{}
+	}
+
+	return *file.Retention
+}
+
+func collectGarbage(retention config.Retention, now time.Time) (gcReport, error) {
+	report := gcReport{}
+	cachedProjects, err := pruneMetadataCache(now)
+	if err != nil {
+		return report, err
+	}
+	report.CachedProjects = cachedProjects
+	responses, err := pruneResponseCache(now)
+	if err != nil {
+		return report, err
+	}
+	report.Responses = responses
+
+	cutoff := retention.Cutoff(now)
+	historyEntries, err := pruneHistory(cutoff)
+	if err != nil {
+		return report, err
+	}
+	report.HistoryEntries = historyEntries
+
+	dirs, err := retainedDumpDirs(retention)
+	if err != nil {
+		return report, err
+	}
+	for _, dir := range dirs {
+		pruned, err := config.PruneDumpDir(dir, cutoff, retention.MaxBytes())
+		report.DumpFiles += pruned.Files
+		report.DumpBytes += pruned.Bytes
+		if err != nil {
+			return report, fmt.Errorf("prune %s: %w", dir, err)
+		}
+	}
+
+	return report, nil
+}
+
+func retainedDumpDirs(retention config.Retention) ([]string, error) {
+	dirs := slices.Clone(retention.DumpDirs)
+	store, err := newDumpDirStore()
+	if err != nil {
+		return dirs, nil
+	}
+	recorded, err := store.Load()
+	if err != nil {
+		return dirs, err
+	}
+	for _, dir := range recorded {
+		if !slices.ContainsFunc(dirs, func(listed string) bool { return sameDir(listed, dir) }) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs, nil
+}
+
+func sameDir(a string, b string) bool {
+	absolute, err := filepath.Abs(a)
+	return err == nil && absolute == b
+}
+
+func pruneMetadataCache(now time.Time) (int, error) {
+	cache, err := newMetadataCache()
+	if err != nil {
+		return 0, nil
+	}
+	removed, err := cache.Prune(now)
+	if err != nil {
+		return 0, fmt.Errorf("prune project metadata: %w", err)
+	}
+
+	return removed, nil
+}
+
+func pruneResponseCache(now time.Time) (int, error) {
+	responses, err := newResponseCache()
+	if err != nil {
+		return 0, nil
+	}
+	removed, err := responses.Prune(now)
+	if err != nil {
+		return 0, fmt.Errorf("prune cached responses: %w", err)
+	}
+
+	return removed, nil
+}
+
+func configureResponseCache(flags rootFlags) {
+	if flags.NoCache {
+		rollbar.SetDefaultResponseCache(nil)
+		return
+	}
+	responses, err := newResponseCache()
+	if err != nil {
+		rollbar.SetDefaultResponseCache(nil)
+		return
+	}
+	rollbar.SetDefaultResponseCache(responses)
+}
+
+func pruneHistory(cutoff time.Time) (int, error) {
+	if cutoff.IsZero() {
+		return 0, nil
+	}
+	history, err := newHistoryStore()
+	if err != nil {
+		return 0, nil
+	}
+	removed, err := history.Prune(cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("prune history: %w", err)
+	}
+
+	return removed, nil
+}
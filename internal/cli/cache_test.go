@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/cache"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func setupGCStamp(t *testing.T) *config.GCStamp {
+	t.Helper()
+	stamp := config.NewGCStampAtPath(filepath.Join(t.TempDir(), "gc-stamp"))
+	original := newGCStamp
+	newGCStamp = func() (*config.GCStamp, error) {
+		return stamp, nil
+	}
+	t.Cleanup(func() {
+		newGCStamp = original
+	})
+
+	return stamp
+}
+
+type retentionFixture struct {
+	history  *config.HistoryStore
+	cache    *config.MetadataCache
+	stamp    *config.GCStamp
+	dumpDirs *config.DumpDirStore
+	dumpDir  string
+}
+
+func setupRetentionFixture(t *testing.T, now time.Time, retention *config.Retention) retentionFixture {
+	t.Helper()
+	fixture := retentionFixture{history: setupHistoryStore(t), cache: setupMetadataCache(t), stamp: setupGCStamp(t), dumpDirs: setupDumpDirStore(t), dumpDir: t.TempDir()}
+	if retention != nil {
+		retention.DumpDirs = []string{fixture.dumpDir}
+	}
+	if err := setupProjectStore(t).Save(config.File{Retention: retention}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	_ = fixture.history.Append(config.HistoryEntry{Time: now.AddDate(0, 0, -60), Args: []string{"old"}})
+	_ = fixture.history.Append(config.HistoryEntry{Time: now, Args: []string{"new"}})
+	_ = fixture.cache.Put("stale", config.ProjectMetadata{ProjectID: 1, FetchedAt: now.AddDate(0, 0, -2)})
+	dump := filepath.Join(fixture.dumpDir, "7.json")
+	if err := os.WriteFile(dump, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	_ = os.Chtimes(dump, now.AddDate(0, 0, -60), now.AddDate(0, 0, -60))
+
+	return fixture
+}
+
+func TestCacheGCAppliesRetention(t *testing.T) {
+	now := time.Now()
+	setupRetentionFixture(t, now, &config.Retention{MaxAgeDays: 30})
+	stdout := setupStdout(t)
+
+	runRootCommand(t, "--format", "json", "cache", "gc")
+
+	var report gcReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatalf("Unmarshal() error = %v, output=%s", err, stdout.String())
+	}
+	if report != (gcReport{HistoryEntries: 1, CachedProjects: 1, DumpFiles: 1, DumpBytes: 2}) {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
+
+func TestCacheGCPrunesRecordedDumpDirs(t *testing.T) {
+	now := time.Now()
+	fixture := setupRetentionFixture(t, now, &config.Retention{MaxAgeDays: 30})
+	recorded := t.TempDir()
+	dump := filepath.Join(recorded, "8.json")
+	if err := os.WriteFile(dump, []byte("{}"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	_ = os.Chtimes(dump, now.AddDate(0, 0, -60), now.AddDate(0, 0, -60))
+	for _, dir := range []string{recorded, fixture.dumpDir} {
+		if err := fixture.dumpDirs.Record(dir); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+	stdout := setupStdout(t)
+
+	runRootCommand(t, "--format", "json", "cache", "gc")
+
+	var report gcReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatalf("Unmarshal() error = %v, output=%s", err, stdout.String())
+	}
+	if report.DumpFiles != 2 {
+		t.Fatalf("expected the listed and recorded dumps pruned once each, got %+v", report)
+	}
+	if _, err := os.Stat(dump); !os.IsNotExist(err) {
+		t.Fatalf("expected recorded dump removed, err=%v", err)
+	}
+}
+
+func TestCacheGCWithoutRetentionOnlyPrunesExpiredCache(t *testing.T) {
+	now := time.Now()
+	fixture := setupRetentionFixture(t, now, nil)
+	stdout := setupStdout(t)
+
+	runRootCommand(t, "cache", "gc")
+
+	if got := stdout.String(); !strings.Contains(got, "removed 0 history entries, 1 cached projects, 0 payload files (0 bytes)") {
+		t.Fatalf("unexpected output: %q", got)
+	}
+	if fixture.stamp.Due(now, autoGCInterval) {
+		t.Fatalf("cache gc should refresh the stamp")
+	}
+}
+
+func TestAutoCollectGarbage(t *testing.T) {
+	now := time.Now()
+	fixture := setupRetentionFixture(t, now, &config.Retention{MaxAgeDays: 30})
+
+	if err := fixture.stamp.Touch(now.Add(-time.Hour)); err != nil {
+		t.Fatalf("Touch() error = %v", err)
+	}
+	autoCollectGarbage(now)
+	if entries, _ := fixture.history.Load(); len(entries) != 2 {
+		t.Fatalf("gc should wait for the interval, history=%+v", entries)
+	}
+
+	autoCollectGarbage(now.Add(autoGCInterval))
+	if entries, _ := fixture.history.Load(); len(entries) != 1 || entries[0].Args[0] != "new" {
+		t.Fatalf("expected old history pruned, got %+v", entries)
+	}
+	if _, err := os.Stat(filepath.Join(fixture.dumpDir, "7.json")); !os.IsNotExist(err) {
+		t.Fatalf("expected expired dump removed, err=%v", err)
+	}
+}
+
+func TestAutoCollectGarbageDisabledWithoutRetention(t *testing.T) {
+	now := time.Now()
+	fixture := setupRetentionFixture(t, now, nil)
+
+	autoCollectGarbage(now.Add(autoGCInterval))
+	if !fixture.stamp.Due(now, autoGCInterval) {
+		t.Fatalf("auto gc should not run without a retention policy")
+	}
+}
+
+func setupResponseCache(t *testing.T) *cache.Store {
+	t.Helper()
+	responses := cache.NewAtDir(filepath.Join(t.TempDir(), "responses"), time.Minute)
+	original := newResponseCache
+	newResponseCache = func() (*cache.Store, error) {
+		return responses, nil
+	}
+	t.Cleanup(func() {
+		newResponseCache = original
+		rollbar.SetDefaultResponseCache(nil)
+	})
+
+	return responses
+}
+
+func TestResponseCacheServesRepeatedLists(t *testing.T) {
+	var served atomic.Int32
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":4,"title":"cached issue","status":"active"}]}}`)
+	}))
+	setupResponseCache(t)
+
+	for _, args := range [][]string{{"recent", "--plain"}, {"recent", "--plain"}} {
+		runRootCommand(t, args...)
+	}
+	if served.Load() != 1 || strings.Count(stdout.String(), "cached issue") != 2 {
+		t.Fatalf("expected the second list from cache, served %d, output %q", served.Load(), stdout.String())
+	}
+
+	runRootCommand(t, "recent", "--plain", "--no-cache")
+	if served.Load() != 2 {
+		t.Fatalf("expected --no-cache to reach Rollbar, served %d", served.Load())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "cache", "clear")
+	if got := stdout.String(); !strings.Contains(got, "removed 1 cached responses") {
+		t.Fatalf("unexpected cache clear output: %q", got)
+	}
+	runRootCommand(t, "recent", "--plain")
+	if served.Load() != 3 {
+		t.Fatalf("expected a fetch after cache clear, served %d", served.Load())
+	}
+}
+
+func TestCacheGCPrunesExpiredResponses(t *testing.T) {
+	now := time.Now()
+	setupRetentionFixture(t, now, nil)
+	responses := setupResponseCache(t)
+	_ = responses.Put("stale", []byte(`{}`), now.Add(-time.Hour))
+	_ = responses.Put("fresh", []byte(`{}`), now)
+	stdout := setupStdout(t)
+
+	runRootCommand(t, "--format", "json", "cache", "gc")
+
+	var report gcReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatalf("Unmarshal() error = %v, output=%s", err, stdout.String())
+	}
+	if report.Responses != 1 {
+		t.Fatalf("expected one expired response pruned, got %+v", report)
+	}
+}
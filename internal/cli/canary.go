@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newCanaryCmd(flags *rootFlags) *cobra.Command {
+	options := app.CanaryOptions{}
+	canaryCmd := &cobra.Command{
+		Use:   "canary",
+		Short: "Compare error rates between a baseline and candidate version",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCanary(cmd.Context(), *flags, options)
+		},
+	}
+	canaryCmd.Flags().StringVar(&options.Baseline, "baseline", "", "Baseline code version")
+	canaryCmd.Flags().StringVar(&options.Candidate, "candidate", "", "Candidate code version")
+	canaryCmd.Flags().IntVar(&options.SampleItems, "sample-items", 20, "Number of recent items to sample occurrences from")
+	canaryCmd.Flags().Float64Var(&options.MaxRateRatio, "max-rate-ratio", 1.5, "Maximum candidate/baseline error rate ratio before failing")
+	canaryCmd.Flags().IntVar(&options.MaxNewItems, "max-new-items", 0, "Maximum items seen only in the candidate before failing")
+	_ = canaryCmd.MarkFlagRequired("baseline")
+	_ = canaryCmd.MarkFlagRequired("candidate")
+
+	return canaryCmd
+}
+
+func runCanary(parent context.Context, flags rootFlags, options app.CanaryOptions) error {
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	options.Environment = flags.Environment
+	report, token, err := runServiceOperation(parent, flags, "Comparing versions", func(service *app.Service) (app.CanaryReport, error) {
+		return service.Canary(ctx, options)
+	})
+	if err != nil {
+		return err
+	}
+	if report, err = anonymized(flags, report); err != nil {
+		return err
+	}
+
+	jsonPayload := redact.Value(map[string]any{"canary": report}, token)
+	if err := printOutput(flags.Format, output.RenderCanaryHumanWithWidth(report, terminalRenderWidth()), jsonPayload); err != nil {
+		return err
+	}
+	if !report.Passed {
+		return errors.New("canary failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCanaryCommand(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":2,"title":"x","status":"active","environment":"production"}]}}`)
+		case "/api/1/item/1/instances":
+			if r.URL.RawQuery != "page=1&per_page=100" {
+				t.Fatalf("unexpected instances query: %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"id":1,"timestamp":10,"data":{"code_version":"v1"}},{"id":2,"timestamp":20,"data":{"code_version":"v2"}}]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "canary", "--baseline", "v1", "--candidate", "v2", "--format", "json")
+	if !strings.Contains(stdout.String(), `"passed": true`) {
+		t.Fatalf("expected passing canary, got %q", stdout.String())
+	}
+
+	stdout.Reset()
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"canary", "--baseline", "v0", "--candidate", "v2"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "canary failed") {
+		t.Fatalf("expected canary failure, got %v", err)
+	}
+	if !strings.Contains(stdout.String(), "Canary: FAIL") {
+		t.Fatalf("expected failure verdict output, got %q", stdout.String())
+	}
+}
@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newAPICompatCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "api-compat",
+		Short: "Check that Rollbar API responses still have the documented shapes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAPICompat(cmd.Context(), *flags)
+		},
+	}
+}
+
+func runAPICompat(parent context.Context, flags rootFlags) error {
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	report, token, err := runServiceOperation(parent, flags, "Checking API responses", func(service *app.Service) (app.APICompatReport, error) {
+		return service.CheckAPICompat(ctx)
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonPayload := redact.Value(map[string]any{"api_compat": report}, token)
+	if err := printOutput(flags.Format, output.RenderAPICompatWithWidth(report, terminalRenderWidth()), jsonPayload); err != nil {
+		return err
+	}
+	if !report.Passed {
+		return errors.New("api compatibility check failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestAPICompatCommand(t *testing.T) {
+	deploys := `{"deploys":[]}`
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":10,"counter":3,"project_id":7}]}}`)
+		case "/api/1/reports/top_active_items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"item":{"id":10,"counter":3}}]}`)
+		case "/api/1/deploys/":
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":%s}`, deploys)
+		case "/api/1/item_by_counter/3":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"itemId":10}}`)
+		case "/api/1/item/10/":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":10,"counter":3}}`)
+		case "/api/1/item/10/instances":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"instances":[{"id":5}]}}`)
+		case "/api/1/reports/occurrence_counts":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[]}`)
+		case "/api/1/project/7":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":7,"name":"web"}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "api-compat", "--format", "json")
+	if !strings.Contains(stdout.String(), `"passed": true`) {
+		t.Fatalf("expected passing check, got %q", stdout.String())
+	}
+
+	deploys = `[]`
+	stdout.Reset()
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"api-compat"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "api compatibility check failed") {
+		t.Fatalf("expected compatibility failure, got %v", err)
+	}
+	if !strings.Contains(stdout.String(), "bare list") || !strings.Contains(stdout.String(), "1 changed, 0 failed") {
+		t.Fatalf("expected changed deploys shape, got %q", stdout.String())
+	}
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+var newContextStore = config.NewContextStore
+
+func isContextReference(value string) bool {
+	return strings.HasPrefix(strings.TrimSpace(value), "@")
+}
+
+func resolveContextReference(flags rootFlags, value string) (domain.ItemCounter, error) {
+	reference := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "@"))
+	token, err := resolveAccessToken(flags)
+	if err != nil {
+		return 0, err
+	}
+	store, err := newContextStore()
+	if err != nil {
+		return 0, err
+	}
+	context, err := store.Get(tokenFingerprint(token))
+	if err != nil {
+		return 0, err
+	}
+
+	if reference == "last" || reference == "it" {
+		if context.Last == 0 {
+			return 0, errors.New("no issue shown yet for @last; run show or a list first")
+		}
+		return domain.ItemCounter(context.Last), nil
+	}
+
+	row, err := strconv.Atoi(reference)
+	if err != nil || row < 1 {
+		return 0, fmt.Errorf("invalid reference %q: use @N for a row of the last list or @last", value)
+	}
+	if row > len(context.Listed) {
+		return 0, fmt.Errorf("@%d is past the %d rows of the last list", row, len(context.Listed))
+	}
+
+	return domain.ItemCounter(context.Listed[row-1]), nil
+}
+
+func rememberListed(token string, issues []app.IssueSummary) {
+	counters := make([]uint64, 0, len(issues))
+	for _, issue := range issues {
+		counters = append(counters, uint64(issue.Counter))
+	}
+	last := uint64(0)
+	if len(counters) > 0 {
+		last = counters[0]
+	}
+
+	updateContext(token, func(context *config.ItemContext) {
+		context.Listed = counters
+		context.Last = last
+	})
+}
+
+func rememberLast(token string, counter domain.ItemCounter) {
+	updateContext(token, func(context *config.ItemContext) {
+		context.Last = uint64(counter)
+	})
+}
+
+func updateContext(token string, update func(*config.ItemContext)) {
+	store, err := newContextStore()
+	if err != nil {
+		return
+	}
+	key := tokenFingerprint(token)
+	context, err := store.Get(key)
+	if err != nil {
+		return
+	}
+	update(&context)
+	context.UpdatedAt = time.Now().UTC()
+	_ = store.Save(key, context)
+}
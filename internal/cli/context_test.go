@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func setupContextStore(t *testing.T) *config.ContextStore {
+	t.Helper()
+	store := config.NewContextStoreAtPath(filepath.Join(t.TempDir(), "context.json"))
+	original := newContextStore
+	newContextStore = func() (*config.ContextStore, error) { return store, nil }
+	t.Cleanup(func() { newContextStore = original })
+
+	return store
+}
+
+func newContextHandler(t *testing.T, capturedPatch *rollbar.ItemPatch) http.Handler {
+	t.Helper()
+	action := newActionSuccessHandler(t, capturedPatch)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+				{"id":2,"counter":270,"title":"older","status":"active","last_occurrence_timestamp":100},
+				{"id":1755568172,"counter":269,"title":"newer","status":"active","last_occurrence_timestamp":200}
+			]}}`)
+		case "/api/1/item/1755568172/instances":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[]}`)
+		default:
+			action.ServeHTTP(w, r)
+		}
+	})
+}
+
+func TestContextReferencesFollowListOutput(t *testing.T) {
+	setNoConfigStore(t)
+	setupContextStore(t)
+	var patchPayload rollbar.ItemPatch
+	stdout := setupServerAndStdout(t, newContextHandler(t, &patchPayload))
+
+	runRootCommand(t, "recent")
+	runRootCommand(t, "resolve", "@1", "--yes")
+	if patchPayload.Status != "resolved" || !strings.Contains(stdout.String(), "resolved issue 269") {
+		t.Fatalf("expected @1 to resolve the first listed row, got %+v %q", patchPayload, stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "show", "@last")
+	if !strings.Contains(stdout.String(), "RST_STREAM") {
+		t.Fatalf("expected @last to show the resolved issue, got %q", stdout.String())
+	}
+}
+
+func TestContextReferenceErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		context config.ItemContext
+		ref     string
+		wantErr string
+	}{
+		{name: "no last", ref: "@last", wantErr: "no issue shown yet"},
+		{name: "past list", context: config.ItemContext{Listed: []uint64{5}}, ref: "@2", wantErr: "past the 1 rows"},
+		{name: "invalid", ref: "@zero", wantErr: "invalid reference"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setNoConfigStore(t)
+			t.Setenv("ROLLBAR_ACCESS_TOKEN", "token")
+			if err := setupContextStore(t).Save(tokenFingerprint("token"), tt.context); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+
+			cmd := NewRootCmd()
+			cmd.SetArgs([]string{"show", tt.ref})
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected %q error, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
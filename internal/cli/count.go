@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func newCountCmd(flags *rootFlags) *cobra.Command {
+	stopAt := 0
+	countCmd := &cobra.Command{
+		Use:   "count",
+		Short: "Print how many issues match the filters",
+		Long: "Print only the number of issues matching the list filters, for scripts and shell prompts.\n" +
+			"--stop-at stops paging once that many matches were seen, enough to test a threshold.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCount(cmd.Context(), *flags, stopAt)
+		},
+	}
+	countCmd.Flags().IntVar(&stopAt, "stop-at", 0, "Stop counting once this many issues match (0 counts every page)")
+
+	return countCmd
+}
+
+func runCount(parent context.Context, flags rootFlags, stopAt int) error {
+	if stopAt < 0 {
+		return errors.New("--stop-at must be >= 0")
+	}
+	if err := rejectListOnlyFilters(flags, "count"); err != nil {
+		return err
+	}
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, time.Minute)
+	defer cancel()
+
+	count, _, err := runServiceOperation(parent, flags, "Counting issues", func(service *app.Service) (app.IssueCount, error) {
+		return service.Count(ctx, filters, stopAt)
+	})
+	if err != nil {
+		return err
+	}
+
+	return printOutput(flags.Format, strconv.Itoa(count.Count), count)
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCountCommand(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/items" || r.URL.Query().Get("status") != "active" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("page") != "1" {
+			t.Fatalf("expected a short first page to end paging, got page %s", r.URL.Query().Get("page"))
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+			{"id":1,"counter":1,"title":"a","status":"active","environment":"production"},
+			{"id":2,"counter":2,"title":"b","status":"active","environment":"staging"},
+			{"id":3,"counter":3,"title":"c","status":"active","environment":"production"}
+		]}}`)
+	}))
+	setNoConfigStore(t)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: []string{"count", "--env", "production", "--status", "active"}, want: "2\n"},
+		{args: []string{"count", "--format", "json"}, want: `"count": 3`},
+		{args: []string{"count", "--stop-at", "1", "--format", "json"}, want: `"complete": true`},
+	}
+	for _, tc := range tests {
+		stdout.Reset()
+		runRootCommand(t, tc.args...)
+		if !strings.Contains(stdout.String(), tc.want) {
+			t.Fatalf("%v: expected %q, got %q", tc.args, tc.want, stdout.String())
+		}
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"count", "--stop-at", "-1"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--stop-at") {
+		t.Fatalf("expected --stop-at error, got %v", err)
+	}
+
+	cmd = NewRootCmd()
+	cmd.SetArgs([]string{"count", "--min-rate", "10/h"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--min-rate only filters issue lists") {
+		t.Fatalf("expected --min-rate rejection, got %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newDeploysCmd(flags *rootFlags) *cobra.Command {
+	deploysCmd := &cobra.Command{
+		Use:   "deploys",
+		Short: "List and record deploys",
+	}
+	deploysCmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List recent deploys, newest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeploysList(cmd.Context(), *flags)
+		},
+	})
+
+	deploy := rollbar.NewDeploy{}
+	recordCmd := &cobra.Command{
+		Use:   "record",
+		Short: "Record a deploy of a revision to the --env environment",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			deploy.Environment = flags.Environment
+			return runDeployRecord(cmd.Context(), *flags, deploy)
+		},
+	}
+	recordCmd.Flags().StringVar(&deploy.Revision, "revision", "", "Deployed revision, e.g. a commit SHA or version tag")
+	recordCmd.Flags().StringVar(&deploy.LocalUsername, "user", "", "Who deployed")
+	recordCmd.Flags().StringVar(&deploy.Comment, "comment", "", "Deploy comment")
+	recordCmd.Flags().StringVar(&deploy.Status, "status", "", "Deploy status: started, succeeded, failed, or timed_out (default succeeded)")
+	_ = recordCmd.MarkFlagRequired("revision")
+	deploysCmd.AddCommand(recordCmd)
+
+	return deploysCmd
+}
+
+func runDeploysList(parent context.Context, flags rootFlags) error {
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	deploys, token, err := runServiceOperation(parent, flags, "Loading deploys", func(service *app.Service) ([]rollbar.Deploy, error) {
+		return service.Deploys(ctx, flags.Limit, flags.Environment)
+	})
+	if err != nil {
+		return err
+	}
+	if deploys, err = anonymized(flags, deploys); err != nil {
+		return err
+	}
+
+	jsonPayload := redact.Value(map[string]any{"deploys": deploys}, token)
+	return printOutput(flags.Format, output.RenderDeployListWithWidth(deploys, terminalRenderWidth()), jsonPayload)
+}
+
+func runDeployRecord(parent context.Context, flags rootFlags, deploy rollbar.NewDeploy) error {
+	if deploy.Environment == "" {
+		return errors.New("deploys record needs --env")
+	}
+	if err := confirmPrompt(flags, fmt.Sprintf("Record deploy of %s to %s?", deploy.Revision, deploy.Environment)); err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	recorded, token, err := runServiceOperation(parent, flags, "Recording deploy", func(service *app.Service) (rollbar.Deploy, error) {
+		return service.RecordDeploy(ctx, deploy)
+	})
+	if err != nil {
+		return err
+	}
+
+	human := fmt.Sprintf("recorded deploy %d of %s to %s", recorded.ID, recorded.Revision, recorded.Environment)
+	jsonPayload := redact.Value(map[string]any{"deploy": recorded}, token)
+	return printOutput(flags.Format, human, jsonPayload)
+}
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDeploysListCommand(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/deploys/" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"deploys":[]}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"deploys":[{"id":9,"environment":"production","revision":"abc123","start_time":1700000000},{"id":8,"environment":"staging","revision":"def456"}]}}`)
+	}))
+
+	runRootCommand(t, "deploys", "list", "--env", "production", "--format", "json")
+	var payload struct {
+		Deploys []struct {
+			ID       uint64 `json:"id"`
+			Revision string `json:"revision"`
+		} `json:"deploys"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout.String())
+	}
+	if len(payload.Deploys) != 1 || payload.Deploys[0].Revision != "abc123" {
+		t.Fatalf("unexpected deploys: %+v", payload.Deploys)
+	}
+}
+
+func TestDeploysRecordCommand(t *testing.T) {
+	var body map[string]string
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/1/deploy" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		_, _ = fmt.Fprint(w, `{"data":{"deploy_id":41}}`)
+	}))
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"deploys", "record", "--revision", "abc123", "--yes"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--env") {
+		t.Fatalf("expected --env error, got %v", err)
+	}
+
+	runRootCommand(t, "deploys", "record", "--revision", "abc123", "--env", "production", "--user", "alice", "--yes")
+	if body["revision"] != "abc123" || body["environment"] != "production" || body["local_username"] != "alice" {
+		t.Fatalf("unexpected request body: %v", body)
+	}
+	if got := stdout.String(); !strings.Contains(got, "recorded deploy 41 of abc123 to production") {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
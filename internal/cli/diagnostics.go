@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+var skippedWorkspaceDirs = map[string]bool{"node_modules": true}
+
+func newDiagnosticsCmd(flags *rootFlags) *cobra.Command {
+	pathPrefix, out := ".", ""
+	diagnosticsCmd := &cobra.Command{
+		Use:   "diagnostics",
+		Short: "Export active issues as LSP diagnostics on workspace files",
+		Long: "Map the innermost stack frame of each active issue's latest occurrence to a file under --path-prefix " +
+			"and print LSP PublishDiagnosticsParams objects, one per file, for editors to underline the throwing lines.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDiagnostics(cmd.Context(), *flags, pathPrefix, out)
+		},
+	}
+	diagnosticsCmd.Flags().StringVar(&pathPrefix, "path-prefix", ".", "Workspace directory whose files frames are mapped to")
+	diagnosticsCmd.Flags().StringVar(&out, "out", "", "Write the diagnostics JSON to this file instead of stdout")
+
+	return diagnosticsCmd
+}
+
+func runDiagnostics(parent context.Context, flags rootFlags, pathPrefix string, out string) error {
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+	root, err := filepath.Abs(pathPrefix)
+	if err != nil {
+		return fmt.Errorf("resolve --path-prefix: %w", err)
+	}
+	files, err := workspaceFiles(root)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	diagnostics, token, err := runServiceOperation(parent, flags, "Mapping active issues to files", func(service *app.Service) ([]app.Diagnostic, error) {
+		return service.Diagnostics(ctx, flags.Limit, filters, app.NewWorkspaceFiles(files))
+	})
+	if err != nil {
+		return err
+	}
+	published := redact.Value(output.LSPDiagnostics(diagnostics, root), token)
+	document, err := output.RenderJSON(published)
+	if err != nil {
+		return fmt.Errorf("render diagnostics: %w", err)
+	}
+
+	if out == "" {
+		_, _ = fmt.Fprintln(stdoutWriter, document)
+		flushWarnings(stderrWriter)
+		return nil
+	}
+	if err := os.WriteFile(out, []byte(document+"\n"), 0o600); err != nil {
+		return fmt.Errorf("write diagnostics: %w", err)
+	}
+
+	fileCount := countDiagnosticFiles(diagnostics)
+	human := fmt.Sprintf("wrote %d diagnostics in %d files to %s", len(diagnostics), fileCount, out)
+	return printOutput(flags.Format, human, map[string]any{"path": out, "diagnostics": len(diagnostics), "files": fileCount})
+}
+
+func workspaceFiles(root string) ([]string, error) {
+	files := make([]string, 0)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			name := entry.Name()
+			if path != root && (strings.HasPrefix(name, ".") || skippedWorkspaceDirs[name]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(relative))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list workspace files: %w", err)
+	}
+
+	return files, nil
+}
+
+func countDiagnosticFiles(diagnostics []app.Diagnostic) int {
+	files := map[string]struct{}{}
+	for _, diagnostic := range diagnostics {
+		files[diagnostic.File] = struct{}{}
+	}
+
+	return len(files)
+}
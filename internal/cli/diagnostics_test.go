@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiagnosticsCommand(t *testing.T) {
+	workspace := t.TempDir()
+	for _, file := range []string{"src/billing/invoice.go", ".cache/billing/invoice.go", "node_modules/web/handler.go"} {
+		path := filepath.Join(workspace, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatalf("MkdirAll() error = %v", err)
+		}
+		if err := os.WriteFile(path, nil, 0o600); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/reports/top_active_items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"item":{"id":1,"counter":2,"title":"bad total","status":"active","environment":"production","level":"error"}},{"item":{"id":5,"counter":6,"title":"handler","status":"active","environment":"production"}}]}`)
+		case "/api/1/item/1/instances":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"instances":[{"id":9,"data":{"body":{"trace":{"frames":[{"filename":"/srv/shop/src/billing/invoice.go","lineno":42}]}}}}]}}`)
+		case "/api/1/item/5/instances":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"instances":[{"id":10,"data":{"body":{"trace":{"frames":[{"filename":"/srv/shop/node_modules/web/handler.go","lineno":3}]}}}}]}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "diagnostics", "--path-prefix", workspace)
+	var published []struct {
+		URI         string `json:"uri"`
+		Diagnostics []struct {
+			Range struct {
+				Start struct {
+					Line int `json:"line"`
+				} `json:"start"`
+			} `json:"range"`
+			Code string `json:"code"`
+		} `json:"diagnostics"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &published); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, stdout.String())
+	}
+	if len(published) != 1 || !strings.HasSuffix(published[0].URI, "/src/billing/invoice.go") || !strings.HasPrefix(published[0].URI, "file:///") {
+		t.Fatalf("unexpected diagnostics: %+v", published)
+	}
+	if got := published[0].Diagnostics; len(got) != 1 || got[0].Code != "#2" || got[0].Range.Start.Line != 41 {
+		t.Fatalf("unexpected file diagnostics: %+v", got)
+	}
+
+	stdout.Reset()
+	out := filepath.Join(t.TempDir(), "diagnostics.json")
+	runRootCommand(t, "diagnostics", "--path-prefix", workspace, "--out", out)
+	if got := stdout.String(); !strings.Contains(got, "wrote 1 diagnostics in 1 files to "+out) {
+		t.Fatalf("unexpected output: %q", got)
+	}
+	if body, err := os.ReadFile(out); err != nil || !strings.Contains(string(body), `"code": "#2"`) {
+		t.Fatalf("unexpected file %q, err %v", body, err)
+	}
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+// Only loopback URLs are accepted so E2E mode can never send a real token
+// elsewhere.
+const (
+	e2eModeEnv   = "ROLLBAZ_E2E"
+	e2eAPIURLEnv = "ROLLBAZ_E2E_API_URL"
+)
+
+func configureE2EMode() error {
+	if os.Getenv(e2eModeEnv) == "" {
+		return nil
+	}
+
+	apiURL := os.Getenv(e2eAPIURLEnv)
+	parsed, err := url.Parse(apiURL)
+	if err != nil {
+		return fmt.Errorf("parse %s: %w", e2eAPIURLEnv, err)
+	}
+	if !isLoopbackHost(parsed.Hostname()) {
+		return fmt.Errorf("%s must point at a loopback mock server, got %q", e2eAPIURLEnv, apiURL)
+	}
+
+	newRollbarClient = func(token string) (*rollbar.Client, error) {
+		return rollbar.NewWithBaseURL(token, apiURL)
+	}
+
+	return nil
+}
+
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
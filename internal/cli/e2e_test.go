@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/txtar"
+
+	"github.com/kevinsheth/rollbaz/internal/cache"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/rollbar/rollbartest"
+)
+
+// e2eExecEnv makes the test binary act as the rollbaz binary, so scripts
+// observe real exit codes and process-level stdout/stderr.
+const e2eExecEnv = "ROLLBAZ_E2E_EXEC"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(e2eExecEnv) != "" {
+		os.Exit(Execute())
+	}
+	// Keep list and show tests from writing @N context to the real config
+	// directory; tests that need it use setupContextStore.
+	newContextStore = func() (*config.ContextStore, error) {
+		return nil, errors.New("item context disabled in tests")
+	}
+	// Tests share request URLs across fixtures, so a response cached by one
+	// must never answer another.
+	newResponseCache = func() (*cache.Store, error) {
+		return nil, errors.New("response cache disabled in tests")
+	}
+	os.Exit(m.Run())
+}
+
+// TestE2EScripts runs testdata/e2e/*.txtar against a fresh mock server per
+// script. Each archive's comment is the script; its files are written to the
+// working directory. Commands: exec rollbaz ARGS, stdout RE, stderr RE,
+// cmp stdout|stderr FILE, and env KEY=VALUE; prefix ! to expect failure.
+func TestE2EScripts(t *testing.T) {
+	scripts, err := filepath.Glob(filepath.Join("testdata", "e2e", "*.txtar"))
+	if err != nil || len(scripts) == 0 {
+		t.Fatalf("no e2e scripts found: %v", err)
+	}
+
+	for _, path := range scripts {
+		t.Run(strings.TrimSuffix(filepath.Base(path), ".txtar"), func(t *testing.T) {
+			newE2EScript(t, path).run()
+		})
+	}
+}
+
+type e2eScript struct {
+	t       *testing.T
+	archive *txtar.Archive
+	dir     string
+	env     []string
+	stdout  string
+	stderr  string
+}
+
+func newE2EScript(t *testing.T, path string) *e2eScript {
+	t.Helper()
+	archive, err := txtar.ParseFile(path)
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	server := rollbartest.NewDefaultServer()
+	t.Cleanup(server.Close)
+	dir := t.TempDir()
+	for _, file := range archive.Files {
+		if err := os.WriteFile(filepath.Join(dir, file.Name), file.Data, 0o600); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	env := append(os.Environ(),
+		e2eExecEnv+"=1",
+		e2eModeEnv+"=1",
+		e2eAPIURLEnv+"="+server.APIURL(),
+		"ROLLBAR_ACCESS_TOKEN="+rollbartest.Token,
+		"HOME="+filepath.Join(dir, "home"),
+		"XDG_CONFIG_HOME="+filepath.Join(dir, "config"),
+		"XDG_CACHE_HOME="+filepath.Join(dir, "cache"),
+		"NO_COLOR=1",
+	)
+
+	return &e2eScript{t: t, archive: archive, dir: dir, env: env}
+}
+
+func (s *e2eScript) run() {
+	for number, line := range strings.Split(string(s.archive.Comment), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		negate := strings.HasPrefix(line, "!")
+		args := splitScriptLine(strings.TrimSpace(strings.TrimPrefix(line, "!")))
+		if err := s.step(args, negate); err != nil {
+			s.t.Fatalf("line %d: %s: %v\nstdout:\n%s\nstderr:\n%s", number+1, line, err, s.stdout, s.stderr)
+		}
+	}
+}
+
+func (s *e2eScript) step(args []string, negate bool) error {
+	switch {
+	case len(args) >= 2 && args[0] == "exec" && args[1] == "rollbaz":
+		return s.exec(args[2:], negate)
+	case len(args) == 2 && (args[0] == "stdout" || args[0] == "stderr"):
+		return s.match(args[0], args[1], negate)
+	case len(args) == 3 && args[0] == "cmp":
+		return s.compare(args[1], args[2])
+	case len(args) == 2 && args[0] == "env":
+		s.env = append(s.env, args[1])
+		return nil
+	default:
+		return errors.New("unknown command")
+	}
+}
+
+func (s *e2eScript) exec(args []string, negate bool) error {
+	var stdout, stderr bytes.Buffer
+	//nolint:gosec // re-executes this test binary with script arguments.
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Dir = s.dir
+	cmd.Env = s.env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	s.stdout, s.stderr = stdout.String(), stderr.String()
+
+	var exitErr *exec.ExitError
+	switch {
+	case err != nil && !errors.As(err, &exitErr):
+		return err
+	case negate && err == nil:
+		return errors.New("command succeeded unexpectedly")
+	case !negate && err != nil:
+		return err
+	}
+
+	return nil
+}
+
+func (s *e2eScript) match(stream string, pattern string, negate bool) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	output := s.stdout
+	if stream == "stderr" {
+		output = s.stderr
+	}
+	if re.MatchString(output) == negate {
+		return errors.New(stream + " match mismatch")
+	}
+
+	return nil
+}
+
+func (s *e2eScript) compare(stream string, name string) error {
+	want, err := os.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return err
+	}
+	got := s.stdout
+	if stream == "stderr" {
+		got = s.stderr
+	}
+	if got != string(want) {
+		return errors.New(stream + " does not match " + name)
+	}
+
+	return nil
+}
+
+// splitScriptLine splits on spaces, keeping single-quoted words together.
+func splitScriptLine(line string) []string {
+	args := make([]string, 0)
+	var current strings.Builder
+	quoted, inWord := false, false
+	for _, r := range line {
+		switch {
+		case r == '\'':
+			quoted, inWord = !quoted, true
+		case r == ' ' && !quoted:
+			if inWord {
+				args = append(args, current.String())
+				current.Reset()
+			}
+			inWord = false
+		default:
+			current.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		args = append(args, current.String())
+	}
+
+	return args
+}
+
+func TestSplitScriptLine(t *testing.T) {
+	got := splitScriptLine(`stdout '"counter": 269'  x`)
+	if len(got) != 3 || got[1] != `"counter": 269` || got[2] != "x" {
+		t.Fatalf("splitScriptLine() = %q", got)
+	}
+	if got := splitScriptLine(`exec rollbaz show ''`); len(got) != 4 || got[3] != "" {
+		t.Fatalf("splitScriptLine(empty quote) = %q", got)
+	}
+}
+
+func TestConfigureE2EMode(t *testing.T) {
+	restore := overrideClientFactory(newRollbarClient)
+	t.Cleanup(restore)
+
+	tests := []struct {
+		name    string
+		mode    string
+		apiURL  string
+		wantErr bool
+	}{
+		{name: "disabled", mode: "", apiURL: "https://api.rollbar.com/api/1"},
+		{name: "loopback ip", mode: "1", apiURL: "http://127.0.0.1:8080/api/1"},
+		{name: "localhost", mode: "1", apiURL: "http://localhost:8080/api/1"},
+		{name: "remote host", mode: "1", apiURL: "https://api.rollbar.com/api/1", wantErr: true},
+		{name: "unparseable", mode: "1", apiURL: "http://[::1", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Setenv(e2eModeEnv, tc.mode)
+		t.Setenv(e2eAPIURLEnv, tc.apiURL)
+		if err := configureE2EMode(); (err != nil) != tc.wantErr {
+			t.Fatalf("%s: configureE2EMode() error = %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+
+	if _, err := newRollbarClient("token"); err != nil {
+		t.Fatalf("newRollbarClient() error = %v", err)
+	}
+}
+
+func TestExecuteRejectsRemoteE2EURL(t *testing.T) {
+	setupStderr(t)
+	t.Setenv(e2eModeEnv, "1")
+	t.Setenv(e2eAPIURLEnv, "https://api.rollbar.com/api/1")
+
+	if code := Execute(); code != 1 {
+		t.Fatalf("Execute() = %d, want 1", code)
+	}
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+const defaultEndpointWindow = 24 * time.Hour
+
+func newEndpointsCmd(flags *rootFlags) *cobra.Command {
+	options := app.EndpointOptions{}
+	endpointsCmd := &cobra.Command{
+		Use:   "endpoints",
+		Short: "Rank request routes by sampled error occurrences",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEndpoints(cmd.Context(), *flags, options)
+		},
+	}
+	endpointsCmd.Flags().IntVar(&options.SampleItems, "sample-items", 20, "Number of recent issues to sample occurrences from")
+
+	return endpointsCmd
+}
+
+func runEndpoints(parent context.Context, flags rootFlags, options app.EndpointOptions) error {
+	since, err := parseFindSince(flags.Since, time.Now())
+	if err != nil {
+		return fmt.Errorf("parse --since: %w", err)
+	}
+	options.Since = time.Now().Add(-defaultEndpointWindow).UTC()
+	if since != nil {
+		options.Since = *since
+	}
+	listFlags := flags
+	listFlags.Since = ""
+	if options.Filters, err = parseIssueFilters(listFlags); err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	report, token, err := runServiceOperation(parent, flags, "Sampling occurrences", func(service *app.Service) (app.EndpointReport, error) {
+		return service.Endpoints(ctx, options)
+	})
+	if err != nil {
+		return err
+	}
+	if report, err = anonymized(flags, report); err != nil {
+		return err
+	}
+
+	jsonPayload := redact.Value(map[string]any{"endpoints": report}, token)
+	return printOutput(flags.Format, redact.String(output.RenderEndpointReportWithWidth(report, terminalRenderWidth()), token), jsonPayload)
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEndpointsCommand(t *testing.T) {
+	now := time.Now().Unix()
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/1/items" && r.URL.Query().Get("page") == "1":
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[{"id":70,"counter":7,"title":"checkout failed","status":"active","environment":"production","last_occurrence_timestamp":%d}]}}`, now-60)
+		case r.URL.Path == "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+		case r.URL.Path == "/api/1/item/70/instances":
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":{"instances":[
+				{"id":1,"timestamp":%[1]d,"data":{"request":{"method":"POST","url":"https://shop.example.com/orders/17?token=abc"}}},
+				{"id":2,"timestamp":%[1]d,"data":{"request":{"method":"POST","url":"https://shop.example.com/orders/18"}}},
+				{"id":3,"timestamp":%[2]d,"data":{"request":{"method":"GET","url":"https://shop.example.com/search"}}}
+			]}}`, now-60, now-7200)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.String())
+		}
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "endpoints", "--since", "1h")
+	got := stdout.String()
+	for _, want := range []string{"2 sampled occurrences of 1 issues", "/orders/:id", "#7"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "/search") {
+		t.Fatalf("expected occurrences older than --since to be left out, got:\n%s", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "endpoints", "--format", "json")
+	if got := stdout.String(); !strings.Contains(got, `"route": "/search"`) || !strings.Contains(got, `"sampled_occurrences": 3`) {
+		t.Fatalf("expected the default 24h window in JSON output, got:\n%s", got)
+	}
+}
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/text/language"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+type redactedError struct {
+	message string
+	cause   error
+}
+
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.cause
+}
+
+type errorKind int
+
+const (
+	errorUnknown errorKind = iota
+	errorUnauthorized
+	errorForbidden
+	errorNotFound
+	errorNoData
+	errorRateLimited
+	errorUnexpectedShape
+	errorStrictWarnings
+	errorRequestBudget
+)
+
+type errorMessage struct {
+	Title  string
+	Detail string
+	Try    []string
+}
+
+var errorCatalogs = map[string]map[errorKind]errorMessage{
+	"en": {
+		errorUnauthorized: {
+			Title:  "Access token rejected",
+			Detail: "Rollbar did not accept the token for this project.",
+			Try:    []string{"regenerate a project access token and update it with `rollbaz project add <name> --token ...`"},
+		},
+		errorForbidden: {
+			Title:  "Access token lacks the required scope",
+			Detail: "The token is valid but not allowed to make this request.",
+			Try:    []string{"use a read token for list/show and a write token for resolve/reopen/mute"},
+		},
+		errorNotFound: {
+			Title:  "Not found in this project",
+			Detail: "Rollbar has no such item or resource for the selected project.",
+			Try:    []string{"check --project or the active project with `rollbaz project list`"},
+		},
+		errorNoData: {
+			Title:  "Rollbar returned no data",
+			Detail: "The request succeeded but the response was empty.",
+			Try:    []string{"confirm the token belongs to the project you expect"},
+		},
+		errorRateLimited: {
+			Title:  "Rate limited by Rollbar",
+			Detail: "Rollbar is throttling requests made with this token.",
+			Try:    []string{"wait a moment and retry", "lower the request rate with --max-rps"},
+		},
+		errorUnexpectedShape: {
+			Title:  "Unexpected response shape",
+			Detail: "Rollbar's response did not match the documented shape; the API may have changed.",
+			Try:    []string{"rerun without --strict to accept a best-effort parse", "run `rollbaz api-compat` to see which endpoints changed"},
+		},
+		errorStrictWarnings: {
+			Title: "Command raised warnings under --strict",
+			Try:   []string{"rerun without --strict to accept partial results"},
+		},
+		errorRequestBudget: {
+			Title:  "API request budget exhausted",
+			Detail: "The command made as many Rollbar requests as it is allowed to.",
+			Try:    []string{"raise it with --max-requests or \"max_requests\" in the config file (0 disables)"},
+		},
+	},
+}
+
+var errorLocale = language.English
+
+func classifyError(err error) errorKind {
+	switch {
+	case errors.Is(err, rollbar.ErrUnauthorized):
+		return errorUnauthorized
+	case errors.Is(err, rollbar.ErrForbidden):
+		return errorForbidden
+	case errors.Is(err, rollbar.ErrNotFound):
+		return errorNotFound
+	case errors.Is(err, rollbar.ErrNoData):
+		return errorNoData
+	case errors.Is(err, rollbar.ErrRateLimited):
+		return errorRateLimited
+	case errors.Is(err, rollbar.ErrUnexpectedShape):
+		return errorUnexpectedShape
+	case errors.Is(err, errStrictWarnings):
+		return errorStrictWarnings
+	case errors.Is(err, rollbar.ErrRequestBudgetExhausted):
+		return errorRequestBudget
+	default:
+		return errorUnknown
+	}
+}
+
+func lookupErrorMessage(err error, locale language.Tag) (errorMessage, bool) {
+	kind := classifyError(err)
+	if kind == errorUnknown {
+		return errorMessage{}, false
+	}
+
+	base, _ := locale.Base()
+	catalog, ok := errorCatalogs[base.String()]
+	if !ok {
+		catalog = errorCatalogs["en"]
+	}
+	message, ok := catalog[kind]
+	if !ok {
+		message, ok = errorCatalogs["en"][kind]
+	}
+
+	return message, ok
+}
+
+func presentError(w io.Writer, err error, verbose bool) {
+	message, ok := lookupErrorMessage(err, errorLocale)
+	if !ok {
+		_, _ = fmt.Fprintln(w, "error: "+err.Error())
+		return
+	}
+
+	_, _ = fmt.Fprintln(w, "error: "+message.Title)
+	switch {
+	case message.Detail == "":
+		_, _ = fmt.Fprintln(w, "  "+err.Error())
+	case verbose:
+		_, _ = fmt.Fprintln(w, "  "+message.Detail)
+		_, _ = fmt.Fprintln(w, "  cause: "+err.Error())
+	default:
+		_, _ = fmt.Fprintln(w, "  "+message.Detail)
+	}
+	for _, line := range message.Try {
+		_, _ = fmt.Fprintln(w, "  try: "+line)
+	}
+	if message.Detail != "" && !verbose {
+		_, _ = fmt.Fprintln(w, "  (run with -v for the full error)")
+	}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"golang.org/x/text/language"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestLookupErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "unauthorized", err: fmt.Errorf("list: %w", rollbar.ErrUnauthorized), want: "regenerate"},
+		{name: "forbidden", err: rollbar.ErrForbidden, want: "write token"},
+		{name: "not found", err: rollbar.ErrNotFound, want: "rollbaz project list"},
+		{name: "no data", err: rollbar.ErrNoData, want: "belongs to the project"},
+		{name: "rate limited", err: rollbar.ErrRateLimited, want: "retry"},
+		{name: "budget", err: fmt.Errorf("items: %w", rollbar.ErrRequestBudgetExhausted), want: "--max-requests"},
+		{name: "unexpected shape", err: rollbar.ErrUnexpectedShape, want: "without --strict"},
+		{name: "strict warnings", err: errStrictWarnings, want: "partial results"},
+		{name: "generic", err: errors.New("boom"), want: ""},
+	}
+
+	for _, tc := range tests {
+		for _, locale := range []language.Tag{language.English, language.German} {
+			message, ok := lookupErrorMessage(sanitizeError(tc.err, "token"), locale)
+			if tc.want == "" {
+				if ok {
+					t.Fatalf("%s: expected no catalog entry, got %+v", tc.name, message)
+				}
+				continue
+			}
+			if !ok || message.Title == "" || !strings.Contains(strings.Join(message.Try, "\n"), tc.want) {
+				t.Fatalf("%s (%s): lookupErrorMessage() = %+v, %v, want try line containing %q", tc.name, locale, message, ok, tc.want)
+			}
+		}
+	}
+}
+
+func TestPresentError(t *testing.T) {
+	cause := sanitizeError(&rollbar.APIError{Op: "items", StatusCode: 401, Message: "invalid access token", Kind: rollbar.ErrUnauthorized}, "secret")
+	tests := []struct {
+		name    string
+		err     error
+		verbose bool
+		want    []string
+		absent  []string
+	}{
+		{
+			name:   "catalog entry",
+			err:    cause,
+			want:   []string{"error: Access token rejected", "try: regenerate", "run with -v"},
+			absent: []string{"status 401"},
+		},
+		{
+			name:    "verbose keeps raw detail",
+			err:     cause,
+			verbose: true,
+			want:    []string{"error: Access token rejected", "cause: rollbar items: status 401: invalid access token"},
+			absent:  []string{"run with -v"},
+		},
+		{
+			name: "entry without detail shows the error",
+			err:  fmt.Errorf("%w: partial_pagination: stopped", errStrictWarnings),
+			want: []string{"error: Command raised warnings under --strict", "partial_pagination: stopped", "try: rerun without --strict"},
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("boom"),
+			want: []string{"error: boom"},
+		},
+	}
+
+	for _, tc := range tests {
+		var out strings.Builder
+		presentError(&out, tc.err, tc.verbose)
+		for _, want := range tc.want {
+			if !strings.Contains(out.String(), want) {
+				t.Fatalf("%s: expected %q in %q", tc.name, want, out.String())
+			}
+		}
+		for _, absent := range tc.absent {
+			if strings.Contains(out.String(), absent) {
+				t.Fatalf("%s: unexpected %q in %q", tc.name, absent, out.String())
+			}
+		}
+	}
+}
+
+func TestExecutePrintsHintForInvalidToken(t *testing.T) {
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, `{"err":1,"message":"invalid access token"}`)
+	}))
+	stderr := setupStderr(t)
+
+	originalArgs := os.Args
+	os.Args = []string{"rollbaz", "recent"}
+	defer func() { os.Args = originalArgs }()
+
+	if code := Execute(); code != 1 {
+		t.Fatalf("Execute() = %d, want 1", code)
+	}
+	if !strings.Contains(stderr.String(), "error: Access token rejected") || !strings.Contains(stderr.String(), "try: regenerate") {
+		t.Fatalf("unexpected stderr: %q", stderr.String())
+	}
+}
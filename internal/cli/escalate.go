@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+const manualEscalationReason = "manual"
+
+var (
+	newLevelHistoryStore = config.NewLevelHistoryStore
+	notifyHTTPClient     = &http.Client{Timeout: 10 * time.Second}
+)
+
+func newEscalateCmd(flags *rootFlags) *cobra.Command {
+	match := ""
+	history := false
+	escalateCmd := &cobra.Command{
+		Use:   "escalate <item-counter>",
+		Short: "Raise an issue's level one step and notify the configured webhook",
+		Args:  itemArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			counter, err := resolveItemArg(cmd.Context(), *flags, args, match)
+			if err != nil {
+				return err
+			}
+			if history {
+				return runLevelHistory(*flags, counter)
+			}
+
+			return runEscalate(cmd.Context(), *flags, counter)
+		},
+	}
+	addMatchFlag(escalateCmd, &match)
+	escalateCmd.Flags().BoolVar(&history, "history", false, "Print the level changes rollbaz made to the issue instead of escalating")
+
+	return escalateCmd
+}
+
+func runEscalate(parent context.Context, flags rootFlags, counter domain.ItemCounter) error {
+	if flags.NotifyWebhook != "" {
+		if err := validateOutboundURL("notify_webhook", flags.NotifyWebhook); err != nil {
+			return err
+		}
+	}
+	if err := confirmWrite(flags, "escalate", counter); err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	escalation, token, err := runServiceOperation(parent, flags, "Escalating issue", func(service *app.Service) (app.Escalation, error) {
+		return service.Escalate(ctx, counter)
+	})
+	if err != nil {
+		return err
+	}
+	rememberLast(token, counter)
+	notified := afterEscalation(ctx, flags, token, escalation, manualEscalationReason)
+	if escalation, err = anonymized(flags, escalation); err != nil {
+		return err
+	}
+
+	human := fmt.Sprintf("escalated issue %s from %s to %s", escalation.Issue.Counter.String(), escalation.From, escalation.To)
+	if notified {
+		human += "; notified notify_webhook"
+	}
+	escalation.Issue.Raw = nil
+	jsonPayload := redact.Value(map[string]any{"escalation": escalation, "notified": notified}, token)
+
+	return printOutput(flags.Format, human, jsonPayload)
+}
+
+func afterEscalation(ctx context.Context, flags rootFlags, token string, escalation app.Escalation, reason string) bool {
+	change := config.LevelChange{
+		Time:    time.Now().UTC(),
+		Counter: uint64(escalation.Issue.Counter),
+		From:    escalation.From.String(),
+		To:      escalation.To.String(),
+		Reason:  reason,
+	}
+	if err := appendLevelChange(token, change); err != nil {
+		addWarnings(app.Warning{Code: warningEscalationFailed, Message: "level history not saved: " + err.Error()})
+	}
+
+	if flags.NotifyWebhook == "" {
+		return false
+	}
+	text := fmt.Sprintf("rollbaz escalated #%s %q from %s to %s (%s)", escalation.Issue.Counter.String(), escalation.Issue.Title, escalation.From, escalation.To, reason)
+	if err := postNotification(ctx, flags.NotifyWebhook, redact.String(text, token)); err != nil {
+		addWarnings(app.Warning{Code: warningNotifyFailed, Message: err.Error()})
+		return false
+	}
+
+	return true
+}
+
+func appendLevelChange(token string, change config.LevelChange) error {
+	store, err := newLevelHistoryStore()
+	if err != nil {
+		return err
+	}
+
+	return store.Append(tokenFingerprint(token), change)
+}
+
+func postNotification(ctx context.Context, webhook string, text string) error {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return fmt.Errorf("encode notification: %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build notification: %w", webhookError(err))
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := notifyHTTPClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("send notification: %w", webhookError(err))
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("send notification: webhook returned HTTP %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// A chat webhook's URL is its secret.
+func webhookError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("%s: %w", urlErr.Op, urlErr.Err)
+	}
+
+	return err
+}
+
+func runLevelHistory(flags rootFlags, counter domain.ItemCounter) error {
+	candidates, err := resolveTokenCandidates(flags)
+	if err != nil {
+		return err
+	}
+	store, err := newLevelHistoryStore()
+	if err != nil {
+		return err
+	}
+	changes, err := store.Item(tokenFingerprint(candidates[0].token), uint64(counter))
+	if err != nil {
+		return err
+	}
+
+	lines := make([]string, 0, len(changes))
+	for _, change := range changes {
+		lines = append(lines, fmt.Sprintf("%s  %s -> %s  (%s)", change.Time.Format(time.RFC3339), change.From, change.To, fallbackText(change.Reason)))
+	}
+	if len(lines) == 0 {
+		lines = append(lines, "no level changes recorded for issue "+counter.String())
+	}
+
+	return printOutput(flags.Format, strings.Join(lines, "\n"), map[string]any{"counter": counter, "changes": changes})
+}
@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+func setupLevelHistoryStore(t *testing.T) *config.LevelHistoryStore {
+	t.Helper()
+	store := config.NewLevelHistoryStoreAtPath(filepath.Join(t.TempDir(), "levels.json"))
+	original := newLevelHistoryStore
+	newLevelHistoryStore = func() (*config.LevelHistoryStore, error) { return store, nil }
+	t.Cleanup(func() { newLevelHistoryStore = original })
+
+	return store
+}
+
+func newEscalationHandler(t *testing.T, patches *[]string) http.Handler {
+	t.Helper()
+	level := "warning"
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/1/item_by_counter/7":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"itemId":70}}`)
+		case r.URL.Path == "/api/1/item/70" && r.Method == http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			*patches = append(*patches, string(body))
+			var patch struct {
+				Level string `json:"level"`
+			}
+			_ = json.Unmarshal(body, &patch)
+			level = patch.Level
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{}}`)
+		case r.URL.Path == "/api/1/item/70/":
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":{"id":70,"counter":7,"title":"checkout failed","status":"active","level":%q,"total_occurrences":3}}`, level)
+		case r.URL.Path == "/api/1/items":
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[{"id":70,"counter":7,"title":"checkout failed","status":"active","level":%q,"total_occurrences":3}]}}`, level)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+func TestEscalateCommand(t *testing.T) {
+	var notifications []string
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		notifications = append(notifications, string(body))
+	}))
+	t.Cleanup(webhook.Close)
+
+	var patches []string
+	stdout := setupServerAndStdout(t, newEscalationHandler(t, &patches))
+	if err := setupProjectStore(t).Save(config.File{NotifyWebhook: webhook.URL}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	history := setupLevelHistoryStore(t)
+
+	runRootCommand(t, "escalate", "7", "--yes")
+	if len(patches) != 1 || patches[0] != `{"level":"error"}` {
+		t.Fatalf("unexpected patches: %v", patches)
+	}
+	if !strings.Contains(stdout.String(), "escalated issue 7 from warning to error; notified notify_webhook") {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+	if len(notifications) != 1 || !strings.Contains(notifications[0], `"text":"rollbaz escalated #7 \"checkout failed\" from warning to error (manual)"`) {
+		t.Fatalf("unexpected notifications: %v", notifications)
+	}
+	changes, err := history.Item(tokenFingerprint("token"), 7)
+	if err != nil || len(changes) != 1 || changes[0].From != "warning" || changes[0].To != "error" || changes[0].Reason != "manual" {
+		t.Fatalf("level history = %+v, err=%v", changes, err)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "escalate", "7", "--history", "--format", "json")
+	if !strings.Contains(stdout.String(), `"to": "error"`) || !strings.Contains(stdout.String(), `"reason": "manual"`) {
+		t.Fatalf("unexpected history output: %q", stdout.String())
+	}
+}
+
+func TestEscalateCommandNotifyFailureWarns(t *testing.T) {
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	t.Cleanup(webhook.Close)
+
+	var patches []string
+	setupServerAndStdout(t, newEscalationHandler(t, &patches))
+	if err := setupProjectStore(t).Save(config.File{NotifyWebhook: webhook.URL}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	setupLevelHistoryStore(t)
+	stderr := setupStderr(t)
+
+	runRootCommand(t, "escalate", "7", "--yes")
+	if len(patches) != 1 || !strings.Contains(stderr.String(), "webhook returned HTTP 502") {
+		t.Fatalf("expected notify warning, patches %v, stderr %q", patches, stderr.String())
+	}
+}
+
+func TestPostNotificationHidesWebhookURL(t *testing.T) {
+	webhook := httptest.NewServer(http.NotFoundHandler())
+	secretURL := webhook.URL + "/services/T000/B000/secret-path"
+	webhook.Close()
+
+	for _, target := range []string{secretURL, "http://[::1]:namedport/services/secret-path"} {
+		err := postNotification(t.Context(), target, "hello")
+		if err == nil || strings.Contains(err.Error(), "secret-path") {
+			t.Fatalf("expected an error without the webhook URL, got %v", err)
+		}
+	}
+}
+
+func TestWatchEscalatesAboveRate(t *testing.T) {
+	var patches []string
+	setupServerAndStdout(t, newEscalationHandler(t, &patches))
+	setNoConfigStore(t)
+	history := setupLevelHistoryStore(t)
+
+	escalator, err := parseWatchEscalation(rootFlags{Yes: true}, "1/s")
+	if err != nil {
+		t.Fatalf("parseWatchEscalation() error = %v", err)
+	}
+	flags := rootFlags{Format: "json", Limit: 10}
+	session := newWatchSession(flags, watchOptions{interval: time.Second, escalateAbove: "1/s"}, escalator)
+	deltas := []app.IssueDelta{{IssueSummary: app.IssueSummary{ItemID: 70, Counter: 7}, Delta: 2, Changed: true}}
+	start := time.Now()
+
+	if got, err := session.publish(t.Context(), deltas, start); err != nil || len(got) != 0 {
+		t.Fatalf("first refresh escalated: %+v, err=%v", got, err)
+	}
+	got, err := session.publish(t.Context(), deltas, start.Add(time.Second))
+	if err != nil || len(got) != 1 || got[0].To != "error" || len(patches) != 1 {
+		t.Fatalf("publish() = %+v, err=%v, patches %v", got, err, patches)
+	}
+	if got, _ := session.publish(t.Context(), deltas, start.Add(2*time.Second)); len(got) != 0 {
+		t.Fatalf("issue escalated twice: %+v", got)
+	}
+	changes, err := history.Item(tokenFingerprint("token"), 7)
+	if err != nil || len(changes) != 1 || changes[0].Reason != "rate reached 1/s" {
+		t.Fatalf("level history = %+v, err=%v", changes, err)
+	}
+}
+
+func TestParseWatchEscalation(t *testing.T) {
+	for _, tt := range []struct {
+		flags rootFlags
+		value string
+		ok    bool
+	}{
+		{value: "", ok: true},
+		{flags: rootFlags{Yes: true}, value: "100/h", ok: true},
+		{flags: rootFlags{Yes: true}, value: "fast"},
+		{value: "100/h"},
+		{flags: rootFlags{Yes: true, Anonymize: true}, value: "100/h"},
+	} {
+		_, err := parseWatchEscalation(tt.flags, tt.value)
+		if (err == nil) != tt.ok {
+			t.Fatalf("parseWatchEscalation(%q) error = %v", tt.value, err)
+		}
+	}
+}
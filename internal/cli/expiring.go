@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newExpiringCmd(flags *rootFlags) *cobra.Command {
+	within := ""
+	expiringCmd := &cobra.Command{
+		Use:   "expiring",
+		Short: "List muted issues whose snooze expires soon",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExpiring(cmd.Context(), *flags, within)
+		},
+	}
+	expiringCmd.Flags().StringVar(&within, "within", "24h", "Expiry window (examples: 2h, 24h)")
+
+	return expiringCmd
+}
+
+func runExpiring(parent context.Context, flags rootFlags, within string) error {
+	window, err := time.ParseDuration(within)
+	if err != nil {
+		return fmt.Errorf("parse --within: %w", err)
+	}
+
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 20*time.Second)
+	defer cancel()
+
+	issues, token, err := runServiceOperation(parent, flags, "Loading muted issues", func(service *app.Service) ([]app.IssueSummary, error) {
+		return service.Expiring(ctx, window, flags.Limit, filters)
+	})
+	if err != nil {
+		return err
+	}
+	if issues, err = anonymized(flags, issues); err != nil {
+		return err
+	}
+
+	jsonPayload := redact.Value(map[string]any{"issues": listPayload(flags, issues)}, token)
+	return printOutput(flags.Format, output.RenderExpiringHumanWithWidth(issues, terminalRenderWidth()), jsonPayload)
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExpiringCommand(t *testing.T) {
+	mutedAt := time.Now().Add(-time.Hour).Unix()
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/items" || r.URL.Query().Get("status") != "muted" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[
+			{"id":1,"counter":5,"title":"soon","status":"muted","snooze_enabled":true,"snooze_enabled_timestamp":%[1]s,"snooze_expiration_in_seconds":7200},
+			{"id":2,"counter":6,"title":"later","status":"muted","snooze_enabled":true,"snooze_enabled_timestamp":%[1]s,"snooze_expiration_in_seconds":604800}
+		]}}`, strconv.FormatInt(mutedAt, 10))
+	}))
+
+	runRootCommand(t, "expiring", "--within", "24h")
+	if !strings.Contains(stdout.String(), "soon") || strings.Contains(stdout.String(), "later") {
+		t.Fatalf("unexpected expiring output: %q", stdout.String())
+	}
+}
+
+func TestExpiringCommandInvalidWindow(t *testing.T) {
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"expiring", "--within", "soon"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "parse --within") {
+		t.Fatalf("expected window parse error, got %v", err)
+	}
+}
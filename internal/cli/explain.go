@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+)
+
+var pendingExplanation struct {
+	mu          sync.Mutex
+	explanation *app.Explanation
+}
+
+func setExplanation(explanation app.Explanation) {
+	pendingExplanation.mu.Lock()
+	defer pendingExplanation.mu.Unlock()
+
+	if explanation.Empty() {
+		pendingExplanation.explanation = nil
+		return
+	}
+	pendingExplanation.explanation = &explanation
+}
+
+func takeExplanation() *app.Explanation {
+	pendingExplanation.mu.Lock()
+	defer pendingExplanation.mu.Unlock()
+
+	explanation := pendingExplanation.explanation
+	pendingExplanation.explanation = nil
+
+	return explanation
+}
+
+func flushExplanation(w io.Writer) {
+	if explanation := takeExplanation(); explanation != nil {
+		_, _ = fmt.Fprintln(w, output.RenderExplanationHuman(*explanation))
+	}
+}
+
+func withExplanation(payload any, explanation *app.Explanation) (any, error) {
+	if explanation == nil {
+		return payload, nil
+	}
+
+	return withPayloadField(payload, "explain", explanation)
+}
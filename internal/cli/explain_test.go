@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func explainServer(t *testing.T) {
+	t.Helper()
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[`+
+			`{"id":1,"counter":4,"title":"prod issue","status":"active","environment":"production"},`+
+			`{"id":2,"counter":5,"title":"qa issue","status":"active","environment":"qa"}]}}`)
+	}))
+}
+
+func TestExplainPrintsToStderrForHumanOutput(t *testing.T) {
+	explainServer(t)
+	stderr := setupStderr(t)
+
+	runRootCommand(t, "recent", "--env", "production", "--plain", "--explain")
+
+	got := stderr.String()
+	for _, want := range []string{
+		"explain: server-side filters: status=active",
+		"explain: client-side filters: env=production",
+		"explain: GET /items?page=1&status=active -> 2 results",
+		"explain: env dropped 1 of 2 items",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in stderr, got %q", want, got)
+		}
+	}
+
+	stderr.Reset()
+	runRootCommand(t, "recent", "--env", "production", "--plain")
+	if strings.Contains(stderr.String(), "explain:") {
+		t.Fatalf("expected no explanation without --explain, got %q", stderr.String())
+	}
+}
+
+func TestExplainAddsJSONBlock(t *testing.T) {
+	explainServer(t)
+	stdout := setupStdout(t)
+
+	runRootCommand(t, "recent", "--env", "production", "--format", "json", "--explain")
+
+	var payload struct {
+		Issues  []app.IssueSummary `json:"issues"`
+		Explain app.Explanation    `json:"explain"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("Unmarshal() error = %v, output=%s", err, stdout.String())
+	}
+	if len(payload.Issues) != 1 || len(payload.Explain.Calls) != 1 || len(payload.Explain.Stages) != 1 || payload.Explain.Stages[0].Dropped != 1 {
+		t.Fatalf("unexpected explained payload: %+v", payload)
+	}
+}
+
+func TestWithExplanation(t *testing.T) {
+	payload := map[string]any{"issues": nil}
+	if got, err := withExplanation(payload, nil); err != nil || fmt.Sprint(got) != fmt.Sprint(payload) {
+		t.Fatalf("expected payload unchanged without an explanation, got %v, %v", got, err)
+	}
+	got, err := withExplanation([]int{1}, &app.Explanation{Stages: []app.ExplainStage{{Stage: "env"}}})
+	if err != nil {
+		t.Fatalf("withExplanation() error = %v", err)
+	}
+	if object, ok := got.(map[string]any); !ok || object["result"] == nil || object["explain"] == nil {
+		t.Fatalf("expected wrapped payload, got %v", got)
+	}
+}
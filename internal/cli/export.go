@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/bundle"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+var runSQLite = func(ctx context.Context, path string, script io.Reader) error {
+	binary, err := lookPath("sqlite3")
+	if err != nil {
+		return errors.New("sqlite export needs the sqlite3 command on PATH (install it with `brew install sqlite` or `apt-get install sqlite3`); use --to sql:FILE to write a script instead")
+	}
+	cmd := exec.CommandContext(ctx, binary, "-bail", path) //nolint:gosec // binary comes from PATH lookup and path is the user's own --to destination
+	cmd.Stdin = script
+	cmd.Stderr = stderrWriter
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run sqlite3: %w", err)
+	}
+
+	return nil
+}
+
+type exportTarget struct {
+	kind string
+	path string
+}
+
+type exportOptions struct {
+	to          string
+	occurrences int
+}
+
+func newExportCmd(flags *rootFlags) *cobra.Command {
+	options := exportOptions{}
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export issues and occurrences for offline SQL analysis or transfer as a bundle",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runExport(cmd.Context(), *flags, options)
+		},
+	}
+	exportCmd.Flags().StringVar(&options.to, "to", "", "Destination: sqlite:FILE.db, sql:FILE.sql, or bundle:DIR (JSON files plus a checksum manifest)")
+	exportCmd.Flags().IntVar(&options.occurrences, "occurrences", 1, "Newest occurrences to export per issue (0 for none)")
+	_ = exportCmd.MarkFlagRequired("to")
+
+	return exportCmd
+}
+
+func runExport(parent context.Context, flags rootFlags, options exportOptions) error {
+	target, err := parseExportTarget(options.to)
+	if err != nil {
+		return err
+	}
+	if options.occurrences < 0 {
+		return errors.New("--occurrences must not be negative")
+	}
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := commandContext(parent, flags, 5*time.Minute)
+	defer cancel()
+
+	data, token, err := runServiceOperation(parent, flags, "Exporting issues", func(service *app.Service) (app.ExportData, error) {
+		return service.Export(ctx, filters, options.occurrences)
+	})
+	if err != nil {
+		return err
+	}
+	for index := range data.Occurrences {
+		data.Occurrences[index].Instance.Raw = redact.RawJSON(data.Occurrences[index].Instance.Raw, token)
+	}
+
+	if err := writeExport(ctx, target, data); err != nil {
+		return err
+	}
+
+	human := fmt.Sprintf("exported %d issues and %d occurrences to %s", len(data.Issues), len(data.Occurrences), options.to)
+	payload := map[string]any{"target": options.to, "issues": len(data.Issues), "occurrences": len(data.Occurrences)}
+	return printOutput(flags.Format, human, payload)
+}
+
+func parseExportTarget(value string) (exportTarget, error) {
+	kind, path, ok := strings.Cut(value, ":")
+	if !ok || strings.TrimSpace(path) == "" {
+		return exportTarget{}, fmt.Errorf("invalid --to %q (expected sqlite:FILE, sql:FILE, or bundle:DIR)", value)
+	}
+	switch kind {
+	case "sqlite", "sql", "bundle":
+		return exportTarget{kind: kind, path: path}, nil
+	default:
+		return exportTarget{}, fmt.Errorf("unsupported export target %q (supported: sqlite, sql, bundle)", kind)
+	}
+}
+
+func writeExport(ctx context.Context, target exportTarget, data app.ExportData) error {
+	if target.kind == "bundle" {
+		files, err := output.BundleFiles(data)
+		if err != nil {
+			return err
+		}
+		_, err = bundle.Write(target.path, files, time.Now())
+		return err
+	}
+	if target.kind == "sqlite" {
+		var script bytes.Buffer
+		if err := output.WriteSQLiteScript(&script, data); err != nil {
+			return err
+		}
+		return runSQLite(ctx, target.path, &script)
+	}
+
+	file, err := os.OpenFile(target.path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600) //nolint:gosec // destination is the user's own --to path
+	if err != nil {
+		return fmt.Errorf("create export file: %w", err)
+	}
+	if err := output.WriteSQLiteScript(file, data); err != nil {
+		_ = file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close export file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newExportHandler(t *testing.T) http.Handler {
+	t.Helper()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			items := ""
+			if r.URL.Query().Get("page") == "1" {
+				items = `{"id":42,"counter":3,"title":"boom","status":"active","environment":"production","last_occurrence_timestamp":1700000000}`
+			}
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[%s]}}`, items)
+		case "/api/1/item/42/instances":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"id":100,"timestamp":1700000000,"data":{"access_token":"token","body":"x"}}]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+}
+
+func overrideRunSQLite(t *testing.T, run func(ctx context.Context, path string, script io.Reader) error) {
+	t.Helper()
+	previous := runSQLite
+	runSQLite = run
+	t.Cleanup(func() { runSQLite = previous })
+}
+
+func TestExportToSQLFile(t *testing.T) {
+	stdout := setupServerAndStdout(t, newExportHandler(t))
+	setNoConfigStore(t)
+	path := filepath.Join(t.TempDir(), "issues.sql")
+
+	runRootCommand(t, "export", "--to", "sql:"+path)
+
+	if got := stdout.String(); !strings.Contains(got, "exported 1 issues and 1 occurrences to sql:"+path) {
+		t.Fatalf("unexpected output: %q", got)
+	}
+	script, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(script), "INSERT OR REPLACE INTO items VALUES (42, NULL, 3, 'boom'") {
+		t.Fatalf("unexpected script:\n%s", script)
+	}
+	if strings.Contains(string(script), `"token"`) || !strings.Contains(string(script), "[REDACTED]") {
+		t.Fatalf("expected redacted occurrence payload:\n%s", script)
+	}
+}
+
+func TestExportToSQLitePipesScript(t *testing.T) {
+	stdout := setupServerAndStdout(t, newExportHandler(t))
+	setNoConfigStore(t)
+	var gotPath, gotScript string
+	overrideRunSQLite(t, func(ctx context.Context, path string, script io.Reader) error {
+		body, err := io.ReadAll(script)
+		gotPath, gotScript = path, string(body)
+		return err
+	})
+
+	runRootCommand(t, "--format", "json", "export", "--to", "sqlite:issues.db", "--occurrences", "0")
+
+	if gotPath != "issues.db" || !strings.Contains(gotScript, "CREATE TABLE IF NOT EXISTS occurrences") {
+		t.Fatalf("unexpected sqlite call: path=%q script=%q", gotPath, gotScript)
+	}
+	if got := stdout.String(); !strings.Contains(got, `"occurrences": 0`) || !strings.Contains(got, `"issues": 1`) {
+		t.Fatalf("unexpected output: %s", got)
+	}
+}
+
+func TestRunSQLite(t *testing.T) {
+	previous := lookPath
+	t.Cleanup(func() { lookPath = previous })
+
+	lookPath = func(string) (string, error) { return "", errors.New("missing") }
+	if err := runSQLite(context.Background(), "x.db", strings.NewReader("")); err == nil || !strings.Contains(err.Error(), "sql:FILE") || !strings.Contains(err.Error(), "install") {
+		t.Fatalf("expected missing sqlite3 error, got %v", err)
+	}
+
+	lookPath = exec.LookPath
+	if _, err := exec.LookPath("sqlite3"); err != nil {
+		t.Skip("sqlite3 not installed")
+	}
+	path := filepath.Join(t.TempDir(), "issues.db")
+	if err := runSQLite(context.Background(), path, strings.NewReader("CREATE TABLE t (id INTEGER);\n")); err != nil {
+		t.Fatalf("runSQLite() error = %v", err)
+	}
+	stderr := setupStderr(t)
+	if err := runSQLite(context.Background(), path, strings.NewReader("not sql;\n")); err == nil {
+		t.Fatalf("expected sqlite3 error for invalid script")
+	}
+	if !strings.Contains(stderr.String(), "syntax error") {
+		t.Fatalf("expected sqlite3 diagnostics on stderr, got %q", stderr.String())
+	}
+}
+
+func TestExportErrors(t *testing.T) {
+	setupServerAndStdout(t, newExportHandler(t))
+	setNoConfigStore(t)
+	overrideRunSQLite(t, func(context.Context, string, io.Reader) error { return errors.New("sqlite failed") })
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "missing target", args: []string{"export"}},
+		{name: "target without path", args: []string{"export", "--to", "sqlite:"}},
+		{name: "unsupported target", args: []string{"export", "--to", "parquet:issues.parquet"}},
+		{name: "negative occurrences", args: []string{"export", "--to", "sql:x.sql", "--occurrences", "-1"}},
+		{name: "invalid filters", args: []string{"export", "--to", "sql:x.sql", "--since", "nope"}},
+		{name: "sqlite failure", args: []string{"export", "--to", "sqlite:issues.db"}},
+		{name: "unwritable file", args: []string{"export", "--to", "sql:" + filepath.Join(t.TempDir(), "missing", "x.sql")}},
+	}
+
+	for _, tc := range tests {
+		cmd := NewRootCmd()
+		cmd.SetArgs(tc.args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("%s: expected error", tc.name)
+		}
+	}
+}
+
+func TestExportToBundleAndVerify(t *testing.T) {
+	stdout := setupServerAndStdout(t, newExportHandler(t))
+	setNoConfigStore(t)
+	dir := filepath.Join(t.TempDir(), "bundle")
+
+	runRootCommand(t, "export", "--to", "bundle:"+dir)
+	occurrences, err := os.ReadFile(filepath.Join(dir, "occurrences.json"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if strings.Contains(string(occurrences), `"token"`) || !strings.Contains(string(occurrences), `"occurrence_id":100`) {
+		t.Fatalf("unexpected occurrences file %s", occurrences)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "verify-bundle", dir)
+	if !strings.Contains(stdout.String(), "bundle OK: schema 1, 2 files") {
+		t.Fatalf("unexpected verify output %q", stdout.String())
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "issues.json"), []byte("[]"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	stdout.Reset()
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"verify-bundle", dir})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "failed verification with 1 problems") {
+		t.Fatalf("expected a verification error, got %v", err)
+	}
+	if !strings.Contains(stdout.String(), "issues.json: size 2") {
+		t.Fatalf("unexpected verify output %q", stdout.String())
+	}
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+type findOptions struct {
+	url      string
+	user     string
+	key      string
+	printRQL bool
+}
+
+func newFindCmd(flags *rootFlags) *cobra.Command {
+	options := findOptions{}
+	findCmd := &cobra.Command{
+		Use:   "find",
+		Short: "Search occurrences by URL, user, or custom field without writing RQL",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFind(cmd.Context(), *flags, options)
+		},
+	}
+	findCmd.Flags().StringVar(&options.url, "by-url", "", "Match occurrences whose request URL contains this text")
+	findCmd.Flags().StringVar(&options.user, "by-user", "", "Match occurrences by person id, username, or email")
+	findCmd.Flags().StringVar(&options.key, "by-key", "", "Match a custom payload field, as key=value")
+	findCmd.Flags().BoolVar(&options.printRQL, "print-rql", false, "Print the generated RQL query instead of running it")
+
+	return findCmd
+}
+
+func runFind(parent context.Context, flags rootFlags, options findOptions) error {
+	query, err := parseFindQuery(flags, options, time.Now())
+	if err != nil {
+		return err
+	}
+	if options.printRQL {
+		rql, err := app.BuildFindRQL(query)
+		if err != nil {
+			return err
+		}
+		return printOutput(flags.Format, rql, map[string]any{"query": rql})
+	}
+
+	ctx, cancel := commandContext(parent, flags, 2*time.Minute)
+	defer cancel()
+
+	result, token, err := runServiceOperation(parent, flags, "Running occurrence search", func(service *app.Service) (app.RQLResult, error) {
+		return service.Find(ctx, query)
+	})
+	if err != nil {
+		return err
+	}
+	if result, err = anonymized(flags, result); err != nil {
+		return err
+	}
+
+	return printOutput(flags.Format, redact.String(output.RenderFindResultWithWidth(result, terminalRenderWidth()), token), redact.Value(result, token))
+}
+
+func parseFindQuery(flags rootFlags, options findOptions, now time.Time) (app.FindQuery, error) {
+	query := app.FindQuery{
+		URL:         strings.TrimSpace(options.url),
+		User:        strings.TrimSpace(options.user),
+		Environment: strings.TrimSpace(flags.Environment),
+		Limit:       flags.Limit,
+	}
+	if options.key != "" {
+		key, value, ok := strings.Cut(options.key, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return app.FindQuery{}, fmt.Errorf("invalid --by-key %q: use key=value", options.key)
+		}
+		query.CustomKey, query.CustomValue = strings.TrimSpace(key), value
+	}
+	if query.URL == "" && query.User == "" && query.CustomKey == "" {
+		return app.FindQuery{}, errors.New("pass at least one of --by-url, --by-user, or --by-key")
+	}
+
+	since, err := parseFindSince(flags.Since, now)
+	if err != nil {
+		return app.FindQuery{}, fmt.Errorf("parse --since: %w", err)
+	}
+	query.Since = since
+
+	return query, nil
+}
+
+func parseFindSince(value string, now time.Time) (*time.Time, error) {
+	if age, err := parseAge(value); err == nil {
+		if age == nil {
+			return nil, nil
+		}
+		since := now.Add(-*age).UTC()
+		return &since, nil
+	}
+
+	return parseFilterTime(value)
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFindCommand(t *testing.T) {
+	setNoConfigStore(t)
+	var query string
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/rql/jobs/":
+			var body struct {
+				QueryString string `json:"query_string"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			query = body.QueryString
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":5,"status":"success"}}`)
+		case "/api/1/rql/job/5/result":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":5,"result":{"columns":["item.counter","item.title","timestamp"],"rows":[[42,"checkout failed",1700000000]]}}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "find", "--by-url", "/checkout", "--env", "production", "--limit", "5")
+	for _, want := range []string{"request.url LIKE '%/checkout%'", "environment = 'production'", "LIMIT 5"} {
+		if !strings.Contains(query, want) {
+			t.Fatalf("expected %q in query, got %q", want, query)
+		}
+	}
+	for _, want := range []string{"ITEM_COUNTER", "42", "checkout failed", "2023-11-14T22:13:20Z"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout.String())
+		}
+	}
+}
+
+func TestFindCommandPrintRQL(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request: %s", r.URL.Path)
+	}))
+
+	runRootCommand(t, "find", "--by-key", "tenant=acme", "--since", "1700000000", "--print-rql")
+	want := "SELECT item.counter, item.title, timestamp, environment, request.url, person.id FROM item_occurrence WHERE custom.tenant = 'acme' AND timestamp >= 1700000000 ORDER BY timestamp DESC LIMIT 10"
+	if strings.TrimSpace(stdout.String()) != want {
+		t.Fatalf("unexpected rql: %q", stdout.String())
+	}
+}
+
+func TestParseFindQuery(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_700_086_400, 0)
+	tests := []struct {
+		name    string
+		flags   rootFlags
+		options findOptions
+		want    string
+		wantErr string
+	}{
+		{name: "relative since", flags: rootFlags{Since: "24h"}, options: findOptions{url: "/a"}, want: "2023-11-14T22:13:20Z"},
+		{name: "day age", flags: rootFlags{Since: "1d"}, options: findOptions{user: "alice"}, want: "2023-11-14T22:13:20Z"},
+		{name: "absolute since", flags: rootFlags{Since: "2023-11-14T22:13:20Z"}, options: findOptions{url: "/a"}, want: "2023-11-14T22:13:20Z"},
+		{name: "no criteria", options: findOptions{}, wantErr: "at least one of"},
+		{name: "bad key", options: findOptions{key: "tenant"}, wantErr: "use key=value"},
+		{name: "bad since", flags: rootFlags{Since: "yesterday"}, options: findOptions{url: "/a"}, wantErr: "parse --since"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseFindQuery(tc.flags, tc.options, now)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("parseFindQuery() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseFindQuery() error = %v", err)
+			}
+			if got.Since == nil || got.Since.UTC().Format(time.RFC3339) != tc.want {
+				t.Fatalf("parseFindQuery() since = %v, want %s", got.Since, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"text/template"
+
+	"github.com/kevinsheth/rollbaz/internal/output"
+)
+
+var outputTemplate *template.Template
+
+func configureFormatting(flags rootFlags) error {
+	numbers, err := output.ParseNumberStyle(flags.NumberFormat)
+	if err != nil {
+		return fmt.Errorf("config number_format: %w", err)
+	}
+	if flags.HumanNumbers {
+		numbers = output.NumbersCompact
+	}
+	timestamps, err := output.ParseTimestampStyle(flags.TimestampFormat)
+	if err != nil {
+		return fmt.Errorf("config timestamp_format: %w", err)
+	}
+	locale, err := output.ParseLocale(flags.Locale)
+	if err != nil {
+		return fmt.Errorf("config locale: %w", err)
+	}
+	truncation, err := output.ParseTruncationStyle(flags.Truncate)
+	if err != nil {
+		return fmt.Errorf("truncate: %w", err)
+	}
+
+	outputTemplate = nil
+	switch {
+	case flags.Format == "template":
+		if outputTemplate, err = output.ParseTemplate(flags.Template); err != nil {
+			return err
+		}
+	case flags.Template != "":
+		return errors.New("--template needs --format template")
+	}
+
+	errorLocale = locale
+	output.SetFormatting(output.Formatting{Locale: locale, Numbers: numbers, Timestamps: timestamps, Truncation: truncation, Color: shouldUseColor(flags.Format)})
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/output"
+)
+
+func TestNumberFormattingFromConfigAndFlag(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":4,"title":"busy","status":"active","total_occurrences":1234567}]}}`)
+	}))
+	t.Cleanup(func() { output.SetFormatting(output.Formatting{}) })
+	store := setupProjectStore(t)
+	if err := store.Save(config.File{NumberFormat: "grouped", Locale: "de-DE"}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	runRootCommand(t, "recent")
+	if !strings.Contains(stdout.String(), "1.234.567") {
+		t.Fatalf("expected locale-grouped count, got %q", stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--human-numbers")
+	if !strings.Contains(stdout.String(), "1,2M") {
+		t.Fatalf("expected compact count, got %q", stdout.String())
+	}
+}
+
+func TestConfigureFormattingRejectsInvalidConfig(t *testing.T) {
+	t.Cleanup(func() { output.SetFormatting(output.Formatting{}) })
+
+	for _, flags := range []rootFlags{
+		{NumberFormat: "roman"},
+		{TimestampFormat: "sometimes"},
+		{Locale: "not a locale"},
+		{Truncate: "fold"},
+	} {
+		if err := configureFormatting(flags); err == nil {
+			t.Fatalf("expected error for %+v", flags)
+		}
+	}
+	if err := configureFormatting(rootFlags{TimestampFormat: "relative"}); err != nil {
+		t.Fatalf("configureFormatting() error = %v", err)
+	}
+}
+
+func TestTruncateFlagKeepsTitleSuffix(t *testing.T) {
+	title := "Timeout calling upstream service while loading order " + strings.Repeat("x", 80) + " id=48213"
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[{"id":1,"counter":4,"title":%q,"status":"active"}]}}`, title)
+	}))
+	t.Cleanup(func() { output.SetFormatting(output.Formatting{}) })
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent")
+	if strings.Contains(stdout.String(), "id=48213") {
+		t.Fatalf("expected default truncation to cut the suffix, got %q", stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--truncate", "middle")
+	if !strings.Contains(stdout.String(), "…") || !strings.Contains(stdout.String(), "id=48213") {
+		t.Fatalf("expected middle truncation to keep the suffix, got %q", stdout.String())
+	}
+}
+
+func TestTemplateFormat(t *testing.T) {
+	stdout := setupServerAndStdout(t, newSuccessHandler(t))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "show", "269", "--format", "template", "--template", "{{.Counter}} {{.Environment}} {{.MainError}}")
+	if got := stdout.String(); got != "269 production ABORTED\n" {
+		t.Fatalf("unexpected show output: %q", got)
+	}
+
+	for _, args := range [][]string{
+		{"show", "269", "--format", "template"},
+		{"show", "269", "--template", "{{.Counter}}"},
+		{"show", "269", "--format", "template", "--template", "{{.Counter"},
+	} {
+		cmd := NewRootCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "template") {
+			t.Fatalf("%v: expected a template error, got %v", args, err)
+		}
+	}
+}
+
+func TestTemplateFormatPerIssue(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":4,"title":"busy","status":"active","last_occurrence_timestamp":1700000000},{"id":2,"counter":5,"title":"idle","status":"active"}]}}`)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--format", "template", "--template", `{{.Counter}} {{.Title}} {{formatTime .LastOccurrenceTimestamp "2006-01-02"}}`)
+	if got := stdout.String(); got != "4 busy 2023-11-14\n5 idle unknown\n" {
+		t.Fatalf("unexpected list output: %q", got)
+	}
+}
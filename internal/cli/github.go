@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/integrations/github"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const warningNoteFailed = "note_failed"
+
+var githubTokenEnvs = []string{"GITHUB_TOKEN", "GH_TOKEN"}
+
+type githubCreateOptions struct {
+	repo   string
+	apiURL string
+	labels []string
+	noNote bool
+	dryRun bool
+}
+
+func newGitHubCmd(flags *rootFlags) *cobra.Command {
+	githubCmd := &cobra.Command{
+		Use:   "github",
+		Short: "File Rollbar issues on GitHub",
+	}
+	githubCmd.AddCommand(newGitHubCreateCmd(flags))
+
+	return githubCmd
+}
+
+func newGitHubCreateCmd(flags *rootFlags) *cobra.Command {
+	options := githubCreateOptions{}
+	match := ""
+	createCmd := &cobra.Command{
+		Use:   "create <item-counter>",
+		Short: "Create a GitHub issue from a Rollbar issue and note its URL on the Rollbar item",
+		Long: "Create a GitHub issue titled after the Rollbar issue, with its main error, stack trace, link, and\n" +
+			"occurrence count, then add the new issue's URL as a comment on the Rollbar item.\n" +
+			"The GitHub token is read from GITHUB_TOKEN or GH_TOKEN.",
+		Args: itemArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			counter, err := resolveItemArg(cmd.Context(), *flags, args, match)
+			if err != nil {
+				return err
+			}
+			return runGitHubCreate(cmd.Context(), *flags, counter, options)
+		},
+	}
+	addMatchFlag(createCmd, &match)
+	createCmd.Flags().StringVar(&options.repo, "repo", "", "Repository to file the issue in, as owner/name")
+	createCmd.Flags().StringVar(&options.apiURL, "api-url", github.DefaultBaseURL, "GitHub API base URL, e.g. https://github.example.com/api/v3 for GitHub Enterprise")
+	createCmd.Flags().StringArrayVar(&options.labels, "label", nil, "Label to add to the GitHub issue; repeatable")
+	createCmd.Flags().BoolVar(&options.noNote, "no-note", false, "Do not comment the GitHub issue URL on the Rollbar item")
+	createCmd.Flags().BoolVar(&options.dryRun, "dry-run", false, "Print the issue title and body without creating anything")
+	_ = createCmd.MarkFlagRequired("repo")
+
+	return createCmd
+}
+
+func runGitHubCreate(parent context.Context, flags rootFlags, counter domain.ItemCounter, options githubCreateOptions) error {
+	repo, err := github.ParseRepo(options.repo)
+	if err != nil {
+		return err
+	}
+	if err := validateOutboundURL("--api-url", options.apiURL); err != nil {
+		return err
+	}
+	githubToken := githubToken()
+	if !options.dryRun {
+		if githubToken == "" {
+			return errors.New("set GITHUB_TOKEN or GH_TOKEN to a token that can create issues in " + repo.String())
+		}
+		if err := confirmPrompt(flags, fmt.Sprintf("Create a GitHub issue in %s for issue %s?", repo.String(), counter.String())); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	detail, token, err := runServiceOperation(parent, flags, "Loading issue detail", func(service *app.Service) (app.IssueDetail, error) {
+		return service.Show(ctx, counter)
+	})
+	if err != nil {
+		return err
+	}
+	rememberLast(token, counter)
+	issue := github.NewIssue{
+		Title:  redact.String(output.GitHubIssueTitle(detail), token),
+		Body:   redact.String(output.RenderGitHubIssueBody(detail, projectIssueURL(ctx, flags, detail), time.Now()), token),
+		Labels: options.labels,
+	}
+	if options.dryRun {
+		human := issue.Title + "\n\n" + issue.Body
+		return printOutput(flags.Format, strings.TrimRight(human, "\n"), map[string]any{"repo": repo.String(), "issue": issue})
+	}
+
+	created, err := github.NewWithBaseURL(options.apiURL, githubToken).WithProxy(rollbar.DefaultProxy).CreateIssue(ctx, repo, issue)
+	if err != nil {
+		return err
+	}
+	noted := !options.noNote && noteGitHubIssue(ctx, flags, detail.ItemID, created.HTMLURL)
+
+	human := fmt.Sprintf("created %s", terminalLink(created.HTMLURL))
+	if noted {
+		human += fmt.Sprintf("; noted on Rollbar issue %s", counter.String())
+	}
+	payload := map[string]any{
+		"repo":         repo.String(),
+		"github_issue": map[string]any{"number": created.Number, "url": created.HTMLURL},
+		"noted":        noted,
+	}
+
+	return printOutput(flags.Format, human, payload)
+}
+
+func noteGitHubIssue(ctx context.Context, flags rootFlags, itemID domain.ItemID, link string) bool {
+	_, _, err := runServiceOperation(ctx, flags, "", func(service *app.Service) (struct{}, error) {
+		return struct{}{}, service.AddItemNote(ctx, itemID, "GitHub issue: "+link)
+	})
+	if err != nil {
+		addWarnings(app.Warning{Code: warningNoteFailed, Message: "GitHub issue created but not noted on the Rollbar item: " + err.Error()})
+		return false
+	}
+
+	return true
+}
+
+func githubToken() string {
+	for _, name := range githubTokenEnvs {
+		if token := strings.TrimSpace(os.Getenv(name)); token != "" {
+			return token
+		}
+	}
+
+	return ""
+}
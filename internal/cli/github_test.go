@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/integrations/github"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestGitHubCreateCommand(t *testing.T) {
+	var notes []string
+	success := newSuccessHandler(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/project/766510":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":766510,"name":"api","account_id":7,"account_slug":"acme"}}`)
+		case "/api/1/item/1755568172/comments":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode comment: %v", err)
+			}
+			notes = append(notes, body["text"])
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{}}`)
+		default:
+			success.ServeHTTP(w, r)
+		}
+	}))
+	setNoConfigStore(t)
+	setupMetadataCache(t)
+
+	var created github.NewIssue
+	githubServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/acme/api/issues" || r.Header.Get("Authorization") != "Bearer gh-token" {
+			t.Fatalf("unexpected github request: %s %v", r.URL.Path, r.Header)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Fatalf("decode issue: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"number":42,"html_url":"https://github.com/acme/api/issues/42"}`)
+	}))
+	t.Cleanup(githubServer.Close)
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("GH_TOKEN", "gh-token")
+
+	runRootCommand(t, "github", "create", "269", "--repo", "acme/api", "--api-url", githubServer.URL, "--label", "rollbar", "--yes")
+	if created.Title != "[Rollbar #269] RST_STREAM" || len(created.Labels) != 1 {
+		t.Fatalf("created issue = %+v", created)
+	}
+	for _, want := range []string{"https://app.rollbar.com/a/acme/fix/item/api/269", "| Occurrences | 7 |", "ABORTED"} {
+		if !strings.Contains(created.Body, want) {
+			t.Fatalf("expected %q in issue body, got %q", want, created.Body)
+		}
+	}
+	if len(notes) != 1 || notes[0] != "GitHub issue: https://github.com/acme/api/issues/42" {
+		t.Fatalf("notes = %q", notes)
+	}
+	if !strings.Contains(stdout.String(), "created https://github.com/acme/api/issues/42; noted on Rollbar issue 269") {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+
+	stdout.Reset()
+	created = github.NewIssue{}
+	runRootCommand(t, "github", "create", "269", "--repo", "acme/api", "--dry-run", "--format", "json")
+	if created.Title != "" || len(notes) != 1 {
+		t.Fatalf("dry run created an issue or note")
+	}
+	if !strings.Contains(stdout.String(), `"title": "[Rollbar #269] RST_STREAM"`) {
+		t.Fatalf("unexpected dry-run output: %q", stdout.String())
+	}
+
+	t.Setenv("GH_TOKEN", "")
+	for _, args := range [][]string{
+		{"github", "create", "269", "--repo", "acme/api", "--yes"},
+		{"github", "create", "269", "--repo", "acme", "--yes"},
+		{"github", "create", "269", "--repo", "acme/api", "--api-url", "http://github.example.com", "--dry-run"},
+	} {
+		cmd := NewRootCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
+
+func TestGitHubCreateUsesProxy(t *testing.T) {
+	success := newSuccessHandler(t)
+	githubHosts := []string{}
+	server := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/acme/api/issues":
+			githubHosts = append(githubHosts, r.Host)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"number":42,"html_url":"https://github.com/acme/api/issues/42"}`)
+		case "/api/1/project/766510":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":766510,"name":"api","account_id":7,"account_slug":"acme"}}`)
+		default:
+			success.ServeHTTP(w, r)
+		}
+	})
+	setupServerAndStdout(t, server)
+	proxy := httptest.NewServer(server)
+	t.Cleanup(proxy.Close)
+	t.Cleanup(func() { rollbar.SetDefaultProxy(nil) })
+	setNoConfigStore(t)
+	setupMetadataCache(t)
+	t.Setenv("GITHUB_TOKEN", "gh-token")
+
+	runRootCommand(t, "github", "create", "269", "--repo", "acme/api", "--api-url", "http://127.0.0.1:1", "--no-note", "--yes", "--proxy", proxy.URL)
+	if len(githubHosts) != 1 || githubHosts[0] != "127.0.0.1:1" {
+		t.Fatalf("expected the GitHub request to go through --proxy, got %v", githubHosts)
+	}
+}
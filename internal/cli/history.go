@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+var newHistoryStore = config.NewHistoryStore
+
+type historyRecord struct {
+	Number  int       `json:"number"`
+	Time    time.Time `json:"time"`
+	Command string    `json:"command"`
+	Args    []string  `json:"args"`
+}
+
+func newHistoryCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history",
+		Short: "List previously executed commands",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHistory(*flags)
+		},
+	}
+}
+
+func newRerunCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rerun <n>",
+		Short: "Run a command from history again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRerun(cmd, args[0])
+		},
+	}
+}
+
+func runHistory(flags rootFlags) error {
+	records, err := loadHistory()
+	if err != nil {
+		return err
+	}
+	if flags.Limit > 0 && len(records) > flags.Limit {
+		records = records[len(records)-flags.Limit:]
+	}
+
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		lines = append(lines, fmt.Sprintf("%4d  %s  %s", record.Number, record.Time.UTC().Format(time.RFC3339), record.Command))
+	}
+	human := "no history recorded"
+	if len(lines) > 0 {
+		human = strings.Join(lines, "\n")
+	}
+
+	return printOutput(flags.Format, human, map[string]any{"history": records})
+}
+
+func runRerun(cmd *cobra.Command, value string) error {
+	number, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || number <= 0 {
+		return fmt.Errorf("invalid history number %q", value)
+	}
+
+	records, err := loadHistory()
+	if err != nil {
+		return err
+	}
+	index := slices.IndexFunc(records, func(record historyRecord) bool {
+		return record.Number == number
+	})
+	if index < 0 {
+		return fmt.Errorf("history entry %d not found; run `rollbaz history` to list entries", number)
+	}
+
+	record := records[index]
+	_, _ = fmt.Fprintf(stderrWriter, "rerunning: %s\n", record.Command)
+
+	root := NewRootCmd()
+	root.SetArgs(record.Args)
+	return root.ExecuteContext(cmd.Context())
+}
+
+func loadHistory() ([]historyRecord, error) {
+	store, err := newHistoryStore()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]historyRecord, 0, len(entries))
+	for _, entry := range entries {
+		records = append(records, historyRecord{
+			Number:  entry.ID,
+			Time:    entry.Time,
+			Command: formatCommandLine(entry.Args),
+			Args:    entry.Args,
+		})
+	}
+
+	return records, nil
+}
+
+func recordHistory(args []string, now time.Time) {
+	if len(args) > 0 && (args[0] == "history" || args[0] == "rerun") {
+		return
+	}
+
+	store, err := newHistoryStore()
+	if err != nil {
+		return
+	}
+	_ = store.Append(config.HistoryEntry{Time: now.UTC(), Args: scrubHistoryArgs(args)})
+}
+
+// --yes is dropped with the secrets so a rerun write asks again.
+func scrubHistoryArgs(args []string) []string {
+	scrubbed := make([]string, 0, len(args))
+	for index := 0; index < len(args); index++ {
+		arg := args[index]
+		if arg == "--token" || (arg == "--proxy" && index+1 < len(args) && proxyHasCredentials(args[index+1])) {
+			index++
+			continue
+		}
+		if arg == "--yes" || strings.HasPrefix(arg, "--token=") || strings.HasPrefix(arg, "--yes=") {
+			continue
+		}
+		if value, ok := strings.CutPrefix(arg, "--proxy="); ok && proxyHasCredentials(value) {
+			continue
+		}
+		scrubbed = append(scrubbed, arg)
+	}
+
+	return scrubbed
+}
+
+// An unparsable proxy cannot be checked, so it counts as secret.
+func proxyHasCredentials(value string) bool {
+	proxyURL, err := url.Parse(strings.TrimSpace(value))
+
+	return err != nil || proxyURL.User != nil
+}
+
+func formatCommandLine(args []string) string {
+	parts := []string{"rollbaz"}
+	for _, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\"'") {
+			arg = strconv.Quote(arg)
+		}
+		parts = append(parts, arg)
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestRecentHumanVerticalFormat(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":5,"title":"stacked","status":"active","environment":"production"}]}}`)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--format", "human-vertical")
+
+	got := stdout.String()
+	if !strings.Contains(got, "COUNTER:     5\n") || !strings.Contains(got, "TITLE:       stacked") || strings.Contains(got, "│") {
+		t.Fatalf("expected vertical output, got %q", got)
+	}
+}
+
+func TestUseVerticalLayout(t *testing.T) {
+	originalStdout, originalIsTerminal, originalGetSize := stdoutWriter, isTerminal, getTerminalSize
+	t.Cleanup(func() {
+		stdoutWriter, isTerminal, getTerminalSize = originalStdout, originalIsTerminal, originalGetSize
+	})
+
+	setupStdout(t)
+	if useVerticalLayout("human") || !useVerticalLayout("human-vertical") || useVerticalLayout("json") {
+		t.Fatalf("unexpected layout selection without a terminal")
+	}
+
+	stdoutWriter = os.Stdout
+	isTerminal = func(int) bool { return true }
+	getTerminalSize = func(int) (int, int, error) { return 72, 40, nil }
+	if !useVerticalLayout("human") {
+		t.Fatalf("expected vertical layout for narrow terminal")
+	}
+
+	getTerminalSize = func(int) (int, int, error) { return 120, 40, nil }
+	if useVerticalLayout("human") {
+		t.Fatalf("expected table layout for wide terminal")
+	}
+}
+
+func TestRecentPlainOutput(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":5,"title":"piped","status":"active","environment":"production"}]}}`)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--plain", "--columns", "counter,env,title")
+
+	if got := stdout.String(); got != "COUNTER\tENV\tTITLE\n5\tproduction\tpiped\n" {
+		t.Fatalf("unexpected plain output: %q", got)
+	}
+}
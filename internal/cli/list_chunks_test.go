@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newPagedItemsHandler(t *testing.T, pages ...int) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		_, _ = fmt.Sscanf(r.URL.Query().Get("page"), "%d", &page)
+		items := make([]string, 0)
+		if page <= len(pages) {
+			for index := range pages[page-1] {
+				counter := page*100 + index
+				items = append(items, fmt.Sprintf(`{"id":%d,"counter":%d,"title":"issue %d","status":"active","last_occurrence_timestamp":%d}`, counter, counter, counter, 1_700_000_000-counter))
+			}
+		}
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[%s]}}`, strings.Join(items, ","))
+	})
+}
+
+func TestRecentAllRendersInChunks(t *testing.T) {
+	stdout := setupServerAndStdout(t, newPagedItemsHandler(t, 3, 2))
+	setNoConfigStore(t)
+	previous := listRenderChunkSize
+	listRenderChunkSize = 2
+	t.Cleanup(func() { listRenderChunkSize = previous })
+
+	runRootCommand(t, "recent", "--all", "--limit", "1")
+	if got := stdout.String(); strings.Count(got, "COUNTER") != 3 || !strings.Contains(got, "issue 201") {
+		t.Fatalf("expected three chunked tables covering every page, got %q", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--all", "--plain", "--columns", "counter,title")
+	got := stdout.String()
+	if strings.Count(got, "COUNTER") != 1 || strings.Count(got, "\n") != 6 {
+		t.Fatalf("expected one header and five plain rows, got %q", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--all", "--format", "human-vertical")
+	if got := stdout.String(); strings.Count(got, "TITLE:") != 5 || strings.Contains(got, "\n\n\n") {
+		t.Fatalf("unexpected vertical chunks: %q", got)
+	}
+}
+
+func TestRecentPageAndMaxPages(t *testing.T) {
+	stdout := setupServerAndStdout(t, newPagedItemsHandler(t, 100, 100, 2))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--plain", "--columns", "counter", "--limit", "2", "--page", "3")
+	if got := stdout.String(); got != "COUNTER\n104\n105\n" {
+		t.Fatalf("expected the third page of two issues, got %q", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--all", "--plain", "--columns", "counter", "--max-pages", "1")
+	if got := stdout.String(); strings.Count(got, "\n") != 101 || strings.Contains(got, "200") {
+		t.Fatalf("expected only the first page, got %d lines", strings.Count(got, "\n"))
+	}
+
+	for _, args := range [][]string{
+		{"recent", "--page", "0"},
+		{"active", "--page", "2", "--all"},
+		{"recent", "--max-pages", "none"},
+	} {
+		cmd := NewRootCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected %v to fail", args)
+		}
+	}
+}
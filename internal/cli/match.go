@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+var itemArgs = cobra.MaximumNArgs(1)
+
+func addMatchFlag(cmd *cobra.Command, match *string) {
+	cmd.Flags().StringVar(match, "match", "", "Act on the one issue whose title contains every word of this text")
+}
+
+func resolveItemArg(parent context.Context, flags rootFlags, args []string, match string) (domain.ItemCounter, error) {
+	match = strings.TrimSpace(match)
+	switch {
+	case len(args) == 1 && match != "":
+		return 0, errors.New("pass an item counter or --match, not both")
+	case len(args) == 1 && isContextReference(args[0]):
+		return resolveContextReference(flags, args[0])
+	case len(args) == 1:
+		return domain.ParseItemReference(args[0])
+	case match == "":
+		return 0, errors.New("an item counter or --match is required")
+	}
+
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	issue, _, err := runServiceOperation(parent, flags, "Finding issue", func(service *app.Service) (app.IssueSummary, error) {
+		return service.FindIssueByTitle(ctx, match)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return issue.Counter, nil
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newMatchHandler(t *testing.T, capturedPatch *rollbar.ItemPatch) http.Handler {
+	t.Helper()
+	action := newActionSuccessHandler(t, capturedPatch)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/items" {
+			if r.URL.Query().Has("status") {
+				t.Fatalf("expected --match to search every status, got %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+				{"id":1755568172,"counter":269,"title":"ECONNRESET to payments","status":"active"},
+				{"id":2,"counter":270,"title":"ECONNRESET to search","status":"active"}
+			]}}`)
+			return
+		}
+		action.ServeHTTP(w, r)
+	})
+}
+
+func TestResolveCommandByMatch(t *testing.T) {
+	setNoConfigStore(t)
+	var patchPayload rollbar.ItemPatch
+	stdout := setupServerAndStdout(t, newMatchHandler(t, &patchPayload))
+
+	runRootCommand(t, "resolve", "--match", "econnreset PAYMENTS", "--yes")
+	if patchPayload.Status != "resolved" || !strings.Contains(stdout.String(), "resolved issue 269") {
+		t.Fatalf("unexpected resolve: %+v %q", patchPayload, stdout.String())
+	}
+}
+
+func TestMatchFlagErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "ambiguous", args: []string{"mute", "--match", "econnreset", "--yes"}, wantErr: "#270  active  ECONNRESET to search"},
+		{name: "no match", args: []string{"reopen", "--match", "deadlock", "--yes"}, wantErr: `no issue title matches "deadlock"`},
+		{name: "both", args: []string{"show", "269", "--match", "payments"}, wantErr: "not both"},
+		{name: "neither", args: []string{"show"}, wantErr: "item counter or --match is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setNoConfigStore(t)
+			setupServerAndStdout(t, newMatchHandler(t, nil))
+
+			cmd := NewRootCmd()
+			cmd.SetArgs(tt.args)
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected %q error, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
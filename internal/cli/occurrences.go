@@ -0,0 +1,232 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/progress"
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+var newDumpDirStore = config.NewDumpDirStore
+
+type dumpOptions struct {
+	last int
+	dir  string
+}
+
+type occurrenceListOptions struct {
+	page    int
+	perPage int
+}
+
+func newOccurrencesCmd(flags *rootFlags) *cobra.Command {
+	options := occurrenceListOptions{}
+	occurrencesCmd := &cobra.Command{
+		Use:     "occurrences <item-counter>",
+		Aliases: []string{"occurrence"},
+		Short:   "List occurrences of an issue, newest first, a page at a time",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			counter, err := resolveItemArg(cmd.Context(), *flags, args, "")
+			if err != nil {
+				return err
+			}
+			return runOccurrencesList(cmd.Context(), *flags, counter, options)
+		},
+	}
+	occurrencesCmd.Flags().IntVar(&options.page, "page", 1, "Page of occurrences to show, 1 being the newest")
+	occurrencesCmd.Flags().IntVar(&options.perPage, "per-page", 20, "Occurrences per page (at most 100)")
+	occurrencesCmd.AddCommand(newOccurrencesDumpCmd(flags))
+	occurrencesCmd.AddCommand(newOccurrencesDiffCmd(flags))
+
+	return occurrencesCmd
+}
+
+func runOccurrencesList(parent context.Context, flags rootFlags, counter domain.ItemCounter, options occurrenceListOptions) error {
+	ctx, cancel := commandContext(parent, flags, 20*time.Second)
+	defer cancel()
+
+	page, token, err := runServiceOperation(parent, flags, "Loading occurrences", func(service *app.Service) (app.OccurrencePage, error) {
+		return service.ListOccurrences(ctx, counter, options.page, options.perPage)
+	})
+	if err != nil {
+		return err
+	}
+	rememberLast(token, counter)
+	if page, err = anonymized(flags, page); err != nil {
+		return err
+	}
+
+	human := redact.String(output.RenderOccurrencePageWithWidth(page, terminalRenderWidth()), token)
+	return printOutput(flags.Format, human, redact.Value(page, token))
+}
+
+func newOccurrencesDumpCmd(flags *rootFlags) *cobra.Command {
+	options := dumpOptions{}
+	dumpCmd := &cobra.Command{
+		Use:   "dump <item-counter>",
+		Short: "Write recent occurrence payloads to one JSON file each",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			counter, err := domain.ParseItemReference(args[0])
+			if err != nil {
+				return err
+			}
+			return runOccurrencesDump(cmd.Context(), *flags, counter, options)
+		},
+	}
+	dumpCmd.Flags().IntVar(&options.last, "last", 50, "Number of most recent occurrences to download")
+	dumpCmd.Flags().StringVar(&options.dir, "dir", ".", "Directory to write <occurrence-id>.json files into")
+
+	return dumpCmd
+}
+
+func runOccurrencesDump(parent context.Context, flags rootFlags, counter domain.ItemCounter, options dumpOptions) error {
+	if options.last <= 0 {
+		return errors.New("--last must be positive")
+	}
+	ctx, cancel := commandContext(parent, flags, 2*time.Minute)
+	defer cancel()
+
+	if err := os.MkdirAll(options.dir, 0o700); err != nil {
+		return fmt.Errorf("create dump directory: %w", err)
+	}
+
+	occurrences, token, err := runServiceOperation(parent, flags, "", func(service *app.Service) ([]rollbar.ItemInstance, error) {
+		return runWithCountProgress(flags.Format, "Downloading occurrences", int64(options.last), func(advance func(int64)) ([]rollbar.ItemInstance, error) {
+			return service.RecentOccurrences(ctx, counter, options.last, func(count int) { advance(int64(count)) })
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	files, err := writeOccurrenceFiles(options.dir, occurrences, token)
+	if err != nil {
+		return err
+	}
+	recordDumpDir(options.dir)
+
+	human := fmt.Sprintf("wrote %d occurrences of issue %s to %s", len(files), counter.String(), options.dir)
+	return printOutput(flags.Format, human, map[string]any{"dir": options.dir, "files": files})
+}
+
+func recordDumpDir(dir string) {
+	store, err := newDumpDirStore()
+	if err == nil {
+		err = store.Record(dir)
+	}
+	if err != nil {
+		addWarnings(app.Warning{Code: warningDumpDirUntracked, Message: "retention will not prune " + dir + ": " + err.Error()})
+	}
+}
+
+func newOccurrencesDiffCmd(flags *rootFlags) *cobra.Command {
+	all := false
+	diffCmd := &cobra.Command{
+		Use:   "diff <occurrence-id> <occurrence-id>",
+		Short: "Compare two occurrence payloads field by field",
+		Long: "Compare two occurrence payloads field by field, listing request, code version, and host\n" +
+			"changes first, to tell whether two occurrences are the same failure mode.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := make([]domain.OccurrenceID, 0, len(args))
+			for _, arg := range args {
+				id, err := domain.ParseOccurrenceID(arg)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+			return runOccurrencesDiff(cmd.Context(), *flags, ids[0], ids[1], all)
+		},
+	}
+	diffCmd.Flags().BoolVar(&all, "all", false, "Also compare the timestamp, uuid, and metadata fields")
+
+	return diffCmd
+}
+
+func runOccurrencesDiff(parent context.Context, flags rootFlags, left domain.OccurrenceID, right domain.OccurrenceID, all bool) error {
+	ctx, cancel := commandContext(parent, flags, 20*time.Second)
+	defer cancel()
+
+	occurrences, token, err := runServiceOperation(parent, flags, "Loading occurrences", func(service *app.Service) ([]rollbar.ItemInstance, error) {
+		return service.GetOccurrences(ctx, left, right)
+	})
+	if err != nil {
+		return err
+	}
+	if occurrences, err = anonymized(flags, occurrences); err != nil {
+		return err
+	}
+	for index := range occurrences {
+		occurrences[index].Data = redact.RawJSON(occurrences[index].Data, token)
+	}
+
+	diff, err := app.DiffOccurrences(occurrences[0], occurrences[1], all)
+	if err != nil {
+		return err
+	}
+
+	return printOutput(flags.Format, redact.String(output.RenderOccurrenceDiff(diff), token), redact.Value(diff, token))
+}
+
+func writeOccurrenceFiles(dir string, occurrences []rollbar.ItemInstance, token string) ([]string, error) {
+	files := make([]string, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		var body bytes.Buffer
+		if err := json.Indent(&body, redact.RawJSON(occurrence.Raw, token), "", "  "); err != nil {
+			return nil, fmt.Errorf("format occurrence %d: %w", occurrence.ID, err)
+		}
+		body.WriteByte('\n')
+
+		path := filepath.Join(dir, occurrence.ID.String()+".json")
+		if err := os.WriteFile(path, body.Bytes(), 0o600); err != nil {
+			return nil, fmt.Errorf("write occurrence %d: %w", occurrence.ID, err)
+		}
+		files = append(files, path)
+	}
+
+	return files, nil
+}
+
+func runWithCountProgress[T any](format string, message string, total int64, operation func(advance func(int64)) (T, error)) (T, error) {
+	if !shouldRenderProgress(format) {
+		return operation(func(int64) {})
+	}
+
+	writer := newProgressWriter()
+	writer.Style().Visibility.Value = true
+	tracker := progress.Tracker{Message: message, Total: total, Units: progress.UnitsDefault}
+	writer.AppendTracker(&tracker)
+
+	done := make(chan struct{})
+	go func() {
+		writer.Render()
+		close(done)
+	}()
+
+	result, err := operation(tracker.Increment)
+	if err != nil {
+		tracker.MarkAsErrored()
+	} else {
+		tracker.MarkAsDone()
+	}
+	waitForProgressStop(done)
+
+	return result, err
+}
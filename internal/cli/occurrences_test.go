@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+func newOccurrencesHandler(t *testing.T) http.Handler {
+	t.Helper()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/item_by_counter/269":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"itemId":1755568172}}`)
+		case "/api/1/item/1755568172/instances":
+			if got := r.URL.Query().Get("per_page"); got != "2" {
+				t.Fatalf("per_page = %q", got)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[`+
+				`{"id":11,"data":{"request":{"headers":{"Authorization":"Bearer token"}},"body":"a"}},`+
+				`{"id":12,"data":{"body":"b"}}]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+}
+
+func setupDumpDirStore(t *testing.T) *config.DumpDirStore {
+	t.Helper()
+	store := config.NewDumpDirStoreAtPath(filepath.Join(t.TempDir(), "dump-dirs.json"))
+	original := newDumpDirStore
+	newDumpDirStore = func() (*config.DumpDirStore, error) {
+		return store, nil
+	}
+	t.Cleanup(func() {
+		newDumpDirStore = original
+	})
+
+	return store
+}
+
+func TestOccurrencesDumpWritesFiles(t *testing.T) {
+	stdout := setupServerAndStdout(t, newOccurrencesHandler(t))
+	setNoConfigStore(t)
+	dumpDirs := setupDumpDirStore(t)
+	dir := filepath.Join(t.TempDir(), "payloads")
+
+	runRootCommand(t, "occurrences", "dump", "269", "--last", "2", "--dir", dir)
+
+	if got := stdout.String(); !strings.Contains(got, "wrote 2 occurrences of issue 269 to "+dir) {
+		t.Fatalf("unexpected output: %q", got)
+	}
+	body, err := os.ReadFile(filepath.Join(dir, "11.json"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if strings.Contains(string(body), "Bearer token") || !strings.Contains(string(body), "[REDACTED]") {
+		t.Fatalf("expected redacted payload, got %s", body)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "12.json")); err != nil {
+		t.Fatalf("Stat(12.json) error = %v", err)
+	}
+	if recorded, err := dumpDirs.Load(); err != nil || len(recorded) != 1 || recorded[0] != dir {
+		t.Fatalf("expected %s recorded for retention, got %v, err=%v", dir, recorded, err)
+	}
+}
+
+func TestOccurrencesDumpJSON(t *testing.T) {
+	stdout := setupServerAndStdout(t, newOccurrencesHandler(t))
+	setNoConfigStore(t)
+	setupDumpDirStore(t)
+	dir := t.TempDir()
+
+	runRootCommand(t, "--format", "json", "occurrences", "dump", "269", "--last", "2", "--dir", dir)
+
+	var payload struct {
+		Dir   string   `json:"dir"`
+		Files []string `json:"files"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("Unmarshal() error = %v, output=%s", err, stdout.String())
+	}
+	if payload.Dir != dir || len(payload.Files) != 2 || payload.Files[0] != filepath.Join(dir, "11.json") {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestOccurrencesDumpErrors(t *testing.T) {
+	setupServerAndStdout(t, newOccurrencesHandler(t))
+	setNoConfigStore(t)
+	setupDumpDirStore(t)
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "non positive last", args: []string{"occurrences", "dump", "269", "--last", "0"}},
+		{name: "bad counter", args: []string{"occurrences", "dump", "abc"}},
+		{name: "dir is a file", args: []string{"occurrences", "dump", "269", "--last", "2", "--dir", blocker}},
+	}
+
+	for _, tc := range tests {
+		cmd := NewRootCmd()
+		cmd.SetArgs(tc.args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("%s: expected error", tc.name)
+		}
+	}
+}
+
+func TestOccurrencesDumpWarnsWhenDirNotRecorded(t *testing.T) {
+	setupServerAndStdout(t, newOccurrencesHandler(t))
+	setNoConfigStore(t)
+	broken := filepath.Join(t.TempDir(), "dump-dirs.json")
+	if err := os.WriteFile(broken, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	original := newDumpDirStore
+	newDumpDirStore = func() (*config.DumpDirStore, error) { return config.NewDumpDirStoreAtPath(broken), nil }
+	t.Cleanup(func() { newDumpDirStore = original })
+	stderr := setupStderr(t)
+
+	runRootCommand(t, "occurrences", "dump", "269", "--last", "2", "--dir", t.TempDir())
+
+	if !strings.Contains(stderr.String(), "retention will not prune") {
+		t.Fatalf("expected untracked dump dir warning, got %q", stderr.String())
+	}
+}
+
+func TestRunWithCountProgress(t *testing.T) {
+	setupFakeTerminal(t, "")
+	t.Setenv("CI", "")
+
+	value, err := runWithCountProgress("human", "counting", 3, func(advance func(int64)) (int, error) {
+		advance(3)
+		return 3, nil
+	})
+	if err != nil || value != 3 {
+		t.Fatalf("runWithCountProgress() = %d, err=%v", value, err)
+	}
+
+	if _, err := runWithCountProgress("human", "failing", 1, func(func(int64)) (int, error) {
+		return 0, fmt.Errorf("boom")
+	}); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestOccurrencesListsPage(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/item_by_counter/269":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"itemId":1755568172}}`)
+		case "/api/1/item/1755568172/instances":
+			if got := r.URL.RawQuery; got != "page=2&per_page=2" {
+				t.Fatalf("unexpected query: %q", got)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"instances":[`+
+				`{"id":21,"timestamp":1700000000,"data":{"environment":"production","code_version":"v2","message":{"body":"payment timeout"}}},`+
+				`{"id":20,"timestamp":1699990000,"data":{"message":{"body":"payment timeout"}}}]}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "occurrences", "269", "--page", "2", "--per-page", "2")
+	for _, want := range []string{"OCCURRENCE_ID", "21", "2023-11-14T22:13:20Z", "production", "v2", "payment timeout", "older occurrences with --page 3"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout.String())
+		}
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "occurrences", "#269", "--page", "2", "--per-page", "2", "--format", "json")
+	var payload struct {
+		Page        int  `json:"page"`
+		HasMore     bool `json:"has_more"`
+		Occurrences []struct {
+			ID        uint64 `json:"id"`
+			Timestamp uint64 `json:"timestamp"`
+		} `json:"occurrences"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("unmarshal: %v (%s)", err, stdout.String())
+	}
+	if payload.Page != 2 || !payload.HasMore || len(payload.Occurrences) != 2 || payload.Occurrences[1].ID != 20 || payload.Occurrences[1].Timestamp != 1699990000 {
+		t.Fatalf("unexpected payload: %+v", payload)
+	}
+}
+
+func TestOccurrencesDiff(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/instance/11":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":11,"data":{"uuid":"a","server":{"host":"web-1"},"request":{"headers":{"Authorization":"Bearer one"}},"message":{"body":"timeout"}}}}`)
+		case "/api/1/instance/12":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":12,"data":{"uuid":"b","server":{"host":"web-2"},"request":{"headers":{"Authorization":"Bearer two"}},"message":{"body":"timeout"}}}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "occurrence", "diff", "11", "12")
+	out := stdout.String()
+	for _, want := range []string{"Main error: same in both: timeout", `~ server.host: "web-1" -> "web-2"`, "Ignored timestamp, uuid, metadata"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output, got %q", want, out)
+		}
+	}
+	if strings.Contains(out, "Bearer") {
+		t.Fatalf("expected credentials to be redacted, got %q", out)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "occurrences", "diff", "11", "12", "--all", "--format", "json")
+	var payload struct {
+		Changes []struct {
+			Path string `json:"path"`
+		} `json:"changes"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("decode diff: %v\n%s", err, stdout.String())
+	}
+	if len(payload.Changes) != 2 || payload.Changes[1].Path != "uuid" {
+		t.Fatalf("unexpected diff payload: %s", stdout.String())
+	}
+}
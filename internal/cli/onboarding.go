@@ -0,0 +1,61 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+const accessTokenDocsURL = "https://docs.rollbar.com/docs/access-tokens"
+
+var (
+	errNoToken = errors.New("no token available: add a project via `rollbaz project add ...` or set ROLLBAR_ACCESS_TOKEN")
+	openURL    = openBrowser
+)
+
+func shouldOnboard(flags rootFlags, err error) bool {
+	return errors.Is(err, errNoToken) && isHumanFormat(flags.Format) && canPromptConfirmation()
+}
+
+func runOnboarding(ctx context.Context) error {
+	_, _ = fmt.Fprintln(stdoutWriter, "No Rollbar project is configured yet. Let's set one up.")
+	_, _ = fmt.Fprint(stdoutWriter, "Open the Rollbar access token guide in your browser? [Y/n]: ")
+
+	reader := bufio.NewReader(stdinReader)
+	line, err := reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("read input: %w", err)
+	}
+
+	answer := strings.TrimSpace(strings.ToLower(line))
+	if answer == "" || answer == "y" || answer == "yes" {
+		if err := openURL(accessTokenDocsURL); err != nil {
+			_, _ = fmt.Fprintf(stdoutWriter, "could not open browser; visit %s\n", terminalLink(accessTokenDocsURL))
+		}
+	}
+
+	return runProjectWizardFrom(ctx, reader, nil)
+}
+
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("open browser: %w", err)
+	}
+
+	return nil
+}
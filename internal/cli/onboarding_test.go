@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func tempFileWithContent(t *testing.T, content string) *os.File {
+	t.Helper()
+	file, err := os.CreateTemp(t.TempDir(), "tty")
+	if err != nil {
+		t.Fatalf("CreateTemp() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = file.Close()
+	})
+	if _, err := file.WriteString(content); err != nil {
+		t.Fatalf("WriteString() error = %v", err)
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		t.Fatalf("Seek() error = %v", err)
+	}
+
+	return file
+}
+
+func setupFakeTerminal(t *testing.T, input string) *os.File {
+	t.Helper()
+	originalStdout, originalStdin, originalIsTerminal := stdoutWriter, stdinReader, isTerminal
+	originalReadPassword, originalOpenURL := readPassword, openURL
+	t.Cleanup(func() {
+		stdoutWriter, stdinReader, isTerminal = originalStdout, originalStdin, originalIsTerminal
+		readPassword, openURL = originalReadPassword, originalOpenURL
+	})
+
+	stdout := tempFileWithContent(t, "")
+	stdoutWriter = stdout
+	stdinReader = tempFileWithContent(t, input)
+	isTerminal = func(int) bool { return true }
+	t.Setenv("CI", "1")
+
+	return stdout
+}
+
+func TestRunServiceOperationOnboardsWithoutToken(t *testing.T) {
+	server := httptest.NewServer(newTokenCheckingHandler("good"))
+	t.Cleanup(server.Close)
+	store := setupProjectStore(t)
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "")
+	stdout := setupFakeTerminal(t, "y\nfigure\n"+server.URL+"/api/1\n\n")
+	readPassword = func(int) ([]byte, error) { return []byte("good"), nil }
+	opened := ""
+	openURL = func(url string) error {
+		opened = url
+		return nil
+	}
+
+	count, _, err := runServiceOperation(context.Background(), rootFlags{Format: "human"}, "Loading", func(service *app.Service) (int, error) {
+		issues, err := service.Recent(context.Background(), 5, app.IssueFilters{})
+		return len(issues), err
+	})
+	if err != nil || count != 0 {
+		t.Fatalf("runServiceOperation() = %d, %v", count, err)
+	}
+	if opened != accessTokenDocsURL {
+		t.Fatalf("expected token guide to open, got %q", opened)
+	}
+	if _, err := store.ResolveProject("figure"); err != nil {
+		t.Fatalf("expected onboarded project to be saved: %v", err)
+	}
+
+	written, err := os.ReadFile(stdout.Name())
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(written), "No Rollbar project is configured yet") {
+		t.Fatalf("unexpected onboarding output: %q", written)
+	}
+}
+
+func TestRunOnboardingUsesCallerContext(t *testing.T) {
+	server := httptest.NewServer(newTokenCheckingHandler("good"))
+	t.Cleanup(server.Close)
+	store := setupProjectStore(t)
+	setupFakeTerminal(t, "n\nfigure\n"+server.URL+"/api/1\n\n")
+	readPassword = func(int) ([]byte, error) { return []byte("good"), nil }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := runOnboarding(ctx); err == nil || !strings.Contains(err.Error(), context.Canceled.Error()) {
+		t.Fatalf("expected the canceled context to stop onboarding, got %v", err)
+	}
+	if _, err := store.ResolveProject("figure"); err == nil {
+		t.Fatalf("expected no project to be saved")
+	}
+}
+
+func TestRunOnboardingReportsBrowserFailure(t *testing.T) {
+	setupProjectStore(t)
+	stdout := setupStdout(t)
+	originalOpenURL := openURL
+	t.Cleanup(func() {
+		openURL = originalOpenURL
+	})
+	openURL = func(string) error { return errors.New("no browser") }
+	setupStdin(t, "\n")
+
+	if err := runOnboarding(context.Background()); err == nil {
+		t.Fatalf("expected missing project name error")
+	}
+	if !strings.Contains(stdout.String(), "visit "+accessTokenDocsURL) {
+		t.Fatalf("expected manual link, got %q", stdout.String())
+	}
+}
+
+func TestShouldOnboard(t *testing.T) {
+	setupStdout(t)
+
+	if shouldOnboard(rootFlags{Format: "human"}, errNoToken) {
+		t.Fatalf("expected no onboarding without a terminal")
+	}
+
+	setupFakeTerminal(t, "")
+	if shouldOnboard(rootFlags{Format: "json"}, errNoToken) {
+		t.Fatalf("expected no onboarding for json output")
+	}
+	if shouldOnboard(rootFlags{Format: "human"}, errors.New("other")) {
+		t.Fatalf("expected no onboarding for unrelated errors")
+	}
+	if !shouldOnboard(rootFlags{Format: "human"}, errNoToken) {
+		t.Fatalf("expected onboarding for interactive human output")
+	}
+}
+
+func TestOpenBrowserMissingCommand(t *testing.T) {
+	t.Setenv("PATH", filepath.Join(t.TempDir(), "empty"))
+	if err := openBrowser(accessTokenDocsURL); err == nil {
+		t.Fatalf("expected error without a browser command")
+	}
+}
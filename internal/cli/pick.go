@@ -0,0 +1,243 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+const occurrenceURLFormat = "https://rollbar.com/occurrence/uuid/?uuid=%s"
+
+var (
+	lookPath       = exec.LookPath
+	runFuzzyFinder = runFzf
+	pickColumns    = []string{"counter", "env", "status", "occurrences", "title"}
+	errPickAborted = errors.New("selection cancelled")
+)
+
+func newPickCmd(flags *rootFlags) *cobra.Command {
+	action := ""
+	pickCmd := &cobra.Command{
+		Use:   "pick",
+		Short: "Pick an issue interactively and act on it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPick(cmd.Context(), *flags, action)
+		},
+	}
+	pickCmd.Flags().StringVar(&action, "action", "", "Action to run on the selected issue: show, open, or resolve")
+
+	return pickCmd
+}
+
+func runPick(parent context.Context, flags rootFlags, action string) error {
+	if err := validatePickAction(action); err != nil {
+		return err
+	}
+
+	issues, now, err := loadPickIssues(parent, flags)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		return errors.New("no issues to pick from")
+	}
+
+	reader := bufio.NewReader(stdinReader)
+	counter, err := selectIssue(reader, issues, now)
+	if err != nil {
+		return err
+	}
+	if action == "" {
+		if action, err = promptPickAction(reader); err != nil {
+			return err
+		}
+	}
+
+	return runPickAction(parent, flags, action, counter)
+}
+
+func loadPickIssues(parent context.Context, flags rootFlags) ([]app.IssueSummary, time.Time, error) {
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var now time.Time
+	issues, _, err := runServiceOperation(parent, flags, "Loading issues", func(service *app.Service) ([]app.IssueSummary, error) {
+		now = service.Now()
+		return service.Recent(ctx, flags.Limit, filters)
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return issues, now, nil
+}
+
+func selectIssue(reader *bufio.Reader, issues []app.IssueSummary, now time.Time) (domain.ItemCounter, error) {
+	if _, err := lookPath("fzf"); err == nil {
+		line, err := runFuzzyFinder(output.RenderIssueListPlain(issues, pickColumns, now))
+		if err != nil {
+			return 0, err
+		}
+		counter, _, _ := strings.Cut(line, "\t")
+		return domain.ParseItemReference(counter)
+	}
+
+	return selectIssueEmbedded(reader, issues)
+}
+
+func runFzf(input string) (string, error) {
+	cmd := exec.Command("fzf", "--header-lines=1", "--delimiter=\t", "--no-multi")
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stderr = os.Stderr
+	var selected bytes.Buffer
+	cmd.Stdout = &selected
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", errPickAborted
+		}
+		return "", fmt.Errorf("run fzf: %w", err)
+	}
+
+	return strings.TrimSpace(selected.String()), nil
+}
+
+func selectIssueEmbedded(reader *bufio.Reader, issues []app.IssueSummary) (domain.ItemCounter, error) {
+	candidates := issues
+	for {
+		for index, issue := range candidates {
+			_, _ = fmt.Fprintf(stdoutWriter, "%3d) #%s  %s  %s\n", index+1, issue.Counter.String(), fallbackText(issue.Environment.String()), issue.Title)
+		}
+		_, _ = fmt.Fprint(stdoutWriter, "Select issue (number, or text to filter; empty to cancel): ")
+
+		line, err := reader.ReadString('\n')
+		value := strings.TrimSpace(line)
+		if number, convErr := strconv.Atoi(value); convErr == nil && number >= 1 && number <= len(candidates) {
+			return candidates[number-1].Counter, nil
+		}
+		if value == "" || err != nil {
+			return 0, errPickAborted
+		}
+
+		candidates = filterPickIssues(issues, value)
+		if len(candidates) == 0 {
+			_, _ = fmt.Fprintf(stdoutWriter, "no issues match %q\n", value)
+			candidates = issues
+		}
+	}
+}
+
+func filterPickIssues(issues []app.IssueSummary, query string) []app.IssueSummary {
+	query = strings.ToLower(query)
+	matches := make([]app.IssueSummary, 0, len(issues))
+	for _, issue := range issues {
+		text := strings.ToLower(issue.Counter.String() + " " + issue.Environment.String() + " " + issue.Title)
+		if strings.Contains(text, query) {
+			matches = append(matches, issue)
+		}
+	}
+
+	return matches
+}
+
+func promptPickAction(reader *bufio.Reader) (string, error) {
+	_, _ = fmt.Fprint(stdoutWriter, "Action [show/open/resolve] (show): ")
+	line, _ := reader.ReadString('\n')
+	value := strings.ToLower(strings.TrimSpace(line))
+	if value == "" {
+		return "show", nil
+	}
+	if err := validatePickAction(value); err != nil {
+		return "", err
+	}
+
+	return value, nil
+}
+
+func validatePickAction(action string) error {
+	switch action {
+	case "", "show", "open", "resolve":
+		return nil
+	default:
+		return fmt.Errorf("unsupported action %q: use show, open, or resolve", action)
+	}
+}
+
+func runPickAction(parent context.Context, flags rootFlags, action string, counter domain.ItemCounter) error {
+	switch action {
+	case "open":
+		return openIssue(parent, flags, counter)
+	case "resolve":
+		return runResolve(parent, flags, []domain.ItemCounter{counter}, "")
+	default:
+		return runShow(parent, flags, counter, showOptions{})
+	}
+}
+
+func openIssue(parent context.Context, flags rootFlags, counter domain.ItemCounter) error {
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	detail, _, err := runServiceOperation(parent, flags, "Loading issue detail", func(service *app.Service) (app.IssueDetail, error) {
+		return service.Show(ctx, counter)
+	})
+	if err != nil {
+		return err
+	}
+
+	link, err := issueLink(ctx, flags, detail)
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintln(stdoutWriter, terminalLink(link))
+	if err := openURL(link); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func issueLink(ctx context.Context, flags rootFlags, detail app.IssueDetail) (string, error) {
+	if link := projectIssueURL(ctx, flags, detail); link != "" {
+		return link, nil
+	}
+
+	uuid := ""
+	if detail.Instance != nil {
+		uuid = summary.OccurrenceUUID(detail.Instance.Data)
+	}
+	if uuid == "" {
+		return "", fmt.Errorf("no occurrence link available for issue %s", detail.Counter.String())
+	}
+
+	return fmt.Sprintf(occurrenceURLFormat, uuid), nil
+}
+
+func fallbackText(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "-"
+	}
+
+	return value
+}
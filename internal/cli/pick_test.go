@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newPickHandler(t *testing.T) http.Handler {
+	t.Helper()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+				{"id":1755568172,"counter":269,"title":"RST_STREAM","status":"active","environment":"production","last_occurrence_timestamp":20},
+				{"id":2,"counter":270,"title":"timeout","status":"active","environment":"staging","last_occurrence_timestamp":10}
+			]}}`)
+		case "/api/1/item_by_counter/269":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"itemId":1755568172}}`)
+		case "/api/1/item/1755568172/":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1755568172,"counter":269,"title":"RST_STREAM","status":"active","environment":"production"}}`)
+		case "/api/1/item/1755568172/instances":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"id":1,"data":{"uuid":"abc-123","trace":{"exception":{"description":"ABORTED"}}}}]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+}
+
+func overridePickFinder(t *testing.T, fzfAvailable bool, finder func(string) (string, error)) {
+	t.Helper()
+	originalLookPath, originalFinder := lookPath, runFuzzyFinder
+	t.Cleanup(func() {
+		lookPath, runFuzzyFinder = originalLookPath, originalFinder
+	})
+	lookPath = func(file string) (string, error) {
+		if fzfAvailable {
+			return "/usr/bin/" + file, nil
+		}
+		return "", errors.New("not found")
+	}
+	runFuzzyFinder = finder
+}
+
+func TestPickEmbeddedFilterThenShow(t *testing.T) {
+	stdout := setupServerAndStdout(t, newPickHandler(t))
+	setNoConfigStore(t)
+	overridePickFinder(t, false, nil)
+	setupStdin(t, "nomatch\nrst\n1\n\n")
+
+	runRootCommand(t, "pick")
+
+	got := stdout.String()
+	for _, want := range []string{"  2) #270  staging  timeout", `no issues match "nomatch"`, "Action [show/open/resolve] (show): ", "Main Error: ABORTED"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got %q", want, got)
+		}
+	}
+}
+
+func TestPickWithFzfOpensOccurrence(t *testing.T) {
+	stdout := setupServerAndStdout(t, newPickHandler(t))
+	setNoConfigStore(t)
+	finderInput := ""
+	overridePickFinder(t, true, func(input string) (string, error) {
+		finderInput = input
+		return "269\tproduction\tactive\tunknown\tRST_STREAM", nil
+	})
+	opened := ""
+	originalOpenURL := openURL
+	t.Cleanup(func() {
+		openURL = originalOpenURL
+	})
+	openURL = func(url string) error {
+		opened = url
+		return nil
+	}
+
+	runRootCommand(t, "pick", "--action", "open")
+
+	if !strings.HasPrefix(finderInput, "COUNTER\tENV\tSTATUS\tOCCURRENCES\tTITLE\n269\t") {
+		t.Fatalf("unexpected finder input: %q", finderInput)
+	}
+	if opened != "https://rollbar.com/occurrence/uuid/?uuid=abc-123" || !strings.Contains(stdout.String(), opened) {
+		t.Fatalf("unexpected opened link %q, output %q", opened, stdout.String())
+	}
+}
+
+func TestPickErrors(t *testing.T) {
+	setupServerAndStdout(t, newPickHandler(t))
+	setNoConfigStore(t)
+	overridePickFinder(t, true, func(string) (string, error) { return "", errPickAborted })
+
+	for _, args := range [][]string{{"pick", "--action", "delete"}, {"pick"}} {
+		cmd := NewRootCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+
+	overridePickFinder(t, false, nil)
+	setupStdin(t, "\n")
+	if err := runPick(t.Context(), rootFlags{Format: "human", Limit: 10}, "show"); !errors.Is(err, errPickAborted) {
+		t.Fatalf("expected cancelled selection, got %v", err)
+	}
+
+	setupStdin(t, "1\ndelete\n")
+	if err := runPick(t.Context(), rootFlags{Format: "human", Limit: 10}, ""); err == nil {
+		t.Fatalf("expected unsupported action error")
+	}
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+
+	"github.com/spf13/cobra"
+)
+
+func enableProfiling(cmd *cobra.Command, flags *rootFlags) {
+	if run := cmd.RunE; run != nil {
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			stop, err := startProfiling(flags.Profile)
+			if err != nil {
+				return err
+			}
+			defer stop()
+
+			return run(cmd, args)
+		}
+	}
+
+	for _, child := range cmd.Commands() {
+		enableProfiling(child, flags)
+	}
+}
+
+func startProfiling(prefix string) (func(), error) {
+	if prefix == "" {
+		return func() {}, nil
+	}
+
+	cpuFile, err := os.Create(filepath.Clean(prefix + ".cpu.pprof"))
+	if err != nil {
+		return nil, fmt.Errorf("create cpu profile: %w", err)
+	}
+	if err := pprof.StartCPUProfile(cpuFile); err != nil {
+		_ = cpuFile.Close()
+		return nil, fmt.Errorf("start cpu profile: %w", err)
+	}
+
+	return func() {
+		pprof.StopCPUProfile()
+		_ = cpuFile.Close()
+		if err := writeHeapProfile(filepath.Clean(prefix + ".heap.pprof")); err != nil {
+			_, _ = fmt.Fprintln(stderrWriter, err)
+		}
+	}, nil
+}
+
+func writeHeapProfile(path string) error {
+	heapFile, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("create heap profile: %w", err)
+	}
+	defer heapFile.Close()
+
+	runtime.GC()
+	if err := pprof.WriteHeapProfile(heapFile); err != nil {
+		return fmt.Errorf("write heap profile: %w", err)
+	}
+
+	return nil
+}
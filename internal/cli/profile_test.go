@@ -0,0 +1,39 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfileFlagWritesPprofFiles(t *testing.T) {
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+	}))
+	setNoConfigStore(t)
+	prefix := filepath.Join(t.TempDir(), "rollbaz")
+
+	runRootCommand(t, "recent", "--profile", prefix)
+
+	for _, suffix := range []string{".cpu.pprof", ".heap.pprof"} {
+		info, err := os.Stat(prefix + suffix)
+		if err != nil || info.Size() == 0 {
+			t.Fatalf("expected non-empty %s profile, err = %v", suffix, err)
+		}
+	}
+}
+
+func TestProfileFlagReportsUnwritablePath(t *testing.T) {
+	setNoConfigStore(t)
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"history", "--profile", filepath.Join(t.TempDir(), "missing", "rollbaz")})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("expected error for unwritable profile path")
+	}
+
+	if err := writeHeapProfile(filepath.Join(t.TempDir(), "missing", "heap.pprof")); err == nil {
+		t.Fatalf("expected heap profile error")
+	}
+}
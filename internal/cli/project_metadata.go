@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+const issueURLFormat = "https://app.rollbar.com/a/%s/fix/item/%s/%s"
+
+var newMetadataCache = config.NewMetadataCache
+
+func cachedProjectMetadata(ctx context.Context, flags rootFlags, projectID domain.ProjectID) (config.ProjectMetadata, error) {
+	token, err := resolveAccessToken(flags)
+	if err != nil {
+		return config.ProjectMetadata{}, err
+	}
+	key := tokenFingerprint(token)
+
+	cache, cacheErr := newMetadataCache()
+	if cacheErr == nil {
+		cached, ok, err := cache.Get(key, time.Now())
+		if err == nil && ok && (cached.ProjectID == projectID || projectID == 0) {
+			return cached, nil
+		}
+	}
+	if projectID == 0 {
+		return config.ProjectMetadata{}, errors.New("project id unknown and not cached for this token")
+	}
+
+	project, _, err := runServiceOperation(ctx, flags, "", func(service *app.Service) (app.ProjectInfo, error) {
+		return service.Project(ctx, projectID)
+	})
+	if err != nil {
+		return config.ProjectMetadata{}, err
+	}
+
+	metadata := config.ProjectMetadata{
+		ProjectID:   project.ID,
+		ProjectSlug: project.Slug,
+		AccountID:   project.AccountID,
+		AccountSlug: project.AccountSlug,
+		FetchedAt:   time.Now().UTC(),
+	}
+	if cacheErr == nil {
+		_ = cache.Put(key, metadata)
+	}
+
+	return metadata, nil
+}
+
+func projectIssueURL(ctx context.Context, flags rootFlags, detail app.IssueDetail) string {
+	if detail.ProjectID == 0 {
+		return ""
+	}
+	metadata, err := cachedProjectMetadata(ctx, flags, detail.ProjectID)
+	if err != nil {
+		return ""
+	}
+
+	return issueWebURL(metadata, detail.Counter)
+}
+
+func issueWebURL(metadata config.ProjectMetadata, counter domain.ItemCounter) string {
+	if metadata.AccountSlug == "" || metadata.ProjectSlug == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(issueURLFormat, url.PathEscape(metadata.AccountSlug), url.PathEscape(metadata.ProjectSlug.String()), counter.String())
+}
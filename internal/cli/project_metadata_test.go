@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func setupMetadataCache(t *testing.T) *config.MetadataCache {
+	t.Helper()
+	cache := config.NewMetadataCacheAtPath(filepath.Join(t.TempDir(), "projects.json"))
+	original := newMetadataCache
+	newMetadataCache = func() (*config.MetadataCache, error) {
+		return cache, nil
+	}
+	t.Cleanup(func() {
+		newMetadataCache = original
+	})
+
+	return cache
+}
+
+func TestCachedProjectMetadataFetchesOnce(t *testing.T) {
+	var requests atomic.Int32
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/project/42" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		requests.Add(1)
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":42,"name":"checkout api","account_id":7,"account_slug":"acme"}}`)
+	}))
+	setNoConfigStore(t)
+	setupMetadataCache(t)
+
+	detail := app.IssueDetail{IssueSummary: app.IssueSummary{ProjectID: 42, Counter: domain.ItemCounter(269)}}
+	for range 2 {
+		link := projectIssueURL(context.Background(), rootFlags{Format: "human"}, detail)
+		if link != "https://app.rollbar.com/a/acme/fix/item/checkout%20api/269" {
+			t.Fatalf("unexpected issue URL: %q", link)
+		}
+	}
+	if requests.Load() != 1 {
+		t.Fatalf("expected project metadata to be fetched once, got %d", requests.Load())
+	}
+}
+
+func TestProjectIssueURLFallbacks(t *testing.T) {
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	setNoConfigStore(t)
+	setupMetadataCache(t)
+
+	if link := projectIssueURL(context.Background(), rootFlags{}, app.IssueDetail{}); link != "" {
+		t.Fatalf("expected no link without project id, got %q", link)
+	}
+	detail := app.IssueDetail{IssueSummary: app.IssueSummary{ProjectID: 42, Counter: 1}}
+	if link := projectIssueURL(context.Background(), rootFlags{}, detail); link != "" {
+		t.Fatalf("expected no link when metadata lookup fails, got %q", link)
+	}
+	if link := issueWebURL(config.ProjectMetadata{ProjectSlug: "checkout"}, 1); link != "" {
+		t.Fatalf("expected no link without account slug, got %q", link)
+	}
+
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "")
+	if _, err := cachedProjectMetadata(context.Background(), rootFlags{}, 42); err == nil {
+		t.Fatalf("expected token error")
+	}
+}
@@ -0,0 +1,140 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+var readPassword = term.ReadPassword
+
+type projectPrompter struct {
+	reader *bufio.Reader
+}
+
+func runProjectWizard(parent context.Context, args []string) error {
+	if !stdinIsTerminal() {
+		return errors.New("project add prompts for the token in an interactive terminal; without one, pass it as `rollbaz project add <name> --token <token>`")
+	}
+	return runProjectWizardFrom(parent, bufio.NewReader(stdinReader), args)
+}
+
+func runProjectWizardFrom(parent context.Context, reader *bufio.Reader, args []string) error {
+	prompter := &projectPrompter{reader: reader}
+	project, err := prompter.collect(args)
+	if err != nil {
+		return err
+	}
+
+	if err := verifyProjectToken(parent, project); err != nil {
+		return err
+	}
+
+	if err := withConfigStore(func(store *config.Store) error {
+		return store.SaveProject(project)
+	}); err != nil {
+		return fmt.Errorf("add project: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(stdoutWriter, "saved project %q\n", project.Name)
+	return nil
+}
+
+func (p *projectPrompter) collect(args []string) (config.Project, error) {
+	project := config.Project{}
+	if len(args) > 0 {
+		project.Name = strings.TrimSpace(args[0])
+	}
+
+	var err error
+	if project.Name == "" {
+		if project.Name, err = p.required("Project name: "); err != nil {
+			return config.Project{}, err
+		}
+	}
+	if project.Token, err = p.secret("Project token: "); err != nil {
+		return config.Project{}, err
+	}
+	if project.Token == "" {
+		return config.Project{}, errors.New("project token is required")
+	}
+	if project.BaseURL, err = p.line("API base URL (optional): "); err != nil {
+		return config.Project{}, err
+	}
+	if project.Environment, err = p.line("Default environment (optional): "); err != nil {
+		return config.Project{}, err
+	}
+
+	return project, nil
+}
+
+func (p *projectPrompter) required(prompt string) (string, error) {
+	value, err := p.line(prompt)
+	if err != nil {
+		return "", err
+	}
+	if value == "" {
+		return "", errors.New("project name is required")
+	}
+
+	return value, nil
+}
+
+func (p *projectPrompter) line(prompt string) (string, error) {
+	_, _ = fmt.Fprint(stdoutWriter, prompt)
+	value, err := p.reader.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("read input: %w", err)
+	}
+
+	return strings.TrimSpace(value), nil
+}
+
+func (p *projectPrompter) secret(prompt string) (string, error) {
+	input, ok := stdinReader.(*os.File)
+	if !ok || !isTerminal(int(input.Fd())) {
+		return p.line(prompt)
+	}
+
+	_, _ = fmt.Fprint(stdoutWriter, prompt)
+	value, err := readPassword(int(input.Fd()))
+	_, _ = fmt.Fprintln(stdoutWriter)
+	if err != nil {
+		return "", fmt.Errorf("read token: %w", err)
+	}
+
+	return strings.TrimSpace(string(value)), nil
+}
+
+func stdinIsTerminal() bool {
+	input, ok := stdinReader.(*os.File)
+	return ok && isTerminal(int(input.Fd()))
+}
+
+func verifyProjectToken(parent context.Context, project config.Project) error {
+	ctx, cancel := context.WithTimeout(parent, 10*time.Second)
+	defer cancel()
+
+	client, err := newProjectClient(project.Token, project.BaseURL, project.Headers)
+	if err != nil {
+		return sanitizeError(err, project.Token)
+	}
+
+	if _, err := runWithProgress("human", "Verifying token", func() (struct{}, error) {
+		return struct{}{}, app.NewService(client).VerifyAccess(ctx)
+	}); err != nil {
+		return sanitizeError(fmt.Errorf("token rejected, project not saved: %w", err), project.Token)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+func setupProjectStore(t *testing.T) *config.Store {
+	t.Helper()
+	store := config.NewStoreAtPath(filepath.Join(t.TempDir(), "config.json"))
+	t.Cleanup(overrideConfigStore(func() (*config.Store, error) {
+		return store, nil
+	}))
+
+	return store
+}
+
+func setupStdin(t *testing.T, input string) {
+	t.Helper()
+	original := stdinReader
+	stdinReader = strings.NewReader(input)
+	t.Cleanup(func() {
+		stdinReader = original
+	})
+}
+
+// setupWizardTerminal feeds input to the wizard as an interactive terminal,
+// answering the hidden token prompt with token.
+func setupWizardTerminal(t *testing.T, token string, input string) {
+	t.Helper()
+	setupFakeTerminal(t, input)
+	readPassword = func(int) ([]byte, error) { return []byte(token), nil }
+}
+
+func TestProjectAddWizardSavesVerifiedProject(t *testing.T) {
+	server := httptest.NewServer(newTokenCheckingHandler("good"))
+	t.Cleanup(server.Close)
+	store := setupProjectStore(t)
+	setupWizardTerminal(t, "good", "figure\n"+server.URL+"/api/1\nproduction\n")
+	stdout := setupStdout(t)
+
+	runRootCommand(t, "project", "add")
+
+	project, err := store.ResolveProject("figure")
+	if err != nil {
+		t.Fatalf("ResolveProject() error = %v", err)
+	}
+	want := config.Project{Name: "figure", Token: "good", BaseURL: server.URL + "/api/1", Environment: "production"}
+	if !reflect.DeepEqual(project, want) {
+		t.Fatalf("ResolveProject() = %+v, want %+v", project, want)
+	}
+	if !strings.Contains(stdout.String(), `saved project "figure"`) {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+}
+
+func TestProjectAddWizardRejectsInvalidToken(t *testing.T) {
+	server := httptest.NewServer(newTokenCheckingHandler("good"))
+	t.Cleanup(server.Close)
+	store := setupProjectStore(t)
+	setupWizardTerminal(t, "bad", server.URL+"/api/1\n\n")
+	setupStdout(t)
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"project", "add", "figure"})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "project not saved") {
+		t.Fatalf("expected rejected token error, got %v", err)
+	}
+	if _, err := store.ResolveProject("figure"); err == nil {
+		t.Fatalf("expected project to remain unsaved")
+	}
+}
+
+func TestProjectAddWizardRequiresInput(t *testing.T) {
+	setupProjectStore(t)
+	setupStdout(t)
+
+	for _, input := range []string{"\n", "figure\n"} {
+		setupWizardTerminal(t, "", input)
+		cmd := NewRootCmd()
+		cmd.SetArgs([]string{"project", "add"})
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected error for input %q", input)
+		}
+	}
+}
+
+func TestProjectAddWizardNeedsTerminal(t *testing.T) {
+	store := setupProjectStore(t)
+	setupStdout(t)
+	setupStdin(t, "figure\ngood\n")
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"project", "add", "figure"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--token <token>") {
+		t.Fatalf("expected a pointer to --token without a terminal, got %v", err)
+	}
+	if _, err := store.ResolveProject("figure"); err == nil {
+		t.Fatalf("expected project to remain unsaved")
+	}
+}
+
+func TestProjectDefaultEnvironmentApplied(t *testing.T) {
+	store := setupProjectStore(t)
+	if err := store.SaveProject(config.Project{Name: "figure", Token: "token", Environment: "staging"}); err != nil {
+		t.Fatalf("SaveProject() error = %v", err)
+	}
+
+	flags := rootFlags{}
+	applyProjectDefaults(&flags)
+	if flags.Environment != "staging" {
+		t.Fatalf("expected default environment, got %q", flags.Environment)
+	}
+
+	flags = rootFlags{Environment: "production"}
+	applyProjectDefaults(&flags)
+	if flags.Environment != "production" {
+		t.Fatalf("expected explicit environment to win, got %q", flags.Environment)
+	}
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRecentMinRateFilter(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+				{"id":1,"counter":1,"title":"old noisy","status":"active","total_occurrences":90000},
+				{"id":2,"counter":2,"title":"new hot","status":"active","total_occurrences":40}
+			]}}`)
+		case "/api/1/reports/occurrence_counts":
+			count := 1
+			if r.URL.Query().Get("item_id") == "2" {
+				count = 40
+			}
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":[[1700000000,%d]]}`, count)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--min-rate", "10/h")
+
+	if got := stdout.String(); strings.Contains(got, "old noisy") || !strings.Contains(got, "new hot") {
+		t.Fatalf("unexpected rate-filtered output: %q", got)
+	}
+}
+
+func TestRecentMinRateInvalid(t *testing.T) {
+	setNoConfigStore(t)
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"recent", "--min-rate", "fast"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--min-rate") {
+		t.Fatalf("expected --min-rate parse error, got %v", err)
+	}
+}
+
+func TestRecentMinRateStopsAtRequestBudget(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/items" {
+			t.Fatalf("request beyond budget reached the server: %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":1,"title":"hot","status":"active"}]}}`)
+	}))
+	stderr := setupStderr(t)
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--min-rate", "10/h", "--max-requests", "1")
+
+	if strings.Contains(stdout.String(), "hot") {
+		t.Fatalf("expected unenriched issue to be dropped, got %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "budget of 1 Rollbar API requests") {
+		t.Fatalf("expected budget warning, got %q", stderr.String())
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"recent", "--max-requests", "-1"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--max-requests") {
+		t.Fatalf("expected --max-requests parse error, got %v", err)
+	}
+}
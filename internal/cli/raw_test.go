@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListJSONRawToggle(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":5,"title":"bulky","status":"active","environment":"production"}]}}`)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--format", "json")
+	if strings.Contains(stdout.String(), `"raw"`) {
+		t.Fatalf("expected lists to omit raw payloads by default, got %q", stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--format", "json", "--include-raw")
+	if !strings.Contains(stdout.String(), `"raw": {`) {
+		t.Fatalf("expected raw payloads with --include-raw, got %q", stdout.String())
+	}
+}
+
+func TestShowJSONRawToggle(t *testing.T) {
+	stdout := setupServerAndStdout(t, newSuccessHandler(t))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "show", "269", "--format", "json")
+	if !strings.Contains(stdout.String(), `"item_raw"`) || !strings.Contains(stdout.String(), `"instance_raw"`) {
+		t.Fatalf("expected show to include raw payloads by default, got %q", stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "show", "269", "--format", "json", "--no-raw")
+	if strings.Contains(stdout.String(), "_raw") || strings.Contains(stdout.String(), `"raw"`) {
+		t.Fatalf("expected --no-raw to omit raw payloads, got %q", stdout.String())
+	}
+}
+
+func TestRawFlagsMutuallyExclusive(t *testing.T) {
+	setNoConfigStore(t)
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"recent", "--include-raw", "--no-raw"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("expected mutually exclusive flag error")
+	}
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newReleaseHealthCmd(flags *rootFlags) *cobra.Command {
+	releaseVersion := ""
+	releaseCmd := &cobra.Command{
+		Use:   "release-health",
+		Short: "Report new and reactivated issues since a deploy",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReleaseHealth(cmd.Context(), *flags, releaseVersion)
+		},
+	}
+	releaseCmd.Flags().StringVar(&releaseVersion, "version", "", "Deployed revision to report on")
+	_ = releaseCmd.MarkFlagRequired("version")
+
+	return releaseCmd
+}
+
+func runReleaseHealth(parent context.Context, flags rootFlags, releaseVersion string) error {
+	ctx, cancel := commandContext(parent, flags, 20*time.Second)
+	defer cancel()
+
+	var now time.Time
+	health, token, err := runServiceOperation(parent, flags, "Loading release health", func(service *app.Service) (app.ReleaseHealth, error) {
+		now = service.Now()
+		return service.ReleaseHealth(ctx, releaseVersion, flags.Environment)
+	})
+	if err != nil {
+		return err
+	}
+	if health, err = anonymized(flags, health); err != nil {
+		return err
+	}
+
+	payload := health
+	if !includeRaw(flags, false) {
+		payload = health.WithoutRaw()
+	}
+	jsonPayload := redact.Value(map[string]any{"release": payload}, token)
+	return printOutput(flags.Format, output.RenderReleaseHealthHumanWithWidth(health, terminalRenderWidth(), now), jsonPayload)
+}
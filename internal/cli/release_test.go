@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestReleaseHealthCommandJSON(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/deploys/":
+			if r.URL.Query().Get("page") != "1" {
+				_, _ = fmt.Fprint(w, `{"err":0,"result":{"deploys":[]}}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"deploys":[{"id":5,"revision":"v1.2.3","environment":"production","start_time":1000}]}}`)
+		case "/api/1/items":
+			if r.URL.Query().Get("page") != "1" {
+				_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":7,"title":"fresh","environment":"production","first_occurrence_timestamp":2000}]}}`)
+		case "/api/1/reports/occurrence_counts":
+			if r.URL.Query().Get("min_ts") != "1000" || r.URL.Query().Get("environment") != "production" {
+				t.Fatalf("unexpected occurrence counts query: %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[[1000,3],[4600,2]]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "release-health", "--version", "v1.2.3", "--format", "json")
+
+	for _, want := range []string{`"occurrences_since_deploy": 5`, `"title": "fresh"`, `"revision": "v1.2.3"`} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout.String())
+		}
+	}
+}
+
+func TestReleaseHealthCommandRequiresVersion(t *testing.T) {
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"release-health"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("expected missing --version error")
+	}
+}
@@ -10,13 +10,16 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/kevinsheth/rollbaz/internal/app"
 	"github.com/kevinsheth/rollbaz/internal/config"
 	"github.com/kevinsheth/rollbaz/internal/domain"
 	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
 )
 
 func TestRunShowHuman(t *testing.T) {
@@ -61,6 +64,46 @@ func TestRunRecentHuman(t *testing.T) {
 	}
 }
 
+func TestRecentCommandShowsLevelByDefault(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":2,"title":"Recent","status":"active","level":"critical","last_occurrence_timestamp":1700000000}]}}`)
+	}))
+
+	runRootCommand(t, "recent")
+	if !strings.Contains(stdout.String(), "LEVEL") || !strings.Contains(stdout.String(), "‼ critical") {
+		t.Fatalf("expected the level icon in the default list, got %q", stdout.String())
+	}
+}
+
+func TestRecentCommandStatusQuery(t *testing.T) {
+	tests := []struct {
+		status    string
+		wantQuery string
+	}{
+		{status: "", wantQuery: "page=1&status=active"},
+		{status: "resolved", wantQuery: "page=1&status=resolved"},
+		{status: "ALL", wantQuery: "page=1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			setNoConfigStore(t)
+			stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Encode(); got != tt.wantQuery {
+					t.Fatalf("query = %q, want %q", got, tt.wantQuery)
+				}
+				_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":2,"title":"Fixed","status":"resolved","last_occurrence_timestamp":1700000000}]}}`)
+			}))
+
+			runRootCommand(t, "recent", "--status", tt.status)
+			if tt.status != "" && !strings.Contains(stdout.String(), "Fixed") {
+				t.Fatalf("unexpected output: %q", stdout.String())
+			}
+		})
+	}
+}
+
 func TestResolveAccessTokenFromConfig(t *testing.T) {
 	dir := t.TempDir()
 	store := config.NewStoreAtPath(filepath.Join(dir, "config.json"))
@@ -307,8 +350,21 @@ func TestResolveCommandWithYes(t *testing.T) {
 	}
 }
 
+func TestReopenCommandSkipsActiveIssue(t *testing.T) {
+	var patchPayload rollbar.ItemPatch
+	stdout := setupServerAndStdout(t, newActionSuccessHandler(t, &patchPayload))
+
+	runRootCommand(t, "reopen", "269", "--yes")
+	if patchPayload.Status != "" {
+		t.Fatalf("expected no patch for an active issue, got %+v", patchPayload)
+	}
+	if !strings.Contains(stdout.String(), "issue 269 already active; nothing to update") {
+		t.Fatalf("unexpected output %q", stdout.String())
+	}
+}
+
 func TestMuteCommandInvalidDuration(t *testing.T) {
-	if err := runMute(context.Background(), rootFlags{}, 269, "500ms"); err == nil {
+	if err := runMute(context.Background(), rootFlags{}, []domain.ItemCounter{269}, "500ms"); err == nil {
 		t.Fatalf("expected invalid duration error")
 	}
 }
@@ -392,6 +448,16 @@ func TestShowCommandRejectsZeroCounter(t *testing.T) {
 	}
 }
 
+func TestShowCommandAcceptsItemURL(t *testing.T) {
+	stdout := setupServerAndStdout(t, newSuccessHandler(t))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "show", "https://app.rollbar.com/a/acme/fix/item/checkout/269")
+	if !strings.Contains(stdout.String(), "RST_STREAM") {
+		t.Fatalf("unexpected output: %s", stdout.String())
+	}
+}
+
 func TestRunShowJSON(t *testing.T) {
 	stdout, err := runShowForFormat(t, "json")
 	if err != nil {
@@ -402,12 +468,68 @@ func TestRunShowJSON(t *testing.T) {
 	}
 }
 
-func TestBuildServiceErrors(t *testing.T) {
+func TestShowCommandHeatmap(t *testing.T) {
+	issue := newSuccessHandler(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/reports/occurrence_counts" {
+			if r.URL.Query().Get("item_id") != "1755568172" || r.URL.Query().Get("bucket_size") != "3600" {
+				t.Fatalf("unexpected occurrence counts query: %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[[1708938000,5]]}`)
+			return
+		}
+		issue.ServeHTTP(w, r)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "show", "269", "--heatmap")
+	if !strings.Contains(stdout.String(), "Occurrences by hour (last 4 weeks") || !strings.Contains(stdout.String(), "busiest hour (5)") {
+		t.Fatalf("expected heatmap in output, got %s", stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "show", "269", "--heatmap", "--format", "json")
+	if !strings.Contains(stdout.String(), `"heatmap"`) || !strings.Contains(stdout.String(), `"peak": 5`) {
+		t.Fatalf("expected heatmap in json output, got %s", stdout.String())
+	}
+}
+
+func TestShowCommandFrames(t *testing.T) {
+	issue := newSuccessHandler(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/item/1755568172/instances" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"id":1,"data":{"body":{"trace":{"exception":{"class":"GRPCError","message":"ABORTED"},"frames":[`+
+				`{"filename":"server.go","lineno":12,"method":"Serve"},{"filename":"stream.go","lineno":88,"method":"Reset"}]}}}}]}`)
+			return
+		}
+		issue.ServeHTTP(w, r)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "show", "269")
+	if !strings.Contains(stdout.String(), "GRPCError: ABORTED") || !strings.Contains(stdout.String(), "server.go:12 in Serve") || !strings.Contains(stdout.String(), "stream.go:88 in Reset") {
+		t.Fatalf("expected stack trace in output, got %s", stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "show", "269", "--frames", "1", "--format", "json")
+	var payload struct {
+		Traces []summary.Trace `json:"traces"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("decode show json: %v", err)
+	}
+	if len(payload.Traces) != 1 || payload.Traces[0].Omitted != 1 || len(payload.Traces[0].Frames) != 1 || payload.Traces[0].Frames[0].Line != 88 {
+		t.Fatalf("expected one structured frame in json output, got %+v", payload.Traces)
+	}
+}
+
+func TestRunServiceOperationErrors(t *testing.T) {
 	setNoConfigStore(t)
 
 	t.Setenv("ROLLBAR_ACCESS_TOKEN", "")
-	if _, _, err := buildService(rootFlags{}); err == nil {
-		t.Fatalf("expected buildService token error")
+	if _, _, err := runServiceOperation(context.Background(), rootFlags{}, "Loading", func(*app.Service) (int, error) { return 0, nil }); err == nil {
+		t.Fatalf("expected runServiceOperation token error")
 	}
 
 	restoreClient := overrideClientFactory(func(token string) (*rollbar.Client, error) {
@@ -416,13 +538,14 @@ func TestBuildServiceErrors(t *testing.T) {
 	defer restoreClient()
 
 	t.Setenv("ROLLBAR_ACCESS_TOKEN", "token")
-	if _, _, err := buildService(rootFlags{}); err == nil {
-		t.Fatalf("expected buildService client error")
+	if _, _, err := runServiceOperation(context.Background(), rootFlags{}, "Loading", func(*app.Service) (int, error) { return 0, nil }); err == nil {
+		t.Fatalf("expected runServiceOperation client error")
 	}
 }
 
 func TestExecuteFailure(t *testing.T) {
 	setNoConfigStore(t)
+	setupHistoryStore(t)
 
 	t.Setenv("ROLLBAR_ACCESS_TOKEN", "")
 	originalArgs := os.Args
@@ -539,9 +662,17 @@ func TestParseIssueFilters(t *testing.T) {
 		{name: "valid unix seconds", flags: rootFlags{Since: "1771495200"}},
 		{name: "negative unix seconds", flags: rootFlags{Since: "-1"}, wantErr: true},
 		{name: "invalid since", flags: rootFlags{Since: "not-a-time"}, wantErr: true},
+		{name: "unknown status", flags: rootFlags{Status: "snoozed"}, wantErr: true},
+		{name: "invalid max age", flags: rootFlags{MaxAge: "soon"}, wantErr: true},
 		{name: "invalid min occurrences", flags: rootFlags{MinOccurrences: "x"}, wantErr: true},
+		{name: "levels", flags: rootFlags{Level: "error, Critical,error"}},
+		{name: "unknown level", flags: rootFlags{Level: "error,fatal"}, wantErr: true},
 		{name: "since after until", flags: rootFlags{Since: "2026-02-19T13:00:00Z", Until: "2026-02-19T12:00:00Z"}, wantErr: true},
 		{name: "min greater than max", flags: rootFlags{MinOccurrences: "10", MaxOccurrences: "9"}, wantErr: true},
+		{name: "preset", flags: rootFlags{Preset: "@critical-prod,@noisy"}},
+		{name: "preset under explicit flags", flags: rootFlags{Environment: "staging", Preset: "@critical-prod"}},
+		{name: "unknown preset", flags: rootFlags{Preset: "@loud"}, wantErr: true},
+		{name: "preset conflicting with flags", flags: rootFlags{MaxOccurrences: "5", Preset: "@noisy"}, wantErr: true},
 	}
 
 	for _, tc := range tests {
@@ -555,9 +686,27 @@ func TestParseIssueFilters(t *testing.T) {
 		if err != nil {
 			t.Fatalf("%s: unexpected error: %v", tc.name, err)
 		}
-		if tc.flags.Environment != "" && filters.Environment != tc.flags.Environment {
+		if tc.flags.Environment != "" && filters.Environment.String() != tc.flags.Environment {
 			t.Fatalf("%s: environment mismatch", tc.name)
 		}
+		if tc.flags.Level != "" && !slices.Equal(filters.Levels, []domain.Level{domain.LevelError, domain.LevelCritical}) {
+			t.Fatalf("%s: unexpected levels %v", tc.name, filters.Levels)
+		}
+	}
+}
+
+func TestApplyFilterPresets(t *testing.T) {
+	now := time.Date(2024, 3, 8, 12, 0, 0, 0, time.UTC)
+	filters, err := applyFilterPresets("@critical-prod, @noisy", app.IssueFilters{Status: domain.StatusMuted}, now)
+	if err != nil {
+		t.Fatalf("applyFilterPresets() error = %v", err)
+	}
+	if filters.Status != domain.StatusMuted || filters.Environment != "production" || filters.MinLevel != domain.LevelCritical ||
+		filters.MinOccurrences == nil || *filters.MinOccurrences != 1000 || filters.Since == nil || !filters.Since.Equal(now.Add(-24*time.Hour)) {
+		t.Fatalf("unexpected combined filters %+v", filters)
+	}
+	if _, err := applyFilterPresets("@loud", app.IssueFilters{}, now); err == nil || !strings.Contains(err.Error(), "parse --preset") {
+		t.Fatalf("expected --preset error, got %v", err)
 	}
 }
 
@@ -591,7 +740,7 @@ func runShowForFormat(t *testing.T, format string) (*bytes.Buffer, error) {
 	t.Helper()
 	stdout := setupServerAndStdout(t, newSuccessHandler(t))
 
-	err := runShow(context.Background(), rootFlags{Format: format}, domain.ItemCounter(269))
+	err := runShow(context.Background(), rootFlags{Format: format}, domain.ItemCounter(269), showOptions{})
 
 	return stdout, err
 }
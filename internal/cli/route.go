@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+type routeOptions struct {
+	file   string
+	dryRun bool
+}
+
+func newRouteCmd(flags *rootFlags) *cobra.Command {
+	options := routeOptions{}
+	routeCmd := &cobra.Command{
+		Use:   "route --file <routing.yml>",
+		Short: "Assign issues in bulk from a file of title and path rules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRoute(cmd.Context(), *flags, options)
+		},
+	}
+	routeCmd.Flags().StringVar(&options.file, "file", "", "Routing file mapping title or path patterns to assignees")
+	routeCmd.Flags().BoolVar(&options.dryRun, "dry-run", false, "Print the assignments that would change without applying them")
+	_ = routeCmd.MarkFlagRequired("file")
+
+	return routeCmd
+}
+
+func runRoute(parent context.Context, flags rootFlags, options routeOptions) error {
+	router, err := loadRouter(options.file)
+	if err != nil {
+		return err
+	}
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 5*time.Minute)
+	defer cancel()
+
+	plan, token, err := runServiceOperation(parent, flags, "Matching issues against routes", func(service *app.Service) (app.RoutePlan, error) {
+		return service.PlanRoutes(ctx, router, filters)
+	})
+	if err != nil {
+		return err
+	}
+	shown, err := anonymized(flags, plan)
+	if err != nil {
+		return err
+	}
+	human := output.RenderRoutePlanWithWidth(shown, terminalRenderWidth())
+	if options.dryRun || len(plan.Changes) == 0 {
+		return printOutput(flags.Format, human, redact.Value(map[string]any{"plan": shown, "applied": 0, "dry_run": options.dryRun}, token))
+	}
+
+	if isHumanFormat(flags.Format) {
+		_, _ = fmt.Fprintln(stdoutWriter, human)
+	}
+	if err := confirmPrompt(flags, fmt.Sprintf("Assign %d issues?", len(plan.Changes))); err != nil {
+		return err
+	}
+
+	var applyErr error
+	applied, _, err := runServiceOperation(parent, flags, "Assigning issues", func(service *app.Service) (int, error) {
+		var applied int
+		applied, applyErr = service.ApplyRoutes(ctx, plan.Changes)
+		return applied, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	summary := fmt.Sprintf("assigned %d of %d issues", applied, len(plan.Changes))
+	if err := printOutput(flags.Format, summary, redact.Value(map[string]any{"plan": shown, "applied": applied, "dry_run": false}, token)); err != nil {
+		return err
+	}
+	if applyErr != nil {
+		return sanitizeError(applyErr, token)
+	}
+
+	return nil
+}
+
+func loadRouter(path string) (*app.Router, error) {
+	if path == "" {
+		return nil, errors.New("--file is required")
+	}
+	routing, err := config.LoadRouting(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]app.RouteRule, 0, len(routing.Routes))
+	for _, route := range routing.Routes {
+		userID, err := routing.AssigneeID(route.Assignee)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, app.RouteRule{Title: route.Title, Path: route.Path, Assignee: route.Assignee, UserID: userID})
+	}
+
+	return app.NewRouter(rules)
+}
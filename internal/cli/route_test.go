@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newRouteHandler(t *testing.T, patches *[]string) http.Handler {
+	t.Helper()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/1/items" && r.URL.Query().Get("page") != "1":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+		case r.URL.Path == "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[`+
+				`{"id":1,"counter":1,"title":"DB timeout","status":"active"},`+
+				`{"id":2,"counter":2,"title":"Search timeout","status":"active","assigned_user_id":11},`+
+				`{"id":3,"counter":3,"title":"nil pointer","status":"active"}]}}`)
+		case r.URL.Path == "/api/1/item/1" && r.Method == http.MethodPatch:
+			body, _ := io.ReadAll(r.Body)
+			*patches = append(*patches, string(body))
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+}
+
+func writeRoutingFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "routing.yml")
+	body := "users:\n  alice: 11\nroutes:\n  - title: (?i)timeout\n    assignee: alice\n"
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	return path
+}
+
+func TestRouteCommand(t *testing.T) {
+	var patches []string
+	stdout := setupServerAndStdout(t, newRouteHandler(t, &patches))
+	setNoConfigStore(t)
+	routing := writeRoutingFile(t)
+
+	runRootCommand(t, "route", "--file", routing, "--dry-run")
+	got := stdout.String()
+	if len(patches) != 0 || !strings.Contains(got, "1 issues to assign (1 already routed, 1 matched no rule)") || !strings.Contains(got, "alice (user 11)") {
+		t.Fatalf("unexpected dry run: patches %v, output %q", patches, got)
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"route", "--file", routing, "--format", "json"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "rerun with --yes") {
+		t.Fatalf("expected confirmation error, got %v", err)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "route", "--file", routing, "--yes")
+	if len(patches) != 1 || patches[0] != `{"assigned_user_id":11}` || !strings.Contains(stdout.String(), "assigned 1 of 1 issues") {
+		t.Fatalf("unexpected apply: patches %v, output %q", patches, stdout.String())
+	}
+}
+
+func TestRouteCommandRejectsBadRoutingFile(t *testing.T) {
+	setNoConfigStore(t)
+	path := filepath.Join(t.TempDir(), "routing.yml")
+	if err := os.WriteFile(path, []byte("routes:\n  - title: \"(\"\n    assignee: 7\n"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"route", "--file", path})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "route 1: title") {
+		t.Fatalf("expected pattern error, got %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/rpc"
+)
+
+var serveRPC = rpc.Serve
+
+func newRPCCmd(flags *rootFlags) *cobra.Command {
+	var stdio bool
+	cmd := &cobra.Command{
+		Use:   "rpc",
+		Short: "Serve issue operations as JSON-RPC for editor plugins",
+		Long: "Serve issue operations as JSON-RPC 2.0, one message per line, so editor plugins can keep one rollbaz process running.\n\n" +
+			"Methods: recent, active, show, file_issues, and, with --yes, resolve, reopen, mute, assign, and unassign.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !stdio {
+				return errors.New("rpc needs --stdio; it is the only transport")
+			}
+			return runRPC(cmd.Context(), *flags)
+		},
+	}
+	cmd.Flags().BoolVar(&stdio, "stdio", false, "Read requests from stdin and write responses to stdout")
+
+	return cmd
+}
+
+func runRPC(parent context.Context, flags rootFlags) error {
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+
+	budget, err := parseRequestBudget(flags.MaxRequests)
+	if err != nil {
+		return err
+	}
+	token, err := resolveAccessToken(flags)
+	if err != nil {
+		return err
+	}
+
+	// A long-running server answers each request live rather than from cache.
+	flags.NoCache = true
+	options := rpc.Options{
+		Limit:       flags.Limit,
+		Filters:     filters,
+		AllowWrites: flags.Yes,
+		Token:       token,
+		BeforeCall:  func() { rollbar.SetDefaultRequestBudget(budget) },
+	}
+	_, _, err = runServiceOperation(parent, flags, "", func(service *app.Service) (struct{}, error) {
+		return struct{}{}, serveRPC(parent, service, options, stdinReader, stdoutWriter)
+	})
+
+	return err
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRPCCommand(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/items" {
+			t.Fatalf("unexpected request: %s", r.URL.String())
+		}
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":11,"counter":7,"title":"checkout failed","status":"active","environment":"production","last_occurrence_timestamp":10}]}}`)
+	}))
+	originalStdin := stdinReader
+	t.Cleanup(func() { stdinReader = originalStdin })
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"rpc"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--stdio") {
+		t.Fatalf("expected --stdio error, got %v", err)
+	}
+
+	stdinReader = tempFileWithContent(t, `{"jsonrpc":"2.0","id":1,"method":"recent","params":{"limit":5}}`+"\n"+
+		`{"jsonrpc":"2.0","id":2,"method":"mute","params":{"counter":7}}`+"\n")
+	runRootCommand(t, "rpc", "--stdio", "--env", "production")
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected two responses, got %q", stdout.String())
+	}
+	if !strings.Contains(lines[0], `"id":1`) || !strings.Contains(lines[0], `"counter":7`) || !strings.Contains(lines[0], `"environment":"production"`) {
+		t.Fatalf("unexpected recent response: %s", lines[0])
+	}
+	if !strings.Contains(lines[1], `"code":-32001`) {
+		t.Fatalf("expected writes to be refused without --yes: %s", lines[1])
+	}
+}
+
+func TestRPCCommandBudgetsAndRedactsEachRequest(t *testing.T) {
+	var requests atomic.Int32
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = fmt.Fprintf(w, `{"err":1,"message":"upstream rejected %s"}`, r.Header.Get("X-Rollbar-Access-Token"))
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+	}))
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "tok-rpc-secret")
+	originalStdin := stdinReader
+	t.Cleanup(func() { stdinReader = originalStdin })
+
+	request := `{"jsonrpc":"2.0","id":1,"method":"recent"}` + "\n"
+	stdinReader = tempFileWithContent(t, strings.Repeat(request, 4))
+	runRootCommand(t, "rpc", "--stdio", "--max-requests", "1", "--retries", "0")
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 4 || strings.Contains(stdout.String(), "tok-rpc-secret") || !strings.Contains(lines[0], "[REDACTED]") {
+		t.Fatalf("expected the token redacted from the upstream error, got %q", stdout.String())
+	}
+	for _, line := range lines[1:] {
+		if strings.Contains(line, `"error"`) {
+			t.Fatalf("expected a fresh request budget per call, got %s", line)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/progress"
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newRQLCmd(flags *rootFlags) *cobra.Command {
+	var timeout time.Duration
+	rqlCmd := &cobra.Command{
+		Use:   "rql <query>",
+		Short: "Run an RQL query and print the result rows",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("timeout") && flags.CommandTimeout > 0 {
+				timeout = flags.CommandTimeout
+			}
+			return runRQL(cmd.Context(), *flags, args[0], timeout)
+		},
+	}
+	rqlCmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for the query job to finish")
+	rqlCmd.AddCommand(newRQLRunCmd(flags))
+	rqlCmd.AddCommand(newRQLQueriesCmd())
+
+	return rqlCmd
+}
+
+func newRQLRunCmd(flags *rootFlags) *cobra.Command {
+	var timeout time.Duration
+	var params []string
+	runCmd := &cobra.Command{
+		Use:   "run <name>",
+		Short: "Run a saved RQL query from the rql.queries library",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("timeout") && flags.CommandTimeout > 0 {
+				timeout = flags.CommandTimeout
+			}
+			query, err := renderSavedRQL(args[0], params)
+			if err != nil {
+				return err
+			}
+			return runRQL(cmd.Context(), *flags, query, timeout)
+		},
+	}
+	runCmd.Flags().StringArrayVar(&params, "param", nil, "Fill a {{.name}} placeholder, as name=value; repeatable")
+	runCmd.Flags().DurationVar(&timeout, "timeout", 5*time.Minute, "How long to wait for the query job to finish")
+
+	return runCmd
+}
+
+func newRQLQueriesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "queries",
+		Short: "List the saved RQL queries and the config file each comes from",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queries, err := loadRQLLibrary()
+			if err != nil {
+				return err
+			}
+			if len(queries) == 0 {
+				_, _ = fmt.Fprintln(stdoutWriter, "no saved rql queries")
+				return nil
+			}
+			for _, query := range queries {
+				_, _ = fmt.Fprintf(stdoutWriter, "%s\t%s\t%s\n", query.Name, query.Query, query.Source)
+			}
+			return nil
+		},
+	}
+}
+
+func loadRQLLibrary() ([]config.SavedRQL, error) {
+	file, userPath := config.File{}, ""
+	if store, err := newConfigStore(); err == nil {
+		if file, err = store.Load(); err != nil {
+			return nil, fmt.Errorf("load config: %w", err)
+		}
+		userPath = store.Path()
+	}
+
+	repoPath := ""
+	if dir, err := os.Getwd(); err == nil {
+		repoPath, _ = config.FindRepoFile(dir)
+	}
+
+	return config.RQLLibrary(file, userPath, repoPath)
+}
+
+func renderSavedRQL(name string, rawParams []string) (string, error) {
+	params, err := parseRQLParams(rawParams)
+	if err != nil {
+		return "", err
+	}
+	queries, err := loadRQLLibrary()
+	if err != nil {
+		return "", err
+	}
+	saved, err := config.FindSavedRQL(queries, name)
+	if err != nil {
+		return "", err
+	}
+
+	return app.RenderRQLTemplate(saved.Name, saved.Query, params)
+}
+
+func parseRQLParams(values []string) (map[string]string, error) {
+	params := make(map[string]string, len(values))
+	for _, value := range values {
+		name, param, ok := strings.Cut(value, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("parse --param %q: use name=value", value)
+		}
+		params[name] = param
+	}
+
+	return params, nil
+}
+
+func runRQL(parent context.Context, flags rootFlags, query string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+
+	result, token, err := runServiceOperation(parent, flags, "", func(service *app.Service) (app.RQLResult, error) {
+		return runWithStatusProgress(flags.Format, "Submitting RQL job", func(status func(string)) (app.RQLResult, error) {
+			return service.RunRQL(ctx, query, func(job rollbar.RQLJob) {
+				status(fmt.Sprintf("RQL job %d: %s", job.ID, job.Status))
+			})
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if result, err = anonymized(flags, result); err != nil {
+		return err
+	}
+
+	return printOutput(flags.Format, redact.String(output.RenderRQLResultWithWidth(result, terminalRenderWidth()), token), redact.Value(result, token))
+}
+
+func runWithStatusProgress[T any](format string, message string, operation func(status func(string)) (T, error)) (T, error) {
+	if !shouldRenderProgress(format) {
+		return operation(func(string) {})
+	}
+
+	writer := newProgressWriter()
+	tracker := progress.Tracker{Message: message, Total: 0, Units: progress.UnitsDefault}
+	writer.AppendTracker(&tracker)
+
+	done := make(chan struct{})
+	go func() {
+		writer.Render()
+		close(done)
+	}()
+
+	result, err := operation(tracker.UpdateMessage)
+	if err != nil {
+		tracker.MarkAsErrored()
+	} else {
+		tracker.MarkAsDone()
+	}
+	waitForProgressStop(done)
+
+	return result, err
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRQLCommand(t *testing.T) {
+	setNoConfigStore(t)
+	var query string
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/rql/jobs/":
+			var body struct {
+				QueryString string `json:"query_string"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			query = body.QueryString
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":9,"status":"success"}}`)
+		case "/api/1/rql/job/9/result":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":9,"result":{"columns":["environment","count(*)"],"rows":[["production",12]]}}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	rql := "SELECT environment, count(*) FROM item_occurrence GROUP BY environment"
+	runRootCommand(t, "rql", rql)
+	if query != rql {
+		t.Fatalf("submitted query = %q", query)
+	}
+	for _, want := range []string{"ENVIRONMENT", "COUNT(*)", "production", "12"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout.String())
+		}
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "rql", rql, "--format", "json")
+	for _, want := range []string{`"job_id": 9`, `"columns": [`, `"production"`} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in json output, got %q", want, stdout.String())
+		}
+	}
+}
+
+func TestRQLRunSavedQuery(t *testing.T) {
+	setNoConfigStore(t)
+	root := t.TempDir()
+	library := `{"rql":{"queries":{"by_user":"SELECT * FROM item_occurrence WHERE person.id = {{.user}} AND environment = {{quote .env}}"}}}`
+	if err := os.WriteFile(filepath.Join(root, ".rollbaz.json"), []byte(library), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	nested := filepath.Join(root, "cmd")
+	if err := os.Mkdir(nested, 0o700); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+	t.Chdir(nested)
+
+	var query string
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/rql/jobs/":
+			var body struct {
+				QueryString string `json:"query_string"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			query = body.QueryString
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":9,"status":"success"}}`)
+		case "/api/1/rql/job/9/result":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":9,"result":{"columns":["count(*)"],"rows":[[4]]}}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "rql", "run", "by_user", "--param", "user=123", "--param", "env=production")
+	if want := "SELECT * FROM item_occurrence WHERE person.id = 123 AND environment = 'production'"; query != want {
+		t.Fatalf("submitted query = %q", query)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "rql", "queries")
+	if !strings.Contains(stdout.String(), "by_user\t") || !strings.Contains(stdout.String(), ".rollbaz.json") {
+		t.Fatalf("queries output = %q", stdout.String())
+	}
+
+	for _, args := range [][]string{
+		{"rql", "run", "by_user", "--param", "env=production"},
+		{"rql", "run", "by_user", "--param", "user"},
+		{"rql", "run", "missing"},
+	} {
+		cmd := NewRootCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func newSearchCmd(flags *rootFlags) *cobra.Command {
+	regex := false
+	searchCmd := &cobra.Command{
+		Use:   "search <text>",
+		Short: "List issues whose title or main error contains text",
+		Long: "List issues whose title or main error message contains text, ignoring case, combined with the usual\n" +
+			"list filters. RQL finds the main error matches; without RQL access only titles are matched.\n" +
+			"--regex matches titles against a regular expression instead.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSearch(cmd.Context(), *flags, args[0], regex)
+		},
+	}
+	searchCmd.Flags().BoolVar(&regex, "regex", false, "Treat text as a regular expression matched against titles")
+
+	return searchCmd
+}
+
+func runSearch(parent context.Context, flags rootFlags, text string, regex bool) error {
+	return runIssueListWithin(parent, flags, 2*time.Minute, func(ctx context.Context, service *app.Service, limit int, filters app.IssueFilters) ([]app.IssueSummary, error) {
+		return service.Search(ctx, app.SearchQuery{Text: text, Regex: regex, Filters: filters, Limit: limit})
+	})
+}
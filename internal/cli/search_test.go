@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSearchCommand(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/rql/jobs/":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":5,"status":"success"}}`)
+		case "/api/1/rql/job/5/result":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":5,"result":{"columns":["item.counter"],"rows":[[12]]}}}`)
+		case "/api/1/items":
+			if r.URL.Query().Get("page") != "1" {
+				_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[`+
+				`{"id":1,"counter":11,"title":"Read timeout","status":"active","environment":"production","last_occurrence_timestamp":1700000000},`+
+				`{"id":2,"counter":12,"title":"NoMethodError","status":"active","environment":"production","last_occurrence_timestamp":1700000100},`+
+				`{"id":3,"counter":13,"title":"KeyError","status":"active","environment":"production","last_occurrence_timestamp":1700000200}]}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "search", "timeout")
+	out := stdout.String()
+	for _, want := range []string{"Read timeout", "NoMethodError"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output, got %q", want, out)
+		}
+	}
+	if strings.Contains(out, "KeyError") {
+		t.Fatalf("unexpected unmatched issue in output: %q", out)
+	}
+}
+
+func TestSearchCommandRegexSkipsRQL(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/items" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[`+
+			`{"id":1,"counter":11,"title":"Read timeout","status":"active","environment":"production"},`+
+			`{"id":3,"counter":13,"title":"KeyError","status":"active","environment":"production"}]}}`)
+	}))
+
+	runRootCommand(t, "search", "--regex", "^Key", "--format", "json")
+	out := stdout.String()
+	if !strings.Contains(out, "KeyError") || strings.Contains(out, "Read timeout") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
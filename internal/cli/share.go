@@ -0,0 +1,208 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+const (
+	shareTokenEnv        = "ROLLBAZ_SHARE_TOKEN"
+	maxShareResponseSize = 64 << 10
+)
+
+var shareHTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+type shareOptions struct {
+	as        string
+	out       string
+	expires   string
+	trendDays int
+	upload    bool
+}
+
+func newShareCmd(flags *rootFlags) *cobra.Command {
+	options := shareOptions{}
+	match := ""
+	shareCmd := &cobra.Command{
+		Use:   "share <item-counter>",
+		Short: "Bundle an issue's detail, latest occurrence, and trend into a shareable document",
+		Args:  itemArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			counter, err := resolveItemArg(cmd.Context(), *flags, args, match)
+			if err != nil {
+				return err
+			}
+			return runShare(cmd.Context(), *flags, counter, options)
+		},
+	}
+	addMatchFlag(shareCmd, &match)
+	shareCmd.Flags().StringVar(&options.as, "as", "markdown", "Bundle format: markdown or html")
+	shareCmd.Flags().StringVar(&options.out, "out", "", "Write the bundle to this file instead of stdout")
+	shareCmd.Flags().StringVar(&options.expires, "expires", "7d", "How long the snapshot stays valid, e.g. 72h or 7d")
+	shareCmd.Flags().IntVar(&options.trendDays, "trend-days", 14, "Days of daily occurrence counts to include")
+	shareCmd.Flags().BoolVar(&options.upload, "upload", false, "Upload the bundle to the configured share_endpoint and print its URL")
+
+	return shareCmd
+}
+
+func runShare(parent context.Context, flags rootFlags, counter domain.ItemCounter, options shareOptions) error {
+	if options.as != "markdown" && options.as != "html" {
+		return fmt.Errorf("unsupported --as %q: use markdown or html", options.as)
+	}
+	ttl, err := parseAge(options.expires)
+	if err != nil || ttl == nil || *ttl <= 0 {
+		return fmt.Errorf("invalid --expires %q", options.expires)
+	}
+	if options.upload {
+		if err := validateShareEndpoint(flags.ShareEndpoint); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	bundle, token, err := runServiceOperation(parent, flags, "Building share bundle", func(service *app.Service) (app.ShareBundle, error) {
+		return service.ShareBundle(ctx, counter, options.trendDays, *ttl)
+	})
+	if err != nil {
+		return err
+	}
+	if bundle, err = anonymized(flags, redactShareBundle(bundle, token)); err != nil {
+		return err
+	}
+
+	document, contentType, err := renderShareBundle(bundle, options.as)
+	if err != nil {
+		return err
+	}
+	document = redact.String(document, token)
+
+	result := map[string]any{"expires_at": bundle.ExpiresAt.Format(time.RFC3339)}
+	lines := []string{}
+	if options.out != "" {
+		if err := os.WriteFile(options.out, []byte(document), 0o600); err != nil {
+			return fmt.Errorf("write share bundle: %w", err)
+		}
+		result["path"] = options.out
+		lines = append(lines, "wrote "+options.out)
+	}
+	if options.upload {
+		link, err := uploadShareBundle(ctx, flags.ShareEndpoint, contentType, document, bundle.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		result["url"] = link
+		lines = append(lines, terminalLink(link))
+	}
+	if len(lines) == 0 {
+		if !isHumanFormat(flags.Format) {
+			return printOutput(flags.Format, "", redact.Value(map[string]any{"share": bundle}, token))
+		}
+		_, _ = fmt.Fprint(stdoutWriter, document)
+		return nil
+	}
+
+	lines = append(lines, "expires "+bundle.ExpiresAt.Format(time.RFC3339))
+	return printOutput(flags.Format, strings.Join(lines, "\n"), result)
+}
+
+func redactShareBundle(bundle app.ShareBundle, token string) app.ShareBundle {
+	bundle.Issue = bundle.Issue.WithoutRaw()
+	bundle.Issue.Title = redact.String(bundle.Issue.Title, token)
+	bundle.Issue.MainError = redact.String(bundle.Issue.MainError, token)
+	if bundle.Issue.Instance != nil {
+		instance := *bundle.Issue.Instance
+		instance.Body = redact.RawJSON(instance.Body, token)
+		instance.Data = redact.RawJSON(instance.Data, token)
+		bundle.Issue.Instance = &instance
+	}
+
+	return bundle
+}
+
+func renderShareBundle(bundle app.ShareBundle, format string) (string, string, error) {
+	if format == "html" {
+		document, err := output.RenderShareHTML(bundle)
+		return document, "text/html; charset=utf-8", err
+	}
+
+	return output.RenderShareMarkdown(bundle), "text/markdown; charset=utf-8", nil
+}
+
+func validateShareEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errors.New("--upload needs a share_endpoint in the config file")
+	}
+
+	return validateOutboundURL("share_endpoint", endpoint)
+}
+
+func validateOutboundURL(setting string, endpoint string) error {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("invalid %s %q", setting, endpoint)
+	}
+	if parsed.Scheme != "https" && (parsed.Scheme != "http" || !isLoopbackHost(parsed.Hostname())) {
+		return fmt.Errorf("%s must use https, got %q", setting, endpoint)
+	}
+
+	return nil
+}
+
+func uploadShareBundle(ctx context.Context, endpoint string, contentType string, document string, expiresAt time.Time) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(document))
+	if err != nil {
+		return "", fmt.Errorf("build share upload: %w", err)
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("Expires", expiresAt.UTC().Format(http.TimeFormat))
+	if token := os.Getenv(shareTokenEnv); token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := shareHTTPClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("upload share bundle: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return "", fmt.Errorf("upload share bundle: endpoint returned HTTP %d", response.StatusCode)
+	}
+	if location := response.Header.Get("Location"); location != "" {
+		return location, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxShareResponseSize))
+	if err != nil {
+		return "", fmt.Errorf("read share upload response: %w", err)
+	}
+	var decoded struct {
+		URL string `json:"url"`
+	}
+	link := strings.TrimSpace(string(body))
+	if json.Unmarshal(body, &decoded) == nil && decoded.URL != "" {
+		link = decoded.URL
+	}
+	if !strings.HasPrefix(link, "https://") && !strings.HasPrefix(link, "http://") {
+		return "", errors.New("upload share bundle: endpoint response did not include a URL")
+	}
+
+	return link, nil
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+func newShareHandler(t *testing.T) http.Handler {
+	t.Helper()
+	issue := newSuccessHandler(t)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/reports/occurrence_counts" {
+			if r.URL.Query().Get("item_id") != "1755568172" || r.URL.Query().Get("bucket_size") != "86400" {
+				t.Fatalf("unexpected occurrence counts query: %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[[1709164800,3],[1709251200,4]]}`)
+			return
+		}
+		issue.ServeHTTP(w, r)
+	})
+}
+
+func TestShareCommandPrintsMarkdown(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, newShareHandler(t))
+
+	runRootCommand(t, "share", "269")
+
+	for _, want := range []string{"# [#269] RST_STREAM", "| Occurrences | 7 |", "ABORTED", "## Trend (last 14 days)", "| 2024-03-01 | 4 |"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout.String())
+		}
+	}
+}
+
+func TestShareCommandWritesHTMLFile(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, newShareHandler(t))
+	path := filepath.Join(t.TempDir(), "issue.html")
+
+	runRootCommand(t, "share", "269", "--as", "html", "--out", path, "--expires", "72h")
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if !strings.Contains(string(content), "<h1>[#269] RST_STREAM</h1>") {
+		t.Fatalf("unexpected html: %s", content)
+	}
+	if !strings.Contains(stdout.String(), "wrote "+path) || !strings.Contains(stdout.String(), "expires ") {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+}
+
+func TestShareCommandUploadsBundle(t *testing.T) {
+	tests := []struct {
+		name     string
+		respond  func(w http.ResponseWriter)
+		wantLink string
+	}{
+		{
+			name: "location header",
+			respond: func(w http.ResponseWriter) {
+				w.Header().Set("Location", "https://paste.example/a")
+				w.WriteHeader(http.StatusCreated)
+			},
+			wantLink: "https://paste.example/a",
+		},
+		{
+			name:     "json body",
+			respond:  func(w http.ResponseWriter) { _, _ = fmt.Fprint(w, `{"url":"https://paste.example/b"}`) },
+			wantLink: "https://paste.example/b",
+		},
+		{
+			name:     "bare body",
+			respond:  func(w http.ResponseWriter) { _, _ = fmt.Fprint(w, "https://paste.example/c\n") },
+			wantLink: "https://paste.example/c",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(shareTokenEnv, "paste-secret")
+			uploads := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer paste-secret" || r.Header.Get("Expires") == "" {
+					t.Fatalf("unexpected upload request: %s %v", r.Method, r.Header)
+				}
+				if !strings.HasPrefix(r.Header.Get("Content-Type"), "text/markdown") || !strings.Contains(string(body), "RST_STREAM") {
+					t.Fatalf("unexpected upload body: %s", body)
+				}
+				tt.respond(w)
+			}))
+			t.Cleanup(uploads.Close)
+			if err := setupProjectStore(t).Save(config.File{ShareEndpoint: uploads.URL}); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			stdout := setupServerAndStdout(t, newShareHandler(t))
+
+			runRootCommand(t, "share", "269", "--upload", "--format", "json")
+
+			if !strings.Contains(stdout.String(), `"url": "`+tt.wantLink+`"`) {
+				t.Fatalf("expected link %q in output, got %q", tt.wantLink, stdout.String())
+			}
+		})
+	}
+}
+
+func TestShareCommandRejectsInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		args     []string
+		wantErr  string
+	}{
+		{name: "format", args: []string{"--as", "pdf"}, wantErr: "unsupported --as"},
+		{name: "expires", args: []string{"--expires", "soon"}, wantErr: "invalid --expires"},
+		{name: "missing endpoint", args: []string{"--upload"}, wantErr: "needs a share_endpoint"},
+		{name: "plain http endpoint", endpoint: "http://paste.example", args: []string{"--upload"}, wantErr: "must use https"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := setupProjectStore(t).Save(config.File{ShareEndpoint: tt.endpoint}); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+			cmd := NewRootCmd()
+			cmd.SetArgs(append([]string{"share", "269"}, tt.args...))
+			err := cmd.Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected %q error, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
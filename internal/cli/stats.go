@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+var defaultStatsWindows = map[string]time.Duration{
+	"hour": 24 * time.Hour,
+	"day":  30 * 24 * time.Hour,
+}
+
+func newStatsCmd(flags *rootFlags) *cobra.Command {
+	bucket := "hour"
+	match := ""
+	statsCmd := &cobra.Command{
+		Use:   "stats <item-counter>",
+		Short: "Chart an issue's occurrences per hour or day",
+		Long: "Chart an issue's occurrences per hour or day as a sparkline and histogram, from Rollbar's\n" +
+			"occurrence counts. --since sets the window, 24h by hour and 30d by day unless given;\n" +
+			"--format json prints the series for dashboards.",
+		Args: itemArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			counter, err := resolveItemArg(cmd.Context(), *flags, args, match)
+			if err != nil {
+				return err
+			}
+			return runStats(cmd.Context(), *flags, counter, bucket)
+		},
+	}
+	addMatchFlag(statsCmd, &match)
+	statsCmd.Flags().StringVar(&bucket, "by", bucket, "Bucket size: hour or day")
+
+	return statsCmd
+}
+
+func runStats(parent context.Context, flags rootFlags, counter domain.ItemCounter, bucket string) error {
+	window, ok := defaultStatsWindows[bucket]
+	if !ok {
+		return fmt.Errorf("unsupported --by %q: use hour or day", bucket)
+	}
+	now := time.Now()
+	since, err := parseFindSince(flags.Since, now)
+	if err != nil {
+		return fmt.Errorf("parse --since: %w", err)
+	}
+	if since != nil {
+		window = now.Sub(*since)
+	}
+
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	stats, token, err := runServiceOperation(parent, flags, "Fetching occurrence counts", func(service *app.Service) (app.ItemStats, error) {
+		return service.ItemStats(ctx, counter, bucket, window)
+	})
+	if err != nil {
+		return err
+	}
+	rememberLast(token, counter)
+
+	return printOutput(flags.Format, output.RenderItemStats(stats), redact.Value(map[string]any{"stats": stats}, token))
+}
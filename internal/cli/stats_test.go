@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStatsCommand(t *testing.T) {
+	issue := newSuccessHandler(t)
+	today := time.Now().UTC().Truncate(24 * time.Hour).Unix()
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/1/reports/occurrence_counts" {
+			if r.URL.Query().Get("item_id") != "1755568172" || r.URL.Query().Get("bucket_size") != "86400" {
+				t.Fatalf("unexpected occurrence counts query: %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":[[%d,7]]}`, today)
+			return
+		}
+		issue.ServeHTTP(w, r)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "stats", "269", "--by", "day", "--since", "7d", "--format", "json")
+	var payload struct {
+		Stats struct {
+			Bucket string `json:"bucket"`
+			Total  uint64 `json:"total"`
+			Series []struct {
+				Count uint64 `json:"count"`
+			} `json:"series"`
+		} `json:"stats"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("decode stats: %v\n%s", err, stdout.String())
+	}
+	if payload.Stats.Bucket != "day" || payload.Stats.Total != 7 || len(payload.Stats.Series) != 7 || payload.Stats.Series[6].Count != 7 {
+		t.Fatalf("unexpected stats payload: %s", stdout.String())
+	}
+}
+
+func TestStatsCommandRejectsBucket(t *testing.T) {
+	setNoConfigStore(t)
+	setupStdout(t)
+
+	err := runStats(t.Context(), rootFlags{}, 269, "minute")
+	if err == nil || !strings.Contains(err.Error(), "use hour or day") {
+		t.Fatalf("expected bucket error, got %v", err)
+	}
+}
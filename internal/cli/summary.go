@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newSummaryCmd(flags *rootFlags) *cobra.Command {
+	allProjects := false
+	summaryCmd := &cobra.Command{
+		Use:   "summary",
+		Short: "One-screen overview of active issues, 24h occurrences, top items, and reactivations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSummary(cmd.Context(), *flags, allProjects)
+		},
+	}
+	summaryCmd.Flags().BoolVar(&allProjects, "all-projects", false, "Summarize every configured project")
+
+	return summaryCmd
+}
+
+type projectSummary struct {
+	Project  string              `json:"project,omitempty"`
+	Identity *projectIdentity    `json:"identity,omitempty"`
+	Summary  app.ProjectOverview `json:"summary"`
+}
+
+type projectIdentity struct {
+	ProjectID   domain.ProjectID   `json:"project_id"`
+	ProjectName domain.ProjectSlug `json:"project_name"`
+	AccountID   uint64             `json:"account_id,omitempty"`
+	AccountSlug string             `json:"account_slug,omitempty"`
+}
+
+func runSummary(parent context.Context, flags rootFlags, allProjects bool) error {
+	projects, err := summaryProjects(flags, allProjects)
+	if err != nil {
+		return err
+	}
+
+	summaries := make([]projectSummary, 0, len(projects))
+	sections := make([]string, 0, len(projects))
+	tokens := make([]string, 0, len(projects))
+	for _, project := range projects {
+		projectFlags := flags
+		if project != "" {
+			projectFlags.Project = project
+		}
+		summary, token, err := loadProjectSummary(parent, projectFlags, project)
+		if err != nil {
+			return err
+		}
+		if allProjects {
+			summary.Identity = resolveProjectIdentity(parent, projectFlags, project, summary.Summary.ProjectID)
+		}
+		summaries = append(summaries, summary)
+		sections = append(sections, output.RenderProjectOverview(summary.label(), summary.Summary, terminalRenderWidth()))
+		tokens = append(tokens, token)
+	}
+
+	human := strings.Join(sections, "\n\n")
+	var payload any = map[string]any{"projects": summaries}
+	for _, token := range tokens {
+		human = redact.String(human, token)
+		payload = redact.Value(payload, token)
+	}
+
+	return printOutput(flags.Format, human, payload)
+}
+
+func summaryProjects(flags rootFlags, allProjects bool) ([]string, error) {
+	if !allProjects {
+		return []string{activeProjectName(flags)}, nil
+	}
+	if flags.Project != "" {
+		return nil, errors.New("cannot use --all-projects with --project")
+	}
+	store, err := newConfigStore()
+	if err != nil {
+		return nil, err
+	}
+	file, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if len(file.Projects) == 0 {
+		return nil, errors.New("no configured projects; add one with `rollbaz project add`")
+	}
+
+	names := make([]string, 0, len(file.Projects))
+	for _, project := range file.Projects {
+		names = append(names, project.Name)
+	}
+
+	return names, nil
+}
+
+func activeProjectName(flags rootFlags) string {
+	if flags.Token != "" {
+		return ""
+	}
+	store, err := newConfigStore()
+	if err != nil {
+		return ""
+	}
+	project, err := store.ResolveProject(flags.Project)
+	if err != nil {
+		return ""
+	}
+
+	return project.Name
+}
+
+func loadProjectSummary(parent context.Context, flags rootFlags, project string) (projectSummary, string, error) {
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	overview, token, err := runServiceOperation(parent, flags, "Summarizing project", func(service *app.Service) (app.ProjectOverview, error) {
+		return service.ProjectOverview(ctx)
+	})
+	if err != nil {
+		if project != "" {
+			return projectSummary{}, token, fmt.Errorf("summarize %s: %w", project, err)
+		}
+		return projectSummary{}, token, err
+	}
+	if overview, err = anonymized(flags, overview); err != nil {
+		return projectSummary{}, token, err
+	}
+
+	return projectSummary{Project: project, Summary: overview}, token, nil
+}
+
+func resolveProjectIdentity(parent context.Context, flags rootFlags, project string, projectID domain.ProjectID) *projectIdentity {
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	metadata, err := cachedProjectMetadata(ctx, flags, projectID)
+	if err != nil {
+		addWarnings(app.Warning{Code: app.WarningEnrichmentSkipped, Message: fmt.Sprintf("could not resolve the Rollbar project name for %s: %v", project, err)})
+		return nil
+	}
+
+	return &projectIdentity{
+		ProjectID:   metadata.ProjectID,
+		ProjectName: metadata.ProjectSlug,
+		AccountID:   metadata.AccountID,
+		AccountSlug: metadata.AccountSlug,
+	}
+}
+
+func (s projectSummary) label() string {
+	if s.Identity == nil || s.Identity.ProjectName == "" || s.Identity.ProjectName.String() == s.Project {
+		return s.Project
+	}
+
+	return fmt.Sprintf("%s (%s)", s.Project, s.Identity.ProjectName)
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func newSummaryHandler(t *testing.T) http.Handler {
+	t.Helper()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			if r.URL.Query().Get("status") != "active" {
+				t.Fatalf("unexpected items query: %s", r.URL.RawQuery)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":7,"title":"fresh","status":"active","first_occurrence_timestamp":1700000000}]}}`)
+		case "/api/1/reports/top_active_items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"item":{"id":1,"counter":7,"title":"fresh","occurrences":12}}]}`)
+		case "/api/1/reports/occurrence_counts":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[[1700000000,30],[1700003600,12]]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+}
+
+func TestSummaryCommand(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, newSummaryHandler(t))
+
+	runRootCommand(t, "summary")
+	for _, want := range []string{"1 active · 42 occurrences/24h · 0 reactivated/24h", "Newest  #7", "Top active", "#7      12x"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in summary, got %q", want, stdout.String())
+		}
+	}
+}
+
+func TestSummaryCommandAllProjects(t *testing.T) {
+	store := setupProjectStore(t)
+	for _, name := range []string{"alpha", "beta"} {
+		if err := store.AddProject(name, "token-"+name); err != nil {
+			t.Fatalf("AddProject() error = %v", err)
+		}
+	}
+	setupMetadataCache(t)
+	stdout := setupServerAndStdout(t, newSummaryHandler(t))
+
+	runRootCommand(t, "summary", "--all-projects", "--format", "json")
+	for _, want := range []string{`"project": "alpha"`, `"project": "beta"`, `"occurrences_24h": 42`} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in summary, got %q", want, stdout.String())
+		}
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"summary", "--all-projects", "--project", "alpha"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "cannot use --all-projects") {
+		t.Fatalf("expected --project conflict error, got %v", err)
+	}
+}
+
+func TestSummaryCommandAllProjectsResolvesProjectNames(t *testing.T) {
+	store := setupProjectStore(t)
+	if err := store.AddProject("prod", "token-prod"); err != nil {
+		t.Fatalf("AddProject() error = %v", err)
+	}
+	setupMetadataCache(t)
+	var projectRequests atomic.Int32
+	summary := newSummaryHandler(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/reports/top_active_items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"item":{"id":1,"project_id":42,"counter":7,"title":"fresh","occurrences":12}}]}`)
+		case "/api/1/project/42":
+			projectRequests.Add(1)
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":42,"name":"checkout-api","account_id":7,"account_slug":"acme"}}`)
+		default:
+			summary.ServeHTTP(w, r)
+		}
+	}))
+
+	runRootCommand(t, "summary", "--all-projects", "--format", "json")
+	for _, want := range []string{`"project": "prod"`, `"project_id": 42`, `"project_name": "checkout-api"`, `"account_slug": "acme"`} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in summary, got %q", want, stdout.String())
+		}
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "summary", "--all-projects")
+	if !strings.Contains(stdout.String(), "prod (checkout-api) · 1 active") {
+		t.Fatalf("expected canonical name in headline, got %q", stdout.String())
+	}
+	if projectRequests.Load() != 1 {
+		t.Fatalf("expected project to be fetched once, got %d", projectRequests.Load())
+	}
+}
+
+func TestProjectSummaryLabel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		summary projectSummary
+		want    string
+	}{
+		{name: "unresolved", summary: projectSummary{Project: "prod"}, want: "prod"},
+		{name: "same name", summary: projectSummary{Project: "checkout", Identity: &projectIdentity{ProjectName: "checkout"}}, want: "checkout"},
+		{name: "alias", summary: projectSummary{Project: "prod", Identity: &projectIdentity{ProjectName: "checkout"}}, want: "prod (checkout)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.summary.label(); got != tt.want {
+				t.Fatalf("label() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+var newCursorStore = config.NewCursorStore
+
+func newSyncCmd(flags *rootFlags) *cobra.Command {
+	reset := false
+	syncCmd := &cobra.Command{
+		Use:   "sync",
+		Short: "List issues seen since the last sync for this project",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd.Context(), *flags, reset)
+		},
+	}
+	syncCmd.Flags().BoolVar(&reset, "reset", false, "Forget the saved cursor and sync from scratch")
+
+	return syncCmd
+}
+
+func runSync(parent context.Context, flags rootFlags, reset bool) error {
+	ctx, cancel := commandContext(parent, flags, 30*time.Second)
+	defer cancel()
+
+	options, err := parseIssueListOptions(flags)
+	if err != nil {
+		return err
+	}
+	store, err := newCursorStore()
+	if err != nil {
+		return fmt.Errorf("open sync cursors: %w", err)
+	}
+	key, since, err := loadSyncCursor(flags, store, reset)
+	if err != nil {
+		return err
+	}
+
+	var now time.Time
+	result, token, err := runServiceOperation(parent, flags, "Syncing issues", func(service *app.Service) (app.SyncResult, error) {
+		now = service.Now()
+		result, err := service.SyncSince(ctx, since, options.filters)
+		if err != nil {
+			return app.SyncResult{}, err
+		}
+		result.Issues, err = service.AnnotateTrends(ctx, result.Issues, options.trendVs)
+		return result, err
+	})
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		key = syncCursorKey(flags, token)
+	}
+	if err := store.Save(key, config.SyncCursor{LastOccurrence: result.Cursor, UpdatedAt: time.Now().UTC()}); err != nil {
+		return fmt.Errorf("save sync cursor: %w", err)
+	}
+	_ = app.SortIssues(result.Issues, flags.Sort)
+
+	human := "no new occurrences since last sync"
+	if len(result.Issues) > 0 {
+		human = renderIssueList(flags, result.Issues, options.columns, now)
+	}
+	payload := redact.Value(map[string]any{"since": result.Since, "cursor": result.Cursor, "issues": listPayload(flags, result.Issues)}, token)
+
+	return printOutput(flags.Format, human, payload)
+}
+
+// The key is returned so the cursor is saved under it even when a fallback
+// token answers.
+func loadSyncCursor(flags rootFlags, store *config.CursorStore, reset bool) (string, uint64, error) {
+	token, err := resolveAccessToken(flags)
+	if err != nil {
+		return "", 0, nil
+	}
+	key := syncCursorKey(flags, token)
+	if reset {
+		if err := store.Reset(key); err != nil {
+			return "", 0, fmt.Errorf("reset sync cursor: %w", err)
+		}
+		return key, 0, nil
+	}
+
+	cursor, err := store.Get(key)
+	if err != nil {
+		return "", 0, fmt.Errorf("load sync cursor: %w", err)
+	}
+
+	return key, cursor.LastOccurrence, nil
+}
+
+func syncCursorKey(flags rootFlags, token string) string {
+	filters := make([]string, 0, 12)
+	for _, filter := range []struct{ name, value string }{
+		{"env", flags.Environment},
+		{"status", flags.Status},
+		{"since", flags.Since},
+		{"until", flags.Until},
+		{"min-occurrences", flags.MinOccurrences},
+		{"max-occurrences", flags.MaxOccurrences},
+		{"min-age", flags.MinAge},
+		{"max-age", flags.MaxAge},
+		{"level", flags.Level},
+		{"preset", flags.Preset},
+	} {
+		if value := strings.ToLower(strings.TrimSpace(filter.value)); value != "" {
+			filters = append(filters, filter.name+"="+value)
+		}
+	}
+	if !flags.AllEnvs && len(flags.HiddenEnvironments) > 0 {
+		hidden := slices.Clone(flags.HiddenEnvironments)
+		slices.Sort(hidden)
+		filters = append(filters, "hidden="+strings.Join(hidden, ","))
+	}
+	if len(filters) == 0 {
+		return tokenFingerprint(token)
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(filters, "\n")))
+
+	return tokenFingerprint(token) + "/" + hex.EncodeToString(sum[:8])
+}
+
+func tokenFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:8])
+}
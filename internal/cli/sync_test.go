@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+)
+
+func setupCursorStore(t *testing.T) *config.CursorStore {
+	t.Helper()
+	store := config.NewCursorStoreAtPath(filepath.Join(t.TempDir(), "sync.json"))
+	original := newCursorStore
+	newCursorStore = func() (*config.CursorStore, error) {
+		return store, nil
+	}
+	t.Cleanup(func() {
+		newCursorStore = original
+	})
+
+	return store
+}
+
+func TestSyncRemembersCursor(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+			{"id":1,"counter":1,"title":"older","status":"active","last_occurrence_timestamp":100},
+			{"id":2,"counter":2,"title":"newer","status":"resolved","last_occurrence_timestamp":200}
+		]}}`)
+	}))
+	setNoConfigStore(t)
+	store := setupCursorStore(t)
+
+	runRootCommand(t, "sync")
+	if got := stdout.String(); !strings.Contains(got, "older") || !strings.Contains(got, "newer") {
+		t.Fatalf("expected the first sync to list every issue, got %q", got)
+	}
+	if cursor, _ := store.Get(tokenFingerprint("token")); cursor.LastOccurrence != 200 {
+		t.Fatalf("expected cursor 200, got %+v", cursor)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "sync")
+	if got := stdout.String(); !strings.Contains(got, "no new occurrences since last sync") {
+		t.Fatalf("expected no new issues, got %q", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "sync", "--reset", "--format", "json")
+	if got := stdout.String(); !strings.Contains(got, `"since": 0`) || !strings.Contains(got, `"cursor": 200`) || !strings.Contains(got, "older") {
+		t.Fatalf("unexpected reset sync json: %q", got)
+	}
+}
+
+func TestSyncErrors(t *testing.T) {
+	setNoConfigStore(t)
+	setupCursorStore(t)
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "token")
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"sync", "--since", "nope"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("expected filter error")
+	}
+
+	blocked := config.NewCursorStoreAtPath(t.TempDir())
+	for _, reset := range []bool{false, true} {
+		if _, _, err := loadSyncCursor(rootFlags{}, blocked, reset); err == nil {
+			t.Fatalf("expected cursor error for reset=%v", reset)
+		}
+	}
+}
+
+func TestSyncCursorPerFilterSet(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+			{"id":1,"counter":1,"title":"prod error","status":"active","environment":"production","last_occurrence_timestamp":100},
+			{"id":2,"counter":2,"title":"staging error","status":"active","environment":"staging","last_occurrence_timestamp":200}
+		]}}`)
+	}))
+	setNoConfigStore(t)
+	store := setupCursorStore(t)
+
+	runRootCommand(t, "sync", "--env", "production")
+	stdout.Reset()
+	runRootCommand(t, "sync")
+	if !strings.Contains(stdout.String(), "staging error") {
+		t.Fatalf("expected a filtered sync to leave the unfiltered cursor alone, got %q", stdout.String())
+	}
+
+	filtered := syncCursorKey(rootFlags{Environment: " Production "}, "token")
+	if filtered == tokenFingerprint("token") || filtered != syncCursorKey(rootFlags{Environment: "production"}, "token") {
+		t.Fatalf("expected a normalized per-filter key, got %q", filtered)
+	}
+	if cursor, _ := store.Get(filtered); cursor.LastOccurrence != 200 {
+		t.Fatalf("expected the filtered cursor at 200, got %+v", cursor)
+	}
+}
+
+func TestSyncCursorKeyFollowsLoadedToken(t *testing.T) {
+	setupServerAndStdout(t, newTokenCheckingHandler("token"))
+	setupStderr(t)
+	setStoredProjectToken(t, "stale")
+	store := setupCursorStore(t)
+
+	runRootCommand(t, "sync")
+	runRootCommand(t, "sync")
+
+	if cursor, _ := store.Get(tokenFingerprint("token")); !cursor.UpdatedAt.IsZero() {
+		t.Fatalf("expected no cursor under the fallback token, got %+v", cursor)
+	}
+	if cursor, _ := store.Get(tokenFingerprint("stale")); cursor.UpdatedAt.IsZero() {
+		t.Fatalf("expected the cursor under the key it was loaded from")
+	}
+}
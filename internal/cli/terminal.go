@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"os"
+
+	prettytext "github.com/jedib0t/go-pretty/v6/text"
+)
+
+// Windows consoles only interpret ANSI sequences once virtual terminal
+// processing is enabled.
+var (
+	enableVirtualTerminal = enableVirtualTerminalProcessing
+	ansiOutput            = true
+)
+
+func configureTerminal() func() {
+	file, ok := stdoutFile()
+	if !ok || !isTerminal(int(file.Fd())) {
+		return func() {}
+	}
+
+	restore, enabled := enableVirtualTerminal(file.Fd())
+	ansiOutput = enabled && os.Getenv("TERM") != "dumb"
+	if !ansiOutput {
+		prettytext.DisableColors()
+	}
+
+	return restore
+}
+
+func shouldUseColor(format string) bool {
+	return shouldRenderProgress(format) && os.Getenv("NO_COLOR") == ""
+}
+
+func terminalLink(url string) string {
+	file, ok := stdoutFile()
+	if !ok || !isTerminal(int(file.Fd())) || !supportsHyperlinks() {
+		return url
+	}
+
+	return prettytext.Hyperlink(url, url)
+}
+
+func supportsHyperlinks() bool {
+	if !ansiOutput {
+		return false
+	}
+	if os.Getenv("WT_SESSION") != "" || os.Getenv("VTE_VERSION") != "" {
+		return true
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty":
+		return true
+	}
+
+	return false
+}
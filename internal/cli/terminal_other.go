@@ -0,0 +1,7 @@
+//go:build !windows
+
+package cli
+
+func enableVirtualTerminalProcessing(uintptr) (func(), bool) {
+	return func() {}, true
+}
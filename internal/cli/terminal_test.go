@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func setFakeTerminal(t *testing.T, vtEnabled bool) *int {
+	t.Helper()
+
+	originalStdout := stdoutWriter
+	originalIsTerminal := isTerminal
+	originalEnable := enableVirtualTerminal
+	originalANSI := ansiOutput
+	restored := 0
+	stdoutWriter = os.Stdout
+	isTerminal = func(int) bool { return true }
+	enableVirtualTerminal = func(uintptr) (func(), bool) {
+		return func() { restored++ }, vtEnabled
+	}
+	t.Cleanup(func() {
+		stdoutWriter = originalStdout
+		isTerminal = originalIsTerminal
+		enableVirtualTerminal = originalEnable
+		ansiOutput = originalANSI
+	})
+	t.Setenv("CI", "")
+	t.Setenv("TERM", "xterm-256color")
+
+	return &restored
+}
+
+func TestConfigureTerminalDisablesProgressWithoutVT(t *testing.T) {
+	restored := setFakeTerminal(t, false)
+
+	configureTerminal()()
+	if ansiOutput || shouldRenderProgress("human") || shouldUseColor("human") {
+		t.Fatalf("expected static output when VT processing is unavailable")
+	}
+	if *restored != 1 {
+		t.Fatalf("restore calls = %d", *restored)
+	}
+}
+
+func TestConfigureTerminalKeepsProgressWithVT(t *testing.T) {
+	setFakeTerminal(t, true)
+
+	configureTerminal()()
+	if !ansiOutput || !shouldRenderProgress("human") {
+		t.Fatalf("expected ANSI output when VT processing is enabled")
+	}
+
+	t.Setenv("TERM", "dumb")
+	configureTerminal()()
+	if ansiOutput {
+		t.Fatalf("expected TERM=dumb to disable ANSI output")
+	}
+}
+
+func TestConfigureTerminalSkipsRedirectedOutput(t *testing.T) {
+	restored := setFakeTerminal(t, false)
+	stdoutWriter = io.Discard
+
+	configureTerminal()()
+	if !ansiOutput || *restored != 0 {
+		t.Fatalf("expected redirected output to leave terminal state alone")
+	}
+}
+
+func TestShouldUseColorHonorsNoColor(t *testing.T) {
+	setFakeTerminal(t, true)
+	t.Setenv("NO_COLOR", "")
+	if !shouldUseColor("human") {
+		t.Fatalf("expected color for interactive human output")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if shouldUseColor("human") {
+		t.Fatalf("expected NO_COLOR to disable color")
+	}
+}
+
+func TestTerminalLink(t *testing.T) {
+	setFakeTerminal(t, true)
+	t.Setenv("TERM_PROGRAM", "")
+	t.Setenv("VTE_VERSION", "")
+	const url = "https://rollbar.com/item/1"
+
+	t.Setenv("WT_SESSION", "")
+	if got := terminalLink(url); got != url {
+		t.Fatalf("terminalLink(conhost) = %q", got)
+	}
+
+	t.Setenv("WT_SESSION", "abc")
+	if got := terminalLink(url); !strings.HasPrefix(got, "\x1b]8;;"+url) || !strings.Contains(got, "\\"+url+"\x1b]8;;") {
+		t.Fatalf("terminalLink(windows terminal) = %q", got)
+	}
+
+	ansiOutput = false
+	if got := terminalLink(url); got != url {
+		t.Fatalf("terminalLink(no ansi) = %q", got)
+	}
+
+	ansiOutput = true
+	stdoutWriter = io.Discard
+	if got := terminalLink(url); got != url {
+		t.Fatalf("terminalLink(redirected) = %q", got)
+	}
+}
@@ -0,0 +1,35 @@
+//go:build windows
+
+package cli
+
+import "golang.org/x/sys/windows"
+
+const utf8CodePage = 65001
+
+func enableVirtualTerminalProcessing(fd uintptr) (func(), bool) {
+	handle := windows.Handle(fd)
+	var mode uint32
+	if err := windows.GetConsoleMode(handle, &mode); err != nil {
+		return func() {}, false
+	}
+
+	codePage, cpErr := windows.GetConsoleOutputCP()
+	if cpErr == nil && codePage != utf8CodePage {
+		_ = windows.SetConsoleOutputCP(utf8CodePage)
+	}
+	restore := func() {
+		_ = windows.SetConsoleMode(handle, mode)
+		if cpErr == nil && codePage != utf8CodePage {
+			_ = windows.SetConsoleOutputCP(codePage)
+		}
+	}
+
+	if mode&windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING != 0 {
+		return restore, true
+	}
+	if err := windows.SetConsoleMode(handle, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING); err != nil {
+		return restore, false
+	}
+
+	return restore, true
+}
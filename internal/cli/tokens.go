@@ -0,0 +1,260 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const envTokenSource = "ROLLBAR_ACCESS_TOKEN"
+
+type tokenCandidate struct {
+	token   string
+	source  string
+	project string
+	baseURL string
+	headers map[string]string
+}
+
+func resolveAccessToken(flags rootFlags) (string, error) {
+	candidates, err := resolveTokenCandidates(flags)
+	if err != nil {
+		return "", err
+	}
+
+	return candidates[0].token, nil
+}
+
+func resolveTokenCandidates(flags rootFlags) ([]tokenCandidate, error) {
+	if flags.Token != "" {
+		return []tokenCandidate{{token: flags.Token, source: "--token"}}, nil
+	}
+
+	candidates := make([]tokenCandidate, 0, 2)
+	store, err := newConfigStore()
+	if err == nil {
+		project, resolveErr := store.ResolveProject(flags.Project)
+		if resolveErr == nil {
+			candidates = append(candidates, tokenCandidate{
+				token:   project.Token,
+				source:  fmt.Sprintf("project %q", project.Name),
+				project: project.Name,
+				baseURL: project.BaseURL,
+				headers: project.Headers,
+			})
+		}
+	}
+
+	envToken := os.Getenv(envTokenSource)
+	if envToken != "" && (len(candidates) == 0 || candidates[0].token != envToken) {
+		candidates = append(candidates, tokenCandidate{token: envToken, source: envTokenSource})
+	}
+
+	if len(candidates) == 0 {
+		if flags.Project != "" {
+			return nil, fmt.Errorf("project %q not configured and ROLLBAR_ACCESS_TOKEN is missing", flags.Project)
+		}
+		return nil, errNoToken
+	}
+
+	return candidates, nil
+}
+
+// runServiceOperation takes the command's context rather than its API
+// timeout, since onboarding may wait on the user first.
+func runServiceOperation[T any](ctx context.Context, flags rootFlags, message string, operation func(*app.Service) (T, error)) (T, string, error) {
+	var zero T
+	rollbar.SetDefaultRequestRate(flags.MaxRPS)
+	budget, err := parseRequestBudget(flags.MaxRequests)
+	if err != nil {
+		return zero, "", err
+	}
+	rollbar.SetDefaultRequestBudget(budget)
+	perMinute, err := parseRequestsPerMinute(flags.MaxPerMinute)
+	if err != nil {
+		return zero, "", err
+	}
+	rollbar.SetDefaultRequestsPerMinute(perMinute)
+	proxyURL, err := rollbar.ParseProxyURL(flags.Proxy)
+	if err != nil {
+		return zero, "", fmt.Errorf("parse --proxy: %w", err)
+	}
+	rollbar.SetDefaultProxy(proxyURL)
+	maxPages, err := parseMaxPages(flags.MaxPages)
+	if err != nil {
+		return zero, "", err
+	}
+	options := []app.Option{
+		app.WithEnvironmentAliases(domain.DefaultEnvironmentAliases().With(flags.EnvironmentAliases)),
+		app.WithMaxItemPages(maxPages),
+	}
+	rollbar.SetDefaultStrict(flags.Strict)
+	rollbar.SetDefaultRetries(flags.CommandRetries)
+	configureResponseCache(flags)
+	candidates, err := resolveTokenCandidates(flags)
+	if shouldOnboard(flags, err) {
+		if err := runOnboarding(ctx); err != nil {
+			return zero, "", err
+		}
+		candidates, err = resolveTokenCandidates(flags)
+	}
+	if err != nil {
+		return zero, "", err
+	}
+
+	primary := candidates[0]
+	result, err := runWithToken(flags, message, primary, options, operation)
+	if err == nil {
+		warnIfBudgetExhausted()
+		if err := strictWarnings(flags); err != nil {
+			return zero, primary.token, err
+		}
+		return result, primary.token, nil
+	}
+	if len(candidates) < 2 || !errors.Is(err, rollbar.ErrUnauthorized) {
+		return zero, primary.token, sanitizeError(err, primary.token)
+	}
+
+	fallback := candidates[1]
+	_, _ = fmt.Fprintf(stderrWriter, "token from %s was rejected; retrying with %s\n", primary.source, fallback.source)
+	result, err = runWithToken(flags, message, fallback, options, operation)
+	if err != nil {
+		return zero, fallback.token, sanitizeError(sanitizeError(err, primary.token), fallback.token)
+	}
+
+	_, _ = fmt.Fprintf(stderrWriter, "token from %s succeeded; update the stale token with `rollbaz project add %s --token ...`\n", fallback.source, primary.project)
+	warnIfBudgetExhausted()
+	if err := strictWarnings(flags); err != nil {
+		return zero, fallback.token, err
+	}
+	return result, fallback.token, nil
+}
+
+func parseRequestBudget(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return rollbar.DefaultRequestBudget, nil
+	}
+	budget, err := strconv.Atoi(value)
+	if err != nil || budget < 0 {
+		return 0, fmt.Errorf("parse --max-requests: invalid request count %q", value)
+	}
+
+	return budget, nil
+}
+
+func parseRequestsPerMinute(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	perMinute, err := strconv.Atoi(value)
+	if err != nil || perMinute < 0 {
+		return 0, fmt.Errorf("parse --max-rpm: invalid request rate %q", value)
+	}
+
+	return perMinute, nil
+}
+
+func parseMaxPages(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	pages, err := strconv.Atoi(value)
+	if err != nil || pages < 1 {
+		return 0, fmt.Errorf("parse --max-pages: invalid page count %q", value)
+	}
+
+	return pages, nil
+}
+
+func warnIfBudgetExhausted() {
+	usage := rollbar.DefaultRequestBudgetUsage()
+	if !usage.Exhausted {
+		return
+	}
+	addWarnings(app.Warning{
+		Code:    warningRequestBudget,
+		Message: fmt.Sprintf("stopped at the budget of %d Rollbar API requests; enrichment is incomplete (raise --max-requests)", usage.Limit),
+	})
+}
+
+func printRateLimitQuota(w io.Writer, now time.Time) {
+	quota, ok := rollbar.DefaultRateLimitQuota()
+	if !ok {
+		return
+	}
+	line := fmt.Sprintf("rate limit: %d", quota.Remaining)
+	if quota.Limit > 0 {
+		line += fmt.Sprintf("/%d", quota.Limit)
+	}
+	line += " requests left"
+	if !quota.Reset.IsZero() {
+		line += fmt.Sprintf(", window resets in %s", max(quota.Reset.Sub(now), 0).Round(time.Second))
+	}
+	_, _ = fmt.Fprintln(w, line)
+}
+
+func runWithToken[T any](flags rootFlags, message string, candidate tokenCandidate, options []app.Option, operation func(*app.Service) (T, error)) (T, error) {
+	client, err := newProjectClient(candidate.token, candidate.baseURL, candidate.headers)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	service := app.NewService(client, options...)
+
+	result, err := runWithProgress(flags.Format, message, func() (T, error) {
+		return operation(service)
+	})
+	addWarnings(service.Warnings()...)
+	if flags.Explain {
+		setExplanation(service.Explanation())
+	}
+
+	return result, err
+}
+
+func newProjectClient(token string, baseURL string, headers map[string]string) (*rollbar.Client, error) {
+	var client *rollbar.Client
+	var err error
+	if baseURL == "" {
+		client, err = newRollbarClient(token)
+	} else {
+		client, err = rollbar.NewWithBaseURL(token, baseURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(headers) > 0 {
+		client.SetHeaders(headers)
+	}
+
+	return client, nil
+}
+
+func applyProjectDefaults(flags *rootFlags) {
+	if flags.Token != "" || flags.Environment != "" {
+		return
+	}
+
+	store, err := newConfigStore()
+	if err != nil {
+		return
+	}
+	project, err := store.ResolveProject(flags.Project)
+	if err != nil {
+		return
+	}
+
+	flags.Environment = project.Environment
+}
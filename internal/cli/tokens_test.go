@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newTokenCheckingHandler(validToken string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Rollbar-Access-Token") != validToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = fmt.Fprint(w, `{"err":1,"message":"invalid access token"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+	})
+}
+
+func setStoredProjectToken(t *testing.T, token string) {
+	t.Helper()
+	store := config.NewStoreAtPath(filepath.Join(t.TempDir(), "config.json"))
+	if err := store.AddProject("figure", token); err != nil {
+		t.Fatalf("AddProject() error = %v", err)
+	}
+	t.Cleanup(overrideConfigStore(func() (*config.Store, error) {
+		return store, nil
+	}))
+}
+
+func TestRunServiceOperationFallsBackToEnvToken(t *testing.T) {
+	stdout := setupServerAndStdout(t, newTokenCheckingHandler("token"))
+	stderr := setupStderr(t)
+	setStoredProjectToken(t, "stale")
+
+	runRootCommand(t, "active", "--format", "json")
+
+	if !strings.Contains(stdout.String(), `"issues"`) {
+		t.Fatalf("unexpected output: %q", stdout.String())
+	}
+	for _, want := range []string{
+		`token from project "figure" was rejected; retrying with ROLLBAR_ACCESS_TOKEN`,
+		"token from ROLLBAR_ACCESS_TOKEN succeeded",
+		"rollbaz project add figure --token",
+	} {
+		if !strings.Contains(stderr.String(), want) {
+			t.Fatalf("expected %q in stderr, got %q", want, stderr.String())
+		}
+	}
+}
+
+func TestRunServiceOperationDoesNotRetryExplicitToken(t *testing.T) {
+	setupServerAndStdout(t, newTokenCheckingHandler("token"))
+	stderr := setupStderr(t)
+	setNoConfigStore(t)
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"active", "--token", "stale", "--format", "json"})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("expected unauthorized error")
+	}
+	if strings.Contains(stderr.String(), "retrying") {
+		t.Fatalf("unexpected retry: %q", stderr.String())
+	}
+}
+
+func TestResolveTokenCandidatesSkipsDuplicateEnvToken(t *testing.T) {
+	setStoredProjectToken(t, "same")
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "same")
+
+	candidates, err := resolveTokenCandidates(rootFlags{})
+	if err != nil {
+		t.Fatalf("resolveTokenCandidates() error = %v", err)
+	}
+	if len(candidates) != 1 {
+		t.Fatalf("expected one candidate, got %+v", candidates)
+	}
+}
+
+func TestRateLimitQuotaAndRequestsPerMinute(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Limit", "5000")
+		w.Header().Set("X-Rate-Limit-Remaining", "4321")
+		w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--max-rpm", "6000")
+
+	stderr := &bytes.Buffer{}
+	printRateLimitQuota(stderr, reset.Add(-42*time.Second))
+	if got := stderr.String(); got != "rate limit: 4321/5000 requests left, window resets in 42s\n" {
+		t.Fatalf("unexpected quota line %q", got)
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"recent", "--max-rpm", "fast"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "parse --max-rpm") {
+		t.Fatalf("expected --max-rpm parse error, got %v", err)
+	}
+}
+
+func TestProjectHeadersAreSentWithRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Gateway-Auth") != "gateway-secret" || r.Header.Get("X-Rollbar-Access-Token") != "good" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(w, `{"err":1,"message":"blocked by proxy"}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "")
+	store := setupProjectStore(t)
+	setupStdout(t)
+	err := store.SaveProject(config.Project{Name: "figure", Token: "good", BaseURL: server.URL + "/api/1", Headers: map[string]string{
+		"x-gateway-auth":         "gateway-secret",
+		"X-Rollbar-Access-Token": "ignored",
+	}})
+	if err != nil {
+		t.Fatalf("SaveProject() error = %v", err)
+	}
+
+	runRootCommand(t, "active", "--format", "json")
+}
+
+func TestConfiguredProxyCarriesRequests(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "rollbar.invalid" {
+			t.Fatalf("expected a proxied request, got host %q", r.Host)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+	}))
+	t.Cleanup(proxy.Close)
+	t.Cleanup(func() { rollbar.SetDefaultProxy(nil) })
+	t.Setenv("ROLLBAR_ACCESS_TOKEN", "")
+	store := setupProjectStore(t)
+	setupStdout(t)
+	err := store.Save(config.File{
+		ActiveProject: "figure",
+		Projects:      []config.Project{{Name: "figure", Token: "good", BaseURL: "http://rollbar.invalid/api/1"}},
+		Proxy:         proxy.URL,
+	})
+	if err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	runRootCommand(t, "active", "--format", "json")
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"active", "--proxy", "ftp://proxy.internal"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "parse --proxy") {
+		t.Fatalf("expected --proxy parse error, got %v", err)
+	}
+}
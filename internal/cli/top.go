@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newTopCmd(flags *rootFlags) *cobra.Command {
+	window := "24h"
+	topCmd := &cobra.Command{
+		Use:   "top",
+		Short: "Rank issues by occurrences over a window, with the change from the window before",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTop(cmd.Context(), *flags, window)
+		},
+	}
+	topCmd.Flags().StringVar(&window, "window", window, "Window to rank over, in whole hours up to 7d, e.g. 6h or 2d")
+
+	return topCmd
+}
+
+func runTop(parent context.Context, flags rootFlags, window string) error {
+	length, err := parseAge(window)
+	if err != nil || length == nil {
+		return fmt.Errorf("invalid --window %q", window)
+	}
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	report, token, err := runServiceOperation(parent, flags, "Ranking issues", func(service *app.Service) (app.TopReport, error) {
+		return service.Top(ctx, *length, listLimit(flags), filters)
+	})
+	if err != nil {
+		return err
+	}
+	ranked := make([]app.IssueSummary, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		ranked = append(ranked, issue.IssueSummary)
+	}
+	rememberListed(token, ranked)
+	if report, err = anonymized(flags, report); err != nil {
+		return err
+	}
+	if !includeRaw(flags, false) {
+		for index := range report.Issues {
+			report.Issues[index].Raw = nil
+		}
+	}
+
+	human := redact.String(output.RenderTopReportWithWidth(report, terminalRenderWidth()), token)
+	return printOutput(flags.Format, human, redact.Value(map[string]any{"top": report}, token))
+}
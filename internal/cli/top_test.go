@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTopCommand(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/reports/top_active_items" || r.URL.Query().Get("hours") != "12" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":[`+
+			`{"item":{"id":1,"counter":11,"title":"steady","environment":"production"},"counts":[1,1,1,1,1,1,1,1,1,1,1,1]},`+
+			`{"item":{"id":2,"counter":12,"title":"spiking","environment":"production"},"counts":[0,0,0,0,0,0,5,5,5,5,5,5]}]}`)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "top", "--window", "6h")
+	out := stdout.String()
+	if !strings.Contains(out, "last 6h") || strings.Index(out, "spiking") > strings.Index(out, "steady") || !strings.Contains(out, "new") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "top", "--window", "6h", "--limit", "1", "--format", "json")
+	var payload struct {
+		Top struct {
+			Issues []struct {
+				Counter           uint64 `json:"counter"`
+				WindowOccurrences uint64 `json:"window_occurrences"`
+				Delta             int64  `json:"delta"`
+			} `json:"issues"`
+		} `json:"top"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+		t.Fatalf("decode top: %v\n%s", err, stdout.String())
+	}
+	if len(payload.Top.Issues) != 1 || payload.Top.Issues[0].Counter != 12 || payload.Top.Issues[0].WindowOccurrences != 30 || payload.Top.Issues[0].Delta != 30 {
+		t.Fatalf("unexpected top payload: %s", stdout.String())
+	}
+}
+
+func TestTopCommandRejectsWindow(t *testing.T) {
+	setNoConfigStore(t)
+
+	if err := runTop(t.Context(), rootFlags{}, "soon"); err == nil || !strings.Contains(err.Error(), "--window") {
+		t.Fatalf("expected window error, got %v", err)
+	}
+}
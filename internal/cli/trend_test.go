@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecentTrendVsLastWeek(t *testing.T) {
+	setNoConfigStore(t)
+	weekAgo := time.Now().Add(-7 * 24 * time.Hour).Unix()
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+				{"id":1,"counter":1,"title":"regressed","status":"active"},
+				{"id":2,"counter":2,"title":"improving","status":"active"}
+			]}}`)
+		case "/api/1/reports/occurrence_counts":
+			maxTimestamp, _ := strconv.ParseInt(r.URL.Query().Get("max_ts"), 10, 64)
+			thisWeek := maxTimestamp > weekAgo+60
+			count := map[string]map[bool]int{"1": {true: 20, false: 4}, "2": {true: 1, false: 8}}[r.URL.Query().Get("item_id")][thisWeek]
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":[[%d,%d]]}`, maxTimestamp-3600, count)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "recent", "--trend-vs", "last-week", "--columns", "counter,title", "--plain")
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	want := []string{"COUNTER\tTREND\tTITLE", "1\tup\tregressed", "2\tdown\timproving"}
+	if !reflect.DeepEqual(lines, want) {
+		t.Fatalf("plain trend output = %q", lines)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--trend-vs", "last-week", "--format", "json")
+	for _, want := range []string{`"current": 20`, `"previous": 4`, `"direction": "up"`} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in json output, got %q", want, stdout.String())
+		}
+	}
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"recent", "--trend-vs", "yesterday"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "--trend-vs") {
+		t.Fatalf("expected --trend-vs error, got %v", err)
+	}
+}
+
+func TestWithTrendColumn(t *testing.T) {
+	tests := []struct {
+		columns []string
+		want    []string
+	}{
+		{columns: []string{"counter", "title"}, want: []string{"counter", "trend", "title"}},
+		{columns: []string{"counter", "status"}, want: []string{"counter", "status", "trend"}},
+		{columns: []string{"trend", "counter"}, want: []string{"trend", "counter"}},
+	}
+	for _, tt := range tests {
+		if got := withTrendColumn(tt.columns); !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("withTrendColumn(%v) = %v, want %v", tt.columns, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/tui"
+)
+
+var runTUI = tui.Run
+
+func newTUICmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tui",
+		Short: "Triage issues in a full-screen terminal UI",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTriageUI(cmd.Context(), *flags)
+		},
+	}
+}
+
+func runTriageUI(parent context.Context, flags rootFlags) error {
+	if !canPromptConfirmation() {
+		return errors.New("tui needs an interactive terminal")
+	}
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+	budget, err := parseRequestBudget(flags.MaxRequests)
+	if err != nil {
+		return err
+	}
+
+	// A long-running session refreshes on demand, so it always reads live.
+	flags.NoCache = true
+	options := tui.Options{
+		Limit:        flags.Limit,
+		Filters:      filters,
+		SkipConfirm:  flags.Yes,
+		BeforeAction: func() { rollbar.SetDefaultRequestBudget(budget) },
+	}
+	_, _, err = runServiceOperation(parent, flags, "", func(service *app.Service) (struct{}, error) {
+		return struct{}{}, runTUI(parent, service, options, stdinReader, stdoutWriter)
+	})
+
+	return err
+}
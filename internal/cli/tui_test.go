@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/tui"
+)
+
+func TestTUICommand(t *testing.T) {
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("unexpected request: %s", r.URL.Path)
+	}))
+
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"tui"})
+	if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), "interactive terminal") {
+		t.Fatalf("expected terminal error, got %v", err)
+	}
+
+	setupFakeTerminal(t, "")
+	original := runTUI
+	t.Cleanup(func() { runTUI = original })
+	var got tui.Options
+	runTUI = func(ctx context.Context, service tui.Service, options tui.Options, in io.Reader, out io.Writer) error {
+		got = options
+		return nil
+	}
+
+	runRootCommand(t, "tui", "--env", "production", "--limit", "25", "--yes")
+	if got.Limit != 25 || got.Filters.Environment != domain.Environment("production") || !got.SkipConfirm {
+		t.Fatalf("unexpected tui options: %+v", got)
+	}
+}
+
+func TestTUICommandBudgetsEachAction(t *testing.T) {
+	setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+	}))
+	setupFakeTerminal(t, "")
+	original := runTUI
+	t.Cleanup(func() { runTUI = original })
+	runTUI = func(ctx context.Context, service tui.Service, options tui.Options, in io.Reader, out io.Writer) error {
+		for range 3 {
+			options.BeforeAction()
+			if _, err := service.Recent(ctx, options.Limit, options.Filters); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	runRootCommand(t, "tui", "--max-requests", "1")
+}
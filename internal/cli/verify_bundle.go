@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/bundle"
+)
+
+func newVerifyBundleCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-bundle <dir>",
+		Short: "Check an export bundle's manifest, schema version, and checksums",
+		Long: "Check a bundle written by `export --to bundle:DIR` after it was copied, e.g. into a network\n" +
+			"without Rollbar access: the manifest's schema version must be one this rollbaz reads, and\n" +
+			"every listed file must be present with its recorded size and SHA-256 checksum.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVerifyBundle(*flags, args[0])
+		},
+	}
+}
+
+func runVerifyBundle(flags rootFlags, dir string) error {
+	report, err := bundle.Verify(dir)
+	if err != nil {
+		return err
+	}
+
+	manifest := report.Manifest
+	human := fmt.Sprintf("bundle OK: schema %d, %d files, created %s", manifest.SchemaVersion, len(manifest.Files), manifest.CreatedAt.Format(time.RFC3339))
+	if !report.OK() {
+		human = "bundle failed verification:\n  " + strings.Join(report.Problems, "\n  ")
+	}
+	if err := printOutput(flags.Format, human, report); err != nil {
+		return err
+	}
+	if !report.OK() {
+		return fmt.Errorf("bundle %s failed verification with %d problems", dir, len(report.Problems))
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newViewCmd(flags *rootFlags) *cobra.Command {
+	viewCmd := &cobra.Command{
+		Use:   "view",
+		Short: "Manage saved filter and sort views",
+		// Saving a view must capture only explicit flags, not the active view.
+		PersistentPreRun: func(*cobra.Command, []string) {},
+	}
+	viewCmd.AddCommand(
+		newViewSaveCmd(flags),
+		newViewUseCmd(),
+		newViewListCmd(),
+		newViewRemoveCmd(),
+	)
+
+	return viewCmd
+}
+
+func newViewSaveCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "save <name>",
+		Short: "Save the current filter and sort flags as a named view",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runViewSave(*flags, args[0])
+		},
+	}
+}
+
+func newViewUseCmd() *cobra.Command {
+	clearView := false
+	useCmd := &cobra.Command{
+		Use:   "use [name]",
+		Short: "Set the active view used as default filters",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := ""
+			if len(args) == 1 {
+				name = args[0]
+			}
+			if name == "" && !clearView {
+				return errors.New("specify a view name or use --clear")
+			}
+			if err := withConfigStore(func(store *config.Store) error {
+				return store.UseView(name)
+			}); err != nil {
+				return fmt.Errorf("use view: %w", err)
+			}
+			return nil
+		},
+	}
+	useCmd.Flags().BoolVar(&clearView, "clear", false, "Deactivate the active view")
+
+	return useCmd
+}
+
+func newViewListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List saved views",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withConfigStore(printViews)
+		},
+	}
+}
+
+func newViewRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a saved view",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := withConfigStore(func(store *config.Store) error {
+				return store.RemoveView(args[0])
+			}); err != nil {
+				return fmt.Errorf("remove view: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+func runViewSave(flags rootFlags, name string) error {
+	options, err := parseIssueListOptions(flags)
+	if err != nil {
+		return err
+	}
+	columns := options.columns
+	if flags.Columns == "" {
+		columns = nil
+	}
+
+	view := config.View{
+		Name:           name,
+		Environment:    flags.Environment,
+		Status:         flags.Status,
+		Since:          flags.Since,
+		Until:          flags.Until,
+		MinOccurrences: flags.MinOccurrences,
+		MaxOccurrences: flags.MaxOccurrences,
+		MinAge:         flags.MinAge,
+		MaxAge:         flags.MaxAge,
+		Level:          flags.Level,
+		MinRate:        flags.MinRate,
+		Sort:           flags.Sort,
+		Columns:        columns,
+	}
+	if err := withConfigStore(func(store *config.Store) error {
+		return store.SaveView(view)
+	}); err != nil {
+		return fmt.Errorf("save view: %w", err)
+	}
+
+	return nil
+}
+
+func printViews(store *config.Store) error {
+	file, err := store.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	if len(file.Views) == 0 {
+		_, _ = fmt.Fprintln(stdoutWriter, "no saved views")
+		return nil
+	}
+
+	for _, view := range file.Views {
+		prefix := "  "
+		if view.Name == file.ActiveView {
+			prefix = "* "
+		}
+		_, _ = fmt.Fprintf(stdoutWriter, "%s%s\t%s\n", prefix, view.Name, describeView(view))
+	}
+
+	return nil
+}
+
+func describeView(view config.View) string {
+	parts := make([]string, 0, 12)
+	for _, setting := range []struct{ flag, value string }{
+		{"--env", view.Environment},
+		{"--status", view.Status},
+		{"--since", view.Since},
+		{"--until", view.Until},
+		{"--min-occurrences", view.MinOccurrences},
+		{"--max-occurrences", view.MaxOccurrences},
+		{"--min-age", view.MinAge},
+		{"--max-age", view.MaxAge},
+		{"--level", view.Level},
+		{"--min-rate", view.MinRate},
+		{"--sort", view.Sort},
+		{"--columns", strings.Join(view.Columns, ",")},
+	} {
+		if setting.value != "" {
+			parts = append(parts, setting.flag+" "+setting.value)
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func applyConfigDefaults(flags *rootFlags) {
+	store, err := newConfigStore()
+	if err != nil {
+		return
+	}
+	file, err := store.Load()
+	if err != nil {
+		return
+	}
+
+	if view, ok := file.ActiveViewSettings(); ok {
+		fillEmpty(&flags.Environment, view.Environment)
+		fillEmpty(&flags.Status, view.Status)
+		fillEmpty(&flags.Since, view.Since)
+		fillEmpty(&flags.Until, view.Until)
+		fillEmpty(&flags.MinOccurrences, view.MinOccurrences)
+		fillEmpty(&flags.MaxOccurrences, view.MaxOccurrences)
+		fillEmpty(&flags.MinAge, view.MinAge)
+		fillEmpty(&flags.MaxAge, view.MaxAge)
+		fillEmpty(&flags.Level, view.Level)
+		fillEmpty(&flags.MinRate, view.MinRate)
+		fillEmpty(&flags.Sort, view.Sort)
+		fillEmpty(&flags.Columns, strings.Join(view.Columns, ","))
+	}
+	fillEmpty(&flags.Columns, strings.Join(file.Columns, ","))
+	flags.EnvironmentAliases = file.EnvironmentAliases
+	flags.HiddenEnvironments = file.HiddenEnvironments
+	flags.NumberFormat = file.NumberFormat
+	flags.TimestampFormat = file.TimestampFormat
+	flags.Locale = file.Locale
+	fillEmpty(&flags.Truncate, file.Truncate)
+	flags.ShareEndpoint = file.ShareEndpoint
+	flags.NotifyWebhook = file.NotifyWebhook
+	fillEmpty(&flags.Proxy, file.Proxy)
+	if file.MaxRequests != nil {
+		fillEmpty(&flags.MaxRequests, strconv.Itoa(*file.MaxRequests))
+	}
+	if file.MaxRequestsPerMinute != nil {
+		fillEmpty(&flags.MaxPerMinute, strconv.Itoa(*file.MaxRequestsPerMinute))
+	}
+	if file.MaxPages != nil {
+		fillEmpty(&flags.MaxPages, strconv.Itoa(*file.MaxPages))
+	}
+}
+
+func applyCommandSettings(cmd *cobra.Command, flags *rootFlags) error {
+	flags.CommandTimeout = 0
+	flags.CommandRetries = rollbar.DefaultRetries
+
+	file := config.File{}
+	if store, err := newConfigStore(); err == nil {
+		if loaded, err := store.Load(); err == nil {
+			file = loaded
+		}
+	}
+
+	path := strings.TrimSpace(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()))
+	if path == "" {
+		path = "recent"
+	}
+	settings := file.CommandSettings(path)
+	timeout, err := settings.TimeoutDuration()
+	if err != nil {
+		return fmt.Errorf("config commands.%s.timeout: %w", path, err)
+	}
+	flags.CommandTimeout = timeout
+	if settings.Retries != nil {
+		if *settings.Retries < 0 {
+			return fmt.Errorf("config commands.%s.retries: invalid retry count %d", path, *settings.Retries)
+		}
+		flags.CommandRetries = *settings.Retries
+	}
+	if flags.Retries != "" {
+		retries, err := strconv.Atoi(strings.TrimSpace(flags.Retries))
+		if err != nil || retries < 0 {
+			return fmt.Errorf("parse --retries: invalid retry count %q", flags.Retries)
+		}
+		flags.CommandRetries = retries
+	}
+
+	return nil
+}
+
+func parseListColumns(value string) ([]string, error) {
+	columns, err := output.ParseListColumns(strings.Split(value, ","))
+	if err != nil {
+		return nil, fmt.Errorf("parse --columns: %w", err)
+	}
+
+	return columns, nil
+}
+
+func fillEmpty(target *string, value string) {
+	if *target == "" {
+		*target = value
+	}
+}
@@ -0,0 +1,265 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/config"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestViewSaveUseAndApply(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+			{"id":1,"counter":1,"title":"warn prod","status":"active","environment":"production","level":"warning","total_occurrences":90},
+			{"id":2,"counter":2,"title":"error prod","status":"active","environment":"production","level":"error","total_occurrences":3},
+			{"id":3,"counter":3,"title":"error staging","status":"active","environment":"staging","level":"error","total_occurrences":50}
+		]}}`)
+	}))
+	store := setupProjectStore(t)
+
+	runRootCommand(t, "view", "save", "oncall", "--env", "production", "--status", "active", "--sort", "priority")
+	runRootCommand(t, "view", "use", "oncall")
+
+	view, ok, err := store.ActiveView()
+	if err != nil || !ok {
+		t.Fatalf("ActiveView() = %v, %v", ok, err)
+	}
+	if !reflect.DeepEqual(view, config.View{Name: "oncall", Environment: "production", Status: "active", Sort: "priority"}) {
+		t.Fatalf("unexpected saved view: %+v", view)
+	}
+
+	runRootCommand(t, "recent")
+	got := stdout.String()
+	if strings.Contains(got, "error staging") {
+		t.Fatalf("expected view env filter to apply, got %q", got)
+	}
+	if strings.Index(got, "error prod") > strings.Index(got, "warn prod") {
+		t.Fatalf("expected priority sort, got %q", got)
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--env", "staging")
+	if !strings.Contains(stdout.String(), "error staging") {
+		t.Fatalf("expected explicit flag to override view, got %q", stdout.String())
+	}
+}
+
+func TestListColumnsFromViewAndConfig(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":4,"title":"narrow","status":"active","environment":"production","level":"error"}]}}`)
+	}))
+	store := setupProjectStore(t)
+	if err := store.Save(config.File{Columns: []string{"counter", "title"}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	runRootCommand(t, "recent")
+	if strings.Contains(stdout.String(), "ENV") || !strings.Contains(stdout.String(), "narrow") {
+		t.Fatalf("expected global columns, got %q", stdout.String())
+	}
+
+	runRootCommand(t, "view", "save", "levels", "--columns", "level,title")
+	runRootCommand(t, "view", "use", "levels")
+	stdout.Reset()
+	runRootCommand(t, "recent")
+	if strings.Contains(stdout.String(), "COUNTER") || !strings.Contains(stdout.String(), "LEVEL") {
+		t.Fatalf("expected view columns to win, got %q", stdout.String())
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "recent", "--columns", "env")
+	if !strings.Contains(stdout.String(), "production") || strings.Contains(stdout.String(), "narrow") {
+		t.Fatalf("expected explicit columns to win, got %q", stdout.String())
+	}
+}
+
+func TestViewListAndRemove(t *testing.T) {
+	store := setupProjectStore(t)
+	stdout := setupStdout(t)
+
+	runRootCommand(t, "view", "list")
+	if err := store.SaveView(config.View{Name: "triage", Status: "active", Sort: "occurrences"}); err != nil {
+		t.Fatalf("SaveView() error = %v", err)
+	}
+	runRootCommand(t, "view", "use", "triage")
+	runRootCommand(t, "view", "list")
+
+	got := stdout.String()
+	if !strings.Contains(got, "no saved views") || !strings.Contains(got, "* triage\t--status active --sort occurrences") {
+		t.Fatalf("unexpected view list: %q", got)
+	}
+
+	runRootCommand(t, "view", "use", "--clear")
+	runRootCommand(t, "view", "remove", "triage")
+	file, err := store.Load()
+	if err != nil || len(file.Views) != 0 || file.ActiveView != "" {
+		t.Fatalf("expected view removed, got %+v, %v", file, err)
+	}
+}
+
+func TestViewErrors(t *testing.T) {
+	setupProjectStore(t)
+
+	for _, args := range [][]string{
+		{"view", "save", "bad", "--sort", "severity"},
+		{"view", "save", "bad", "--since", "yesterday"},
+		{"view", "use"},
+		{"view", "save", "bad", "--columns", "owner"},
+		{"recent", "--columns", "owner"},
+		{"view", "use", "missing"},
+		{"view", "remove", "missing"},
+		{"recent", "--sort", "severity"},
+	} {
+		cmd := NewRootCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
+
+func TestEnvironmentAliasesFromConfig(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[`+
+			`{"id":1,"counter":4,"title":"live issue","status":"active","environment":"Live"},`+
+			`{"id":2,"counter":5,"title":"qa issue","status":"active","environment":"qa"}]}}`)
+	}))
+	store := setupProjectStore(t)
+	if err := store.Save(config.File{EnvironmentAliases: map[string]string{"live": "production"}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	runRootCommand(t, "recent", "--env", "prod", "--plain")
+	got := stdout.String()
+	if !strings.Contains(got, "live issue") || strings.Contains(got, "qa issue") || !strings.Contains(got, "production") {
+		t.Fatalf("expected aliased environment filtering, got %q", got)
+	}
+}
+
+func TestHiddenEnvironmentsFromConfig(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[`+
+			`{"id":1,"counter":4,"title":"live issue","status":"active","environment":"production"},`+
+			`{"id":2,"counter":5,"title":"dev issue","status":"active","environment":"dev"}]}}`)
+	}))
+	store := setupProjectStore(t)
+	if err := store.Save(config.File{HiddenEnvironments: []string{"development"}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	tests := []struct {
+		args     []string
+		wantDev  bool
+		wantLive bool
+	}{
+		{args: []string{"recent", "--plain"}, wantLive: true},
+		{args: []string{"recent", "--plain", "--all-envs"}, wantDev: true, wantLive: true},
+		{args: []string{"recent", "--plain", "--env", "development"}, wantDev: true},
+	}
+	for _, tt := range tests {
+		stdout.Reset()
+		runRootCommand(t, tt.args...)
+		got := stdout.String()
+		if strings.Contains(got, "dev issue") != tt.wantDev || strings.Contains(got, "live issue") != tt.wantLive {
+			t.Fatalf("%v: unexpected output %q", tt.args, got)
+		}
+	}
+}
+
+func TestCommandRetriesFromConfig(t *testing.T) {
+	var served atomic.Int32
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if served.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":4,"title":"flaky issue","status":"active"}]}}`)
+	}))
+	store := setupProjectStore(t)
+	retries := 1
+	rollbar.SetDefaultRequestRate(0)
+	if err := store.Save(config.File{Commands: map[string]config.CommandSettings{"recent": {Retries: &retries}}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	runRootCommand(t, "recent", "--plain")
+	if !strings.Contains(stdout.String(), "flaky issue") || served.Load() != 2 {
+		t.Fatalf("expected a retried list, served %d, got %q", served.Load(), stdout.String())
+	}
+
+	served.Store(0)
+	cmd := NewRootCmd()
+	cmd.SetArgs([]string{"recent", "--plain", "--retries", "0"})
+	if err := cmd.Execute(); err == nil || served.Load() != 1 {
+		t.Fatalf("expected --retries 0 to fail on the first error, served %d, err %v", served.Load(), err)
+	}
+}
+
+func TestApplyCommandSettings(t *testing.T) {
+	store := setupProjectStore(t)
+	three := 3
+	if err := store.Save(config.File{Commands: map[string]config.CommandSettings{
+		"export":  {Timeout: "10m", Retries: &three},
+		"recent":  {Timeout: "45s"},
+		"deploys": {Timeout: "soon"},
+	}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	root := NewRootCmd()
+	find := func(args ...string) *cobra.Command {
+		cmd, _, err := root.Find(args)
+		if err != nil {
+			t.Fatalf("Find(%v) error = %v", args, err)
+		}
+		return cmd
+	}
+
+	tests := []struct {
+		cmd         *cobra.Command
+		retries     string
+		wantTimeout time.Duration
+		wantRetries int
+		wantErr     string
+	}{
+		{cmd: find("export"), wantTimeout: 10 * time.Minute, wantRetries: 3},
+		{cmd: root, wantTimeout: 45 * time.Second, wantRetries: rollbar.DefaultRetries},
+		{cmd: find("show"), wantRetries: rollbar.DefaultRetries},
+		{cmd: find("deploys", "list"), wantErr: "config commands.deploys list.timeout"},
+		{cmd: find("export"), retries: "0", wantTimeout: 10 * time.Minute},
+		{cmd: find("show"), retries: "4", wantRetries: 4},
+		{cmd: find("show"), retries: "-1", wantErr: "parse --retries"},
+	}
+	for _, tc := range tests {
+		flags := rootFlags{CommandTimeout: time.Hour, CommandRetries: 9, Retries: tc.retries}
+		err := applyCommandSettings(tc.cmd, &flags)
+		if tc.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("%s: expected %q error, got %v", tc.cmd.Name(), tc.wantErr, err)
+			}
+			continue
+		}
+		if err != nil || flags.CommandTimeout != tc.wantTimeout || flags.CommandRetries != tc.wantRetries {
+			t.Fatalf("%s: got timeout %v retries %d err %v", tc.cmd.Name(), flags.CommandTimeout, flags.CommandRetries, err)
+		}
+	}
+
+	ctx, cancel := commandContext(context.Background(), rootFlags{}, time.Minute)
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > time.Minute {
+		t.Fatalf("expected the default timeout, got %v", deadline)
+	}
+	ctx, cancel = commandContext(context.Background(), rootFlags{CommandTimeout: time.Hour}, time.Minute)
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) < time.Minute {
+		t.Fatalf("expected the configured timeout, got %v", deadline)
+	}
+}
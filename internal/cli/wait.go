@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+var errWaitTimeout = errors.New("timed out waiting for issue")
+
+const exitWaitTimeout = 3
+
+type waitOptions struct {
+	status         string
+	minOccurrences uint64
+	timeout        time.Duration
+	interval       time.Duration
+}
+
+func newWaitCmd(flags *rootFlags) *cobra.Command {
+	options := waitOptions{}
+	match := ""
+	waitCmd := &cobra.Command{
+		Use:   "wait <item-counter>",
+		Short: "Block until an issue reaches a status or occurrence count",
+		Long: "Poll an issue until it has the --until-status status and at least --until-occurrences\n" +
+			"occurrences, whichever are given. Exits 0 once they hold, 3 if --timeout passes first, and\n" +
+			"1 on any other error, so deploy scripts can wait on a known blocker.",
+		Args: itemArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("timeout") && flags.CommandTimeout > 0 {
+				options.timeout = flags.CommandTimeout
+			}
+			counter, err := resolveItemArg(cmd.Context(), *flags, args, match)
+			if err != nil {
+				return err
+			}
+			return runWait(cmd.Context(), *flags, counter, options)
+		},
+	}
+	addMatchFlag(waitCmd, &match)
+	waitCmd.Flags().StringVar(&options.status, "until-status", "", "Status to wait for: active, resolved, muted, or archived")
+	waitCmd.Flags().Uint64Var(&options.minOccurrences, "until-occurrences", 0, "Total occurrence count to wait for")
+	waitCmd.Flags().DurationVar(&options.timeout, "timeout", 10*time.Minute, "How long to wait before giving up")
+	waitCmd.Flags().DurationVar(&options.interval, "interval", 15*time.Second, "Time between polls")
+
+	return waitCmd
+}
+
+func runWait(parent context.Context, flags rootFlags, counter domain.ItemCounter, options waitOptions) error {
+	condition := app.WaitCondition{}
+	if options.status != "" {
+		status, err := domain.ParseStatus(options.status)
+		if err != nil {
+			return fmt.Errorf("parse --until-status: %w", err)
+		}
+		condition.Status = status
+	}
+	if options.minOccurrences > 0 {
+		condition.MinOccurrences = &options.minOccurrences
+	}
+	if condition.Validate() != nil {
+		return errors.New("pass --until-status, --until-occurrences, or both")
+	}
+	if options.timeout <= 0 || options.interval <= 0 {
+		return errors.New("--timeout and --interval must be positive")
+	}
+
+	// Every poll must reach Rollbar.
+	flags.NoCache = true
+	ctx, cancel := context.WithTimeout(parent, options.timeout)
+	defer cancel()
+
+	message := fmt.Sprintf("Waiting for issue %s to reach %s", counter.String(), condition)
+	var last app.IssueSummary
+	issue, token, err := runServiceOperation(parent, flags, "", func(service *app.Service) (app.IssueSummary, error) {
+		return runWithStatusProgress(flags.Format, message, func(status func(string)) (app.IssueSummary, error) {
+			return service.Wait(ctx, counter, condition, options.interval, func(issue app.IssueSummary) {
+				last = issue
+				status(fmt.Sprintf("%s (now %s)", message, waitReading(issue)))
+			})
+		})
+	})
+	if errors.Is(err, context.DeadlineExceeded) && parent.Err() == nil {
+		return fmt.Errorf("%w %s to reach %s after %s (now %s)", errWaitTimeout, counter.String(), condition, options.timeout, waitReading(last))
+	}
+	if err != nil {
+		return err
+	}
+	rememberLast(token, counter)
+
+	if issue, err = anonymized(flags, issue); err != nil {
+		return err
+	}
+	if !includeRaw(flags, false) {
+		issue.Raw = nil
+	}
+	human := fmt.Sprintf("issue %s reached %s", counter.String(), condition)
+	return printOutput(flags.Format, human, redact.Value(map[string]any{"counter": counter, "condition": condition.String(), "issue": issue}, token))
+}
+
+func waitReading(issue app.IssueSummary) string {
+	if issue.Status == "" {
+		return "not read yet"
+	}
+	if issue.Occurrences == nil {
+		return issue.Status.String()
+	}
+
+	return fmt.Sprintf("%s, %d occurrences", issue.Status, *issue.Occurrences)
+}
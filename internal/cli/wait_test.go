@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWaitCommand(t *testing.T) {
+	stdout := setupServerAndStdout(t, newSuccessHandler(t))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "wait", "269", "--until-status", "active", "--until-occurrences", "5")
+	if got := stdout.String(); !strings.Contains(got, "issue 269 reached status active and at least 5 occurrences") {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
+
+func TestWaitCommandTimeout(t *testing.T) {
+	setupServerAndStdout(t, newSuccessHandler(t))
+	setNoConfigStore(t)
+
+	err := runWait(t.Context(), rootFlags{Format: "json"}, 269, waitOptions{status: "resolved", timeout: 50 * time.Millisecond, interval: 10 * time.Millisecond})
+	if !errors.Is(err, errWaitTimeout) || exitCode(err) != exitWaitTimeout {
+		t.Fatalf("expected wait timeout, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "now active, 7 occurrences") {
+		t.Fatalf("expected the last reading in the error, got %v", err)
+	}
+}
+
+func TestWaitCommandNeedsCondition(t *testing.T) {
+	setNoConfigStore(t)
+
+	tests := []waitOptions{
+		{timeout: time.Minute, interval: time.Second},
+		{status: "done", timeout: time.Minute, interval: time.Second},
+		{status: "resolved", interval: time.Second},
+	}
+	for _, options := range tests {
+		if err := runWait(t.Context(), rootFlags{}, 269, options); err == nil {
+			t.Fatalf("expected error for %+v", options)
+		}
+	}
+}
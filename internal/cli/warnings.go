@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const (
+	warningRequestBudget    = "request_budget"
+	warningNotifyFailed     = "notify_failed"
+	warningEscalationFailed = "escalation_failed"
+	warningDumpDirUntracked = "dump_dir_untracked"
+)
+
+var errStrictWarnings = errors.New("--strict: command raised warnings")
+
+var pendingWarnings struct {
+	mu      sync.Mutex
+	entries []app.Warning
+}
+
+func addWarnings(warnings ...app.Warning) {
+	pendingWarnings.mu.Lock()
+	defer pendingWarnings.mu.Unlock()
+
+	pendingWarnings.entries = append(pendingWarnings.entries, warnings...)
+}
+
+func takeWarnings() []app.Warning {
+	pendingWarnings.mu.Lock()
+	defer pendingWarnings.mu.Unlock()
+
+	warnings := pendingWarnings.entries
+	pendingWarnings.entries = nil
+
+	return warnings
+}
+
+func strictWarnings(flags rootFlags) error {
+	if !flags.Strict {
+		return nil
+	}
+	warnings := takeWarnings()
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(warnings))
+	for _, warning := range warnings {
+		messages = append(messages, warning.Code+": "+warning.Message)
+	}
+
+	return fmt.Errorf("%w: %s", errStrictWarnings, strings.Join(messages, "; "))
+}
+
+func flushWarnings(w io.Writer) {
+	for _, warning := range takeWarnings() {
+		_, _ = fmt.Fprintln(w, "warning: "+warning.Message)
+	}
+}
+
+func withWarnings(payload any, warnings []app.Warning) (any, error) {
+	if len(warnings) == 0 {
+		return payload, nil
+	}
+
+	return withPayloadField(payload, "warnings", warnings)
+}
+
+func withPayloadField(payload any, key string, value any) (any, error) {
+	if object, ok := payload.(map[string]any); ok {
+		merged := make(map[string]any, len(object)+1)
+		for name, field := range object {
+			merged[name] = field
+		}
+		merged[key] = value
+		return merged, nil
+	}
+
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encode payload: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var object map[string]any
+	if err := decoder.Decode(&object); err != nil || object == nil {
+		return map[string]any{"result": payload, key: value}, nil //nolint:nilerr // non-object payloads are wrapped instead
+	}
+	object[key] = value
+
+	return object, nil
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestWithWarnings(t *testing.T) {
+	warnings := []app.Warning{{Code: "partial_pagination", Message: "stopped"}}
+	tests := []struct {
+		name    string
+		payload any
+		want    []string
+	}{
+		{name: "map", payload: map[string]any{"issues": []int{1}}, want: []string{`"issues"`, `"code": "partial_pagination"`}},
+		{name: "struct", payload: struct {
+			Count uint64 `json:"count"`
+		}{Count: 12345678901234567}, want: []string{`"count": 12345678901234567`, `"warnings"`}},
+		{name: "array", payload: []int{1, 2}, want: []string{`"result": [`, `"warnings"`}},
+	}
+
+	for _, tc := range tests {
+		got, err := withWarnings(tc.payload, warnings)
+		if err != nil {
+			t.Fatalf("%s: withWarnings() error = %v", tc.name, err)
+		}
+		rendered, err := output.RenderJSON(got)
+		if err != nil {
+			t.Fatalf("%s: RenderJSON() error = %v", tc.name, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(rendered, want) {
+				t.Fatalf("%s: expected %q in %s", tc.name, want, rendered)
+			}
+		}
+	}
+
+	payload := map[string]any{"issues": nil}
+	if got, _ := withWarnings(payload, nil); fmt.Sprint(got) != fmt.Sprint(payload) {
+		t.Fatalf("expected payload unchanged without warnings, got %v", got)
+	}
+}
+
+func TestFlushWarnings(t *testing.T) {
+	addWarnings(app.Warning{Code: "a", Message: "first"}, app.Warning{Code: "b", Message: "second"})
+	out := &bytes.Buffer{}
+	flushWarnings(out)
+	if out.String() != "warning: first\nwarning: second\n" {
+		t.Fatalf("unexpected warnings output: %q", out.String())
+	}
+	if remaining := takeWarnings(); len(remaining) != 0 {
+		t.Fatalf("expected warnings to be drained, got %+v", remaining)
+	}
+}
+
+func TestRecentJSONIncludesWarnings(t *testing.T) {
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":1,"title":"hot","status":"active"}]}}`)
+	}))
+	stderr := setupStderr(t)
+	setNoConfigStore(t)
+
+	runRootCommand(t, "recent", "--min-rate", "10/h", "--max-requests", "1", "--format", "json")
+
+	for _, want := range []string{`"warnings": [`, `"code": "enrichment_skipped"`, `"code": "request_budget"`} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in JSON output, got %q", want, stdout.String())
+		}
+	}
+	if stderr.Len() != 0 {
+		t.Fatalf("expected warnings only in JSON, got stderr %q", stderr.String())
+	}
+}
+
+func TestStrictMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		items   string
+		args    []string
+		wantErr error
+	}{
+		{name: "warnings fail", items: `{"items":[{"id":1,"counter":1,"title":"hot"}]}`, args: []string{"--min-rate", "10/h", "--max-requests", "1"}, wantErr: errStrictWarnings},
+		{name: "decode fallback fails", items: `[{"id":1,"counter":1,"title":"hot"}]`, wantErr: rollbar.ErrUnexpectedShape},
+		{name: "documented shape passes", items: `{"items":[{"id":1,"counter":1,"title":"hot"}]}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"err":0,"result":%s}`, tc.items)
+			}))
+			setupStderr(t)
+			setNoConfigStore(t)
+
+			cmd := NewRootCmd()
+			cmd.SetArgs(append([]string{"recent", "--strict"}, tc.args...))
+			err := cmd.Execute()
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Execute() error = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/events"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const clearScreen = "\033[H\033[2J"
+
+type watchOptions struct {
+	interval      time.Duration
+	count         int
+	escalateAbove string
+	stream        bool
+	active        bool
+	columns       []string
+}
+
+func newWatchCmd(flags *rootFlags) *cobra.Command {
+	options := watchOptions{}
+	watchCmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Refresh recent issues on an interval and show occurrence deltas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWatch(cmd.Context(), *flags, options)
+		},
+	}
+	watchCmd.Flags().DurationVar(&options.interval, "interval", 30*time.Second, "Time between refreshes")
+	watchCmd.Flags().IntVar(&options.count, "count", 0, "Stop after this many refreshes (0 runs until interrupted)")
+	watchCmd.Flags().BoolVar(&options.stream, "stream", false, "Print new and updated issues as lines instead of redrawing the table")
+	watchCmd.Flags().BoolVar(&options.active, "active", false, "Watch the top active issues instead of recent ones")
+	watchCmd.Flags().StringVar(&options.escalateAbove, "escalate-above", "", "Escalate issues whose rate between refreshes reaches this, e.g. 100/h")
+
+	return watchCmd
+}
+
+func runWatch(parent context.Context, flags rootFlags, options watchOptions) error {
+	if options.interval <= 0 {
+		return errors.New("--interval must be positive")
+	}
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+	if err := app.ValidateSortOrder(flags.Sort); err != nil {
+		return err
+	}
+	escalator, err := parseWatchEscalation(flags, options.escalateAbove)
+	if err != nil {
+		return err
+	}
+	if options.columns, err = parseListColumns(flags.Columns); err != nil {
+		return err
+	}
+
+	// Conditional requests keep polling cheap without serving stale refreshes.
+	flags.NoCache = true
+	rollbar.SetDefaultConditionalRequests(true)
+	defer rollbar.SetDefaultConditionalRequests(false)
+
+	if options.stream && isHumanFormat(flags.Format) {
+		list := "recent"
+		if options.active {
+			list = "active"
+		}
+		_, _ = fmt.Fprintf(stdoutWriter, "Watching %s issues every %s; new and updated issues are printed as they arrive\n", list, options.interval)
+	}
+
+	session := newWatchSession(flags, options, escalator)
+	ticker := time.NewTicker(options.interval)
+	defer ticker.Stop()
+
+	for refresh := 1; ; refresh++ {
+		if err := refreshWatch(parent, flags, filters, session, options); err != nil {
+			if parent.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if options.count > 0 && refresh >= options.count {
+			return nil
+		}
+
+		select {
+		case <-parent.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func parseWatchEscalation(flags rootFlags, value string) (*app.RateEscalator, error) {
+	threshold, err := app.ParseOccurrenceRate(value)
+	if err != nil {
+		return nil, fmt.Errorf("parse --escalate-above: %w", err)
+	}
+	if threshold == nil {
+		return nil, nil
+	}
+	if flags.Anonymize {
+		return nil, errors.New("--escalate-above cannot be combined with --anonymize")
+	}
+	if !flags.Yes {
+		return nil, errors.New("--escalate-above changes issue levels unattended; rerun with --yes")
+	}
+	if flags.NotifyWebhook != "" {
+		if err := validateOutboundURL("notify_webhook", flags.NotifyWebhook); err != nil {
+			return nil, err
+		}
+	}
+
+	return app.NewRateEscalator(*threshold), nil
+}
+
+type watchSession struct {
+	tracker     *app.OccurrenceTracker
+	detector    *events.Detector
+	escalator   *app.RateEscalator
+	threshold   string
+	bus         *events.Bus
+	escalations []app.Escalation
+}
+
+func newWatchSession(flags rootFlags, options watchOptions, escalator *app.RateEscalator) *watchSession {
+	session := &watchSession{
+		tracker:   app.NewOccurrenceTracker(),
+		detector:  events.NewDetector(options.interval),
+		escalator: escalator,
+		threshold: options.escalateAbove,
+		bus:       events.NewBus(),
+	}
+	if escalator != nil {
+		events.On(session.bus, func(ctx context.Context, spike events.Spike) error {
+			if escalation, ok := escalateSpike(ctx, flags, spike); ok {
+				session.escalations = append(session.escalations, escalation)
+			}
+			return nil
+		})
+	}
+
+	return session
+}
+
+func (s *watchSession) publish(ctx context.Context, deltas []app.IssueDelta, now time.Time) ([]app.Escalation, error) {
+	detected := s.detector.Detect(deltas, now)
+	if s.escalator != nil {
+		for _, issue := range s.escalator.Due(deltas, now) {
+			detected = append(detected, events.Spike{Issue: issue, Threshold: s.threshold, At: now})
+		}
+	}
+
+	s.escalations = make([]app.Escalation, 0)
+	err := s.bus.Publish(ctx, detected...)
+
+	return s.escalations, err
+}
+
+func refreshWatch(parent context.Context, flags rootFlags, filters app.IssueFilters, session *watchSession, options watchOptions) error {
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	var refreshedAt time.Time
+	issues, token, err := runServiceOperation(parent, flags, "", func(service *app.Service) ([]app.IssueSummary, error) {
+		refreshedAt = service.Now()
+		if options.active {
+			return service.Active(ctx, flags.Limit, filters)
+		}
+		return service.Recent(ctx, flags.Limit, filters)
+	})
+	if err != nil {
+		return err
+	}
+	if issues, err = anonymized(flags, issues); err != nil {
+		return err
+	}
+	_ = app.SortIssues(issues, flags.Sort)
+	deltas := session.tracker.Update(app.WithoutRaw(issues))
+	escalations, err := session.publish(parent, deltas, refreshedAt)
+	if err != nil {
+		return err
+	}
+	if options.stream {
+		return streamWatch(flags, token, deltas, escalations, refreshedAt)
+	}
+
+	if !isHumanFormat(flags.Format) {
+		payload := map[string]any{"refreshed_at": refreshedAt.Format(time.RFC3339), "issues": deltas}
+		if len(escalations) > 0 {
+			payload["escalations"] = escalations
+		}
+		return printOutput(flags.Format, "", redact.Value(payload, token))
+	}
+
+	if session.tracker.Stable() {
+		// Warnings repeat every poll; they were printed with the last redraw.
+		takeWarnings()
+		return nil
+	}
+
+	if shouldRenderProgress(flags.Format) {
+		_, _ = fmt.Fprint(stdoutWriter, clearScreen)
+	}
+	header := fmt.Sprintf("Every %s · refreshed %s · %d issues (%d changed)", options.interval, refreshedAt.Format(time.TimeOnly), len(deltas), countChanged(deltas))
+	for _, escalation := range escalations {
+		header += fmt.Sprintf("\nescalated issue %s from %s to %s", escalation.Issue.Counter.String(), escalation.From, escalation.To)
+	}
+
+	return printOutput(flags.Format, header+"\n"+output.RenderWatchHumanWithWidth(deltas, terminalRenderWidth(), options.columns, shouldUseColor(flags.Format), refreshedAt), nil)
+}
+
+func streamWatch(flags rootFlags, token string, deltas []app.IssueDelta, escalations []app.Escalation, refreshedAt time.Time) error {
+	changes := app.Changes(deltas)
+	if !isHumanFormat(flags.Format) {
+		for _, change := range changes {
+			event := "updated"
+			if change.New {
+				event = "new"
+			}
+			if err := printWatchEvent(flags.Format, redact.Value(map[string]any{"event": event, "refreshed_at": refreshedAt.Format(time.RFC3339), "issue": change}, token)); err != nil {
+				return err
+			}
+		}
+		for _, escalation := range escalations {
+			if err := printWatchEvent(flags.Format, redact.Value(map[string]any{"event": "escalated", "refreshed_at": refreshedAt.Format(time.RFC3339), "escalation": escalation}, token)); err != nil {
+				return err
+			}
+		}
+		flushWarnings(stderrWriter)
+		return nil
+	}
+
+	lines := make([]string, 0, 1+len(escalations))
+	if events := output.RenderWatchEvents(changes, refreshedAt.Local(), shouldUseColor(flags.Format)); events != "" {
+		lines = append(lines, events)
+	}
+	for _, escalation := range escalations {
+		lines = append(lines, fmt.Sprintf("%s escalated issue %s from %s to %s", refreshedAt.Local().Format(time.TimeOnly), escalation.Issue.Counter.String(), escalation.From, escalation.To))
+	}
+	if len(lines) == 0 {
+		flushWarnings(stderrWriter)
+		return nil
+	}
+
+	return printOutput(flags.Format, redact.String(strings.Join(lines, "\n"), token), nil)
+}
+
+func escalateSpike(parent context.Context, flags rootFlags, spike events.Spike) (app.Escalation, bool) {
+	ctx, cancel := commandContext(parent, flags, 10*time.Second)
+	defer cancel()
+
+	escalation, token, err := runServiceOperation(parent, flags, "", func(service *app.Service) (app.Escalation, error) {
+		return service.Escalate(ctx, spike.Issue.Counter)
+	})
+	if err != nil {
+		addWarnings(app.Warning{Code: warningEscalationFailed, Message: fmt.Sprintf("issue %s: %v", spike.Issue.Counter.String(), err)})
+		return app.Escalation{}, false
+	}
+	afterEscalation(ctx, flags, token, escalation, "rate reached "+spike.Threshold)
+	escalation.Issue.Raw = nil
+
+	return escalation, true
+}
+
+func printWatchEvent(format string, payload any) error {
+	if format != "json" {
+		return printOutput(format, "", payload)
+	}
+
+	return printJSONLine(payload)
+}
+
+func printJSONLine(payload any) error {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("render json: %w", err)
+	}
+	_, _ = fmt.Fprintln(stdoutWriter, string(encoded))
+
+	return nil
+}
+
+func countChanged(deltas []app.IssueDelta) int {
+	changed := 0
+	for _, delta := range deltas {
+		if delta.Changed {
+			changed++
+		}
+	}
+
+	return changed
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newGrowingItemsHandler() http.Handler {
+	var calls atomic.Int64
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		occurrences := 5 + 3*calls.Add(1)
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":{"items":[{"id":1,"counter":9,"title":"growing","status":"active","environment":"production","total_occurrences":%d}]}}`, occurrences)
+	})
+}
+
+func TestWatchShowsDeltas(t *testing.T) {
+	stdout := setupServerAndStdout(t, newGrowingItemsHandler())
+	setNoConfigStore(t)
+
+	runRootCommand(t, "watch", "--interval", "5ms", "--count", "2")
+
+	got := stdout.String()
+	if strings.Count(got, "refreshed") != 2 || !strings.Contains(got, "11 (+3)") || !strings.Contains(got, "(1 changed)") {
+		t.Fatalf("unexpected watch output: %q", got)
+	}
+}
+
+func TestWatchJSON(t *testing.T) {
+	stdout := setupServerAndStdout(t, newGrowingItemsHandler())
+	setNoConfigStore(t)
+
+	runRootCommand(t, "watch", "--interval", "5ms", "--count", "2", "--format", "json")
+
+	if !strings.Contains(stdout.String(), `"delta": 3`) || !strings.Contains(stdout.String(), `"refreshed_at"`) {
+		t.Fatalf("unexpected watch json: %q", stdout.String())
+	}
+}
+
+func TestWatchStopsOnCancel(t *testing.T) {
+	setupServerAndStdout(t, newGrowingItemsHandler())
+	setNoConfigStore(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := runWatch(ctx, rootFlags{Format: "human", Limit: 10}, watchOptions{interval: time.Hour}); err != nil {
+		t.Fatalf("runWatch() error = %v", err)
+	}
+}
+
+func TestWatchErrors(t *testing.T) {
+	setNoConfigStore(t)
+
+	for _, args := range [][]string{
+		{"watch", "--interval", "0s"},
+		{"watch", "--since", "nope"},
+		{"watch", "--sort", "nope"},
+	} {
+		cmd := NewRootCmd()
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
+
+func TestWatchRevalidatesAndSkipsUnchangedRenders(t *testing.T) {
+	var notModified atomic.Int64
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"items-v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"items-v1"`)
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":9,"title":"steady","status":"active","total_occurrences":5}]}}`)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "watch", "--interval", "5ms", "--count", "3")
+
+	if got := strings.Count(stdout.String(), "refreshed"); got != 1 {
+		t.Fatalf("expected a single render for unchanged refreshes, got %d: %q", got, stdout.String())
+	}
+	if notModified.Load() != 2 {
+		t.Fatalf("expected two 304 revalidations, got %d", notModified.Load())
+	}
+}
+
+func TestWatchStreamPrintsNewAndUpdatedIssues(t *testing.T) {
+	var calls atomic.Int64
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/reports/top_active_items" {
+			t.Fatalf("unexpected path %s", r.URL.Path)
+		}
+		items := `{"item":{"id":1,"counter":9,"title":"growing","status":"active","environment":"production","total_occurrences":5}}`
+		if calls.Add(1) > 1 {
+			items = `{"item":{"id":1,"counter":9,"title":"growing","status":"active","environment":"production","total_occurrences":8}},` +
+				`{"item":{"id":2,"counter":10,"title":"fresh","status":"active","environment":"production","total_occurrences":1}}`
+		}
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":[%s]}`, items)
+	}))
+	setNoConfigStore(t)
+
+	runRootCommand(t, "watch", "--stream", "--active", "--interval", "5ms", "--count", "3")
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[0], "Watching active issues every 5ms") ||
+		!strings.Contains(lines[1], "UPDATED #9 production 8 (+3) · growing") || !strings.Contains(lines[2], "NEW     #10 production 1 (new) · fresh") {
+		t.Fatalf("unexpected stream output: %q", stdout.String())
+	}
+}
+
+func TestWatchStreamJSONLines(t *testing.T) {
+	stdout := setupServerAndStdout(t, newGrowingItemsHandler())
+	setNoConfigStore(t)
+
+	runRootCommand(t, "watch", "--stream", "--interval", "5ms", "--count", "2", "--format", "json")
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 1 || !strings.HasPrefix(lines[0], `{"event":"updated"`) || !strings.Contains(lines[0], `"delta":3`) {
+		t.Fatalf("unexpected stream json: %q", stdout.String())
+	}
+}
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/output"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+func newWorkloadCmd(flags *rootFlags) *cobra.Command {
+	return &cobra.Command{
+		Use:   "workload",
+		Short: "Group active issues by assignee, with issue and occurrence counts",
+		Long: "Group the issues matching the list filters, active ones by default, by assignee, unassigned\n" +
+			"issues included, with how many issues and occurrences each has and their share of the total.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWorkload(cmd.Context(), *flags)
+		},
+	}
+}
+
+func runWorkload(parent context.Context, flags rootFlags) error {
+	filters, err := parseIssueFilters(flags)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := commandContext(parent, flags, time.Minute)
+	defer cancel()
+
+	workload, token, err := runServiceOperation(parent, flags, "Grouping issues by assignee", func(service *app.Service) (app.Workload, error) {
+		return service.Workload(ctx, filters)
+	})
+	if err != nil {
+		return err
+	}
+	if workload, err = anonymized(flags, workload); err != nil {
+		return err
+	}
+
+	return printOutput(flags.Format, redact.String(output.RenderWorkload(workload), token), redact.Value(map[string]any{"workload": workload}, token))
+}
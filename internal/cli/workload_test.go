@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWorkloadCommand(t *testing.T) {
+	setNoConfigStore(t)
+	stdout := setupServerAndStdout(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/items":
+			if r.URL.Query().Get("page") != "1" {
+				_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[]}}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[
+				{"id":1,"counter":1,"title":"a","status":"active","assigned_user_id":5,"total_occurrences":30},
+				{"id":2,"counter":2,"title":"b","status":"active","assigned_user_id":5,"total_occurrences":10},
+				{"id":3,"counter":3,"title":"c","status":"active","total_occurrences":60}
+			]}}`)
+		case "/api/1/user/5":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = fmt.Fprint(w, `{"err":1,"message":"insufficient privileges"}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+
+	runRootCommand(t, "workload")
+	for _, want := range []string{"user 5", "(unassigned)", "67%", "40", "60%", "TOTAL"} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in output, got %q", want, stdout.String())
+		}
+	}
+
+	stdout.Reset()
+	runRootCommand(t, "workload", "--format", "json")
+	for _, want := range []string{`"workload": {`, `"assignee": null`, `"items": 2`, `"occurrences": 100`} {
+		if !strings.Contains(stdout.String(), want) {
+			t.Fatalf("expected %q in json output, got %q", want, stdout.String())
+		}
+	}
+}
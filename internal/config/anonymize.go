@@ -0,0 +1,51 @@
+package config
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const anonymizeKeySize = 32
+
+type AnonymizeKeyStore struct {
+	path string
+}
+
+func NewAnonymizeKeyStore() (*AnonymizeKeyStore, error) {
+	configRoot, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve config dir: %w", err)
+	}
+
+	return &AnonymizeKeyStore{path: filepath.Join(configRoot, "rollbaz", "anonymize.key")}, nil
+}
+
+func NewAnonymizeKeyStoreAtPath(path string) *AnonymizeKeyStore {
+	return &AnonymizeKeyStore{path: path}
+}
+
+func (s *AnonymizeKeyStore) Key() ([]byte, error) {
+	key, err := os.ReadFile(s.path)
+	if err == nil && len(key) == anonymizeKeySize {
+		return key, nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read anonymize key: %w", err)
+	}
+
+	key = make([]byte, anonymizeKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate anonymize key: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return nil, fmt.Errorf("create config directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, key, 0o600); err != nil {
+		return nil, fmt.Errorf("write anonymize key: %w", err)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnonymizeKeyStoreCreatesStableKey(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "anonymize.key")
+	store := NewAnonymizeKeyStoreAtPath(path)
+
+	first, err := store.Key()
+	if err != nil || len(first) != anonymizeKeySize {
+		t.Fatalf("Key() = %d bytes, err=%v", len(first), err)
+	}
+	second, err := store.Key()
+	if err != nil || !bytes.Equal(first, second) {
+		t.Fatalf("expected stable key, err=%v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("key file mode = %v", info.Mode().Perm())
+	}
+}
+
+func TestAnonymizeKeyStoreReplacesMalformedKey(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "anonymize.key")
+	if err := os.WriteFile(path, []byte("short"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	key, err := NewAnonymizeKeyStoreAtPath(path).Key()
+	if err != nil || len(key) != anonymizeKeySize {
+		t.Fatalf("Key() = %d bytes, err=%v", len(key), err)
+	}
+}
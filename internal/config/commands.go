@@ -0,0 +1,67 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type CommandSettings struct {
+	Timeout string `json:"timeout,omitempty"`
+	Retries *int   `json:"retries,omitempty"`
+}
+
+func (s CommandSettings) TimeoutDuration() (time.Duration, error) {
+	if s.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(s.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid timeout %q", s.Timeout)
+	}
+
+	return timeout, nil
+}
+
+func (f File) CommandSettings(path string) CommandSettings {
+	path = strings.Join(strings.Fields(path), " ")
+	if path == "" {
+		return CommandSettings{}
+	}
+
+	settings := CommandSettings{}
+	top, _, nested := strings.Cut(path, " ")
+	entries := []string{top}
+	if nested {
+		entries = append(entries, path)
+	}
+	for _, name := range entries {
+		entry := f.Commands[name]
+		if entry.Timeout != "" {
+			settings.Timeout = entry.Timeout
+		}
+		if entry.Retries != nil {
+			settings.Retries = entry.Retries
+		}
+	}
+
+	return settings
+}
+
+func normalizeCommands(commands map[string]CommandSettings) map[string]CommandSettings {
+	if len(commands) == 0 {
+		return nil
+	}
+
+	normalized := make(map[string]CommandSettings, len(commands))
+	for name, settings := range commands {
+		name = strings.Join(strings.Fields(name), " ")
+		if name == "" {
+			continue
+		}
+		settings.Timeout = strings.TrimSpace(settings.Timeout)
+		normalized[name] = settings
+	}
+
+	return normalized
+}
@@ -0,0 +1,75 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileCommandSettings(t *testing.T) {
+	t.Parallel()
+
+	five, two := 5, 2
+	file := File{Commands: map[string]CommandSettings{
+		"export":       {Timeout: "10m", Retries: &five},
+		"deploys":      {Timeout: "30s", Retries: &two},
+		"deploys list": {Timeout: "1m"},
+	}}
+
+	tests := []struct {
+		path        string
+		wantTimeout string
+		wantRetries *int
+	}{
+		{path: "export", wantTimeout: "10m", wantRetries: &five},
+		{path: "deploys list", wantTimeout: "1m", wantRetries: &two},
+		{path: "deploys record", wantTimeout: "30s", wantRetries: &two},
+		{path: "recent"},
+		{path: ""},
+	}
+
+	for _, tc := range tests {
+		got := file.CommandSettings(tc.path)
+		if got.Timeout != tc.wantTimeout || got.Retries != tc.wantRetries {
+			t.Fatalf("CommandSettings(%q) = %+v", tc.path, got)
+		}
+	}
+}
+
+func TestCommandSettingsTimeoutDuration(t *testing.T) {
+	t.Parallel()
+
+	if got, err := (CommandSettings{}).TimeoutDuration(); err != nil || got != 0 {
+		t.Fatalf("empty TimeoutDuration() = %v, %v", got, err)
+	}
+	if got, err := (CommandSettings{Timeout: "30s"}).TimeoutDuration(); err != nil || got != 30*time.Second {
+		t.Fatalf("TimeoutDuration() = %v, %v", got, err)
+	}
+	for _, value := range []string{"soon", "-1s", "0s"} {
+		if _, err := (CommandSettings{Timeout: value}).TimeoutDuration(); err == nil {
+			t.Fatalf("expected error for %q", value)
+		}
+	}
+}
+
+func TestStoreRoundTripsCommandSettings(t *testing.T) {
+	t.Parallel()
+
+	store := NewStoreAtPath(filepath.Join(t.TempDir(), "config.json"))
+	retries := 5
+	if err := store.Save(File{Commands: map[string]CommandSettings{
+		" deploys  list ": {Timeout: " 30s ", Retries: &retries},
+		"  ":              {Timeout: "1s"},
+	}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	file, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	settings, ok := file.Commands["deploys list"]
+	if len(file.Commands) != 1 || !ok || settings.Timeout != "30s" || settings.Retries == nil || *settings.Retries != 5 {
+		t.Fatalf("unexpected commands: %+v", file.Commands)
+	}
+}
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type ItemContext struct {
+	Listed    []uint64  `json:"listed,omitempty"`
+	Last      uint64    `json:"last,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ContextStore struct {
+	path string
+}
+
+func NewContextStore() (*ContextStore, error) {
+	configRoot, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve config dir: %w", err)
+	}
+
+	return &ContextStore{path: filepath.Join(configRoot, "rollbaz", "context.json")}, nil
+}
+
+func NewContextStoreAtPath(path string) *ContextStore {
+	return &ContextStore{path: path}
+}
+
+func (s *ContextStore) Get(key string) (ItemContext, error) {
+	contexts, err := s.load()
+	if err != nil {
+		return ItemContext{}, err
+	}
+
+	return contexts[key], nil
+}
+
+func (s *ContextStore) Save(key string, context ItemContext) error {
+	contexts, err := s.load()
+	if err != nil {
+		return err
+	}
+	contexts[key] = context
+
+	return s.write(contexts)
+}
+
+func (s *ContextStore) load() (map[string]ItemContext, error) {
+	body, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]ItemContext{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read item context: %w", err)
+	}
+
+	contexts := map[string]ItemContext{}
+	if err := json.Unmarshal(body, &contexts); err != nil {
+		return nil, fmt.Errorf("decode item context: %w", err)
+	}
+
+	return contexts, nil
+}
+
+func (s *ContextStore) write(contexts map[string]ItemContext) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+
+	body, err := json.MarshalIndent(contexts, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode item context: %w", err)
+	}
+
+	if err := os.WriteFile(s.path, append(body, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write item context: %w", err)
+	}
+
+	return nil
+}
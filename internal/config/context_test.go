@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestContextStoreSaveGet(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "context.json")
+	store := NewContextStoreAtPath(path)
+
+	context, err := store.Get("project-a")
+	if err != nil || context.Last != 0 || len(context.Listed) != 0 {
+		t.Fatalf("Get() empty = %+v, err=%v", context, err)
+	}
+
+	if err := store.Save("project-a", ItemContext{Listed: []uint64{7, 3}, Last: 3}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := store.Save("project-b", ItemContext{Last: 42}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	context, err = store.Get("project-a")
+	if err != nil || !slices.Equal(context.Listed, []uint64{7, 3}) || context.Last != 3 {
+		t.Fatalf("Get() = %+v, err=%v", context, err)
+	}
+	if context, _ := store.Get("project-b"); context.Last != 42 {
+		t.Fatalf("expected separate project context, got %+v", context)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("item context permissions = %v, err=%v", info, err)
+	}
+}
+
+func TestContextStoreRejectsCorruptFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "context.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := NewContextStoreAtPath(path).Get("project-a"); err == nil {
+		t.Fatalf("expected decode error")
+	}
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type SyncCursor struct {
+	LastOccurrence uint64    `json:"last_occurrence"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type CursorStore struct {
+	path string
+}
+
+func NewCursorStore() (*CursorStore, error) {
+	configRoot, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve config dir: %w", err)
+	}
+
+	return &CursorStore{path: filepath.Join(configRoot, "rollbaz", "sync.json")}, nil
+}
+
+func NewCursorStoreAtPath(path string) *CursorStore {
+	return &CursorStore{path: path}
+}
+
+func (s *CursorStore) Get(key string) (SyncCursor, error) {
+	cursors, err := s.load()
+	if err != nil {
+		return SyncCursor{}, err
+	}
+
+	return cursors[key], nil
+}
+
+func (s *CursorStore) Save(key string, cursor SyncCursor) error {
+	cursors, err := s.load()
+	if err != nil {
+		return err
+	}
+	cursors[key] = cursor
+
+	return s.write(cursors)
+}
+
+func (s *CursorStore) Reset(key string) error {
+	cursors, err := s.load()
+	if err != nil {
+		return err
+	}
+	delete(cursors, key)
+
+	return s.write(cursors)
+}
+
+func (s *CursorStore) load() (map[string]SyncCursor, error) {
+	body, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]SyncCursor{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read sync cursors: %w", err)
+	}
+
+	cursors := map[string]SyncCursor{}
+	if err := json.Unmarshal(body, &cursors); err != nil {
+		return nil, fmt.Errorf("decode sync cursors: %w", err)
+	}
+
+	return cursors, nil
+}
+
+func (s *CursorStore) write(cursors map[string]SyncCursor) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+
+	body, err := json.MarshalIndent(cursors, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode sync cursors: %w", err)
+	}
+
+	if err := os.WriteFile(s.path, append(body, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write sync cursors: %w", err)
+	}
+
+	return nil
+}
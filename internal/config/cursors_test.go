@@ -0,0 +1,80 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCursorStoreSaveGetReset(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "sync.json")
+	store := NewCursorStoreAtPath(path)
+
+	cursor, err := store.Get("project-a")
+	if err != nil || cursor.LastOccurrence != 0 {
+		t.Fatalf("Get() empty = %+v, err=%v", cursor, err)
+	}
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := store.Save("project-a", SyncCursor{LastOccurrence: 1700, UpdatedAt: now}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if err := store.Save("project-b", SyncCursor{LastOccurrence: 42}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	cursor, err = store.Get("project-a")
+	if err != nil || cursor.LastOccurrence != 1700 || !cursor.UpdatedAt.Equal(now) {
+		t.Fatalf("Get() = %+v, err=%v", cursor, err)
+	}
+
+	if err := store.Reset("project-a"); err != nil {
+		t.Fatalf("Reset() error = %v", err)
+	}
+	if cursor, _ := store.Get("project-a"); cursor.LastOccurrence != 0 {
+		t.Fatalf("expected reset cursor, got %+v", cursor)
+	}
+	if cursor, _ := store.Get("project-b"); cursor.LastOccurrence != 42 {
+		t.Fatalf("reset must keep other projects, got %+v", cursor)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("sync cursor permissions = %v, err=%v", info, err)
+	}
+}
+
+func TestCursorStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "sync.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	store := NewCursorStoreAtPath(path)
+	if _, err := store.Get("a"); err == nil {
+		t.Fatalf("expected decode error")
+	}
+	if err := store.Save("a", SyncCursor{}); err == nil {
+		t.Fatalf("expected save error for corrupt cursors")
+	}
+	if err := store.Reset("a"); err == nil {
+		t.Fatalf("expected reset error for corrupt cursors")
+	}
+
+	blocked := NewCursorStoreAtPath(filepath.Join(path, "sync.json"))
+	if err := blocked.Save("a", SyncCursor{}); err == nil {
+		t.Fatalf("expected directory creation error")
+	}
+}
+
+func TestNewCursorStore(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if _, err := NewCursorStore(); err != nil {
+		t.Fatalf("NewCursorStore() error = %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+type DumpDirStore struct {
+	path string
+}
+
+func NewDumpDirStore() (*DumpDirStore, error) {
+	configRoot, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve config dir: %w", err)
+	}
+
+	return &DumpDirStore{path: filepath.Join(configRoot, "rollbaz", "dump-dirs.json")}, nil
+}
+
+func NewDumpDirStoreAtPath(path string) *DumpDirStore {
+	return &DumpDirStore{path: path}
+}
+
+func (s *DumpDirStore) Load() ([]string, error) {
+	body, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read dump directories: %w", err)
+	}
+
+	dirs := []string{}
+	if err := json.Unmarshal(body, &dirs); err != nil {
+		return nil, fmt.Errorf("decode dump directories: %w", err)
+	}
+
+	return dirs, nil
+}
+
+func (s *DumpDirStore) Record(dir string) error {
+	absolute, err := filepath.Abs(dir)
+	if err != nil {
+		return fmt.Errorf("resolve dump directory: %w", err)
+	}
+	dirs, err := s.Load()
+	if err != nil {
+		return err
+	}
+	if slices.Contains(dirs, absolute) {
+		return nil
+	}
+	dirs = append(dirs, absolute)
+	slices.Sort(dirs)
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+	body, err := json.MarshalIndent(dirs, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode dump directories: %w", err)
+	}
+	if err := os.WriteFile(s.path, append(body, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write dump directories: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestDumpDirStoreRecordsAbsolutePathsOnce(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	path := filepath.Join(root, "nested", "dump-dirs.json")
+	store := NewDumpDirStoreAtPath(path)
+	if dirs, err := store.Load(); err != nil || len(dirs) != 0 {
+		t.Fatalf("Load() empty = %v, err=%v", dirs, err)
+	}
+
+	payloads := filepath.Join(root, "payloads")
+	for _, dir := range []string{payloads, filepath.Join(root, "a"), payloads + "/"} {
+		if err := store.Record(dir); err != nil {
+			t.Fatalf("Record(%q) error = %v", dir, err)
+		}
+	}
+
+	dirs, err := store.Load()
+	if err != nil || !slices.Equal(dirs, []string{filepath.Join(root, "a"), payloads}) {
+		t.Fatalf("Load() = %v, err=%v", dirs, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("dump directory permissions = %v, err=%v", info, err)
+	}
+}
+
+func TestDumpDirStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "dump-dirs.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	store := NewDumpDirStoreAtPath(path)
+	if _, err := store.Load(); err == nil {
+		t.Fatalf("expected decode error")
+	}
+	if err := store.Record(t.TempDir()); err == nil {
+		t.Fatalf("expected Record to surface the decode error")
+	}
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const maxHistoryEntries = 200
+
+type HistoryEntry struct {
+	ID   int       `json:"id"`
+	Time time.Time `json:"time"`
+	Args []string  `json:"args"`
+}
+
+// NextID outlives every entry, so IDs keep counting up after pruning.
+type historyFile struct {
+	NextID  int            `json:"next_id"`
+	Entries []HistoryEntry `json:"entries"`
+}
+
+type HistoryStore struct {
+	path string
+}
+
+func NewHistoryStore() (*HistoryStore, error) {
+	configRoot, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve config dir: %w", err)
+	}
+
+	return &HistoryStore{path: filepath.Join(configRoot, "rollbaz", "history.json")}, nil
+}
+
+func NewHistoryStoreAtPath(path string) *HistoryStore {
+	return &HistoryStore{path: path}
+}
+
+func (s *HistoryStore) Load() ([]HistoryEntry, error) {
+	file, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return file.Entries, nil
+}
+
+func (s *HistoryStore) Append(entry HistoryEntry) error {
+	return withFileLock(s.path, func() error {
+		file, err := s.load()
+		if err != nil {
+			return err
+		}
+
+		entry.ID = file.NextID
+		file.NextID++
+		file.Entries = append(file.Entries, entry)
+		if len(file.Entries) > maxHistoryEntries {
+			file.Entries = file.Entries[len(file.Entries)-maxHistoryEntries:]
+		}
+
+		return s.save(file)
+	})
+}
+
+func (s *HistoryStore) Prune(cutoff time.Time) (int, error) {
+	removed := 0
+	err := withFileLock(s.path, func() error {
+		file, err := s.load()
+		if err != nil {
+			return err
+		}
+
+		kept := make([]HistoryEntry, 0, len(file.Entries))
+		for _, entry := range file.Entries {
+			if !entry.Time.Before(cutoff) {
+				kept = append(kept, entry)
+			}
+		}
+		removed = len(file.Entries) - len(kept)
+		if removed == 0 {
+			return nil
+		}
+		file.Entries = kept
+
+		return s.save(file)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return removed, nil
+}
+
+func (s *HistoryStore) load() (historyFile, error) {
+	body, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return historyFile{NextID: 1, Entries: []HistoryEntry{}}, nil
+	}
+	if err != nil {
+		return historyFile{}, fmt.Errorf("read history: %w", err)
+	}
+
+	var file historyFile
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(body, &file.Entries)
+	} else {
+		err = json.Unmarshal(body, &file)
+	}
+	if err != nil {
+		return historyFile{}, fmt.Errorf("decode history: %w", err)
+	}
+	if file.Entries == nil {
+		file.Entries = []HistoryEntry{}
+	}
+
+	lastID := 0
+	for index := range file.Entries {
+		if file.Entries[index].ID <= lastID {
+			file.Entries[index].ID = lastID + 1
+		}
+		lastID = file.Entries[index].ID
+	}
+	file.NextID = max(file.NextID, lastID+1)
+
+	return file, nil
+}
+
+func (s *HistoryStore) save(file historyFile) error {
+	body, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode history: %w", err)
+	}
+
+	if err := writeFileAtomic(s.path, append(body, '\n')); err != nil {
+		return fmt.Errorf("write history: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestHistoryStoreAppendAndLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "history.json")
+	store := NewHistoryStoreAtPath(path)
+
+	entries, err := store.Load()
+	if err != nil || len(entries) != 0 {
+		t.Fatalf("Load() empty = %+v, err=%v", entries, err)
+	}
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := store.Append(HistoryEntry{Time: now, Args: []string{"active", "--env", "production"}}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	entries, err = store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(entries) != 1 || !entries[0].Time.Equal(now) || len(entries[0].Args) != 3 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("history permissions = %o, want 600", info.Mode().Perm())
+	}
+}
+
+func TestHistoryStoreConcurrentAppends(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "history.json")
+	var wg sync.WaitGroup
+	for index := range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			store := NewHistoryStoreAtPath(path)
+			if err := store.Append(HistoryEntry{Args: []string{strconv.Itoa(index)}}); err != nil {
+				t.Errorf("Append() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	entries, err := NewHistoryStoreAtPath(path).Load()
+	if err != nil || len(entries) != 20 {
+		t.Fatalf("Load() = %d entries, err=%v", len(entries), err)
+	}
+	seen := map[int]bool{}
+	for _, entry := range entries {
+		seen[entry.ID] = true
+	}
+	if len(seen) != 20 {
+		t.Fatalf("expected 20 distinct IDs, got %+v", entries)
+	}
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Fatalf("expected lock to be released, stat err=%v", err)
+	}
+}
+
+func TestHistoryStoreBreaksStaleLock(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "history.json")
+	if err := os.WriteFile(path+".lock", nil, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	stale := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(path+".lock", stale, stale); err != nil {
+		t.Fatalf("Chtimes() error = %v", err)
+	}
+
+	if err := NewHistoryStoreAtPath(path).Append(HistoryEntry{Args: []string{"recent"}}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+}
+
+func TestHistoryStoreKeepsMostRecentEntries(t *testing.T) {
+	t.Parallel()
+
+	store := NewHistoryStoreAtPath(filepath.Join(t.TempDir(), "history.json"))
+	for index := 0; index < maxHistoryEntries+5; index++ {
+		if err := store.Append(HistoryEntry{Args: []string{"show", strconv.Itoa(index)}}); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	entries, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(entries) != maxHistoryEntries || entries[0].Args[1] != "5" || entries[0].ID != 6 {
+		t.Fatalf("unexpected trimmed history: len=%d first=%+v", len(entries), entries[0])
+	}
+}
+
+func TestHistoryStoreIDsSurvivePruning(t *testing.T) {
+	t.Parallel()
+
+	store := NewHistoryStoreAtPath(filepath.Join(t.TempDir(), "history.json"))
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	for range 2 {
+		if err := store.Append(HistoryEntry{Time: now.AddDate(0, 0, -30)}); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+	if _, err := store.Prune(now); err != nil {
+		t.Fatalf("Prune() error = %v", err)
+	}
+	if err := store.Append(HistoryEntry{Time: now}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	entries, err := store.Load()
+	if err != nil || len(entries) != 1 || entries[0].ID != 3 {
+		t.Fatalf("expected the next ID after pruning everything, got %+v, err=%v", entries, err)
+	}
+}
+
+func TestHistoryStoreNumbersLegacyEntries(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "history.json")
+	if err := os.WriteFile(path, []byte(`[{"time":"2024-01-01T00:00:00Z","args":["recent"]},{"time":"2024-01-02T00:00:00Z","args":["active"]}]`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	store := NewHistoryStoreAtPath(path)
+	if err := store.Append(HistoryEntry{Args: []string{"show", "1"}}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	entries, err := store.Load()
+	if err != nil || len(entries) != 3 || entries[0].ID != 1 || entries[1].ID != 2 || entries[2].ID != 3 {
+		t.Fatalf("unexpected legacy numbering: %+v, err=%v", entries, err)
+	}
+}
+
+func TestHistoryStoreErrors(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "history.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	store := NewHistoryStoreAtPath(path)
+	if _, err := store.Load(); err == nil {
+		t.Fatalf("expected decode error")
+	}
+	if err := store.Append(HistoryEntry{}); err == nil {
+		t.Fatalf("expected append error for corrupt history")
+	}
+}
+
+func TestNewHistoryStore(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if _, err := NewHistoryStore(); err != nil {
+		t.Fatalf("NewHistoryStore() error = %v", err)
+	}
+}
+
+func TestHistoryStorePrune(t *testing.T) {
+	t.Parallel()
+
+	store := NewHistoryStoreAtPath(filepath.Join(t.TempDir(), "history.json"))
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	for _, age := range []int{10, 5, 1} {
+		if err := store.Append(HistoryEntry{Time: now.AddDate(0, 0, -age), Args: []string{strconv.Itoa(age)}}); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	removed, err := store.Prune(now.AddDate(0, 0, -7))
+	if err != nil || removed != 1 {
+		t.Fatalf("Prune() = %d, %v", removed, err)
+	}
+	entries, err := store.Load()
+	if err != nil || len(entries) != 2 || entries[0].Args[0] != "5" {
+		t.Fatalf("unexpected entries after prune: %+v, err=%v", entries, err)
+	}
+
+	if removed, err := store.Prune(now.AddDate(0, 0, -7)); err != nil || removed != 0 {
+		t.Fatalf("second Prune() = %d, %v", removed, err)
+	}
+
+	broken := NewHistoryStoreAtPath(filepath.Join(t.TempDir(), "history.json"))
+	if err := os.WriteFile(broken.path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := broken.Prune(now); err == nil {
+		t.Fatalf("expected decode error")
+	}
+}
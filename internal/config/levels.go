@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const maxLevelChangesPerProject = 500
+
+type LevelChange struct {
+	Time    time.Time `json:"time"`
+	Counter uint64    `json:"counter"`
+	From    string    `json:"from"`
+	To      string    `json:"to"`
+	Reason  string    `json:"reason,omitempty"`
+}
+
+type LevelHistoryStore struct {
+	path string
+}
+
+func NewLevelHistoryStore() (*LevelHistoryStore, error) {
+	configRoot, err := os.UserConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve config dir: %w", err)
+	}
+
+	return &LevelHistoryStore{path: filepath.Join(configRoot, "rollbaz", "levels.json")}, nil
+}
+
+func NewLevelHistoryStoreAtPath(path string) *LevelHistoryStore {
+	return &LevelHistoryStore{path: path}
+}
+
+func (s *LevelHistoryStore) Item(project string, counter uint64) ([]LevelChange, error) {
+	history, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]LevelChange, 0)
+	for _, change := range history[project] {
+		if change.Counter == counter {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
+
+func (s *LevelHistoryStore) Append(project string, change LevelChange) error {
+	history, err := s.load()
+	if err != nil {
+		return err
+	}
+
+	changes := append(history[project], change)
+	if len(changes) > maxLevelChangesPerProject {
+		changes = changes[len(changes)-maxLevelChangesPerProject:]
+	}
+	history[project] = changes
+
+	return s.write(history)
+}
+
+func (s *LevelHistoryStore) load() (map[string][]LevelChange, error) {
+	body, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string][]LevelChange{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read level history: %w", err)
+	}
+
+	history := map[string][]LevelChange{}
+	if err := json.Unmarshal(body, &history); err != nil {
+		return nil, fmt.Errorf("decode level history: %w", err)
+	}
+
+	return history, nil
+}
+
+func (s *LevelHistoryStore) write(history map[string][]LevelChange) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+
+	body, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode level history: %w", err)
+	}
+
+	if err := os.WriteFile(s.path, append(body, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write level history: %w", err)
+	}
+
+	return nil
+}
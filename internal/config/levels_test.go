@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLevelHistoryStore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "nested", "levels.json")
+	store := NewLevelHistoryStoreAtPath(path)
+
+	changes, err := store.Item("project-a", 4)
+	if err != nil || len(changes) != 0 {
+		t.Fatalf("Item() empty = %+v, err=%v", changes, err)
+	}
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, change := range []LevelChange{
+		{Time: now, Counter: 4, From: "warning", To: "error", Reason: "manual"},
+		{Time: now.Add(time.Hour), Counter: 5, From: "info", To: "warning"},
+		{Time: now.Add(2 * time.Hour), Counter: 4, From: "error", To: "critical", Reason: "rate 120/h"},
+	} {
+		if err := store.Append("project-a", change); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+	if err := store.Append("project-b", LevelChange{Counter: 4, From: "debug", To: "info"}); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	changes, err = store.Item("project-a", 4)
+	if err != nil || len(changes) != 2 || changes[0].To != "error" || changes[1].To != "critical" || !changes[0].Time.Equal(now) {
+		t.Fatalf("Item() = %+v, err=%v", changes, err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("level history permissions = %v, err=%v", info, err)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := store.Item("project-a", 4); err == nil {
+		t.Fatalf("expected decode error")
+	}
+}
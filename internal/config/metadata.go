@@ -0,0 +1,118 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+const ProjectMetadataTTL = 24 * time.Hour
+
+type ProjectMetadata struct {
+	ProjectID   domain.ProjectID   `json:"project_id"`
+	ProjectSlug domain.ProjectSlug `json:"project_slug"`
+	AccountID   uint64             `json:"account_id"`
+	AccountSlug string             `json:"account_slug,omitempty"`
+	FetchedAt   time.Time          `json:"fetched_at"`
+}
+
+type MetadataCache struct {
+	path string
+	ttl  time.Duration
+}
+
+func NewMetadataCache() (*MetadataCache, error) {
+	cacheRoot, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve cache dir: %w", err)
+	}
+
+	return NewMetadataCacheAtPath(filepath.Join(cacheRoot, "rollbaz", "projects.json")), nil
+}
+
+func NewMetadataCacheAtPath(path string) *MetadataCache {
+	return &MetadataCache{path: path, ttl: ProjectMetadataTTL}
+}
+
+func (c *MetadataCache) Get(key string, now time.Time) (ProjectMetadata, bool, error) {
+	entries, err := c.load()
+	if err != nil {
+		return ProjectMetadata{}, false, err
+	}
+
+	metadata, ok := entries[key]
+	if !ok || now.Sub(metadata.FetchedAt) > c.ttl {
+		return ProjectMetadata{}, false, nil
+	}
+
+	return metadata, true, nil
+}
+
+func (c *MetadataCache) Put(key string, metadata ProjectMetadata) error {
+	entries, err := c.load()
+	if err != nil {
+		entries = map[string]ProjectMetadata{}
+	}
+	entries[key] = metadata
+
+	return c.save(entries)
+}
+
+func (c *MetadataCache) save(entries map[string]ProjectMetadata) error {
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return fmt.Errorf("create cache directory: %w", err)
+	}
+
+	body, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode project metadata: %w", err)
+	}
+
+	if err := os.WriteFile(c.path, append(body, '\n'), 0o600); err != nil {
+		return fmt.Errorf("write project metadata: %w", err)
+	}
+
+	return nil
+}
+
+func (c *MetadataCache) Prune(now time.Time) (int, error) {
+	entries, err := c.load()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for key, metadata := range entries {
+		if now.Sub(metadata.FetchedAt) > c.ttl {
+			delete(entries, key)
+			removed++
+		}
+	}
+	if removed == 0 {
+		return 0, nil
+	}
+
+	return removed, c.save(entries)
+}
+
+func (c *MetadataCache) load() (map[string]ProjectMetadata, error) {
+	body, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]ProjectMetadata{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read project metadata: %w", err)
+	}
+
+	entries := map[string]ProjectMetadata{}
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, fmt.Errorf("decode project metadata: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMetadataCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cache", "projects.json")
+	cache := NewMetadataCacheAtPath(path)
+	fetched := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	if _, ok, err := cache.Get("key", fetched); ok || err != nil {
+		t.Fatalf("Get() on empty cache = %v, %v", ok, err)
+	}
+
+	metadata := ProjectMetadata{ProjectID: 42, ProjectSlug: "checkout", AccountID: 7, AccountSlug: "acme", FetchedAt: fetched}
+	if err := cache.Put("key", metadata); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+
+	got, ok, err := cache.Get("key", fetched.Add(time.Hour))
+	if err != nil || !ok || got.ProjectSlug != "checkout" || got.AccountSlug != "acme" {
+		t.Fatalf("Get() fresh = %+v, %v, %v", got, ok, err)
+	}
+	if _, ok, _ := cache.Get("key", fetched.Add(ProjectMetadataTTL+time.Second)); ok {
+		t.Fatalf("expected expired entry to miss")
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("cache permissions = %v, err=%v", info, err)
+	}
+}
+
+func TestMetadataCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "projects.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	cache := NewMetadataCacheAtPath(path)
+	if _, _, err := cache.Get("key", time.Now()); err == nil {
+		t.Fatalf("expected decode error")
+	}
+	if err := cache.Put("key", ProjectMetadata{ProjectID: 1}); err != nil {
+		t.Fatalf("Put() should replace a corrupt cache, got %v", err)
+	}
+	if _, ok, err := cache.Get("key", time.Now()); err != nil || ok {
+		t.Fatalf("zero FetchedAt should be expired: ok=%v err=%v", ok, err)
+	}
+
+	blocked := NewMetadataCacheAtPath(filepath.Join(path, "projects.json"))
+	if err := blocked.Put("key", ProjectMetadata{}); err == nil {
+		t.Fatalf("expected directory creation error")
+	}
+}
+
+func TestMetadataCachePrune(t *testing.T) {
+	t.Parallel()
+
+	cache := NewMetadataCacheAtPath(filepath.Join(t.TempDir(), "projects.json"))
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	_ = cache.Put("fresh", ProjectMetadata{ProjectID: 1, FetchedAt: now})
+	_ = cache.Put("stale", ProjectMetadata{ProjectID: 2, FetchedAt: now.Add(-2 * ProjectMetadataTTL)})
+
+	if removed, err := cache.Prune(now); err != nil || removed != 1 {
+		t.Fatalf("Prune() = %d, %v", removed, err)
+	}
+	if _, ok, _ := cache.Get("fresh", now); !ok {
+		t.Fatalf("fresh entry should survive prune")
+	}
+	if removed, err := cache.Prune(now); err != nil || removed != 0 {
+		t.Fatalf("second Prune() = %d, %v", removed, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "projects.json")
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := NewMetadataCacheAtPath(path).Prune(now); err == nil {
+		t.Fatalf("expected decode error")
+	}
+}
+
+func TestNewMetadataCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	if _, err := NewMetadataCache(); err != nil {
+		t.Fatalf("NewMetadataCache() error = %v", err)
+	}
+}
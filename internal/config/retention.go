@@ -0,0 +1,142 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+)
+
+type Retention struct {
+	MaxAgeDays int      `json:"max_age_days,omitempty"`
+	MaxSizeMB  int      `json:"max_size_mb,omitempty"`
+	DumpDirs   []string `json:"dump_dirs,omitempty"`
+}
+
+type PruneResult struct {
+	Files int   `json:"files"`
+	Bytes int64 `json:"bytes"`
+}
+
+var occurrenceDumpFile = regexp.MustCompile(`^[0-9]+\.json$`)
+
+func (r Retention) Enabled() bool {
+	return r.MaxAgeDays > 0 || r.MaxSizeMB > 0
+}
+
+func (r Retention) Cutoff(now time.Time) time.Time {
+	if r.MaxAgeDays <= 0 {
+		return time.Time{}
+	}
+
+	return now.AddDate(0, 0, -r.MaxAgeDays)
+}
+
+func (r Retention) MaxBytes() int64 {
+	return int64(r.MaxSizeMB) * 1024 * 1024
+}
+
+type dumpFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+func PruneDumpDir(dir string, cutoff time.Time, maxBytes int64) (PruneResult, error) {
+	files, err := listDumpFiles(dir)
+	if err != nil {
+		return PruneResult{}, err
+	}
+	sort.Slice(files, func(i int, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	var total int64
+	for _, file := range files {
+		total += file.size
+	}
+
+	result := PruneResult{}
+	for _, file := range files {
+		expired := file.modTime.Before(cutoff)
+		oversized := maxBytes > 0 && total > maxBytes
+		if !expired && !oversized {
+			continue
+		}
+		if err := os.Remove(file.path); err != nil {
+			return result, fmt.Errorf("remove dump file: %w", err)
+		}
+		total -= file.size
+		result.Files++
+		result.Bytes += file.size
+	}
+
+	return result, nil
+}
+
+func listDumpFiles(dir string) ([]dumpFile, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read dump directory: %w", err)
+	}
+
+	files := make([]dumpFile, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() || !occurrenceDumpFile.MatchString(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("stat dump file: %w", err)
+		}
+		files = append(files, dumpFile{path: filepath.Join(dir, entry.Name()), size: info.Size(), modTime: info.ModTime()})
+	}
+
+	return files, nil
+}
+
+type GCStamp struct {
+	path string
+}
+
+func NewGCStamp() (*GCStamp, error) {
+	cacheRoot, err := os.UserCacheDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve cache dir: %w", err)
+	}
+
+	return NewGCStampAtPath(filepath.Join(cacheRoot, "rollbaz", "gc-stamp")), nil
+}
+
+func NewGCStampAtPath(path string) *GCStamp {
+	return &GCStamp{path: path}
+}
+
+func (s *GCStamp) Due(now time.Time, interval time.Duration) bool {
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return true
+	}
+
+	return now.Sub(info.ModTime()) >= interval
+}
+
+func (s *GCStamp) Touch(now time.Time) error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("create cache directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, nil, 0o600); err != nil {
+		return fmt.Errorf("write gc stamp: %w", err)
+	}
+	if err := os.Chtimes(s.path, now, now); err != nil {
+		return fmt.Errorf("update gc stamp: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRetentionLimits(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	if (Retention{}).Enabled() || !(Retention{MaxSizeMB: 1}).Enabled() || !(Retention{MaxAgeDays: 1}).Enabled() {
+		t.Fatalf("unexpected Enabled() results")
+	}
+	if !(Retention{}).Cutoff(now).IsZero() {
+		t.Fatalf("expected zero cutoff without an age limit")
+	}
+	if got := (Retention{MaxAgeDays: 30}).Cutoff(now); !got.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Cutoff() = %v", got)
+	}
+	if got := (Retention{MaxSizeMB: 2}).MaxBytes(); got != 2*1024*1024 {
+		t.Fatalf("MaxBytes() = %d", got)
+	}
+}
+
+func writeDumpFile(t *testing.T, dir string, name string, size int, modTime time.Time) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, make([]byte, size), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Chtimes() error = %v", err)
+	}
+
+	return path
+}
+
+func TestPruneDumpDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	expired := writeDumpFile(t, dir, "1.json", 10, now.AddDate(0, 0, -40))
+	oldest := writeDumpFile(t, dir, "2.json", 30, now.AddDate(0, 0, -3))
+	newest := writeDumpFile(t, dir, "3.json", 30, now.AddDate(0, 0, -1))
+	unrelated := writeDumpFile(t, dir, "notes.json", 100, now.AddDate(0, 0, -90))
+
+	result, err := PruneDumpDir(dir, now.AddDate(0, 0, -30), 40)
+	if err != nil {
+		t.Fatalf("PruneDumpDir() error = %v", err)
+	}
+	if result.Files != 2 || result.Bytes != 40 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for path, wantExists := range map[string]bool{expired: false, oldest: false, newest: true, unrelated: true} {
+		if _, err := os.Stat(path); (err == nil) != wantExists {
+			t.Fatalf("%s exists=%v, want %v", filepath.Base(path), err == nil, wantExists)
+		}
+	}
+
+	if result, err := PruneDumpDir(filepath.Join(dir, "missing"), now, 1); err != nil || result.Files != 0 {
+		t.Fatalf("missing dir = %+v, %v", result, err)
+	}
+	if _, err := PruneDumpDir(newest, now, 1); err == nil {
+		t.Fatalf("expected error when dir is a file")
+	}
+}
+
+func TestGCStamp(t *testing.T) {
+	t.Parallel()
+
+	stamp := NewGCStampAtPath(filepath.Join(t.TempDir(), "cache", "gc-stamp"))
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	if !stamp.Due(now, time.Hour) {
+		t.Fatalf("missing stamp should be due")
+	}
+	if err := stamp.Touch(now); err != nil {
+		t.Fatalf("Touch() error = %v", err)
+	}
+	if stamp.Due(now.Add(30*time.Minute), time.Hour) || !stamp.Due(now.Add(time.Hour), time.Hour) {
+		t.Fatalf("unexpected Due() after Touch()")
+	}
+
+	blocked := NewGCStampAtPath(filepath.Join(stamp.path, "nested"))
+	if err := blocked.Touch(now); err == nil {
+		t.Fatalf("expected error when parent is a file")
+	}
+}
+
+func TestNewGCStamp(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	stamp, err := NewGCStamp()
+	if err != nil || filepath.Base(stamp.path) != "gc-stamp" {
+		t.Fatalf("NewGCStamp() = %+v, %v", stamp, err)
+	}
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Routing struct {
+	Users  map[string]uint64 `yaml:"users"`
+	Routes []RoutingRule     `yaml:"routes"`
+}
+
+type RoutingRule struct {
+	Title    string `yaml:"title"`
+	Path     string `yaml:"path"`
+	Assignee string `yaml:"assignee"`
+}
+
+func LoadRouting(path string) (Routing, error) {
+	body, err := os.ReadFile(path)
+	if err != nil {
+		return Routing{}, fmt.Errorf("read routing file: %w", err)
+	}
+
+	var routing Routing
+	decoder := yaml.NewDecoder(bytes.NewReader(body))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&routing); err != nil {
+		return Routing{}, fmt.Errorf("decode routing file %s: %w", path, err)
+	}
+	if len(routing.Routes) == 0 {
+		return Routing{}, fmt.Errorf("routing file %s has no routes", path)
+	}
+	for index, rule := range routing.Routes {
+		if strings.TrimSpace(rule.Title) == "" && strings.TrimSpace(rule.Path) == "" {
+			return Routing{}, fmt.Errorf("route %d: set title, path, or both", index+1)
+		}
+		if _, err := routing.AssigneeID(rule.Assignee); err != nil {
+			return Routing{}, fmt.Errorf("route %d: %w", index+1, err)
+		}
+	}
+
+	return routing, nil
+}
+
+func (r Routing) AssigneeID(assignee string) (uint64, error) {
+	assignee = strings.TrimSpace(assignee)
+	if assignee == "" {
+		return 0, errors.New("assignee is required")
+	}
+	if id, ok := r.Users[assignee]; ok {
+		return id, nil
+	}
+	if id, err := strconv.ParseUint(assignee, 10, 64); err == nil && id > 0 {
+		return id, nil
+	}
+
+	return 0, fmt.Errorf("unknown assignee %q: add it under users or use a numeric Rollbar user ID", assignee)
+}
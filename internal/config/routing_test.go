@@ -0,0 +1,56 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadRouting(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr string
+	}{
+		{name: "valid", body: "users:\n  alice: 1234\nroutes:\n  - title: timeout\n    assignee: alice\n  - path: ^billing/\n    assignee: 5678\n"},
+		{name: "json", body: `{"routes":[{"title":"x","assignee":"7"}]}`},
+		{name: "no routes", body: "users:\n  alice: 1\n", wantErr: "has no routes"},
+		{name: "no matcher", body: "routes:\n  - assignee: 7\n", wantErr: "route 1: set title, path, or both"},
+		{name: "unknown assignee", body: "routes:\n  - title: x\n    assignee: bob\n", wantErr: `route 1: unknown assignee "bob"`},
+		{name: "missing assignee", body: "routes:\n  - title: x\n", wantErr: "route 1: assignee is required"},
+		{name: "unknown field", body: "routes:\n  - titel: x\n    assignee: 7\n", wantErr: "field titel not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			path := filepath.Join(t.TempDir(), "routing.yml")
+			if err := os.WriteFile(path, []byte(tt.body), 0o600); err != nil {
+				t.Fatalf("WriteFile() error = %v", err)
+			}
+
+			routing, err := LoadRouting(path)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("LoadRouting() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadRouting() error = %v", err)
+			}
+			for _, rule := range routing.Routes {
+				if _, err := routing.AssigneeID(rule.Assignee); err != nil {
+					t.Fatalf("AssigneeID(%q) error = %v", rule.Assignee, err)
+				}
+			}
+		})
+	}
+
+	if _, err := LoadRouting(filepath.Join(t.TempDir(), "missing.yml")); err == nil {
+		t.Fatalf("expected missing file error")
+	}
+}
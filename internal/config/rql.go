@@ -0,0 +1,108 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Only the repo file's rql section is read, so it can never carry tokens.
+const RepoFileName = ".rollbaz.json"
+
+type RQLSettings struct {
+	Queries map[string]string `json:"queries,omitempty"`
+}
+
+type SavedRQL struct {
+	Name   string `json:"name"`
+	Query  string `json:"query"`
+	Source string `json:"source"`
+}
+
+func FindRepoFile(dir string) (string, bool) {
+	for {
+		path := filepath.Join(dir, RepoFileName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return "", false
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+func RQLLibrary(file File, userPath string, repoPath string) ([]SavedRQL, error) {
+	library := map[string]SavedRQL{}
+	if file.RQL != nil {
+		addSavedRQL(library, file.RQL.Queries, userPath)
+	}
+	if repoPath != "" {
+		settings, err := loadRepoRQL(repoPath)
+		if err != nil {
+			return nil, err
+		}
+		addSavedRQL(library, settings.Queries, repoPath)
+	}
+
+	queries := make([]SavedRQL, 0, len(library))
+	for _, query := range library {
+		queries = append(queries, query)
+	}
+	sort.Slice(queries, func(i int, j int) bool {
+		return queries[i].Name < queries[j].Name
+	})
+
+	return queries, nil
+}
+
+func FindSavedRQL(queries []SavedRQL, name string) (SavedRQL, error) {
+	name = strings.TrimSpace(name)
+	for _, query := range queries {
+		if query.Name == name {
+			return query, nil
+		}
+	}
+	if len(queries) == 0 {
+		return SavedRQL{}, fmt.Errorf("rql query %q not found: no queries saved under rql.queries", name)
+	}
+
+	return SavedRQL{}, fmt.Errorf("rql query %q not found", name)
+}
+
+func loadRepoRQL(path string) (RQLSettings, error) {
+	body, err := os.ReadFile(path) //nolint:gosec // path is the repo config found by FindRepoFile
+	if errors.Is(err, os.ErrNotExist) {
+		return RQLSettings{}, nil
+	}
+	if err != nil {
+		return RQLSettings{}, fmt.Errorf("read repo config: %w", err)
+	}
+
+	var repo struct {
+		RQL RQLSettings `json:"rql"`
+	}
+	if err := json.Unmarshal(body, &repo); err != nil {
+		return RQLSettings{}, fmt.Errorf("decode repo config %s: %w", path, err)
+	}
+
+	return repo.RQL, nil
+}
+
+func addSavedRQL(library map[string]SavedRQL, queries map[string]string, source string) {
+	for name, query := range queries {
+		name, query = strings.TrimSpace(name), strings.TrimSpace(query)
+		if name == "" || query == "" {
+			continue
+		}
+		library[name] = SavedRQL{Name: name, Query: query, Source: source}
+	}
+}
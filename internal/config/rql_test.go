@@ -0,0 +1,79 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFindRepoFile(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	nested := filepath.Join(root, "services", "api")
+	if err := os.MkdirAll(nested, 0o700); err != nil {
+		t.Fatalf("MkdirAll() error = %v", err)
+	}
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0o700); err != nil {
+		t.Fatalf("Mkdir() error = %v", err)
+	}
+
+	if path, ok := FindRepoFile(nested); ok {
+		t.Fatalf("FindRepoFile() without a repo file = %q", path)
+	}
+
+	repoPath := filepath.Join(root, RepoFileName)
+	if err := os.WriteFile(repoPath, []byte(`{}`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if path, ok := FindRepoFile(nested); !ok || path != repoPath {
+		t.Fatalf("FindRepoFile() = %q, %v", path, ok)
+	}
+}
+
+func TestRQLLibrary(t *testing.T) {
+	t.Parallel()
+
+	repoPath := filepath.Join(t.TempDir(), RepoFileName)
+	repo := `{"projects":[{"name":"ignored","token":"secret"}],"rql":{"queries":{"by_user":"SELECT * FROM item_occurrence WHERE person.id = {{.user}}","slow":"SELECT 2"}}}`
+	if err := os.WriteFile(repoPath, []byte(repo), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	file := File{RQL: &RQLSettings{Queries: map[string]string{"slow": "SELECT 1", "mine": " SELECT 3 ", " ": "SELECT 4"}}}
+
+	queries, err := RQLLibrary(file, "config.json", repoPath)
+	if err != nil {
+		t.Fatalf("RQLLibrary() error = %v", err)
+	}
+	names := make([]string, 0, len(queries))
+	for _, query := range queries {
+		names = append(names, query.Name)
+	}
+	if strings.Join(names, ",") != "by_user,mine,slow" {
+		t.Fatalf("RQLLibrary() names = %v", names)
+	}
+	if queries[1].Query != "SELECT 3" || queries[1].Source != "config.json" {
+		t.Fatalf("user query = %#v", queries[1])
+	}
+	if queries[2].Query != "SELECT 2" || queries[2].Source != repoPath {
+		t.Fatalf("repo query should win on a shared name, got %#v", queries[2])
+	}
+
+	if _, err := FindSavedRQL(queries, "by_user"); err != nil {
+		t.Fatalf("FindSavedRQL() error = %v", err)
+	}
+	if _, err := FindSavedRQL(queries, "missing"); err == nil {
+		t.Fatalf("expected missing query error")
+	}
+	if _, err := FindSavedRQL(nil, "missing"); err == nil || !strings.Contains(err.Error(), "rql.queries") {
+		t.Fatalf("FindSavedRQL() on empty library error = %v", err)
+	}
+
+	if err := os.WriteFile(repoPath, []byte(`{`), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if _, err := RQLLibrary(File{}, "", repoPath); err == nil {
+		t.Fatalf("expected decode error for a broken repo file")
+	}
+}
@@ -11,13 +11,37 @@ import (
 )
 
 type Project struct {
-	Name  string `json:"name"`
-	Token string `json:"token"`
+	Name        string `json:"name"`
+	Token       string `json:"token"`
+	BaseURL     string `json:"base_url,omitempty"`
+	Environment string `json:"environment,omitempty"`
+
+	Headers map[string]string `json:"headers,omitempty"`
 }
 
 type File struct {
-	ActiveProject string    `json:"active_project"`
-	Projects      []Project `json:"projects"`
+	ActiveProject string     `json:"active_project"`
+	Projects      []Project  `json:"projects"`
+	ActiveView    string     `json:"active_view,omitempty"`
+	Views         []View     `json:"views,omitempty"`
+	Columns       []string   `json:"columns,omitempty"`
+	Retention     *Retention `json:"retention,omitempty"`
+
+	EnvironmentAliases   map[string]string `json:"environment_aliases,omitempty"`
+	HiddenEnvironments   []string          `json:"hidden_environments,omitempty"`
+	NumberFormat         string            `json:"number_format,omitempty"`
+	TimestampFormat      string            `json:"timestamp_format,omitempty"`
+	Locale               string            `json:"locale,omitempty"`
+	Truncate             string            `json:"truncate,omitempty"`
+	ShareEndpoint        string            `json:"share_endpoint,omitempty"`
+	NotifyWebhook        string            `json:"notify_webhook,omitempty"`
+	Proxy                string            `json:"proxy,omitempty"`
+	MaxRequests          *int              `json:"max_requests,omitempty"`
+	MaxRequestsPerMinute *int              `json:"max_requests_per_minute,omitempty"`
+	MaxPages             *int              `json:"max_pages,omitempty"`
+
+	Commands map[string]CommandSettings `json:"commands,omitempty"`
+	RQL      *RQLSettings               `json:"rql,omitempty"`
 }
 
 type Store struct {
@@ -77,10 +101,18 @@ func (s *Store) Save(file File) error {
 }
 
 func (s *Store) AddProject(name string, token string) error {
-	if strings.TrimSpace(name) == "" {
+	return s.saveProject(Project{Name: name, Token: token}, false)
+}
+
+func (s *Store) SaveProject(project Project) error {
+	return s.saveProject(project, true)
+}
+
+func (s *Store) saveProject(project Project, replace bool) error {
+	if strings.TrimSpace(project.Name) == "" {
 		return errors.New("project name is required")
 	}
-	if strings.TrimSpace(token) == "" {
+	if strings.TrimSpace(project.Token) == "" {
 		return errors.New("project token is required")
 	}
 
@@ -89,17 +121,17 @@ func (s *Store) AddProject(name string, token string) error {
 		return err
 	}
 
-	if index, ok := projectIndexByName(file.Projects, name); ok {
-		file.Projects[index].Token = token
-		if file.ActiveProject == "" {
-			file.ActiveProject = name
+	if index, ok := projectIndexByName(file.Projects, project.Name); ok {
+		if replace {
+			file.Projects[index] = project
+		} else {
+			file.Projects[index].Token = project.Token
 		}
-		return s.Save(file)
+	} else {
+		file.Projects = append(file.Projects, project)
 	}
-
-	file.Projects = append(file.Projects, Project{Name: name, Token: token})
 	if file.ActiveProject == "" {
-		file.ActiveProject = name
+		file.ActiveProject = project.Name
 	}
 
 	return s.Save(file)
@@ -182,13 +214,22 @@ func (s *Store) CycleProject() (string, error) {
 }
 
 func (s *Store) ResolveToken(projectName string) (string, string, error) {
-	file, err := s.Load()
+	project, err := s.ResolveProject(projectName)
 	if err != nil {
 		return "", "", err
 	}
 
+	return project.Token, project.Name, nil
+}
+
+func (s *Store) ResolveProject(projectName string) (Project, error) {
+	file, err := s.Load()
+	if err != nil {
+		return Project{}, err
+	}
+
 	if len(file.Projects) == 0 {
-		return "", "", errors.New("no configured projects")
+		return Project{}, errors.New("no configured projects")
 	}
 
 	target := projectName
@@ -196,18 +237,18 @@ func (s *Store) ResolveToken(projectName string) (string, string, error) {
 		target = file.ActiveProject
 	}
 	if target == "" {
-		return "", "", errors.New("no active project configured")
+		return Project{}, errors.New("no active project configured")
 	}
 
 	index, ok := projectIndexByName(file.Projects, target)
 	if ok {
 		if strings.TrimSpace(file.Projects[index].Token) == "" {
-			return "", "", fmt.Errorf("project %q has no token", target)
+			return Project{}, fmt.Errorf("project %q has no token", target)
 		}
-		return file.Projects[index].Token, target, nil
+		return file.Projects[index], nil
 	}
 
-	return "", "", fmt.Errorf("project %q not found", target)
+	return Project{}, fmt.Errorf("project %q not found", target)
 }
 
 func normalize(file File) File {
@@ -217,13 +258,68 @@ func normalize(file File) File {
 		if name == "" {
 			continue
 		}
-		trimmedProjects = append(trimmedProjects, Project{Name: name, Token: strings.TrimSpace(project.Token)})
+		trimmedProjects = append(trimmedProjects, Project{
+			Name:        name,
+			Token:       strings.TrimSpace(project.Token),
+			BaseURL:     strings.TrimSpace(project.BaseURL),
+			Environment: strings.TrimSpace(project.Environment),
+			Headers:     trimmedHeaders(project.Headers),
+		})
 	}
 	sort.Slice(trimmedProjects, func(i int, j int) bool {
 		return trimmedProjects[i].Name < trimmedProjects[j].Name
 	})
 
-	return File{ActiveProject: strings.TrimSpace(file.ActiveProject), Projects: trimmedProjects}
+	return File{
+		ActiveProject: strings.TrimSpace(file.ActiveProject),
+		Projects:      trimmedProjects,
+		ActiveView:    strings.TrimSpace(file.ActiveView),
+		Views:         normalizeViews(file.Views),
+		Columns:       file.Columns,
+		Retention:     file.Retention,
+
+		EnvironmentAliases:   file.EnvironmentAliases,
+		HiddenEnvironments:   trimmedList(file.HiddenEnvironments),
+		NumberFormat:         strings.TrimSpace(file.NumberFormat),
+		TimestampFormat:      strings.TrimSpace(file.TimestampFormat),
+		Locale:               strings.TrimSpace(file.Locale),
+		Truncate:             strings.TrimSpace(file.Truncate),
+		ShareEndpoint:        strings.TrimSpace(file.ShareEndpoint),
+		NotifyWebhook:        strings.TrimSpace(file.NotifyWebhook),
+		Proxy:                strings.TrimSpace(file.Proxy),
+		MaxRequests:          file.MaxRequests,
+		MaxRequestsPerMinute: file.MaxRequestsPerMinute,
+		MaxPages:             file.MaxPages,
+
+		Commands: normalizeCommands(file.Commands),
+		RQL:      file.RQL,
+	}
+}
+
+func trimmedList(values []string) []string {
+	var trimmed []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			trimmed = append(trimmed, value)
+		}
+	}
+
+	return trimmed
+}
+
+func trimmedHeaders(headers map[string]string) map[string]string {
+	var trimmed map[string]string
+	for name, value := range headers {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		if trimmed == nil {
+			trimmed = make(map[string]string, len(headers))
+		}
+		trimmed[name] = strings.TrimSpace(value)
+	}
+
+	return trimmed
 }
 
 func projectIndexByName(projects []Project, name string) (int, bool) {
@@ -3,6 +3,8 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -128,6 +130,54 @@ func TestStoreAddProjectUpdatesExisting(t *testing.T) {
 	}
 }
 
+func TestStoreSaveProjectKeepsSettings(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTempStore(t)
+	if err := store.SaveProject(Project{Name: "app", Token: "token-1", BaseURL: " https://rollbar.example/api/1 ", Environment: "production", Headers: map[string]string{" X-Tenant ": " acme ", " ": "dropped"}}); err != nil {
+		t.Fatalf("SaveProject() error = %v", err)
+	}
+	if err := store.AddProject("app", "token-2"); err != nil {
+		t.Fatalf("AddProject() error = %v", err)
+	}
+
+	project, err := store.ResolveProject("")
+	if err != nil {
+		t.Fatalf("ResolveProject() error = %v", err)
+	}
+	want := Project{Name: "app", Token: "token-2", BaseURL: "https://rollbar.example/api/1", Environment: "production", Headers: map[string]string{"X-Tenant": "acme"}}
+	if !reflect.DeepEqual(project, want) {
+		t.Fatalf("ResolveProject() = %+v, want %+v", project, want)
+	}
+
+	if err := store.SaveProject(Project{Name: "app", Token: "token-3"}); err != nil {
+		t.Fatalf("SaveProject() replace error = %v", err)
+	}
+	project, err = store.ResolveProject("app")
+	if err != nil {
+		t.Fatalf("ResolveProject() error = %v", err)
+	}
+	if project.BaseURL != "" || project.Environment != "" || project.Headers != nil {
+		t.Fatalf("expected settings to be replaced, got %+v", project)
+	}
+}
+
+func TestStoreSaveTrimsHiddenEnvironments(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTempStore(t)
+	if err := store.Save(File{HiddenEnvironments: []string{" development ", "", "test"}}); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	file, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if want := []string{"development", "test"}; !slices.Equal(file.HiddenEnvironments, want) {
+		t.Fatalf("HiddenEnvironments = %v, want %v", file.HiddenEnvironments, want)
+	}
+}
+
 func TestStoreLoadDecodeError(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type View struct {
+	Name           string   `json:"name"`
+	Environment    string   `json:"environment,omitempty"`
+	Status         string   `json:"status,omitempty"`
+	Since          string   `json:"since,omitempty"`
+	Until          string   `json:"until,omitempty"`
+	MinOccurrences string   `json:"min_occurrences,omitempty"`
+	MaxOccurrences string   `json:"max_occurrences,omitempty"`
+	MinAge         string   `json:"min_age,omitempty"`
+	MaxAge         string   `json:"max_age,omitempty"`
+	Level          string   `json:"level,omitempty"`
+	MinRate        string   `json:"min_rate,omitempty"`
+	Sort           string   `json:"sort,omitempty"`
+	Columns        []string `json:"columns,omitempty"`
+}
+
+func (s *Store) SaveView(view View) error {
+	view.Name = strings.TrimSpace(view.Name)
+	if view.Name == "" {
+		return errors.New("view name is required")
+	}
+
+	file, err := s.Load()
+	if err != nil {
+		return err
+	}
+
+	if index, ok := viewIndexByName(file.Views, view.Name); ok {
+		file.Views[index] = view
+	} else {
+		file.Views = append(file.Views, view)
+	}
+
+	return s.Save(file)
+}
+
+func (s *Store) UseView(name string) error {
+	file, err := s.Load()
+	if err != nil {
+		return err
+	}
+
+	if name != "" {
+		if _, ok := viewIndexByName(file.Views, name); !ok {
+			return fmt.Errorf("view %q not found", name)
+		}
+	}
+	file.ActiveView = name
+
+	return s.Save(file)
+}
+
+func (s *Store) RemoveView(name string) error {
+	file, err := s.Load()
+	if err != nil {
+		return err
+	}
+
+	index, ok := viewIndexByName(file.Views, name)
+	if !ok {
+		return fmt.Errorf("view %q not found", name)
+	}
+	file.Views = append(file.Views[:index], file.Views[index+1:]...)
+	if file.ActiveView == name {
+		file.ActiveView = ""
+	}
+
+	return s.Save(file)
+}
+
+func (s *Store) ActiveView() (View, bool, error) {
+	file, err := s.Load()
+	if err != nil {
+		return View{}, false, err
+	}
+
+	view, ok := file.ActiveViewSettings()
+	return view, ok, nil
+}
+
+func (f File) ActiveViewSettings() (View, bool) {
+	if f.ActiveView == "" {
+		return View{}, false
+	}
+
+	index, ok := viewIndexByName(f.Views, f.ActiveView)
+	if !ok {
+		return View{}, false
+	}
+
+	return f.Views[index], true
+}
+
+func normalizeViews(views []View) []View {
+	normalized := make([]View, 0, len(views))
+	for _, view := range views {
+		view.Name = strings.TrimSpace(view.Name)
+		if view.Name == "" {
+			continue
+		}
+		normalized = append(normalized, view)
+	}
+	sort.Slice(normalized, func(i int, j int) bool {
+		return normalized[i].Name < normalized[j].Name
+	})
+
+	return normalized
+}
+
+func viewIndexByName(views []View, name string) (int, bool) {
+	for index := range views {
+		if views[index].Name == name {
+			return index, true
+		}
+	}
+
+	return 0, false
+}
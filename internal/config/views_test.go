@@ -0,0 +1,71 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStoreViews(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTempStore(t)
+	if err := store.AddProject("app", "token"); err != nil {
+		t.Fatalf("AddProject() error = %v", err)
+	}
+
+	if _, ok, err := store.ActiveView(); err != nil || ok {
+		t.Fatalf("ActiveView() without views = %v, %v", ok, err)
+	}
+
+	oncall := View{Name: " oncall ", Environment: "production", Status: "active", Sort: "priority"}
+	if err := store.SaveView(oncall); err != nil {
+		t.Fatalf("SaveView() error = %v", err)
+	}
+	if err := store.SaveView(View{Name: "oncall", Environment: "staging", Columns: []string{"counter", "title"}}); err != nil {
+		t.Fatalf("SaveView() replace error = %v", err)
+	}
+	if err := store.UseView("oncall"); err != nil {
+		t.Fatalf("UseView() error = %v", err)
+	}
+
+	view, ok, err := store.ActiveView()
+	if err != nil || !ok {
+		t.Fatalf("ActiveView() = %v, %v", ok, err)
+	}
+	if !reflect.DeepEqual(view, View{Name: "oncall", Environment: "staging", Columns: []string{"counter", "title"}}) {
+		t.Fatalf("unexpected active view: %+v", view)
+	}
+
+	file, err := store.Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if file.ActiveProject != "app" || len(file.Views) != 1 {
+		t.Fatalf("views should not disturb projects: %+v", file)
+	}
+
+	if err := store.RemoveView("oncall"); err != nil {
+		t.Fatalf("RemoveView() error = %v", err)
+	}
+	if _, ok, _ := store.ActiveView(); ok {
+		t.Fatalf("expected removed view to be deactivated")
+	}
+}
+
+func TestStoreViewErrors(t *testing.T) {
+	t.Parallel()
+
+	store, _ := newTempStore(t)
+	if err := store.SaveView(View{Name: " "}); err == nil {
+		t.Fatalf("expected missing name error")
+	}
+	if err := store.UseView("missing"); err == nil {
+		t.Fatalf("expected unknown view error")
+	}
+	if err := store.RemoveView("missing"); err == nil {
+		t.Fatalf("expected unknown view remove error")
+	}
+	if err := store.UseView(""); err != nil {
+		t.Fatalf("UseView(\"\") error = %v", err)
+	}
+}
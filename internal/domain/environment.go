@@ -0,0 +1,46 @@
+package domain
+
+import "strings"
+
+type Environment string
+
+func NormalizeEnvironment(value string) Environment {
+	return Environment(strings.ToLower(strings.TrimSpace(value)))
+}
+
+func (e Environment) String() string {
+	return string(e)
+}
+
+type EnvironmentAliases map[Environment]Environment
+
+func DefaultEnvironmentAliases() EnvironmentAliases {
+	return EnvironmentAliases{
+		"prod":  "production",
+		"prd":   "production",
+		"stage": "staging",
+		"stg":   "staging",
+		"dev":   "development",
+	}
+}
+
+func (a EnvironmentAliases) With(overrides map[string]string) EnvironmentAliases {
+	merged := make(EnvironmentAliases, len(a)+len(overrides))
+	for alias, canonical := range a {
+		merged[alias] = canonical
+	}
+	for alias, canonical := range overrides {
+		merged[NormalizeEnvironment(alias)] = NormalizeEnvironment(canonical)
+	}
+
+	return merged
+}
+
+func (a EnvironmentAliases) Canonical(value string) Environment {
+	normalized := NormalizeEnvironment(value)
+	if canonical, ok := a[normalized]; ok {
+		return canonical
+	}
+
+	return normalized
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "testing"
+
+func TestNormalizeEnvironment(t *testing.T) {
+	t.Parallel()
+
+	if got := NormalizeEnvironment("  Production "); got != "production" || got.String() != "production" {
+		t.Fatalf("NormalizeEnvironment() = %q", got)
+	}
+}
+
+func TestEnvironmentAliases(t *testing.T) {
+	t.Parallel()
+
+	defaults := DefaultEnvironmentAliases()
+	aliases := defaults.With(map[string]string{" Live ": "Production", "prod": "prod-eu"})
+
+	tests := map[string]Environment{
+		"PROD":       "prod-eu",
+		"live":       "production",
+		"stg":        "staging",
+		" Staging ":  "staging",
+		"qa":         "qa",
+		"":           "",
+		"production": "production",
+	}
+	for value, want := range tests {
+		if got := aliases.Canonical(value); got != want {
+			t.Fatalf("Canonical(%q) = %q, want %q", value, got, want)
+		}
+	}
+	if defaults.Canonical("prod") != "production" || defaults.Canonical("live") != "live" {
+		t.Fatalf("With() should not modify the receiver")
+	}
+	if got := EnvironmentAliases(nil).Canonical("Prod"); got != "prod" {
+		t.Fatalf("nil aliases Canonical() = %q", got)
+	}
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Level string
+
+const (
+	LevelDebug    Level = "debug"
+	LevelInfo     Level = "info"
+	LevelWarning  Level = "warning"
+	LevelError    Level = "error"
+	LevelCritical Level = "critical"
+)
+
+var levels = []Level{LevelDebug, LevelInfo, LevelWarning, LevelError, LevelCritical}
+
+func ParseLevel(value string) (Level, error) {
+	level := Level(strings.ToLower(strings.TrimSpace(value)))
+	if level.Rank() == 0 {
+		return "", fmt.Errorf("unsupported level %q: use debug, info, warning, error, or critical", value)
+	}
+
+	return level, nil
+}
+
+func LevelFromNumber(number int) Level {
+	if number%10 == 0 && number >= 10 && number <= 10*len(levels) {
+		return levels[number/10-1]
+	}
+
+	return Level(strconv.Itoa(number))
+}
+
+func (l Level) Rank() int {
+	normalized := Level(strings.ToLower(string(l)))
+	for index, level := range levels {
+		if normalized == level {
+			return index + 1
+		}
+	}
+
+	return 0
+}
+
+func (l Level) AtLeast(minimum Level) bool {
+	return l.Rank() >= minimum.Rank()
+}
+
+func (l Level) Next() (Level, bool) {
+	rank := l.Rank()
+	if rank == 0 || rank == len(levels) {
+		return "", false
+	}
+
+	return levels[rank], true
+}
+
+func CompareLevels(left Level, right Level) int {
+	return cmp.Compare(left.Rank(), right.Rank())
+}
+
+func (l Level) String() string {
+	return string(l)
+}
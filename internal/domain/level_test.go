@@ -0,0 +1,67 @@
+package domain
+
+import "testing"
+
+func TestParseLevel(t *testing.T) {
+	t.Parallel()
+
+	if got, err := ParseLevel(" Error "); err != nil || got != LevelError {
+		t.Fatalf("ParseLevel() = %q, %v", got, err)
+	}
+	for _, value := range []string{"", "fatal", "40"} {
+		if _, err := ParseLevel(value); err == nil {
+			t.Fatalf("ParseLevel(%q) expected error", value)
+		}
+	}
+}
+
+func TestLevelFromNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := map[int]Level{10: LevelDebug, 20: LevelInfo, 30: LevelWarning, 40: LevelError, 50: LevelCritical, 0: "0", 35: "35", 60: "60"}
+	for number, want := range tests {
+		if got := LevelFromNumber(number); got != want {
+			t.Fatalf("LevelFromNumber(%d) = %q, want %q", number, got, want)
+		}
+	}
+}
+
+func TestLevelOrdering(t *testing.T) {
+	t.Parallel()
+
+	if LevelDebug.Rank() != 1 || LevelCritical.Rank() != 5 || Level("CRITICAL").Rank() != 5 || Level("unknown").Rank() != 0 {
+		t.Fatalf("unexpected ranks")
+	}
+	if !LevelCritical.AtLeast(LevelError) || !LevelError.AtLeast(LevelError) || LevelWarning.AtLeast(LevelError) {
+		t.Fatalf("unexpected AtLeast() results")
+	}
+	if CompareLevels(LevelError, LevelWarning) <= 0 || CompareLevels(LevelInfo, LevelCritical) >= 0 || CompareLevels("", "other") != 0 {
+		t.Fatalf("unexpected CompareLevels() results")
+	}
+	if LevelWarning.String() != "warning" {
+		t.Fatalf("unexpected String()")
+	}
+}
+
+func TestLevelNext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		level  Level
+		want   Level
+		wantOK bool
+	}{
+		{level: LevelDebug, want: LevelInfo, wantOK: true},
+		{level: "Warning", want: LevelError, wantOK: true},
+		{level: LevelError, want: LevelCritical, wantOK: true},
+		{level: LevelCritical},
+		{level: "unknown"},
+	}
+
+	for _, tc := range tests {
+		got, ok := tc.level.Next()
+		if got != tc.want || ok != tc.wantOK {
+			t.Fatalf("%q.Next() = %q, %v, want %q, %v", tc.level, got, ok, tc.want, tc.wantOK)
+		}
+	}
+}
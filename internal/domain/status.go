@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Status string
+
+const (
+	StatusActive   Status = "active"
+	StatusResolved Status = "resolved"
+	StatusMuted    Status = "muted"
+	StatusArchived Status = "archived"
+)
+
+var statuses = []Status{StatusActive, StatusResolved, StatusMuted, StatusArchived}
+
+func ParseStatus(value string) (Status, error) {
+	status := Status(strings.ToLower(strings.TrimSpace(value)))
+	if !status.Valid() {
+		return "", fmt.Errorf("unsupported status %q: use active, resolved, muted, or archived", value)
+	}
+
+	return status, nil
+}
+
+func (s Status) Valid() bool {
+	for _, status := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s Status) String() string {
+	return string(s)
+}
@@ -0,0 +1,41 @@
+package domain
+
+import "testing"
+
+func TestParseStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		want    Status
+		wantErr bool
+	}{
+		{value: "active", want: StatusActive},
+		{value: " Resolved ", want: StatusResolved},
+		{value: "MUTED", want: StatusMuted},
+		{value: "archived", want: StatusArchived},
+		{value: "", wantErr: true},
+		{value: "snoozed", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseStatus(tc.value)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("ParseStatus(%q) = %q, expected error", tc.value, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.want || got.String() != string(tc.want) {
+			t.Fatalf("ParseStatus(%q) = %q, %v", tc.value, got, err)
+		}
+	}
+}
+
+func TestStatusValid(t *testing.T) {
+	t.Parallel()
+
+	if !StatusMuted.Valid() || Status("Muted").Valid() || Status("").Valid() {
+		t.Fatalf("unexpected Valid() results")
+	}
+}
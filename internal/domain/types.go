@@ -1,8 +1,12 @@
 package domain
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"net/url"
 	"strconv"
+	"strings"
 )
 
 type ItemCounter uint64
@@ -12,10 +16,47 @@ func ParseItemCounter(value string) (ItemCounter, error) {
 	if err != nil {
 		return 0, fmt.Errorf("parse item counter: %w", err)
 	}
+	if parsed == 0 {
+		return 0, errors.New("item counter must be greater than 0")
+	}
+	if parsed > math.MaxInt64 {
+		return 0, fmt.Errorf("item counter %s is out of range", value)
+	}
 
 	return ItemCounter(parsed), nil
 }
 
+func ParseItemReference(value string) (ItemCounter, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "://") {
+		return parseItemURL(value)
+	}
+
+	return ParseItemCounter(strings.TrimPrefix(value, "#"))
+}
+
+func parseItemURL(value string) (ItemCounter, error) {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return 0, fmt.Errorf("parse item url: %w", err)
+	}
+
+	segments := strings.FieldsFunc(parsed.Path, func(r rune) bool { return r == '/' })
+	for index := len(segments) - 1; index > 0; index-- {
+		if _, err := strconv.ParseUint(segments[index], 10, 64); err != nil {
+			continue
+		}
+		switch {
+		case segments[index-1] == "items":
+			return ParseItemCounter(segments[index])
+		case index >= 2 && segments[index-2] == "item":
+			return ParseItemCounter(segments[index])
+		}
+	}
+
+	return 0, fmt.Errorf("no item counter in url %q", value)
+}
+
 func (c ItemCounter) String() string {
 	return strconv.FormatUint(uint64(c), 10)
 }
@@ -25,3 +66,33 @@ type ItemID uint64
 func (id ItemID) String() string {
 	return strconv.FormatUint(uint64(id), 10)
 }
+
+type OccurrenceID uint64
+
+func ParseOccurrenceID(value string) (OccurrenceID, error) {
+	parsed, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse occurrence id: %w", err)
+	}
+	if parsed == 0 {
+		return 0, errors.New("occurrence id must be greater than 0")
+	}
+
+	return OccurrenceID(parsed), nil
+}
+
+func (id OccurrenceID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+type ProjectID uint64
+
+func (id ProjectID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+type ProjectSlug string
+
+func (s ProjectSlug) String() string {
+	return string(s)
+}
@@ -18,8 +18,61 @@ func TestParseItemCounter(t *testing.T) {
 func TestParseItemCounterInvalid(t *testing.T) {
 	t.Parallel()
 
-	if _, err := ParseItemCounter("invalid"); err == nil {
-		t.Fatalf("expected parse error")
+	for _, value := range []string{"invalid", "", "0", "-1", "#269", "9223372036854775808", "18446744073709551616"} {
+		if _, err := ParseItemCounter(value); err == nil {
+			t.Fatalf("ParseItemCounter(%q) expected error", value)
+		}
+	}
+}
+
+func TestParseOccurrenceID(t *testing.T) {
+	t.Parallel()
+
+	id, err := ParseOccurrenceID(" 412093221 ")
+	if err != nil || id != OccurrenceID(412093221) {
+		t.Fatalf("ParseOccurrenceID() = %d, %v", id, err)
+	}
+	for _, value := range []string{"", "0", "-1", "abc"} {
+		if _, err := ParseOccurrenceID(value); err == nil {
+			t.Fatalf("ParseOccurrenceID(%q) expected error", value)
+		}
+	}
+}
+
+func TestParseItemReference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		want    ItemCounter
+		wantErr bool
+	}{
+		{value: "269", want: 269},
+		{value: " #269 ", want: 269},
+		{value: "https://app.rollbar.com/a/acme/fix/item/checkout/269", want: 269},
+		{value: "https://app.rollbar.com/a/acme/fix/item/checkout/269?item_occurrence=1", want: 269},
+		{value: "https://rollbar.com/acme/checkout/items/269/", want: 269},
+		{value: "https://rollbar.com/acme/checkout/items/269/occurrences/998877/", want: 269},
+		{value: "0", wantErr: true},
+		{value: "#", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "https://rollbar.com/acme/checkout/items/", wantErr: true},
+		{value: "https://rollbar.com/acme/checkout/items/0", wantErr: true},
+		{value: "https://app.rollbar.com/a/acme/fix/item/checkout", wantErr: true},
+		{value: "https://example.com/%zz", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		got, err := ParseItemReference(tc.value)
+		if tc.wantErr {
+			if err == nil {
+				t.Fatalf("ParseItemReference(%q) = %d, expected error", tc.value, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Fatalf("ParseItemReference(%q) = %d, %v", tc.value, got, err)
+		}
 	}
 }
 
@@ -33,4 +86,8 @@ func TestStringers(t *testing.T) {
 	if ItemID(99).String() != "99" {
 		t.Fatalf("unexpected item id string")
 	}
+
+	if OccurrenceID(1234).String() != "1234" || ProjectID(766510).String() != "766510" || ProjectSlug("checkout").String() != "checkout" {
+		t.Fatalf("unexpected occurrence/project strings")
+	}
 }
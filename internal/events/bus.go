@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+type Handler func(context.Context, Event) error
+
+type subscription struct {
+	id      uint64
+	handler Handler
+}
+
+type Bus struct {
+	mu            sync.Mutex
+	nextID        uint64
+	subscriptions []subscription
+}
+
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+func (b *Bus) Subscribe(handler Handler) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.nextID++
+	id := b.nextID
+	b.subscriptions = append(b.subscriptions, subscription{id: id, handler: handler})
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		for index, subscription := range b.subscriptions {
+			if subscription.id == id {
+				b.subscriptions = append(b.subscriptions[:index:index], b.subscriptions[index+1:]...)
+				return
+			}
+		}
+	}
+}
+
+func On[E Event](bus *Bus, handler func(context.Context, E) error) func() {
+	return bus.Subscribe(func(ctx context.Context, event Event) error {
+		typed, ok := event.(E)
+		if !ok {
+			return nil
+		}
+		return handler(ctx, typed)
+	})
+}
+
+func (b *Bus) Publish(ctx context.Context, events ...Event) error {
+	b.mu.Lock()
+	subscriptions := append([]subscription(nil), b.subscriptions...)
+	b.mu.Unlock()
+
+	var errs []error
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		for _, subscription := range subscriptions {
+			if err := subscription.handler(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("%s handler: %w", event.Kind(), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
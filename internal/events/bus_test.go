@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBusDeliversInSubscriptionOrder(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus()
+	var got []string
+	bus.Subscribe(func(ctx context.Context, event Event) error {
+		got = append(got, "all:"+string(event.Kind()))
+		return nil
+	})
+	On(bus, func(ctx context.Context, spike Spike) error {
+		got = append(got, "spike:"+spike.Threshold)
+		return nil
+	})
+
+	if err := bus.Publish(context.Background(), NewItem{}, Spike{Threshold: "10/m"}); err != nil {
+		t.Fatalf("Publish() error = %v", err)
+	}
+	want := []string{"all:new_item", "all:spike", "spike:10/m"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("delivered %v, want %v", got, want)
+	}
+}
+
+func TestBusUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus()
+	calls := 0
+	unsubscribe := On(bus, func(ctx context.Context, item NewItem) error {
+		calls++
+		return nil
+	})
+	kept := 0
+	bus.Subscribe(func(ctx context.Context, event Event) error {
+		kept++
+		return nil
+	})
+
+	_ = bus.Publish(context.Background(), NewItem{})
+	unsubscribe()
+	unsubscribe()
+	_ = bus.Publish(context.Background(), NewItem{})
+	if calls != 1 || kept != 2 {
+		t.Fatalf("expected 1 call before unsubscribing and 2 for the other handler, got %d and %d", calls, kept)
+	}
+}
+
+func TestBusPublishJoinsHandlerErrors(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus()
+	boom := errors.New("boom")
+	bus.Subscribe(func(ctx context.Context, event Event) error {
+		return boom
+	})
+	delivered := 0
+	bus.Subscribe(func(ctx context.Context, event Event) error {
+		delivered++
+		return nil
+	})
+
+	err := bus.Publish(context.Background(), Reactivated{}, SnoozeExpiring{})
+	if !errors.Is(err, boom) || !strings.Contains(err.Error(), "reactivated handler: boom") || delivered != 2 {
+		t.Fatalf("Publish() error = %v, delivered %d", err, delivered)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := NewBus().Publish(ctx, NewItem{}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation error, got %v", err)
+	}
+}
@@ -0,0 +1,60 @@
+package events
+
+import (
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type Detector struct {
+	snoozeWithin time.Duration
+	statuses     map[domain.ItemID]domain.Status
+	snoozes      map[domain.ItemID]uint64
+}
+
+func NewDetector(snoozeWithin time.Duration) *Detector {
+	return &Detector{
+		snoozeWithin: snoozeWithin,
+		statuses:     map[domain.ItemID]domain.Status{},
+		snoozes:      map[domain.ItemID]uint64{},
+	}
+}
+
+func (d *Detector) Detect(deltas []app.IssueDelta, at time.Time) []Event {
+	detected := make([]Event, 0)
+	for _, delta := range deltas {
+		issue := delta.IssueSummary
+		previous, seen := d.statuses[issue.ItemID]
+		d.statuses[issue.ItemID] = issue.Status
+		switch {
+		case delta.New:
+			detected = append(detected, NewItem{Issue: issue, At: at})
+		case seen && issue.Status == domain.StatusActive && previous != "" && previous != domain.StatusActive:
+			detected = append(detected, Reactivated{Issue: issue, From: previous, At: at})
+		}
+
+		if expiring, ok := d.snoozeExpiring(issue, at); ok {
+			detected = append(detected, expiring)
+		}
+	}
+
+	return detected
+}
+
+func (d *Detector) snoozeExpiring(issue app.IssueSummary, at time.Time) (SnoozeExpiring, bool) {
+	if d.snoozeWithin <= 0 || issue.SnoozeExpiresAt == nil || issue.Status != domain.StatusMuted {
+		return SnoozeExpiring{}, false
+	}
+	expiresAt := *issue.SnoozeExpiresAt
+	if announced, ok := d.snoozes[issue.ItemID]; ok && announced == expiresAt {
+		return SnoozeExpiring{}, false
+	}
+	windowStart, windowEnd := max(at.Unix(), 0), max(at.Add(d.snoozeWithin).Unix(), 0)
+	if expiresAt < uint64(windowStart) || expiresAt > uint64(windowEnd) {
+		return SnoozeExpiring{}, false
+	}
+	d.snoozes[issue.ItemID] = expiresAt
+
+	return SnoozeExpiring{Issue: issue, ExpiresAt: time.Unix(int64(expiresAt), 0).UTC(), At: at}, true
+}
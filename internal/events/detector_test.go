@@ -0,0 +1,70 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestDetectorDetect(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	soon := uint64(now.Add(10 * time.Minute).Unix())
+	later := uint64(now.Add(2 * time.Hour).Unix())
+	issue := func(id domain.ItemID, status domain.Status, snooze *uint64) app.IssueSummary {
+		return app.IssueSummary{ItemID: id, Counter: domain.ItemCounter(id), Status: status, SnoozeExpiresAt: snooze}
+	}
+	detector := NewDetector(30 * time.Minute)
+
+	first := detector.Detect([]app.IssueDelta{
+		{IssueSummary: issue(1, domain.StatusResolved, nil)},
+		{IssueSummary: issue(2, domain.StatusMuted, &soon)},
+		{IssueSummary: issue(3, domain.StatusMuted, &later)},
+	}, now)
+	if len(first) != 1 || first[0].Kind() != KindSnoozeExpiring || first[0].(SnoozeExpiring).Issue.ItemID != 2 {
+		t.Fatalf("unexpected first refresh events: %+v", first)
+	}
+
+	second := detector.Detect([]app.IssueDelta{
+		{IssueSummary: issue(1, domain.StatusActive, nil), Changed: true, Delta: 1},
+		{IssueSummary: issue(2, domain.StatusMuted, &soon)},
+		{IssueSummary: issue(4, domain.StatusActive, nil), Changed: true, New: true},
+	}, now.Add(time.Minute))
+	if len(second) != 2 {
+		t.Fatalf("unexpected second refresh events: %+v", second)
+	}
+	reactivated, ok := second[0].(Reactivated)
+	if !ok || reactivated.Issue.ItemID != 1 || reactivated.From != domain.StatusResolved {
+		t.Fatalf("expected issue 1 reactivated from resolved, got %+v", second[0])
+	}
+	if item, ok := second[1].(NewItem); !ok || item.Issue.ItemID != 4 {
+		t.Fatalf("expected issue 4 to be new, got %+v", second[1])
+	}
+}
+
+func TestDetectorSnoozeWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	past := uint64(now.Add(-time.Minute).Unix())
+	soon := uint64(now.Add(time.Minute).Unix())
+	for _, tt := range []struct {
+		name   string
+		within time.Duration
+		issue  app.IssueSummary
+	}{
+		{name: "disabled", issue: app.IssueSummary{Status: domain.StatusMuted, SnoozeExpiresAt: &soon}},
+		{name: "already expired", within: time.Hour, issue: app.IssueSummary{Status: domain.StatusMuted, SnoozeExpiresAt: &past}},
+		{name: "not muted", within: time.Hour, issue: app.IssueSummary{Status: domain.StatusActive, SnoozeExpiresAt: &soon}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := NewDetector(tt.within).Detect([]app.IssueDelta{{IssueSummary: tt.issue}}, now); len(got) != 0 {
+				t.Fatalf("expected no events, got %+v", got)
+			}
+		})
+	}
+}
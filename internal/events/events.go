@@ -0,0 +1,52 @@
+package events
+
+import (
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type Kind string
+
+const (
+	KindNewItem        Kind = "new_item"
+	KindReactivated    Kind = "reactivated"
+	KindSpike          Kind = "spike"
+	KindSnoozeExpiring Kind = "snooze_expiring"
+)
+
+type Event interface {
+	Kind() Kind
+}
+
+type NewItem struct {
+	Issue app.IssueSummary `json:"issue"`
+	At    time.Time        `json:"at"`
+}
+
+func (NewItem) Kind() Kind { return KindNewItem }
+
+type Reactivated struct {
+	Issue app.IssueSummary `json:"issue"`
+	From  domain.Status    `json:"from"`
+	At    time.Time        `json:"at"`
+}
+
+func (Reactivated) Kind() Kind { return KindReactivated }
+
+type Spike struct {
+	Issue     app.IssueSummary `json:"issue"`
+	Threshold string           `json:"threshold"`
+	At        time.Time        `json:"at"`
+}
+
+func (Spike) Kind() Kind { return KindSpike }
+
+type SnoozeExpiring struct {
+	Issue     app.IssueSummary `json:"issue"`
+	ExpiresAt time.Time        `json:"expires_at"`
+	At        time.Time        `json:"at"`
+}
+
+func (SnoozeExpiring) Kind() Kind { return KindSnoozeExpiring }
@@ -0,0 +1,140 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const DefaultBaseURL = "https://api.github.com"
+
+const (
+	apiVersion      = "2022-11-28"
+	maxResponseSize = 1 << 20
+)
+
+type Repo struct {
+	Owner string
+	Name  string
+}
+
+func (r Repo) String() string {
+	return r.Owner + "/" + r.Name
+}
+
+func ParseRepo(value string) (Repo, error) {
+	owner, name, ok := strings.Cut(strings.TrimSpace(value), "/")
+	owner, name = strings.TrimSpace(owner), strings.TrimSuffix(strings.TrimSpace(name), ".git")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return Repo{}, fmt.Errorf("invalid repository %q: use owner/name", value)
+	}
+
+	return Repo{Owner: owner, Name: name}, nil
+}
+
+type NewIssue struct {
+	Title  string   `json:"title"`
+	Body   string   `json:"body"`
+	Labels []string `json:"labels,omitempty"`
+}
+
+type Issue struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+}
+
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("github returned HTTP %d", e.StatusCode)
+	}
+
+	return fmt.Sprintf("github returned HTTP %d: %s", e.StatusCode, e.Message)
+}
+
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+func New(token string) *Client {
+	return NewWithBaseURL(DefaultBaseURL, token)
+}
+
+func NewWithBaseURL(baseURL string, token string) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (c *Client) WithProxy(proxy func(*http.Request) (*url.URL, error)) *Client {
+	c.httpClient.Transport = &http.Transport{
+		Proxy:               proxy,
+		TLSHandshakeTimeout: 10 * time.Second,
+		IdleConnTimeout:     90 * time.Second,
+	}
+
+	return c
+}
+
+func (c *Client) CreateIssue(ctx context.Context, repo Repo, issue NewIssue) (Issue, error) {
+	if strings.TrimSpace(c.token) == "" {
+		return Issue{}, errors.New("github token is required")
+	}
+	body, err := json.Marshal(issue)
+	if err != nil {
+		return Issue{}, fmt.Errorf("encode github issue: %w", err)
+	}
+
+	endpoint := c.baseURL + "/repos/" + url.PathEscape(repo.Owner) + "/" + url.PathEscape(repo.Name) + "/issues"
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return Issue{}, fmt.Errorf("build github request: %w", err)
+	}
+	request.Header.Set("Accept", "application/vnd.github+json")
+	request.Header.Set("Authorization", "Bearer "+c.token)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("X-GitHub-Api-Version", apiVersion)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return Issue{}, fmt.Errorf("create github issue: %w", err)
+	}
+	defer response.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(response.Body, maxResponseSize))
+	if err != nil {
+		return Issue{}, fmt.Errorf("read github response: %w", err)
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		var reply struct {
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(raw, &reply)
+		return Issue{}, fmt.Errorf("create github issue in %s: %w", repo.String(), &APIError{StatusCode: response.StatusCode, Message: reply.Message})
+	}
+
+	var created Issue
+	if err := json.Unmarshal(raw, &created); err != nil {
+		return Issue{}, fmt.Errorf("decode github issue: %w", err)
+	}
+	if created.HTMLURL == "" {
+		return Issue{}, errors.New("github response did not include the issue URL")
+	}
+
+	return created, nil
+}
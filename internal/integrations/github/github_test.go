@@ -0,0 +1,123 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRepo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    Repo
+		wantErr bool
+	}{
+		{input: "kevinsheth/rollbaz", want: Repo{Owner: "kevinsheth", Name: "rollbaz"}},
+		{input: " acme/api.git ", want: Repo{Owner: "acme", Name: "api"}},
+		{input: "rollbaz", wantErr: true},
+		{input: "/rollbaz", wantErr: true},
+		{input: "acme/", wantErr: true},
+		{input: "acme/api/issues", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseRepo(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Fatalf("ParseRepo(%q) = %+v, %v", tt.input, got, err)
+		}
+	}
+	if got := (Repo{Owner: "acme", Name: "api"}).String(); got != "acme/api" {
+		t.Fatalf("Repo.String() = %q", got)
+	}
+}
+
+func TestCreateIssue(t *testing.T) {
+	t.Parallel()
+
+	var received NewIssue
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/repos/acme/api/issues" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer gh-token" || r.Header.Get("X-GitHub-Api-Version") != apiVersion {
+			t.Fatalf("unexpected headers: %v", r.Header)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"number":42,"html_url":"https://github.com/acme/api/issues/42"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	issue := NewIssue{Title: "TypeError", Body: "details", Labels: []string{"rollbar"}}
+	got, err := NewWithBaseURL(server.URL+"/", "gh-token").CreateIssue(context.Background(), Repo{Owner: "acme", Name: "api"}, issue)
+	if err != nil {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	if got.Number != 42 || got.HTMLURL != "https://github.com/acme/api/issues/42" {
+		t.Fatalf("CreateIssue() = %+v", got)
+	}
+	if !reflect.DeepEqual(received, issue) {
+		t.Fatalf("sent issue = %+v", received)
+	}
+}
+
+func TestCreateIssueWithProxy(t *testing.T) {
+	t.Parallel()
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "github.invalid" || r.URL.Path != "/repos/acme/api/issues" {
+			t.Fatalf("expected a proxied request to github.invalid, got %s %s", r.Host, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"number":7,"html_url":"https://github.com/acme/api/issues/7"}`)
+	}))
+	t.Cleanup(proxy.Close)
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	client := NewWithBaseURL("http://github.invalid", "gh-token").WithProxy(http.ProxyURL(proxyURL))
+	if got, err := client.CreateIssue(context.Background(), Repo{Owner: "acme", Name: "api"}, NewIssue{Title: "x"}); err != nil || got.Number != 7 {
+		t.Fatalf("CreateIssue() = %+v, %v", got, err)
+	}
+}
+
+func TestCreateIssueErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/acme/locked/issues":
+			w.WriteHeader(http.StatusGone)
+			_, _ = fmt.Fprint(w, `{"message":"Issues are disabled for this repo"}`)
+		default:
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"number":1}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewWithBaseURL(server.URL, "gh-token")
+	_, err := client.CreateIssue(context.Background(), Repo{Owner: "acme", Name: "locked"}, NewIssue{Title: "x"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusGone || !strings.Contains(err.Error(), "Issues are disabled") {
+		t.Fatalf("CreateIssue() error = %v", err)
+	}
+	if _, err := client.CreateIssue(context.Background(), Repo{Owner: "acme", Name: "api"}, NewIssue{Title: "x"}); err == nil {
+		t.Fatalf("expected error for a reply without a URL")
+	}
+	if _, err := NewWithBaseURL(server.URL, " ").CreateIssue(context.Background(), Repo{Owner: "acme", Name: "api"}, NewIssue{Title: "x"}); err == nil {
+		t.Fatalf("expected missing token error")
+	}
+}
@@ -0,0 +1,44 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type bundleOccurrence struct {
+	OccurrenceID domain.OccurrenceID `json:"occurrence_id"`
+	ItemID       domain.ItemID       `json:"item_id"`
+	Timestamp    *uint64             `json:"timestamp"`
+	Payload      json.RawMessage     `json:"payload,omitempty"`
+}
+
+func BundleFiles(data app.ExportData) (map[string][]byte, error) {
+	issues := make([]app.IssueSummary, 0, len(data.Issues))
+	for _, issue := range data.Issues {
+		issue.Raw = nil
+		issues = append(issues, issue)
+	}
+	occurrences := make([]bundleOccurrence, 0, len(data.Occurrences))
+	for _, occurrence := range data.Occurrences {
+		occurrences = append(occurrences, bundleOccurrence{
+			OccurrenceID: occurrence.Instance.ID,
+			ItemID:       occurrence.ItemID,
+			Timestamp:    occurrence.Instance.Timestamp,
+			Payload:      occurrence.Instance.Raw,
+		})
+	}
+
+	files := map[string][]byte{}
+	for name, value := range map[string]any{"issues.json": issues, "occurrences.json": occurrences} {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("encode %s: %w", name, err)
+		}
+		files[name] = encoded
+	}
+
+	return files, nil
+}
@@ -0,0 +1,50 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func RenderCanaryHumanWithWidth(report app.CanaryReport, maxWidth int) string {
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(normalizeWidth(maxWidth, defaultListRowWidth))
+	tw.AppendHeader(table.Row{"ROLE", "VERSION", "OCCURRENCES", "ITEMS", "NEW_ITEMS", "RATE_PER_HOUR"})
+	tw.AppendRow(canaryRow("baseline", report.Baseline))
+	tw.AppendRow(canaryRow("candidate", report.Candidate))
+
+	verdict := "PASS"
+	if !report.Passed {
+		verdict = "FAIL"
+	}
+
+	lines := []string{
+		fmt.Sprintf("Canary: %s (sampled %d items)", verdict, report.SampledItems),
+		"",
+		strings.TrimRight(tw.Render(), "\n"),
+	}
+	if report.RateRatio != nil {
+		lines = append(lines, "", fmt.Sprintf("Rate ratio: %.2fx", *report.RateRatio))
+	}
+	for _, reason := range report.Reasons {
+		lines = append(lines, "- "+reason)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func canaryRow(role string, stats app.CanaryVersionStats) table.Row {
+	return table.Row{
+		role,
+		fallback(stats.Version),
+		formatting.count(stats.Occurrences),
+		strconv.Itoa(stats.Items),
+		strconv.Itoa(stats.NewItems),
+		strconv.FormatFloat(stats.RatePerHour, 'f', 2, 64),
+	}
+}
@@ -0,0 +1,34 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderCanaryHumanWithWidth(t *testing.T) {
+	t.Parallel()
+
+	ratio := 3.0
+	report := app.CanaryReport{
+		Baseline:     app.CanaryVersionStats{Version: "v1", Occurrences: 2, RatePerHour: 1},
+		Candidate:    app.CanaryVersionStats{Version: "v2", Occurrences: 6, RatePerHour: 3},
+		RateRatio:    &ratio,
+		SampledItems: 4,
+		Reasons:      []string{"candidate error rate is 3.00x baseline (max 1.50x)"},
+	}
+
+	got := RenderCanaryHumanWithWidth(report, 120)
+	for _, want := range []string{"Canary: FAIL (sampled 4 items)", "candidate", "3.00", "Rate ratio: 3.00x", "- candidate error rate"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+
+	report.Passed = true
+	report.Reasons = nil
+	if got := RenderCanaryHumanWithWidth(report, 120); !strings.Contains(got, "Canary: PASS") {
+		t.Fatalf("expected pass verdict, got: %q", got)
+	}
+}
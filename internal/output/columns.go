@@ -0,0 +1,199 @@
+package output
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	prettytext "github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+const listTableBorderWidth = 3
+
+type listColumn struct {
+	header string
+	width  int
+	value  func(app.IssueSummary, Formatting) string
+	plain  func(app.IssueSummary) string
+}
+
+var DefaultListColumns = []string{"counter", "status", "env", "level", "occurrences", "last_seen", "title"}
+
+var listColumns = map[string]listColumn{
+	"counter": {header: "COUNTER", width: 10, value: func(issue app.IssueSummary, _ Formatting) string { return issue.Counter.String() }},
+	"status":  {header: "STATUS", width: 10, value: func(issue app.IssueSummary, _ Formatting) string { return fallback(issue.Status.String()) }},
+	"env":     {header: "ENV", width: 14, value: func(issue app.IssueSummary, _ Formatting) string { return fallback(issue.Environment.String()) }},
+	"level": {header: "LEVEL", width: 12, value: func(issue app.IssueSummary, f Formatting) string { return f.level(issue.Level) },
+		plain: func(issue app.IssueSummary) string { return fallback(issue.Level.String()) }},
+	"occurrences": {header: "OCCURRENCES", width: 14, value: func(issue app.IssueSummary, f Formatting) string { return f.occurrences(issue.Occurrences) }},
+	"last_seen": {header: "LAST_SEEN", width: 23, value: func(issue app.IssueSummary, f Formatting) string {
+		return f.timestamp(issue.LastOccurrenceTimestamp, f.now)
+	}},
+	"age": {header: "AGE", width: 8, value: func(issue app.IssueSummary, f Formatting) string {
+		return formatAge(issue.FirstOccurrenceTimestamp, f.now)
+	}},
+	"trend": {header: "TREND", width: 7, value: func(issue app.IssueSummary, f Formatting) string { return f.trend(issue.Trend) },
+		plain: func(issue app.IssueSummary) string { return trendDirection(issue.Trend) }},
+	"title": {header: "TITLE", value: func(issue app.IssueSummary, _ Formatting) string { return fallback(issue.Title) }},
+}
+
+func ParseListColumns(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return DefaultListColumns, nil
+	}
+
+	columns := make([]string, 0, len(names))
+	seen := map[string]struct{}{}
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := listColumns[name]; !ok {
+			return nil, fmt.Errorf("unknown column %q: use %s", name, strings.Join(knownListColumns(), ", "))
+		}
+		if _, duplicate := seen[name]; duplicate {
+			continue
+		}
+		seen[name] = struct{}{}
+		columns = append(columns, name)
+	}
+	if len(columns) == 0 {
+		return DefaultListColumns, nil
+	}
+
+	return columns, nil
+}
+
+func knownListColumns() []string {
+	return []string{"counter", "status", "env", "level", "occurrences", "trend", "last_seen", "age", "title"}
+}
+
+func RenderIssueListVertical(issues []app.IssueSummary, columns []string, now time.Time) string {
+	if len(issues) == 0 {
+		return "no issues found"
+	}
+
+	selected := selectListColumns(columns)
+	labelWidth := 0
+	for _, column := range selected {
+		labelWidth = max(labelWidth, len(column.header)+1)
+	}
+
+	format := formatting.at(now)
+	blocks := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		lines := make([]string, 0, len(selected))
+		for _, column := range selected {
+			lines = append(lines, fmt.Sprintf("%-*s %s", labelWidth, column.header+":", column.value(issue, format)))
+		}
+		blocks = append(blocks, strings.Join(lines, "\n"))
+	}
+
+	return strings.Join(blocks, "\n\n")
+}
+
+func RenderIssueListPlain(issues []app.IssueSummary, columns []string, now time.Time) string {
+	return renderIssueListPlain(issues, columns, true, now)
+}
+
+func renderIssueListPlain(issues []app.IssueSummary, columns []string, withHeader bool, now time.Time) string {
+	selected := selectListColumns(columns)
+	format := Formatting{}.at(now)
+	tw := table.NewWriter()
+	if withHeader {
+		header := make(table.Row, 0, len(selected))
+		for _, column := range selected {
+			header = append(header, column.header)
+		}
+		tw.AppendHeader(header)
+	}
+	for _, issue := range issues {
+		row := make(table.Row, 0, len(selected))
+		for _, column := range selected {
+			value := ""
+			if column.plain != nil {
+				value = column.plain(issue)
+			} else {
+				value = column.value(issue, format)
+			}
+			row = append(row, strings.Join(strings.Fields(value), " "))
+		}
+		tw.AppendRow(row)
+	}
+
+	return tw.RenderTSV()
+}
+
+type levelStyle struct {
+	icon   string
+	colors prettytext.Colors
+}
+
+var levelStyles = map[domain.Level]levelStyle{
+	domain.LevelCritical: {icon: "‼", colors: prettytext.Colors{prettytext.Bold, prettytext.FgHiRed}},
+	domain.LevelError:    {icon: "✖", colors: prettytext.Colors{prettytext.FgRed}},
+	domain.LevelWarning:  {icon: "▲", colors: prettytext.Colors{prettytext.FgYellow}},
+	domain.LevelInfo:     {icon: "●", colors: prettytext.Colors{prettytext.FgCyan}},
+	domain.LevelDebug:    {icon: "○", colors: prettytext.Colors{prettytext.FgHiBlack}},
+}
+
+func (f Formatting) level(level domain.Level) string {
+	style, ok := levelStyles[domain.Level(strings.ToLower(level.String()))]
+	if !ok {
+		return fallback(level.String())
+	}
+	value := style.icon + " " + strings.ToLower(level.String())
+	if f.Color {
+		return style.colors.Sprint(value)
+	}
+
+	return value
+}
+
+var trendStyles = map[string]levelStyle{
+	app.TrendUp:   {icon: "▲", colors: prettytext.Colors{prettytext.FgRed}},
+	app.TrendDown: {icon: "▼", colors: prettytext.Colors{prettytext.FgGreen}},
+	app.TrendFlat: {icon: "="},
+}
+
+func (f Formatting) trend(trend *app.IssueTrend) string {
+	if trend == nil {
+		return "-"
+	}
+	style, ok := trendStyles[trend.Direction]
+	if !ok {
+		return "-"
+	}
+	if f.Color && len(style.colors) > 0 {
+		return style.colors.Sprint(style.icon)
+	}
+
+	return style.icon
+}
+
+func trendDirection(trend *app.IssueTrend) string {
+	if trend == nil {
+		return "-"
+	}
+
+	return trend.Direction
+}
+
+func formatAge(firstSeen *uint64, reference time.Time) string {
+	if firstSeen == nil || *firstSeen > math.MaxInt64 {
+		return "unknown"
+	}
+
+	age := reference.Sub(time.Unix(int64(*firstSeen), 0))
+	if age < 0 {
+		return "0s"
+	}
+
+	return formatDuration(age)
+}
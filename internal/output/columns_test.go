@@ -0,0 +1,199 @@
+package output
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+var testNow = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+func TestParseListColumns(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "default", input: nil, want: DefaultListColumns},
+		{name: "blank", input: []string{" ", ""}, want: DefaultListColumns},
+		{name: "custom", input: []string{"Title", " counter ", "title", "level"}, want: []string{"title", "counter", "level"}},
+		{name: "unknown", input: []string{"owner"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseListColumns(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseListColumns() error = %v", err)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseListColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderIssueListHumanWithColumns(t *testing.T) {
+	t.Parallel()
+
+	issues := []app.IssueSummary{{Counter: domain.ItemCounter(7), Title: "boom", Status: "active", Environment: "production", Level: "error"}}
+
+	got := RenderIssueListHumanWithColumns(issues, 100, []string{"title", "counter", "level"}, testNow)
+	header := strings.Split(got, "\n")[1]
+	if !strings.Contains(header, "TITLE") || strings.Index(header, "TITLE") > strings.Index(header, "COUNTER") {
+		t.Fatalf("expected custom column order, got %q", got)
+	}
+	if strings.Contains(got, "ENV") || strings.Contains(got, "production") || !strings.Contains(got, "error") {
+		t.Fatalf("expected only selected columns, got %q", got)
+	}
+
+	withoutTitle := RenderIssueListHumanWithColumns(issues, 100, []string{"counter", "status"}, testNow)
+	if strings.Contains(withoutTitle, "boom") {
+		t.Fatalf("expected title to be hidden, got %q", withoutTitle)
+	}
+
+	fallbackColumns := RenderIssueListHumanWithColumns(issues, 100, []string{"unknown"}, testNow)
+	if fallbackColumns != RenderIssueListHumanWithWidth(issues, 100, testNow) {
+		t.Fatalf("expected default columns for unknown names, got %q", fallbackColumns)
+	}
+}
+
+func TestRenderIssueListVertical(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderIssueListVertical(nil, nil, testNow); got != "no issues found" {
+		t.Fatalf("unexpected empty output: %q", got)
+	}
+
+	issues := []app.IssueSummary{
+		{Counter: domain.ItemCounter(7), Title: "boom", Status: "active"},
+		{Counter: domain.ItemCounter(8), Title: "bang"},
+	}
+	got := RenderIssueListVertical(issues, []string{"counter", "status", "title"}, testNow)
+	want := "COUNTER: 7\nSTATUS:  active\nTITLE:   boom\n\nCOUNTER: 8\nSTATUS:  unknown\nTITLE:   bang"
+	if got != want {
+		t.Fatalf("RenderIssueListVertical() = %q, want %q", got, want)
+	}
+}
+
+func TestRenderIssueListPlain(t *testing.T) {
+	t.Parallel()
+
+	issues := []app.IssueSummary{{Counter: domain.ItemCounter(7), Title: "boom\tat  line\n3", Status: "active"}}
+	got := RenderIssueListPlain(issues, []string{"counter", "status", "title"}, testNow)
+	want := "COUNTER\tSTATUS\tTITLE\n7\tactive\tboom at line 3"
+	if got != want {
+		t.Fatalf("RenderIssueListPlain() = %q, want %q", got, want)
+	}
+
+	levels := RenderIssueListPlain([]app.IssueSummary{{Counter: domain.ItemCounter(7), Level: "critical"}}, []string{"counter", "level"}, testNow)
+	if levels != "COUNTER\tLEVEL\n7\tcritical" {
+		t.Fatalf("expected plain level without icon, got %q", levels)
+	}
+
+	if got := RenderIssueListPlain(nil, []string{"counter"}, testNow); got != "COUNTER" {
+		t.Fatalf("unexpected empty plain output: %q", got)
+	}
+}
+
+func TestFormattingLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		level domain.Level
+		color bool
+		want  string
+	}{
+		{level: "critical", want: "‼ critical"},
+		{level: "Warning", want: "▲ warning"},
+		{level: "debug", want: "○ debug"},
+		{level: "", want: "unknown"},
+		{level: "35", want: "35"},
+		{level: "error", color: true, want: "\x1b[31m✖ error\x1b[0m"},
+	}
+	for _, tt := range tests {
+		if got := (Formatting{Color: tt.color}).level(tt.level); got != tt.want {
+			t.Fatalf("level(%q, color=%v) = %q, want %q", tt.level, tt.color, got, tt.want)
+		}
+	}
+}
+
+func TestFormattingTrend(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		trend *app.IssueTrend
+		color bool
+		want  string
+		plain string
+	}{
+		{trend: &app.IssueTrend{Current: 9, Previous: 3, Direction: app.TrendUp}, want: "▲", plain: "up"},
+		{trend: &app.IssueTrend{Current: 1, Previous: 3, Direction: app.TrendDown}, want: "▼", plain: "down"},
+		{trend: &app.IssueTrend{Direction: app.TrendFlat}, color: true, want: "=", plain: "flat"},
+		{trend: &app.IssueTrend{Direction: app.TrendUp}, color: true, want: "\x1b[31m▲\x1b[0m", plain: "up"},
+		{trend: nil, want: "-", plain: "-"},
+	}
+	for _, tt := range tests {
+		if got := (Formatting{Color: tt.color}).trend(tt.trend); got != tt.want {
+			t.Fatalf("trend(%+v, color=%v) = %q, want %q", tt.trend, tt.color, got, tt.want)
+		}
+		if got := trendDirection(tt.trend); got != tt.plain {
+			t.Fatalf("trendDirection(%+v) = %q, want %q", tt.trend, got, tt.plain)
+		}
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	t.Parallel()
+
+	reference := time.Unix(1700000000, 0)
+	at := func(offset time.Duration) *uint64 {
+		value := uint64(reference.Add(-offset).Unix())
+		return &value
+	}
+	future := uint64(reference.Add(time.Hour).Unix())
+	overflow := uint64(math.MaxUint64)
+
+	tests := []struct {
+		firstSeen *uint64
+		want      string
+	}{
+		{firstSeen: nil, want: "unknown"},
+		{firstSeen: &overflow, want: "unknown"},
+		{firstSeen: &future, want: "0s"},
+		{firstSeen: at(45 * time.Second), want: "45s"},
+		{firstSeen: at(5 * time.Minute), want: "5m"},
+		{firstSeen: at(3 * time.Hour), want: "3h"},
+		{firstSeen: at(40 * 24 * time.Hour), want: "40d"},
+	}
+
+	for _, tt := range tests {
+		if got := formatAge(tt.firstSeen, reference); got != tt.want {
+			t.Fatalf("formatAge() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestRelativeColumnsUseRenderTime(t *testing.T) {
+	t.Parallel()
+
+	firstSeen := uint64(testNow.Add(-3 * time.Hour).Unix())
+	issues := []app.IssueSummary{{Counter: domain.ItemCounter(7), FirstOccurrenceTimestamp: &firstSeen}}
+
+	if got := RenderIssueListPlain(issues, []string{"counter", "age"}, testNow); got != "COUNTER\tAGE\n7\t3h" {
+		t.Fatalf("RenderIssueListPlain() = %q", got)
+	}
+	if got := RenderIssueListVertical(issues, []string{"age"}, testNow.Add(24*time.Hour)); got != "AGE: 1d" {
+		t.Fatalf("RenderIssueListVertical() = %q", got)
+	}
+}
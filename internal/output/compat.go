@@ -0,0 +1,44 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func RenderAPICompatWithWidth(report app.APICompatReport, maxWidth int) string {
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(normalizeWidth(maxWidth, defaultListRowWidth))
+	tw.AppendHeader(table.Row{"ENDPOINT", "STATUS", "DETAIL"})
+	for _, check := range report.Endpoints {
+		tw.AppendRow(table.Row{check.Endpoint, check.Status, compatDetail(check)})
+	}
+
+	verdict := "all endpoints returned documented shapes"
+	if !report.Passed {
+		verdict = fmt.Sprintf("%d changed, %d failed", report.Changed, report.Failed)
+	}
+
+	return strings.TrimRight(tw.Render(), "\n") + "\n\n" + verdict
+}
+
+func compatDetail(check app.EndpointCheck) string {
+	if check.Error != "" {
+		return check.Error
+	}
+
+	details := make([]string, 0, len(check.Shapes))
+	for _, shape := range check.Shapes {
+		if shape.Matches() {
+			details = append(details, fmt.Sprintf("%s: %s", shape.Aspect, shape.Observed))
+			continue
+		}
+		details = append(details, fmt.Sprintf("%s: %s, expected %s", shape.Aspect, shape.Observed, shape.Expected))
+	}
+
+	return fallback(strings.Join(details, "; "))
+}
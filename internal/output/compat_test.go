@@ -0,0 +1,31 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestRenderAPICompatWithWidth(t *testing.T) {
+	t.Parallel()
+
+	report := app.APICompatReport{Endpoints: []app.EndpointCheck{
+		{Endpoint: "items", Status: app.CompatOK, Shapes: []rollbar.ShapeObservation{{Endpoint: "items", Aspect: "envelope", Expected: `{"items": [...]}`, Observed: `{"items": [...]}`}}},
+		{Endpoint: "deploys", Status: app.CompatChanged, Shapes: []rollbar.ShapeObservation{{Endpoint: "deploys", Aspect: "envelope", Expected: `{"deploys": [...]}`, Observed: "bare list"}}},
+		{Endpoint: "project", Status: app.CompatError, Error: "get project: boom"},
+	}, Changed: 1, Failed: 1}
+
+	got := RenderAPICompatWithWidth(report, 160)
+	for _, want := range []string{"ENDPOINT", `envelope: {"items": [...]}`, `envelope: bare list, expected {"deploys": [...]}`, "get project: boom", "1 changed, 1 failed"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+
+	passed := RenderAPICompatWithWidth(app.APICompatReport{Endpoints: report.Endpoints[:1], Passed: true}, 160)
+	if !strings.Contains(passed, "all endpoints returned documented shapes") {
+		t.Fatalf("expected passing verdict, got: %q", passed)
+	}
+}
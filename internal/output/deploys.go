@@ -0,0 +1,45 @@
+package output
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const deployNonCommentWidth = 78
+
+func RenderDeployListWithWidth(deploys []rollbar.Deploy, maxWidth int) string {
+	if len(deploys) == 0 {
+		return "no deploys"
+	}
+
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	commentWidth := clampInt(targetWidth-deployNonCommentWidth, minListTitleWidth, maxListTitleWidth)
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(targetWidth)
+	tw.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 7, WidthMax: commentWidth, WidthMaxEnforcer: formatting.truncate},
+	})
+	tw.AppendHeader(table.Row{"ID", "ENV", "REVISION", "STATUS", "USER", "STARTED", "COMMENT"})
+
+	now := time.Now()
+	for _, deploy := range deploys {
+		tw.AppendRow(table.Row{
+			strconv.FormatUint(deploy.ID, 10),
+			fallback(deploy.Environment),
+			fallback(deploy.Revision),
+			fallback(deploy.Status),
+			fallback(deploy.LocalUsername),
+			formatting.timestamp(deploy.StartTime, now),
+			fallback(deploy.Comment),
+		})
+	}
+
+	return strings.TrimRight(tw.Render(), "\n")
+}
@@ -0,0 +1,25 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestRenderDeployListWithWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderDeployListWithWidth(nil, 120); got != "no deploys" {
+		t.Fatalf("unexpected empty output: %q", got)
+	}
+
+	started := uint64(1700000000)
+	deploys := []rollbar.Deploy{{ID: 9, Environment: "production", Revision: "abc123", Status: "succeeded", LocalUsername: "alice", StartTime: &started}}
+	got := RenderDeployListWithWidth(deploys, 120)
+	for _, want := range []string{"REVISION", "abc123", "production", "succeeded", "alice", "2023-11-14T22:13:20Z", "9"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+}
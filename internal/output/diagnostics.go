@@ -0,0 +1,124 @@
+package output
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+const (
+	lspError       = 1
+	lspWarning     = 2
+	lspInformation = 3
+	lspHint        = 4
+)
+
+type FileDiagnostics struct {
+	URI         string          `json:"uri"`
+	Diagnostics []LSPDiagnostic `json:"diagnostics"`
+}
+
+type LSPDiagnostic struct {
+	Range    LSPRange          `json:"range"`
+	Severity int               `json:"severity"`
+	Code     string            `json:"code"`
+	Source   string            `json:"source"`
+	Message  string            `json:"message"`
+	Data     LSPDiagnosticData `json:"data"`
+}
+
+type LSPRange struct {
+	Start LSPPosition `json:"start"`
+	End   LSPPosition `json:"end"`
+}
+
+type LSPPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type LSPDiagnosticData struct {
+	Counter     domain.ItemCounter `json:"counter"`
+	Environment string             `json:"environment,omitempty"`
+	Occurrences *uint64            `json:"occurrences,omitempty"`
+}
+
+func LSPDiagnostics(diagnostics []app.Diagnostic, root string) []FileDiagnostics {
+	byFile := map[string][]LSPDiagnostic{}
+	for _, diagnostic := range diagnostics {
+		line := max(diagnostic.Frame.Line-1, 0)
+		issue := diagnostic.Issue
+		byFile[diagnostic.File] = append(byFile[diagnostic.File], LSPDiagnostic{
+			Range:    LSPRange{Start: LSPPosition{Line: line}, End: LSPPosition{Line: line + 1}},
+			Severity: lspSeverity(issue.Level),
+			Code:     "#" + issue.Counter.String(),
+			Source:   "rollbaz",
+			Message:  diagnosticMessage(issue),
+			Data:     LSPDiagnosticData{Counter: issue.Counter, Environment: issue.Environment.String(), Occurrences: issue.Occurrences},
+		})
+	}
+
+	files := make([]string, 0, len(byFile))
+	for file := range byFile {
+		files = append(files, file)
+	}
+	slices.Sort(files)
+
+	published := make([]FileDiagnostics, 0, len(files))
+	for _, file := range files {
+		entries := byFile[file]
+		slices.SortStableFunc(entries, func(left LSPDiagnostic, right LSPDiagnostic) int {
+			return left.Range.Start.Line - right.Range.Start.Line
+		})
+		published = append(published, FileDiagnostics{URI: fileURI(filepath.Join(root, filepath.FromSlash(file))), Diagnostics: entries})
+	}
+
+	return published
+}
+
+func lspSeverity(level domain.Level) int {
+	switch level {
+	case domain.LevelWarning:
+		return lspWarning
+	case domain.LevelInfo:
+		return lspInformation
+	case domain.LevelDebug:
+		return lspHint
+	default:
+		return lspError
+	}
+}
+
+func diagnosticMessage(issue app.IssueSummary) string {
+	details := []string{}
+	if issue.Occurrences != nil {
+		details = append(details, fmt.Sprintf("%d occurrences", *issue.Occurrences))
+	}
+	if issue.Environment != "" {
+		details = append(details, "in "+issue.Environment.String())
+	}
+	if issue.LastOccurrenceTimestamp != nil {
+		details = append(details, "last seen "+Formatting{}.timestamp(issue.LastOccurrenceTimestamp, time.Now()))
+	}
+	message := fmt.Sprintf("Rollbar #%s: %s", issue.Counter.String(), fallback(issue.Title))
+	if len(details) == 0 {
+		return message
+	}
+
+	return message + " (" + strings.Join(details, ", ") + ")"
+}
+
+func fileURI(path string) string {
+	slashed := filepath.ToSlash(path)
+	if !strings.HasPrefix(slashed, "/") {
+		slashed = "/" + slashed
+	}
+
+	return (&url.URL{Scheme: "file", Path: slashed}).String()
+}
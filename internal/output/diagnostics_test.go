@@ -0,0 +1,43 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+func TestLSPDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	occurrences, lastSeen := uint64(12), uint64(1700000000)
+	diagnostics := []app.Diagnostic{
+		{Issue: app.IssueSummary{Counter: 2, Title: "slow query", Level: domain.LevelWarning}, File: "app/web/handler.go", Frame: summary.Frame{Line: 30}},
+		{Issue: app.IssueSummary{Counter: 1, Title: "nil map", Level: domain.LevelCritical, Environment: "production", Occurrences: &occurrences, LastOccurrenceTimestamp: &lastSeen}, File: "app/web/handler.go", Frame: summary.Frame{Line: 7}},
+		{Issue: app.IssueSummary{Counter: 3, Title: "bad total"}, File: "app/billing/invoice.go"},
+	}
+
+	got := LSPDiagnostics(diagnostics, "/home/dev/shop")
+	if len(got) != 2 || got[0].URI != "file:///home/dev/shop/app/billing/invoice.go" || got[1].URI != "file:///home/dev/shop/app/web/handler.go" {
+		t.Fatalf("unexpected files: %+v", got)
+	}
+	if start := got[0].Diagnostics[0].Range.Start; start.Line != 0 {
+		t.Fatalf("expected a missing line to map to the first line, got %+v", start)
+	}
+
+	handler := got[1].Diagnostics
+	if handler[0].Range.Start.Line != 6 || handler[0].Range.End.Line != 7 || handler[1].Range.Start.Line != 29 {
+		t.Fatalf("expected zero-based lines sorted by position, got %+v", handler)
+	}
+	first := handler[0]
+	if first.Severity != lspError || first.Code != "#1" || first.Source != "rollbaz" || first.Data.Counter != 1 {
+		t.Fatalf("unexpected diagnostic: %+v", first)
+	}
+	if want := "Rollbar #1: nil map (12 occurrences, in production, last seen 2023-11-14T22:13:20Z)"; first.Message != want {
+		t.Fatalf("message = %q, want %q", first.Message, want)
+	}
+	if handler[1].Severity != lspWarning {
+		t.Fatalf("expected warning severity, got %+v", handler[1])
+	}
+}
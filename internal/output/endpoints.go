@@ -0,0 +1,67 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+const (
+	endpointNonRouteWidth = 72
+	endpointListedIssues  = 4
+)
+
+func RenderEndpointReportWithWidth(report app.EndpointReport, maxWidth int) string {
+	heading := fmt.Sprintf("%s sampled occurrences of %d issues since %s", formatting.count(uint64(report.SampledOccurrences)), report.SampledItems, report.Since.UTC().Format(time.RFC3339))
+	if report.WithoutRequest > 0 {
+		heading += fmt.Sprintf(" (%s without a request URL)", formatting.count(uint64(report.WithoutRequest)))
+	}
+	if len(report.Endpoints) == 0 {
+		return heading + "\nno occurrences with a request URL"
+	}
+
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	routeWidth := clampInt(targetWidth-endpointNonRouteWidth, minListTitleWidth, maxListTitleWidth)
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(targetWidth)
+	tw.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 3, WidthMax: routeWidth, WidthMaxEnforcer: formatting.truncate},
+	})
+	tw.AppendHeader(table.Row{"OCCURRENCES", "METHOD", "ROUTE", "ISSUES", "LAST_SEEN"})
+	now := time.Now()
+	for _, endpoint := range report.Endpoints {
+		lastSeen := &endpoint.LastSeen
+		if endpoint.LastSeen == 0 {
+			lastSeen = nil
+		}
+		tw.AppendRow(table.Row{
+			formatting.count(uint64(endpoint.Occurrences)),
+			fallback(endpoint.Method),
+			endpoint.Route,
+			endpointIssues(endpoint.Counters),
+			formatting.timestamp(lastSeen, now),
+		})
+	}
+
+	return heading + "\n" + strings.TrimRight(tw.Render(), "\n")
+}
+
+func endpointIssues(counters []domain.ItemCounter) string {
+	listed := make([]string, 0, endpointListedIssues+1)
+	for index, counter := range counters {
+		if index == endpointListedIssues {
+			listed = append(listed, fmt.Sprintf("+%d", len(counters)-index))
+			break
+		}
+		listed = append(listed, "#"+counter.String())
+	}
+
+	return strings.Join(listed, " ")
+}
@@ -0,0 +1,34 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestRenderEndpointReportWithWidth(t *testing.T) {
+	t.Parallel()
+
+	since := time.Unix(1_700_000_000, 0)
+	empty := RenderEndpointReportWithWidth(app.EndpointReport{Since: since, SampledItems: 2, SampledOccurrences: 3, WithoutRequest: 3}, 120)
+	if empty != "3 sampled occurrences of 2 issues since 2023-11-14T22:13:20Z (3 without a request URL)\nno occurrences with a request URL" {
+		t.Fatalf("unexpected empty report: %q", empty)
+	}
+
+	report := app.EndpointReport{Since: since, SampledItems: 6, SampledOccurrences: 9, Endpoints: []app.EndpointStat{
+		{Method: "POST", Route: "/orders/:id", Occurrences: 7, Counters: []domain.ItemCounter{11, 12, 13, 14, 15, 16}, LastSeen: 1_700_000_100},
+		{Route: "/search", Occurrences: 2, Counters: []domain.ItemCounter{11}},
+	}}
+	got := RenderEndpointReportWithWidth(report, 120)
+	for _, want := range []string{"9 sampled occurrences of 6 issues", "│ POST ", "/orders/:id", "#11 #12 #13 #14 +2", "2023-11-14T22:15:00Z", "│ unknown │ /search"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "/orders/:id") > strings.Index(got, "/search") {
+		t.Fatalf("expected the busiest endpoint first, got:\n%s", got)
+	}
+}
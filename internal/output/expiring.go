@@ -0,0 +1,41 @@
+package output
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const expiringNonTitleWidth = 62
+
+func RenderExpiringHumanWithWidth(issues []app.IssueSummary, maxWidth int) string {
+	if len(issues) == 0 {
+		return "no muted issues expiring in window"
+	}
+
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	titleWidth := clampInt(targetWidth-expiringNonTitleWidth, minListTitleWidth, maxListTitleWidth)
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(targetWidth)
+	tw.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 5, WidthMax: titleWidth, WidthMaxEnforcer: formatting.truncate},
+	})
+	tw.AppendHeader(table.Row{"COUNTER", "ENV", "OCCURRENCES", "EXPIRES_AT", "TITLE"})
+
+	for _, issue := range issues {
+		tw.AppendRow(table.Row{
+			issue.Counter.String(),
+			fallback(issue.Environment.String()),
+			formatting.occurrences(issue.Occurrences),
+			formatting.timestamp(issue.SnoozeExpiresAt, time.Now()),
+			fallback(issue.Title),
+		})
+	}
+
+	return strings.TrimRight(tw.Render(), "\n")
+}
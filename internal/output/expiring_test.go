@@ -0,0 +1,26 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestRenderExpiringHumanWithWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderExpiringHumanWithWidth(nil, 120); got != "no muted issues expiring in window" {
+		t.Fatalf("unexpected empty output: %q", got)
+	}
+
+	expiresAt := uint64(1700000000)
+	issues := []app.IssueSummary{{Counter: domain.ItemCounter(12), Title: "snoozed", SnoozeExpiresAt: &expiresAt}}
+	got := RenderExpiringHumanWithWidth(issues, 120)
+	for _, want := range []string{"EXPIRES_AT", "2023-11-14T22:13:20Z", "snoozed", "12"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package output
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func RenderExplanationHuman(explanation app.Explanation) string {
+	lines := []string{
+		"explain: server-side filters: " + describeExplainFilters(explanation.ServerFilters),
+		"explain: client-side filters: " + describeExplainFilters(explanation.ClientFilters),
+	}
+	for _, call := range explanation.Calls {
+		lines = append(lines, fmt.Sprintf("explain: GET %s -> %d results", explainEndpoint(call), call.Results))
+	}
+	for _, stage := range explanation.Stages {
+		lines = append(lines, fmt.Sprintf("explain: %s dropped %d of %d items", stage.Stage, stage.Dropped, stage.In))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func describeExplainFilters(filters []app.ExplainFilter) string {
+	if len(filters) == 0 {
+		return "none"
+	}
+
+	parts := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		parts = append(parts, filter.Name+"="+filter.Value)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func explainEndpoint(call app.ExplainCall) string {
+	if len(call.Params) == 0 {
+		return call.Endpoint
+	}
+
+	keys := make([]string, 0, len(call.Params))
+	for key := range call.Params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, key+"="+call.Params[key])
+	}
+
+	return call.Endpoint + "?" + strings.Join(params, "&")
+}
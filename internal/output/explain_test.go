@@ -0,0 +1,33 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderExplanationHuman(t *testing.T) {
+	t.Parallel()
+
+	got := RenderExplanationHuman(app.Explanation{
+		ServerFilters: []app.ExplainFilter{{Name: "status", Value: "active"}},
+		ClientFilters: []app.ExplainFilter{{Name: "env", Value: "production"}, {Name: "min_occurrences", Value: "10"}},
+		Calls: []app.ExplainCall{
+			{Endpoint: "/items", Params: map[string]string{"status": "active", "page": "1"}, Results: 100},
+			{Endpoint: "/reports/top_active_items", Results: 3},
+		},
+		Stages: []app.ExplainStage{{Stage: "env", In: 100, Out: 12, Dropped: 88}},
+	})
+	want := "explain: server-side filters: status=active\n" +
+		"explain: client-side filters: env=production, min_occurrences=10\n" +
+		"explain: GET /items?page=1&status=active -> 100 results\n" +
+		"explain: GET /reports/top_active_items -> 3 results\n" +
+		"explain: env dropped 88 of 100 items"
+	if got != want {
+		t.Fatalf("RenderExplanationHuman() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := RenderExplanationHuman(app.Explanation{}); got != "explain: server-side filters: none\nexplain: client-side filters: none" {
+		t.Fatalf("unexpected empty explanation: %q", got)
+	}
+}
@@ -0,0 +1,85 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const findNonTitleWidth = 90
+
+func RenderFindResultWithWidth(result app.RQLResult, maxWidth int) string {
+	if len(result.Rows) == 0 {
+		return "no matching occurrences"
+	}
+
+	return RenderRQLResultWithWidth(result, maxWidth)
+}
+
+func RenderRQLResultWithWidth(result app.RQLResult, maxWidth int) string {
+	if len(result.Rows) == 0 {
+		return "no rows"
+	}
+
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	titleWidth := clampInt(targetWidth-findNonTitleWidth, minListTitleWidth, maxListTitleWidth)
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(targetWidth)
+
+	header := make(table.Row, 0, len(result.Columns))
+	configs := make([]table.ColumnConfig, 0, 1)
+	for index, column := range result.Columns {
+		header = append(header, strings.ToUpper(strings.ReplaceAll(column, ".", "_")))
+		if column == "item.title" {
+			configs = append(configs, table.ColumnConfig{Number: index + 1, WidthMax: titleWidth, WidthMaxEnforcer: formatting.truncate})
+		}
+	}
+	tw.AppendHeader(header)
+	tw.SetColumnConfigs(configs)
+
+	now := time.Now()
+	for _, values := range result.Rows {
+		row := make(table.Row, 0, len(result.Columns))
+		for index, column := range result.Columns {
+			var value any
+			if index < len(values) {
+				value = values[index]
+			}
+			row = append(row, findCell(column, value, now))
+		}
+		tw.AppendRow(row)
+	}
+
+	lines := []string{strings.TrimRight(tw.Render(), "\n")}
+	for _, message := range result.Errors {
+		lines = append(lines, "warning: "+message)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func findCell(column string, value any, now time.Time) string {
+	switch typed := value.(type) {
+	case nil:
+		return "-"
+	case json.Number:
+		if column == "timestamp" {
+			if seconds, err := strconv.ParseUint(typed.String(), 10, 64); err == nil {
+				return formatting.timestamp(&seconds, now)
+			}
+		}
+		return typed.String()
+	case string:
+		return fallback(typed)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
@@ -0,0 +1,44 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderFindResultWithWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderFindResultWithWidth(app.RQLResult{}, 120); got != "no matching occurrences" {
+		t.Fatalf("unexpected empty output: %q", got)
+	}
+
+	result := app.RQLResult{
+		Columns: []string{"item.counter", "item.title", "timestamp", "person.id"},
+		Rows:    [][]any{{json.Number("42"), "checkout failed", json.Number("1700000000"), nil}},
+		Errors:  []string{"partial result"},
+	}
+	got := RenderFindResultWithWidth(result, 120)
+	for _, want := range []string{"ITEM_COUNTER", "PERSON_ID", "42", "checkout failed", "2023-11-14T22:13:20Z", "-", "warning: partial result"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+}
+
+func TestRenderRQLResultWithWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderRQLResultWithWidth(app.RQLResult{Columns: []string{"count(*)"}}, 120); got != "no rows" {
+		t.Fatalf("unexpected empty output: %q", got)
+	}
+
+	got := RenderRQLResultWithWidth(app.RQLResult{Columns: []string{"environment", "count(*)"}, Rows: [][]any{{"production", json.Number("3")}, {"staging"}}}, 120)
+	for _, want := range []string{"ENVIRONMENT", "COUNT(*)", "production", "3", "staging", "-"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+}
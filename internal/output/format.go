@@ -0,0 +1,184 @@
+package output
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+type NumberStyle string
+
+const (
+	NumbersPlain   NumberStyle = "plain"
+	NumbersGrouped NumberStyle = "grouped"
+	NumbersCompact NumberStyle = "compact"
+)
+
+type TimestampStyle string
+
+const (
+	TimestampsAbsolute TimestampStyle = "absolute"
+	TimestampsRelative TimestampStyle = "relative"
+)
+
+type Formatting struct {
+	Locale     language.Tag
+	Numbers    NumberStyle
+	Timestamps TimestampStyle
+	Truncation TruncationStyle
+	Color      bool
+	now        time.Time
+}
+
+func (f Formatting) at(now time.Time) Formatting {
+	f.now = now
+	return f
+}
+
+var formatting Formatting
+
+func SetFormatting(value Formatting) {
+	formatting = value
+}
+
+func ParseNumberStyle(value string) (NumberStyle, error) {
+	switch style := NumberStyle(strings.ToLower(strings.TrimSpace(value))); style {
+	case "":
+		return NumbersPlain, nil
+	case NumbersPlain, NumbersGrouped, NumbersCompact:
+		return style, nil
+	default:
+		return "", fmt.Errorf("unknown number format %q: use plain, grouped, or compact", value)
+	}
+}
+
+func ParseTimestampStyle(value string) (TimestampStyle, error) {
+	switch style := TimestampStyle(strings.ToLower(strings.TrimSpace(value))); style {
+	case "":
+		return TimestampsAbsolute, nil
+	case TimestampsAbsolute, TimestampsRelative:
+		return style, nil
+	default:
+		return "", fmt.Errorf("unknown timestamp format %q: use absolute or relative", value)
+	}
+}
+
+func ParseLocale(value string) (language.Tag, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return environmentLocale(), nil
+	}
+	tag, err := language.Parse(posixToBCP47(value))
+	if err != nil {
+		return language.Und, fmt.Errorf("unknown locale %q", value)
+	}
+
+	return tag, nil
+}
+
+func environmentLocale() language.Tag {
+	for _, name := range []string{"LC_ALL", "LC_NUMERIC", "LANG"} {
+		value := posixToBCP47(os.Getenv(name))
+		if value == "" || value == "C" || value == "POSIX" {
+			continue
+		}
+		if tag, err := language.Parse(value); err == nil {
+			return tag
+		}
+	}
+
+	return language.English
+}
+
+func posixToBCP47(value string) string {
+	if index := strings.IndexAny(value, ".@"); index >= 0 {
+		value = value[:index]
+	}
+
+	return strings.ReplaceAll(value, "_", "-")
+}
+
+func (f Formatting) occurrences(value *uint64) string {
+	if value == nil {
+		return "unknown"
+	}
+
+	return f.count(*value)
+}
+
+func (f Formatting) count(value uint64) string {
+	switch f.Numbers {
+	case NumbersGrouped:
+		return f.printer().Sprint(number.Decimal(value))
+	case NumbersCompact:
+		return f.compact(value)
+	default:
+		return strconv.FormatUint(value, 10)
+	}
+}
+
+var compactSuffixes = []string{"", "k", "M", "B", "T"}
+
+func (f Formatting) compact(value uint64) string {
+	if value < 1000 {
+		return strconv.FormatUint(value, 10)
+	}
+
+	scaled := float64(value)
+	unit := 0
+	for unit < len(compactSuffixes)-1 && math.Round(scaled*10)/10 >= 1000 {
+		scaled /= 1000
+		unit++
+	}
+
+	return f.printer().Sprint(number.Decimal(scaled, number.MaxFractionDigits(1))) + compactSuffixes[unit]
+}
+
+func (f Formatting) printer() *message.Printer {
+	if f.Locale == language.Und {
+		return message.NewPrinter(language.English)
+	}
+
+	return message.NewPrinter(f.Locale)
+}
+
+func (f Formatting) timestamp(unixSeconds *uint64, reference time.Time) string {
+	if unixSeconds == nil || *unixSeconds > math.MaxInt64 {
+		return "unknown"
+	}
+	moment := time.Unix(int64(*unixSeconds), 0)
+	if f.Timestamps != TimestampsRelative {
+		return moment.UTC().Format(time.RFC3339)
+	}
+
+	return relativeTime(moment, reference)
+}
+
+func relativeTime(moment time.Time, reference time.Time) string {
+	elapsed := reference.Sub(moment)
+	if elapsed < 0 {
+		return "in " + formatDuration(-elapsed)
+	}
+
+	return formatDuration(elapsed) + " ago"
+}
+
+func formatDuration(value time.Duration) string {
+	switch {
+	case value < time.Minute:
+		return fmt.Sprintf("%ds", int(value/time.Second))
+	case value < time.Hour:
+		return fmt.Sprintf("%dm", int(value/time.Minute))
+	case value < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(value/time.Hour))
+	default:
+		return fmt.Sprintf("%dd", int(value/(24*time.Hour)))
+	}
+}
@@ -0,0 +1,115 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestFormattingCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		formatting Formatting
+		value      uint64
+		want       string
+	}{
+		{name: "plain", formatting: Formatting{}, value: 1234567, want: "1234567"},
+		{name: "grouped english", formatting: Formatting{Numbers: NumbersGrouped}, value: 1234567, want: "1,234,567"},
+		{name: "grouped german", formatting: Formatting{Locale: language.German, Numbers: NumbersGrouped}, value: 1234567, want: "1.234.567"},
+		{name: "compact small", formatting: Formatting{Numbers: NumbersCompact}, value: 999, want: "999"},
+		{name: "compact thousands", formatting: Formatting{Numbers: NumbersCompact}, value: 1234, want: "1.2k"},
+		{name: "compact whole", formatting: Formatting{Numbers: NumbersCompact}, value: 2000000, want: "2M"},
+		{name: "compact rounds up a unit", formatting: Formatting{Numbers: NumbersCompact}, value: 999960, want: "1M"},
+		{name: "compact german", formatting: Formatting{Locale: language.German, Numbers: NumbersCompact}, value: 12345, want: "12,3k"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := test.formatting.count(test.value); got != test.want {
+				t.Fatalf("count(%d) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+
+	if got := (Formatting{Numbers: NumbersGrouped}).occurrences(nil); got != "unknown" {
+		t.Fatalf("occurrences(nil) = %q", got)
+	}
+}
+
+func TestFormattingRelativeTimestamp(t *testing.T) {
+	t.Parallel()
+
+	reference := time.Unix(1700000000, 0)
+	relative := Formatting{Timestamps: TimestampsRelative}
+	past := uint64(1700000000 - 3*3600)
+	future := uint64(1700000000 + 2*86400)
+
+	if got := relative.timestamp(&past, reference); got != "3h ago" {
+		t.Fatalf("timestamp(past) = %q", got)
+	}
+	if got := relative.timestamp(&future, reference); got != "in 2d" {
+		t.Fatalf("timestamp(future) = %q", got)
+	}
+	if got := relative.timestamp(nil, reference); got != "unknown" {
+		t.Fatalf("timestamp(nil) = %q", got)
+	}
+}
+
+func TestParseFormattingOptions(t *testing.T) {
+	if style, err := ParseNumberStyle(" Grouped "); err != nil || style != NumbersGrouped {
+		t.Fatalf("ParseNumberStyle() = %q, %v", style, err)
+	}
+	if style, err := ParseNumberStyle(""); err != nil || style != NumbersPlain {
+		t.Fatalf("ParseNumberStyle(empty) = %q, %v", style, err)
+	}
+	if _, err := ParseNumberStyle("roman"); err == nil {
+		t.Fatalf("expected error for unknown number style")
+	}
+	if style, err := ParseTimestampStyle("relative"); err != nil || style != TimestampsRelative {
+		t.Fatalf("ParseTimestampStyle() = %q, %v", style, err)
+	}
+	if _, err := ParseTimestampStyle("sometimes"); err == nil {
+		t.Fatalf("expected error for unknown timestamp style")
+	}
+
+	if tag, err := ParseLocale("de_DE.UTF-8"); err != nil || tag != language.MustParse("de-DE") {
+		t.Fatalf("ParseLocale(posix) = %v, %v", tag, err)
+	}
+	if _, err := ParseLocale("not a locale"); err == nil {
+		t.Fatalf("expected error for invalid locale")
+	}
+
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_NUMERIC", "C")
+	t.Setenv("LANG", "fr_FR.UTF-8")
+	if tag, err := ParseLocale(""); err != nil || tag != language.MustParse("fr-FR") {
+		t.Fatalf("ParseLocale(env) = %v, %v", tag, err)
+	}
+	t.Setenv("LANG", "")
+	if tag, _ := ParseLocale(""); tag != language.English {
+		t.Fatalf("ParseLocale(no env) = %v", tag)
+	}
+}
+
+func TestSetFormattingAppliesToHumanButNotPlainLists(t *testing.T) {
+	SetFormatting(Formatting{Numbers: NumbersGrouped})
+	t.Cleanup(func() { SetFormatting(Formatting{}) })
+
+	occurrences := uint64(48213)
+	issues := []app.IssueSummary{{Counter: domain.ItemCounter(7), Title: "busy", Occurrences: &occurrences}}
+
+	if got := RenderIssueListHumanWithWidth(issues, 120, testNow); !strings.Contains(got, "48,213") {
+		t.Fatalf("expected grouped count in table, got %q", got)
+	}
+	if got := RenderIssueListPlain(issues, []string{"occurrences"}, testNow); !strings.Contains(got, "48213") || strings.Contains(got, "48,213") {
+		t.Fatalf("expected exact count in TSV, got %q", got)
+	}
+}
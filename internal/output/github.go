@@ -0,0 +1,67 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const maxGitHubTitleLength = 256
+
+func GitHubIssueTitle(detail app.IssueDetail) string {
+	title := fmt.Sprintf("[Rollbar #%s] %s", detail.Counter.String(), strings.Join(strings.Fields(fallback(detail.Title)), " "))
+	if utf8.RuneCountInString(title) <= maxGitHubTitleLength {
+		return title
+	}
+
+	return string([]rune(title)[:maxGitHubTitleLength-1]) + "…"
+}
+
+func RenderGitHubIssueBody(detail app.IssueDetail, link string, now time.Time) string {
+	lines := []string{}
+	if link != "" {
+		lines = append(lines, fmt.Sprintf("Rollbar item: [#%s](%s)", detail.Counter.String(), link), "")
+	}
+	lines = append(lines,
+		"| Field | Value |",
+		"| --- | --- |",
+		fmt.Sprintf("| Counter | #%s |", detail.Counter.String()),
+		fmt.Sprintf("| Environment | %s |", escapeMarkdown(fallback(detail.Environment.String()))),
+		fmt.Sprintf("| Level | %s |", escapeMarkdown(fallback(detail.Level.String()))),
+		fmt.Sprintf("| Status | %s |", escapeMarkdown(fallback(detail.Status.String()))),
+		fmt.Sprintf("| Occurrences | %s |", Formatting{}.occurrences(detail.Occurrences)),
+		fmt.Sprintf("| First seen | %s |", Formatting{}.timestamp(detail.FirstOccurrenceTimestamp, now)),
+		fmt.Sprintf("| Last seen | %s |", Formatting{}.timestamp(detail.LastOccurrenceTimestamp, now)),
+		"",
+		"## Main error",
+		"",
+		"```",
+		fallback(detail.MainError),
+		"```",
+	)
+
+	if len(detail.Traces) > 0 {
+		lines = append(lines, "", "## Stack trace (most recent call last)", "", "```")
+		for index, trace := range detail.Traces {
+			heading := traceHeading(trace)
+			if index > 0 {
+				heading = "Caused by: " + heading
+			}
+			lines = append(lines, heading)
+			if trace.Omitted > 0 {
+				lines = append(lines, fmt.Sprintf("  ... %d earlier frames", trace.Omitted))
+			}
+			for _, frame := range trace.Frames {
+				lines = append(lines, "  "+formatFrame(frame))
+			}
+		}
+		lines = append(lines, "```")
+	}
+
+	lines = append(lines, "", "_Filed with rollbaz._")
+
+	return strings.Join(lines, "\n") + "\n"
+}
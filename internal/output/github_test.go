@@ -0,0 +1,59 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+func TestGitHubIssueTitle(t *testing.T) {
+	t.Parallel()
+
+	detail := app.IssueDetail{IssueSummary: app.IssueSummary{Counter: 274, Title: "TypeError:\n  undefined is not a function"}}
+	if got := GitHubIssueTitle(detail); got != "[Rollbar #274] TypeError: undefined is not a function" {
+		t.Fatalf("GitHubIssueTitle() = %q", got)
+	}
+
+	detail.Title = strings.Repeat("x", 400)
+	got := GitHubIssueTitle(detail)
+	if utf8.RuneCountInString(got) != maxGitHubTitleLength || !strings.HasSuffix(got, "…") {
+		t.Fatalf("long GitHubIssueTitle() = %q", got)
+	}
+}
+
+func TestRenderGitHubIssueBody(t *testing.T) {
+	t.Parallel()
+
+	occurrences, lastSeen := uint64(1234), uint64(1700000000)
+	detail := app.IssueDetail{
+		IssueSummary: app.IssueSummary{Counter: 274, Environment: "production", Level: "error", Status: "active", Occurrences: &occurrences, LastOccurrenceTimestamp: &lastSeen},
+		MainError:    "undefined is not a function",
+		Traces: []summary.Trace{
+			{Exception: "TypeError", Message: "undefined is not a function", Frames: []summary.Frame{{Filename: "app/checkout.js", Line: 42, Method: "submit"}}},
+			{Exception: "NetworkError", Omitted: 3},
+		},
+	}
+	got := RenderGitHubIssueBody(detail, "https://app.rollbar.com/a/acme/fix/item/api/274", time.Unix(1700003600, 0))
+	for _, want := range []string{
+		"Rollbar item: [#274](https://app.rollbar.com/a/acme/fix/item/api/274)",
+		"| Environment | production |",
+		"| Occurrences | 1234 |",
+		"| Last seen | 2023-11-14T22:13:20Z |",
+		"## Main error",
+		"TypeError: undefined is not a function\n  app/checkout.js:42 in submit",
+		"Caused by: NetworkError\n  ... 3 earlier frames",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in body, got %q", want, got)
+		}
+	}
+
+	plain := RenderGitHubIssueBody(app.IssueDetail{IssueSummary: app.IssueSummary{Counter: 1}}, "", time.Unix(0, 0))
+	if strings.Contains(plain, "Rollbar item:") || strings.Contains(plain, "Stack trace") {
+		t.Fatalf("body without link or traces = %q", plain)
+	}
+}
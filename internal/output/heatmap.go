@@ -0,0 +1,51 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+var heatmapShades = []string{"  ", "░░", "▒▒", "▓▓", "██"}
+
+var heatmapDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday}
+
+func RenderOccurrenceHeatmap(heatmap app.OccurrenceHeatmap) string {
+	lines := []string{
+		fmt.Sprintf("Occurrences by hour (last %d weeks, %s)", heatmap.Weeks, heatmap.TimeZone),
+	}
+	if heatmap.Peak == 0 {
+		return strings.Join(append(lines, "No occurrences in this window."), "\n")
+	}
+
+	header := strings.Builder{}
+	header.WriteString("    ")
+	for hour := 0; hour < 24; hour += 3 {
+		fmt.Fprintf(&header, "%02d    ", hour)
+	}
+	lines = append(lines, strings.TrimRight(header.String(), " "))
+
+	for _, day := range heatmapDays {
+		row := strings.Builder{}
+		row.WriteString(day.String()[:3] + " ")
+		for _, count := range heatmap.Cells[day] {
+			row.WriteString(heatmapShade(count, heatmap.Peak))
+		}
+		lines = append(lines, strings.TrimRight(row.String(), " "))
+	}
+
+	lines = append(lines, fmt.Sprintf("░ ▒ ▓ █ up to 25/50/75/100%% of the busiest hour (%s)", formatting.count(heatmap.Peak)))
+
+	return strings.Join(lines, "\n")
+}
+
+func heatmapShade(count uint64, peak uint64) string {
+	if count == 0 || peak == 0 {
+		return heatmapShades[0]
+	}
+	level := (count*4 + peak - 1) / peak
+
+	return heatmapShades[min(level, 4)]
+}
@@ -0,0 +1,44 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderOccurrenceHeatmap(t *testing.T) {
+	t.Parallel()
+
+	heatmap := app.OccurrenceHeatmap{Weeks: 4, TimeZone: "UTC", Peak: 8}
+	heatmap.Cells[time.Monday][0] = 8
+	heatmap.Cells[time.Monday][1] = 1
+	heatmap.Cells[time.Sunday][23] = 4
+
+	lines := strings.Split(RenderOccurrenceHeatmap(heatmap), "\n")
+	if len(lines) != 10 {
+		t.Fatalf("expected title, header, 7 days, and legend, got %q", lines)
+	}
+	if lines[0] != "Occurrences by hour (last 4 weeks, UTC)" || !strings.HasPrefix(lines[1], "    00    03") {
+		t.Fatalf("unexpected heading: %q", lines[:2])
+	}
+	if lines[2] != "Mon ██░░" {
+		t.Fatalf("unexpected Monday row: %q", lines[2])
+	}
+	if lines[8] != "Sun "+strings.Repeat("  ", 23)+"▒▒" {
+		t.Fatalf("unexpected Sunday row: %q", lines[8])
+	}
+	if !strings.Contains(lines[9], "busiest hour (8)") {
+		t.Fatalf("unexpected legend: %q", lines[9])
+	}
+}
+
+func TestRenderOccurrenceHeatmapEmpty(t *testing.T) {
+	t.Parallel()
+
+	got := RenderOccurrenceHeatmap(app.OccurrenceHeatmap{Weeks: 4, TimeZone: "UTC"})
+	if !strings.HasSuffix(got, "No occurrences in this window.") {
+		t.Fatalf("unexpected output: %q", got)
+	}
+}
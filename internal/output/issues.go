@@ -3,51 +3,56 @@ package output
 import (
 	"encoding/json"
 	"fmt"
-	"math"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
-	prettytext "github.com/jedib0t/go-pretty/v6/text"
 
 	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
 )
 
 const (
 	defaultListRowWidth   = 120
 	minListTitleWidth     = 24
 	maxListTitleWidth     = 120
-	listNonTitleWidth     = 74
 	defaultDetailRowWidth = 120
 	minDetailValueWidth   = 40
 	maxDetailValueWidth   = 100
 	detailNonValueWidth   = 20
 )
 
-func RenderIssueListHuman(issues []app.IssueSummary) string {
-	return RenderIssueListHumanWithWidth(issues, defaultListRowWidth)
+func RenderIssueListHuman(issues []app.IssueSummary, now time.Time) string {
+	return RenderIssueListHumanWithWidth(issues, defaultListRowWidth, now)
 }
 
-func RenderIssueListHumanWithWidth(issues []app.IssueSummary, maxWidth int) string {
+func RenderIssueListHumanWithWidth(issues []app.IssueSummary, maxWidth int, now time.Time) string {
+	return RenderIssueListHumanWithColumns(issues, maxWidth, DefaultListColumns, now)
+}
+
+func RenderIssueListHumanWithColumns(issues []app.IssueSummary, maxWidth int, columns []string, now time.Time) string {
 	if len(issues) == 0 {
 		return "no issues found"
 	}
 
+	selected := selectListColumns(columns)
+	header := make(table.Row, 0, len(selected))
+	for _, column := range selected {
+		header = append(header, column.header)
+	}
+
 	tw := table.NewWriter()
 	tw.SetStyle(table.StyleLight)
-	configureListTable(tw, maxWidth)
-	tw.AppendHeader(table.Row{"COUNTER", "STATUS", "ENV", "OCCURRENCES", "LAST_SEEN", "TITLE"})
+	configureListTable(tw, maxWidth, selected)
+	tw.AppendHeader(header)
 
+	format := formatting.at(now)
 	for _, issue := range issues {
-		tw.AppendRow(table.Row{
-			issue.Counter.String(),
-			fallback(issue.Status),
-			fallback(issue.Environment),
-			formatOccurrences(issue.Occurrences),
-			formatTimestamp(issue.LastOccurrenceTimestamp),
-			fallback(issue.Title),
-		})
+		row := make(table.Row, 0, len(selected))
+		for _, column := range selected {
+			row = append(row, column.value(issue, format))
+		}
+		tw.AppendRow(row)
 	}
 
 	return strings.TrimRight(tw.Render(), "\n")
@@ -65,24 +70,41 @@ func RenderIssueDetailHumanWithWidth(detail app.IssueDetail, maxWidth int) strin
 	tw.SetStyle(table.StyleLight)
 	tw.SetAllowedRowLength(rowWidth)
 	tw.SetColumnConfigs([]table.ColumnConfig{
-		{Number: 2, WidthMax: valueWidth, WidthMaxEnforcer: prettytext.Trim},
+		{Number: 2, WidthMax: valueWidth, WidthMaxEnforcer: formatting.truncate},
 	})
 	tw.AppendRow(table.Row{"Title", fallback(detail.Title)})
-	tw.AppendRow(table.Row{"Status", fallback(detail.Status)})
-	tw.AppendRow(table.Row{"Environment", fallback(detail.Environment)})
-	tw.AppendRow(table.Row{"Occurrences", formatOccurrences(detail.Occurrences)})
+	tw.AppendRow(table.Row{"Status", fallback(detail.Status.String())})
+	tw.AppendRow(table.Row{"Environment", fallback(detail.Environment.String())})
+	if detail.Assignee != nil {
+		tw.AppendRow(table.Row{"Assignee", UserName(*detail.Assignee)})
+	}
+	tw.AppendRow(table.Row{"Occurrences", formatting.occurrences(detail.Occurrences)})
 	tw.AppendRow(table.Row{"Counter", detail.Counter.String()})
 	tw.AppendRow(table.Row{"Item ID", detail.ItemID.String()})
+	if detail.SnoozeExpiresAt != nil {
+		tw.AppendRow(table.Row{"Snooze Expires", formatting.timestamp(detail.SnoozeExpiresAt, time.Now())})
+	}
 
 	renderedTable := strings.TrimRight(tw.Render(), "\n")
+	if len(detail.Traces) > 0 {
+		renderedTable += "\n\n" + RenderTraces(detail.Traces, rowWidth)
+	}
 	if shouldIncludeMainErrorLine(detail) {
-		heading := "Main Error: " + prettytext.Trim(fallback(detail.MainError), valueWidth)
+		heading := "Main Error: " + formatting.truncate(fallback(detail.MainError), valueWidth)
 		return heading + "\n\n" + renderedTable
 	}
 
 	return renderedTable
 }
 
+func UserName(user rollbar.User) string {
+	if user.Username == "" {
+		return fmt.Sprintf("user %d", user.ID)
+	}
+
+	return fmt.Sprintf("%s (user %d)", user.Username, user.ID)
+}
+
 func RenderJSON(value any) (string, error) {
 	body, err := json.MarshalIndent(value, "", "  ")
 	if err != nil {
@@ -92,44 +114,58 @@ func RenderJSON(value any) (string, error) {
 	return string(body), nil
 }
 
-func formatTimestamp(unixSeconds *uint64) string {
-	if unixSeconds == nil {
-		return "unknown"
+func configureListTable(tw table.Writer, maxWidth int, columns []listColumn) {
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	tw.SetAllowedRowLength(targetWidth)
+
+	nonTitleWidth := listTableBorderWidth
+	titleNumber := 0
+	for index, column := range columns {
+		if column.header == "TITLE" {
+			titleNumber = index + 1
+			continue
+		}
+		nonTitleWidth += column.width
 	}
-	if *unixSeconds > math.MaxInt64 {
-		return "unknown"
+	if titleNumber == 0 {
+		return
 	}
 
-	return time.Unix(int64(*unixSeconds), 0).UTC().Format(time.RFC3339)
+	titleWidth := clampInt(targetWidth-nonTitleWidth, minListTitleWidth, maxListTitleWidth)
+	tw.SetColumnConfigs([]table.ColumnConfig{
+		{Number: titleNumber, WidthMax: titleWidth, WidthMaxEnforcer: formatting.truncate},
+	})
 }
 
-func configureListTable(tw table.Writer, maxWidth int) {
-	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
-	titleWidth := targetWidth - listNonTitleWidth
-	if titleWidth < minListTitleWidth {
-		titleWidth = minListTitleWidth
+func selectListColumns(names []string) []listColumn {
+	selected := make([]listColumn, 0, len(names))
+	for _, name := range names {
+		if column, ok := listColumns[name]; ok {
+			selected = append(selected, column)
+		}
 	}
-	if titleWidth > maxListTitleWidth {
-		titleWidth = maxListTitleWidth
+	if len(selected) == 0 {
+		return selectListColumns(DefaultListColumns)
 	}
 
-	tw.SetAllowedRowLength(targetWidth)
-	tw.SetColumnConfigs([]table.ColumnConfig{
-		{Number: 6, WidthMax: titleWidth, WidthMaxEnforcer: prettytext.Trim},
-	})
+	return selected
 }
 
 func detailValueWidth(maxWidth int) int {
 	targetWidth := normalizeWidth(maxWidth, defaultDetailRowWidth)
-	valueWidth := targetWidth - detailNonValueWidth
-	if valueWidth < minDetailValueWidth {
-		valueWidth = minDetailValueWidth
+
+	return clampInt(targetWidth-detailNonValueWidth, minDetailValueWidth, maxDetailValueWidth)
+}
+
+func clampInt(value int, minimum int, maximum int) int {
+	if value < minimum {
+		return minimum
 	}
-	if valueWidth > maxDetailValueWidth {
-		valueWidth = maxDetailValueWidth
+	if value > maximum {
+		return maximum
 	}
 
-	return valueWidth
+	return value
 }
 
 func normalizeWidth(value int, fallback int) int {
@@ -140,14 +176,6 @@ func normalizeWidth(value int, fallback int) int {
 	return value
 }
 
-func formatOccurrences(occurrences *uint64) string {
-	if occurrences == nil {
-		return "unknown"
-	}
-
-	return strconv.FormatUint(*occurrences, 10)
-}
-
 func shouldIncludeMainErrorLine(detail app.IssueDetail) bool {
 	mainError := strings.TrimSpace(detail.MainError)
 	if mainError == "" || strings.EqualFold(mainError, "unknown") {
@@ -1,12 +1,16 @@
 package output
 
 import (
+	"fmt"
 	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kevinsheth/rollbaz/internal/app"
 	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output/snapshottest"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
 )
 
 func TestRenderIssueListHuman(t *testing.T) {
@@ -23,7 +27,7 @@ func TestRenderIssueListHuman(t *testing.T) {
 		LastOccurrenceTimestamp: &lastSeen,
 	}}
 
-	got := RenderIssueListHuman(issues)
+	got := RenderIssueListHuman(issues, testNow)
 	if !strings.Contains(got, "269") {
 		t.Fatalf("unexpected output: %q", got)
 	}
@@ -47,7 +51,7 @@ func TestRenderIssueListHumanTruncatesLongTitle(t *testing.T) {
 		Title:   longTitle,
 	}}
 
-	got := RenderIssueListHuman(issues)
+	got := RenderIssueListHuman(issues, testNow)
 	if strings.Contains(got, longTitle) {
 		t.Fatalf("expected truncated title, got: %q", got)
 	}
@@ -64,8 +68,8 @@ func TestRenderIssueListHumanWithWidth(t *testing.T) {
 		Title:   strings.Repeat("x", 200),
 	}}
 
-	narrow := RenderIssueListHumanWithWidth(issues, 90)
-	wide := RenderIssueListHumanWithWidth(issues, 140)
+	narrow := RenderIssueListHumanWithWidth(issues, 90, testNow)
+	wide := RenderIssueListHumanWithWidth(issues, 140, testNow)
 
 	narrowLongest := longestLineWidth(narrow)
 	wideLongest := longestLineWidth(wide)
@@ -74,6 +78,45 @@ func TestRenderIssueListHumanWithWidth(t *testing.T) {
 	}
 }
 
+func snapshotIssues() []app.IssueSummary {
+	occurrences := uint64(1520)
+	lastSeen := uint64(1700000000)
+	return []app.IssueSummary{
+		{
+			Counter:                 domain.ItemCounter(269),
+			Title:                   "RST_STREAM closed stream with code 2 while reading response body from upstream",
+			Status:                  domain.StatusActive,
+			Environment:             "production",
+			Level:                   domain.LevelError,
+			Occurrences:             &occurrences,
+			LastOccurrenceTimestamp: &lastSeen,
+		},
+		{Counter: domain.ItemCounter(7), Title: "nil map write", Status: domain.StatusResolved, Environment: "staging"},
+	}
+}
+
+func TestRenderIssueListHumanSnapshot(t *testing.T) {
+	t.Parallel()
+
+	issues := snapshotIssues()
+	snapshottest.AssertWidths(t, "issue_list", snapshottest.DefaultWidths, func(width int) string {
+		return RenderIssueListHumanWithWidth(issues, width, testNow)
+	})
+}
+
+func TestRenderIssueDetailHumanSnapshot(t *testing.T) {
+	t.Parallel()
+
+	detail := app.IssueDetail{
+		IssueSummary: snapshotIssues()[0],
+		MainError:    "rpc error: code = Internal desc = stream terminated by RST_STREAM with error code: INTERNAL_ERROR",
+	}
+	detail.ItemID = domain.ItemID(1755568172)
+	snapshottest.AssertWidths(t, "issue_detail", snapshottest.DefaultWidths, func(width int) string {
+		return RenderIssueDetailHumanWithWidth(detail, width)
+	})
+}
+
 func TestRenderJSON(t *testing.T) {
 	t.Parallel()
 
@@ -164,18 +207,18 @@ func TestRenderIssueDetailHumanWithWidth(t *testing.T) {
 func TestFormatTimestamp(t *testing.T) {
 	t.Parallel()
 
-	if got := formatTimestamp(nil); got != "unknown" {
-		t.Fatalf("formatTimestamp(nil) = %q", got)
+	if got := (Formatting{}).timestamp(nil, time.Time{}); got != "unknown" {
+		t.Fatalf("timestamp(nil) = %q", got)
 	}
 
 	value := uint64(1700000000)
-	if got := formatTimestamp(&value); got != "2023-11-14T22:13:20Z" {
-		t.Fatalf("formatTimestamp() = %q", got)
+	if got := (Formatting{}).timestamp(&value, time.Time{}); got != "2023-11-14T22:13:20Z" {
+		t.Fatalf("timestamp() = %q", got)
 	}
 
 	overflow := uint64(math.MaxUint64)
-	if got := formatTimestamp(&overflow); got != "unknown" {
-		t.Fatalf("formatTimestamp(overflow) = %q", got)
+	if got := (Formatting{}).timestamp(&overflow, time.Time{}); got != "unknown" {
+		t.Fatalf("timestamp(overflow) = %q", got)
 	}
 }
 
@@ -189,3 +232,77 @@ func longestLineWidth(value string) int {
 
 	return max
 }
+
+func TestRenderIssueDetailHumanShowsSnoozeExpiry(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := uint64(1700000000)
+	detail := app.IssueDetail{IssueSummary: app.IssueSummary{Counter: domain.ItemCounter(1), SnoozeEnabled: true, SnoozeExpiresAt: &expiresAt}}
+	got := RenderIssueDetailHuman(detail)
+	if !strings.Contains(got, "Snooze Expires") || !strings.Contains(got, "2023-11-14T22:13:20Z") {
+		t.Fatalf("expected snooze expiry row, got: %q", got)
+	}
+}
+
+func TestRenderIssueDetailHumanShowsAssignee(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		assignee *rollbar.User
+		want     string
+	}{
+		{assignee: &rollbar.User{ID: 7, Username: "alice"}, want: "alice (user 7)"},
+		{assignee: &rollbar.User{ID: 7}, want: "user 7"},
+	}
+
+	for _, tt := range tests {
+		got := RenderIssueDetailHuman(app.IssueDetail{IssueSummary: app.IssueSummary{Counter: 1}, Assignee: tt.assignee})
+		if !strings.Contains(got, "Assignee") || !strings.Contains(got, tt.want) {
+			t.Fatalf("expected assignee %q, got: %q", tt.want, got)
+		}
+	}
+	if got := RenderIssueDetailHuman(app.IssueDetail{IssueSummary: app.IssueSummary{Counter: 1}}); strings.Contains(got, "Assignee") {
+		t.Fatalf("expected no assignee row for an unassigned issue, got: %q", got)
+	}
+}
+
+const renderAllocsPerIssueBudget = 80
+
+func syntheticIssues(count int) []app.IssueSummary {
+	issues := make([]app.IssueSummary, 0, count)
+	for index := range count {
+		last := uint64(1_700_000_000 + index)
+		occurrences := uint64(index * 7)
+		issues = append(issues, app.IssueSummary{
+			ItemID:                  domain.ItemID(index + 1),
+			Counter:                 domain.ItemCounter(index + 1),
+			Title:                   fmt.Sprintf("TypeError: cannot read property 'id' of undefined in handler %d while processing request", index),
+			Status:                  "active",
+			Environment:             "production",
+			Level:                   "error",
+			LastOccurrenceTimestamp: &last,
+			Occurrences:             &occurrences,
+		})
+	}
+
+	return issues
+}
+
+func TestRenderIssueListAllocationBudget(t *testing.T) {
+	issues := syntheticIssues(200)
+	allocs := testing.AllocsPerRun(5, func() {
+		_ = RenderIssueListHumanWithWidth(issues, 120, testNow)
+	})
+	if perIssue := allocs / float64(len(issues)); perIssue > renderAllocsPerIssueBudget {
+		t.Fatalf("rendering allocates %.0f times per issue, budget is %d", perIssue, renderAllocsPerIssueBudget)
+	}
+}
+
+func BenchmarkRenderIssueListHumanWithWidth(b *testing.B) {
+	issues := syntheticIssues(500)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = RenderIssueListHumanWithWidth(issues, 120, testNow)
+	}
+}
@@ -0,0 +1,69 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const diffValueWidth = 60
+
+var diffMarkers = map[string]string{"changed": "~", "added": "+", "removed": "-"}
+
+func RenderOccurrenceDiff(diff app.OccurrenceDiff) string {
+	lines := []string{fmt.Sprintf("Occurrence %s vs %s", diff.Left.String(), diff.Right.String())}
+	if diff.SameError() {
+		lines = append(lines, "Main error: same in both: "+diff.LeftError)
+	} else {
+		lines = append(lines, "Main error: differs", "  "+diff.Left.String()+": "+diff.LeftError, "  "+diff.Right.String()+": "+diff.RightError)
+	}
+
+	notable, other := make([]string, 0), make([]string, 0)
+	for _, change := range diff.Changes {
+		line := "  " + renderDiffChange(change)
+		if change.Notable {
+			notable = append(notable, line)
+		} else {
+			other = append(other, line)
+		}
+	}
+	if len(notable) == 0 && len(other) == 0 {
+		lines = append(lines, "", "No differences in the payloads.")
+	}
+	if len(notable) > 0 {
+		lines = append(lines, "", "Request, version, and host changes:")
+		lines = append(lines, notable...)
+	}
+	if len(other) > 0 {
+		lines = append(lines, "", "Other changes:")
+		lines = append(lines, other...)
+	}
+	if len(diff.Ignored) > 0 {
+		lines = append(lines, "", fmt.Sprintf("Ignored %s; pass --all to compare them too.", strings.Join(diff.Ignored, ", ")))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func renderDiffChange(change app.OccurrenceChange) string {
+	marker := diffMarkers[change.Kind]
+	switch change.Kind {
+	case "added":
+		return fmt.Sprintf("%s %s: %s", marker, change.Path, renderDiffValue(change.Right))
+	case "removed":
+		return fmt.Sprintf("%s %s: %s", marker, change.Path, renderDiffValue(change.Left))
+	default:
+		return fmt.Sprintf("%s %s: %s -> %s", marker, change.Path, renderDiffValue(change.Left), renderDiffValue(change.Right))
+	}
+}
+
+func renderDiffValue(value any) string {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return formatting.truncateLine(string(encoded), diffValueWidth)
+}
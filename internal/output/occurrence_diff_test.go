@@ -0,0 +1,54 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderOccurrenceDiff(t *testing.T) {
+	t.Parallel()
+
+	diff := app.OccurrenceDiff{
+		Left:       1,
+		Right:      2,
+		LeftError:  "read timeout",
+		RightError: "connection refused",
+		Changes: []app.OccurrenceChange{
+			{Path: "code_version", Kind: "changed", Left: "v1", Right: "v2", Notable: true},
+			{Path: "request.params.page", Kind: "added", Right: json.Number("2"), Notable: true},
+			{Path: "trace.frames[1]", Kind: "removed", Left: map[string]any{"lineno": 20}},
+		},
+		Ignored: []string{"timestamp", "uuid"},
+	}
+
+	want := strings.Join([]string{
+		"Occurrence 1 vs 2",
+		"Main error: differs",
+		"  1: read timeout",
+		"  2: connection refused",
+		"",
+		"Request, version, and host changes:",
+		`  ~ code_version: "v1" -> "v2"`,
+		"  + request.params.page: 2",
+		"",
+		"Other changes:",
+		`  - trace.frames[1]: {"lineno":20}`,
+		"",
+		"Ignored timestamp, uuid; pass --all to compare them too.",
+	}, "\n")
+	if got := RenderOccurrenceDiff(diff); got != want {
+		t.Fatalf("unexpected diff:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderOccurrenceDiffIdentical(t *testing.T) {
+	t.Parallel()
+
+	got := RenderOccurrenceDiff(app.OccurrenceDiff{Left: 1, Right: 2, LeftError: "boom", RightError: "boom"})
+	if got != "Occurrence 1 vs 2\nMain error: same in both: boom\n\nNo differences in the payloads." {
+		t.Fatalf("unexpected diff: %q", got)
+	}
+}
@@ -0,0 +1,48 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const occurrencesNonErrorWidth = 80
+
+func RenderOccurrencePageWithWidth(page app.OccurrencePage, maxWidth int) string {
+	if len(page.Occurrences) == 0 {
+		return fmt.Sprintf("no occurrences on page %d", page.Page)
+	}
+
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	errorWidth := clampInt(targetWidth-occurrencesNonErrorWidth, minListTitleWidth, maxListTitleWidth)
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(targetWidth)
+	tw.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 5, WidthMax: errorWidth, WidthMaxEnforcer: formatting.truncate},
+	})
+	tw.AppendHeader(table.Row{"OCCURRENCE_ID", "TIMESTAMP", "ENV", "CODE_VERSION", "MAIN_ERROR"})
+
+	now := time.Now()
+	for _, occurrence := range page.Occurrences {
+		tw.AppendRow(table.Row{
+			occurrence.ID.String(),
+			formatting.timestamp(occurrence.Timestamp, now),
+			fallback(occurrence.Environment),
+			fallback(occurrence.CodeVersion),
+			fallback(occurrence.MainError),
+		})
+	}
+
+	lines := []string{strings.TrimRight(tw.Render(), "\n")}
+	if page.HasMore {
+		lines = append(lines, fmt.Sprintf("page %d; older occurrences with --page %d", page.Page, page.Page+1))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,32 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderOccurrencePageWithWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderOccurrencePageWithWidth(app.OccurrencePage{Page: 3}, 120); got != "no occurrences on page 3" {
+		t.Fatalf("unexpected empty output: %q", got)
+	}
+
+	timestamp := uint64(1700000000)
+	page := app.OccurrencePage{
+		Page:    1,
+		HasMore: true,
+		Occurrences: []app.OccurrenceSummary{
+			{ID: 991, Timestamp: &timestamp, Environment: "production", CodeVersion: "v1.4.0", MainError: "Timeout calling payments"},
+			{ID: 990, MainError: "unknown"},
+		},
+	}
+	got := RenderOccurrencePageWithWidth(page, 140)
+	for _, want := range []string{"OCCURRENCE_ID", "991", "2023-11-14T22:13:20Z", "production", "v1.4.0", "Timeout calling payments", "990", "older occurrences with --page 2"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+}
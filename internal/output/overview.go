@@ -0,0 +1,47 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func RenderProjectOverview(project string, overview app.ProjectOverview, maxWidth int) string {
+	active := formatting.count(uint64(overview.ActiveItems))
+	if overview.MoreActiveItems {
+		active += "+"
+	}
+	headline := fmt.Sprintf("%s active · %s occurrences/24h · %d reactivated/24h",
+		active, formatting.count(overview.Occurrences24h), len(overview.Reactivated24h))
+	if project != "" {
+		headline = project + " · " + headline
+	}
+
+	lines := []string{headline}
+	if newest := overview.NewestItem; newest != nil {
+		lines = append(lines, overviewLine(maxWidth, "Newest  ", *newest, "first seen "+formatting.timestamp(newest.FirstOccurrenceTimestamp, time.Now())))
+	}
+	if len(overview.TopItems) > 0 {
+		lines = append(lines, "Top active")
+		for _, issue := range overview.TopItems {
+			lines = append(lines, overviewLine(maxWidth, "  ", issue, formatting.occurrences(issue.Occurrences)+"x"))
+		}
+	}
+	if len(overview.Reactivated24h) > 0 {
+		lines = append(lines, "Reactivated")
+		for _, issue := range overview.Reactivated24h {
+			lines = append(lines, overviewLine(maxWidth, "  ", issue, fallback(issue.Environment.String())))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func overviewLine(maxWidth int, prefix string, issue app.IssueSummary, detail string) string {
+	line := fmt.Sprintf("%s#%-6s %-10s ", prefix, issue.Counter.String(), detail)
+	titleWidth := max(normalizeWidth(maxWidth, defaultListRowWidth)-len([]rune(line)), 10)
+
+	return line + formatting.truncateLine(fallback(issue.Title), titleWidth)
+}
@@ -0,0 +1,48 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestRenderProjectOverview(t *testing.T) {
+	t.Parallel()
+
+	occurrences := uint64(1200)
+	overview := app.ProjectOverview{
+		ActiveItems:     1000,
+		MoreActiveItems: true,
+		Occurrences24h:  4210,
+		TopItems:        []app.IssueSummary{{Counter: domain.ItemCounter(269), Title: "RST_STREAM " + strings.Repeat("x", 200), Occurrences: &occurrences}},
+		NewestItem:      &app.IssueSummary{Counter: domain.ItemCounter(412), Title: "TypeError"},
+		Reactivated24h:  []app.IssueSummary{{Counter: domain.ItemCounter(12), Title: "came back", Environment: "production"}},
+	}
+
+	got := RenderProjectOverview("figure", overview, 80)
+	lines := strings.Split(got, "\n")
+	if lines[0] != "figure · 1000+ active · 4210 occurrences/24h · 1 reactivated/24h" {
+		t.Fatalf("unexpected headline: %q", lines[0])
+	}
+	for _, want := range []string{"Newest  #412", "first seen unknown", "Top active", "  #269    1200x", "Reactivated", "  #12     production came back"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in overview:\n%s", want, got)
+		}
+	}
+	for _, line := range lines {
+		if len([]rune(line)) > 80 {
+			t.Fatalf("line wider than 80 columns: %q", line)
+		}
+	}
+}
+
+func TestRenderProjectOverviewWithoutProjectName(t *testing.T) {
+	t.Parallel()
+
+	got := RenderProjectOverview("", app.ProjectOverview{}, 0)
+	if got != "0 active · 0 occurrences/24h · 0 reactivated/24h" {
+		t.Fatalf("unexpected overview: %q", got)
+	}
+}
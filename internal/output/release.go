@@ -0,0 +1,31 @@
+package output
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func RenderReleaseHealthHumanWithWidth(health app.ReleaseHealth, maxWidth int, now time.Time) string {
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(normalizeWidth(maxWidth, defaultDetailRowWidth))
+	tw.AppendRow(table.Row{"Version", fallback(health.Version)})
+	tw.AppendRow(table.Row{"Environment", fallback(health.Deploy.Environment)})
+	tw.AppendRow(table.Row{"Deployed", formatting.timestamp(health.Deploy.StartTime, now)})
+	tw.AppendRow(table.Row{"New Items", strconv.Itoa(len(health.NewItems))})
+	tw.AppendRow(table.Row{"Reactivated", strconv.Itoa(len(health.ReactivatedItems))})
+	tw.AppendRow(table.Row{"Occurrences", formatting.count(health.OccurrencesSinceDeploy)})
+
+	sections := []string{
+		strings.TrimRight(tw.Render(), "\n"),
+		"New items\n" + RenderIssueListHumanWithWidth(health.NewItems, maxWidth, now),
+		"Reactivated items\n" + RenderIssueListHumanWithWidth(health.ReactivatedItems, maxWidth, now),
+	}
+
+	return strings.Join(sections, "\n\n")
+}
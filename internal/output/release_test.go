@@ -0,0 +1,29 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestRenderReleaseHealthHumanWithWidth(t *testing.T) {
+	t.Parallel()
+
+	deployedAt := uint64(1700000000)
+	health := app.ReleaseHealth{
+		Version:                "v1.2.3",
+		Deploy:                 rollbar.Deploy{Environment: "production", StartTime: &deployedAt},
+		NewItems:               []app.IssueSummary{{Counter: domain.ItemCounter(41), Title: "new failure"}},
+		OccurrencesSinceDeploy: 12,
+	}
+
+	got := RenderReleaseHealthHumanWithWidth(health, 120, testNow)
+	for _, want := range []string{"v1.2.3", "2023-11-14T22:13:20Z", "new failure", "Reactivated items\nno issues found", "12"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got: %q", want, got)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const routeNonTitleWidth = 60
+
+func RenderRoutePlanWithWidth(plan app.RoutePlan, maxWidth int) string {
+	heading := fmt.Sprintf("%d issues to assign (%d already routed, %d matched no rule)", len(plan.Changes), plan.Unchanged, plan.Unmatched)
+	if len(plan.Changes) == 0 {
+		return heading
+	}
+
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	titleWidth := clampInt(targetWidth-routeNonTitleWidth, minListTitleWidth, maxListTitleWidth)
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(targetWidth)
+	tw.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 5, WidthMax: titleWidth, WidthMaxEnforcer: formatting.truncate},
+	})
+	tw.AppendHeader(table.Row{"COUNTER", "FROM", "TO", "RULE", "TITLE"})
+	for _, change := range plan.Changes {
+		from := "unassigned"
+		if change.From != nil {
+			from = "user " + strconv.FormatUint(*change.From, 10)
+		}
+		to := change.Assignee
+		if to != strconv.FormatUint(change.To, 10) {
+			to += fmt.Sprintf(" (user %d)", change.To)
+		}
+		tw.AppendRow(table.Row{change.Issue.Counter.String(), from, to, strconv.Itoa(change.Rule), fallback(change.Issue.Title)})
+	}
+
+	return heading + "\n" + strings.TrimRight(tw.Render(), "\n")
+}
@@ -0,0 +1,29 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestRenderRoutePlanWithWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderRoutePlanWithWidth(app.RoutePlan{Unchanged: 2, Unmatched: 3}, 120); got != "0 issues to assign (2 already routed, 3 matched no rule)" {
+		t.Fatalf("unexpected empty plan output: %q", got)
+	}
+
+	previous := uint64(5)
+	plan := app.RoutePlan{Changes: []app.RouteChange{
+		{Issue: app.IssueSummary{Counter: domain.ItemCounter(1), Title: "DB timeout"}, Rule: 1, Assignee: "alice", To: 11},
+		{Issue: app.IssueSummary{Counter: domain.ItemCounter(2), Title: "nil pointer"}, Rule: 2, Assignee: "22", From: &previous, To: 22},
+	}}
+	got := RenderRoutePlanWithWidth(plan, 120)
+	for _, want := range []string{"2 issues to assign", "unassigned", "alice (user 11)", "user 5", "│ 22 ", "DB timeout"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in output, got:\n%s", want, got)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const shareTrendBarWidth = 30
+
+type shareField struct {
+	Label string
+	Value string
+}
+
+type shareTrendRow struct {
+	Day   string
+	Count string
+	Bar   string
+}
+
+type shareView struct {
+	Heading     string
+	GeneratedAt string
+	ExpiresAt   string
+	Fields      []shareField
+	MainError   string
+	Occurrence  []shareField
+	Payload     string
+	TrendDays   int
+	Trend       []shareTrendRow
+}
+
+func RenderShareMarkdown(bundle app.ShareBundle) string {
+	view := newShareView(bundle)
+
+	lines := []string{
+		"# " + escapeMarkdown(view.Heading),
+		"",
+		fmt.Sprintf("> Snapshot generated %s. Expires %s; re-run `rollbaz share` for fresh data.", view.GeneratedAt, view.ExpiresAt),
+		"",
+		"| Field | Value |",
+		"| --- | --- |",
+	}
+	for _, field := range view.Fields {
+		lines = append(lines, fmt.Sprintf("| %s | %s |", field.Label, escapeMarkdown(field.Value)))
+	}
+
+	lines = append(lines, "", "## Main error", "", "```", view.MainError, "```")
+
+	if len(view.Occurrence) > 0 {
+		lines = append(lines, "", "## Latest occurrence", "")
+		for _, field := range view.Occurrence {
+			lines = append(lines, fmt.Sprintf("- **%s:** %s", field.Label, escapeMarkdown(field.Value)))
+		}
+		if view.Payload != "" {
+			lines = append(lines, "", "<details><summary>Payload</summary>", "", "```json", view.Payload, "```", "", "</details>")
+		}
+	}
+
+	lines = append(lines, "", fmt.Sprintf("## Trend (last %d days)", view.TrendDays), "")
+	if len(view.Trend) == 0 {
+		lines = append(lines, "No occurrences in this window.")
+	} else {
+		lines = append(lines, "| Day | Occurrences | |", "| --- | ---: | --- |")
+		for _, row := range view.Trend {
+			lines = append(lines, fmt.Sprintf("| %s | %s | `%s` |", row.Day, row.Count, row.Bar))
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+var shareHTMLTemplate = template.Must(template.New("share").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Heading}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", sans-serif; max-width: 960px; margin: 2rem auto; padding: 0 1rem; color: #1f2328; }
+table { border-collapse: collapse; }
+td, th { border: 1px solid #d0d7de; padding: 4px 10px; text-align: left; vertical-align: top; }
+pre { background: #f6f8fa; padding: 12px; overflow-x: auto; }
+.notice { color: #57606a; }
+.bar { font-family: monospace; color: #cf222e; }
+</style>
+</head>
+<body>
+<h1>{{.Heading}}</h1>
+<p class="notice">Snapshot generated {{.GeneratedAt}}. Expires {{.ExpiresAt}}; re-run <code>rollbaz share</code> for fresh data.</p>
+<table>
+{{- range .Fields}}
+<tr><th>{{.Label}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+<h2>Main error</h2>
+<pre>{{.MainError}}</pre>
+{{- if .Occurrence}}
+<h2>Latest occurrence</h2>
+<ul>
+{{- range .Occurrence}}
+<li><strong>{{.Label}}:</strong> {{.Value}}</li>
+{{- end}}
+</ul>
+{{- if .Payload}}
+<details><summary>Payload</summary><pre>{{.Payload}}</pre></details>
+{{- end}}
+{{- end}}
+<h2>Trend (last {{.TrendDays}} days)</h2>
+{{- if .Trend}}
+<table>
+<tr><th>Day</th><th>Occurrences</th><th></th></tr>
+{{- range .Trend}}
+<tr><td>{{.Day}}</td><td>{{.Count}}</td><td class="bar">{{.Bar}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p>No occurrences in this window.</p>
+{{- end}}
+</body>
+</html>
+`))
+
+func RenderShareHTML(bundle app.ShareBundle) (string, error) {
+	var out bytes.Buffer
+	if err := shareHTMLTemplate.Execute(&out, newShareView(bundle)); err != nil {
+		return "", fmt.Errorf("render share html: %w", err)
+	}
+
+	return out.String(), nil
+}
+
+func newShareView(bundle app.ShareBundle) shareView {
+	issue := bundle.Issue
+	view := shareView{
+		Heading:     fmt.Sprintf("[#%s] %s", issue.Counter.String(), fallback(issue.Title)),
+		GeneratedAt: bundle.GeneratedAt.UTC().Format(time.RFC3339),
+		ExpiresAt:   bundle.ExpiresAt.UTC().Format(time.RFC3339),
+		Fields: []shareField{
+			{Label: "Status", Value: fallback(issue.Status.String())},
+			{Label: "Environment", Value: fallback(issue.Environment.String())},
+			{Label: "Level", Value: fallback(issue.Level.String())},
+			{Label: "Occurrences", Value: formatting.occurrences(issue.Occurrences)},
+			{Label: "First seen", Value: Formatting{}.timestamp(issue.FirstOccurrenceTimestamp, bundle.GeneratedAt)},
+			{Label: "Last seen", Value: Formatting{}.timestamp(issue.LastOccurrenceTimestamp, bundle.GeneratedAt)},
+			{Label: "Item ID", Value: issue.ItemID.String()},
+		},
+		MainError: fallback(issue.MainError),
+		TrendDays: bundle.TrendDays,
+		Trend:     shareTrendRows(bundle.Trend),
+	}
+
+	if instance := issue.Instance; instance != nil {
+		view.Occurrence = []shareField{
+			{Label: "Occurrence ID", Value: instance.ID.String()},
+			{Label: "UUID", Value: fallback(bundle.OccurrenceUUID)},
+			{Label: "Timestamp", Value: Formatting{}.timestamp(instance.Timestamp, bundle.GeneratedAt)},
+			{Label: "Code version", Value: fallback(bundle.CodeVersion)},
+		}
+		view.Payload = indentJSON(instance.Data)
+	}
+
+	return view
+}
+
+func shareTrendRows(counts []rollbar.OccurrenceCount) []shareTrendRow {
+	peak := uint64(0)
+	for _, count := range counts {
+		peak = max(peak, count.Count)
+	}
+
+	rows := make([]shareTrendRow, 0, len(counts))
+	for _, count := range counts {
+		bar := ""
+		if peak > 0 {
+			width := int(float64(count.Count) / float64(peak) * shareTrendBarWidth)
+			if count.Count > 0 && width == 0 {
+				width = 1
+			}
+			bar = strings.Repeat("█", width)
+		}
+		rows = append(rows, shareTrendRow{
+			Day:   time.Unix(int64(min(count.Timestamp, math.MaxInt64)), 0).UTC().Format(time.DateOnly),
+			Count: formatting.count(count.Count),
+			Bar:   bar,
+		})
+	}
+
+	return rows
+}
+
+func indentJSON(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, raw, "", "  "); err != nil {
+		return string(raw)
+	}
+
+	return out.String()
+}
+
+func escapeMarkdown(value string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ", "\r", " ").Replace(value)
+}
@@ -0,0 +1,89 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func sampleShareBundle() app.ShareBundle {
+	occurrences := uint64(12)
+	timestamp := uint64(1709290000)
+	generated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	return app.ShareBundle{
+		Issue: app.IssueDetail{
+			IssueSummary: app.IssueSummary{
+				ItemID:      domain.ItemID(99),
+				Counter:     domain.ItemCounter(7),
+				Title:       "Cannot read <script> | value",
+				Status:      "active",
+				Environment: "production",
+				Occurrences: &occurrences,
+			},
+			MainError: "TypeError: x is undefined",
+			Instance:  &rollbar.ItemInstance{ID: 5, Timestamp: &timestamp, Data: json.RawMessage(`{"uuid":"abc"}`)},
+		},
+		OccurrenceUUID: "abc",
+		CodeVersion:    "v1.4.0",
+		Trend:          []rollbar.OccurrenceCount{{Timestamp: 1709164800, Count: 2}, {Timestamp: 1709251200, Count: 10}, {Timestamp: 1709337600, Count: 0}},
+		TrendDays:      3,
+		GeneratedAt:    generated,
+		ExpiresAt:      generated.Add(72 * time.Hour),
+	}
+}
+
+func TestRenderShareMarkdown(t *testing.T) {
+	t.Parallel()
+
+	got := RenderShareMarkdown(sampleShareBundle())
+	for _, want := range []string{
+		`# [#7] Cannot read <script> \| value`,
+		"Expires 2024-03-04T12:00:00Z",
+		"| Occurrences | 12 |",
+		"TypeError: x is undefined",
+		"- **Code version:** v1.4.0",
+		`"uuid": "abc"`,
+		"## Trend (last 3 days)",
+		"| 2024-03-01 | 10 | `" + strings.Repeat("█", shareTrendBarWidth) + "` |",
+		"| 2024-02-29 | 2 | `" + strings.Repeat("█", 6) + "` |",
+		"| 2024-03-02 | 0 | `` |",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in markdown:\n%s", want, got)
+		}
+	}
+}
+
+func TestRenderShareHTMLEscapesContent(t *testing.T) {
+	t.Parallel()
+
+	got, err := RenderShareHTML(sampleShareBundle())
+	if err != nil {
+		t.Fatalf("RenderShareHTML() error = %v", err)
+	}
+	if strings.Contains(got, "<script>") || !strings.Contains(got, "&lt;script&gt;") {
+		t.Fatalf("expected escaped title, got:\n%s", got)
+	}
+	if !strings.Contains(got, "<!DOCTYPE html>") || !strings.Contains(got, "<strong>Code version:</strong> v1.4.0") {
+		t.Fatalf("unexpected html:\n%s", got)
+	}
+}
+
+func TestRenderShareMarkdownWithoutOccurrence(t *testing.T) {
+	t.Parallel()
+
+	bundle := sampleShareBundle()
+	bundle.Issue.Instance = nil
+	bundle.Trend = nil
+
+	got := RenderShareMarkdown(bundle)
+	if strings.Contains(got, "Latest occurrence") || !strings.Contains(got, "No occurrences in this window.") {
+		t.Fatalf("unexpected markdown:\n%s", got)
+	}
+}
@@ -0,0 +1,92 @@
+package snapshottest
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files under testdata")
+
+var DefaultWidths = []int{80, 120, 200}
+
+func Assert(t testing.TB, name string, got string) {
+	t.Helper()
+
+	if err := compare(goldenPath(name), got, *update); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func AssertWidths(t *testing.T, name string, widths []int, render func(width int) string) {
+	t.Helper()
+
+	for _, width := range widths {
+		t.Run(fmt.Sprintf("width=%d", width), func(t *testing.T) {
+			Assert(t, fmt.Sprintf("%s.w%d", name, width), render(width))
+		})
+	}
+}
+
+func goldenPath(name string) string {
+	return filepath.Join("testdata", name+".golden")
+}
+
+func compare(path string, got string, update bool) error {
+	if update {
+		return write(path, got)
+	}
+
+	//nolint:gosec // golden paths are built from test names under testdata.
+	want, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("golden file %s does not exist; run go test with -update to create it", path)
+	}
+	if err != nil {
+		return fmt.Errorf("read golden file: %w", err)
+	}
+
+	if normalized := strings.ReplaceAll(string(want), "\r\n", "\n"); normalized != got {
+		return fmt.Errorf("output does not match %s (run go test with -update to accept):\n%s", path, diff(normalized, got))
+	}
+
+	return nil
+}
+
+func write(path string, got string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create golden dir: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(got), 0o600); err != nil {
+		return fmt.Errorf("write golden file: %w", err)
+	}
+
+	return nil
+}
+
+func diff(want string, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for index := range max(len(wantLines), len(gotLines)) {
+		wantLine := lineAt(wantLines, index)
+		gotLine := lineAt(gotLines, index)
+		if wantLine != gotLine {
+			return fmt.Sprintf("line %d:\n- want: %q\n+ got:  %q", index+1, wantLine, gotLine)
+		}
+	}
+
+	return ""
+}
+
+func lineAt(lines []string, index int) string {
+	if index < len(lines) {
+		return lines[index]
+	}
+
+	return "<missing>"
+}
@@ -0,0 +1,88 @@
+package snapshottest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "testdata", "table.golden")
+	if err := compare(path, "a\nb", false); err == nil || !strings.Contains(err.Error(), "-update") {
+		t.Fatalf("expected missing golden error, got %v", err)
+	}
+	if err := compare(path, "a\nb", true); err != nil {
+		t.Fatalf("compare(update) error = %v", err)
+	}
+	if err := compare(path, "a\nb", false); err != nil {
+		t.Fatalf("compare() error = %v", err)
+	}
+
+	err := compare(path, "a\nc\nd", false)
+	if err == nil || !strings.Contains(err.Error(), "line 2:") || !strings.Contains(err.Error(), `"c"`) {
+		t.Fatalf("expected line diff, got %v", err)
+	}
+}
+
+func TestCompareNormalizesCRLF(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "crlf.golden")
+	if err := os.WriteFile(path, []byte("a\r\nb"), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := compare(path, "a\nb", false); err != nil {
+		t.Fatalf("compare() error = %v", err)
+	}
+}
+
+func TestCompareErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := compare(dir, "x", false); err == nil {
+		t.Fatalf("expected read error for directory")
+	}
+
+	blocker := filepath.Join(dir, "file")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	if err := compare(filepath.Join(blocker, "x.golden"), "x", true); err == nil {
+		t.Fatalf("expected mkdir error")
+	}
+	if err := compare(dir, "x", true); err == nil {
+		t.Fatalf("expected write error for directory")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		want string
+		got  string
+		out  string
+	}{
+		{want: "a", got: "a", out: ""},
+		{want: "a\nb", got: "a", out: "line 2:\n- want: \"b\"\n+ got:  \"<missing>\""},
+		{want: "a", got: "a\nb", out: "line 2:\n- want: \"<missing>\"\n+ got:  \"b\""},
+	}
+
+	for _, tc := range tests {
+		if got := diff(tc.want, tc.got); got != tc.out {
+			t.Fatalf("diff(%q, %q) = %q, want %q", tc.want, tc.got, got, tc.out)
+		}
+	}
+}
+
+func TestAssertWidths(t *testing.T) {
+	t.Parallel()
+
+	AssertWidths(t, "example", []int{10, 20}, func(width int) string {
+		return strings.Repeat("-", width)
+	})
+}
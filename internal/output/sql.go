@@ -0,0 +1,78 @@
+package output
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const sqliteSchema = `CREATE TABLE IF NOT EXISTS items (
+  item_id INTEGER PRIMARY KEY,
+  project_id INTEGER,
+  counter INTEGER NOT NULL,
+  title TEXT NOT NULL,
+  status TEXT,
+  environment TEXT,
+  level TEXT,
+  occurrences INTEGER,
+  first_seen INTEGER,
+  last_seen INTEGER
+);
+CREATE TABLE IF NOT EXISTS occurrences (
+  occurrence_id INTEGER PRIMARY KEY,
+  item_id INTEGER NOT NULL REFERENCES items(item_id),
+  timestamp INTEGER,
+  payload TEXT
+);
+CREATE INDEX IF NOT EXISTS occurrences_item_id ON occurrences(item_id);
+`
+
+func WriteSQLiteScript(w io.Writer, data app.ExportData) error {
+	buffered := bufio.NewWriter(w)
+	_, _ = buffered.WriteString("BEGIN TRANSACTION;\n")
+	_, _ = buffered.WriteString(sqliteSchema)
+	for _, issue := range data.Issues {
+		_, _ = fmt.Fprintf(buffered, "INSERT OR REPLACE INTO items VALUES (%d, %s, %d, %s, %s, %s, %s, %s, %s, %s);\n",
+			uint64(issue.ItemID), sqlOptionalID(uint64(issue.ProjectID)), uint64(issue.Counter), sqlText(issue.Title),
+			sqlText(issue.Status.String()), sqlText(issue.Environment.String()), sqlText(issue.Level.String()),
+			sqlInteger(issue.Occurrences), sqlInteger(issue.FirstOccurrenceTimestamp), sqlInteger(issue.LastOccurrenceTimestamp))
+	}
+	for _, occurrence := range data.Occurrences {
+		payload := "NULL"
+		if len(occurrence.Instance.Raw) > 0 {
+			payload = sqlText(string(occurrence.Instance.Raw))
+		}
+		_, _ = fmt.Fprintf(buffered, "INSERT OR REPLACE INTO occurrences VALUES (%d, %d, %s, %s);\n",
+			occurrence.Instance.ID, uint64(occurrence.ItemID), sqlInteger(occurrence.Instance.Timestamp), payload)
+	}
+	_, _ = buffered.WriteString("COMMIT;\n")
+
+	if err := buffered.Flush(); err != nil {
+		return fmt.Errorf("write sql script: %w", err)
+	}
+
+	return nil
+}
+
+func sqlText(value string) string {
+	value = strings.ReplaceAll(value, "\x00", "")
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+func sqlInteger(value *uint64) string {
+	if value == nil {
+		return "NULL"
+	}
+	return strconv.FormatUint(*value, 10)
+}
+
+func sqlOptionalID(value uint64) string {
+	if value == 0 {
+		return "NULL"
+	}
+	return strconv.FormatUint(value, 10)
+}
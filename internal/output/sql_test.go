@@ -0,0 +1,57 @@
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestWriteSQLiteScript(t *testing.T) {
+	t.Parallel()
+
+	occurrences := uint64(7)
+	timestamp := uint64(1700000000)
+	data := app.ExportData{
+		Issues: []app.IssueSummary{
+			{ItemID: 42, ProjectID: 9, Counter: 3, Title: "it's\x00 broken", Status: "active", Occurrences: &occurrences, LastOccurrenceTimestamp: &timestamp},
+			{ItemID: 43, Counter: 4, Title: "plain"},
+		},
+		Occurrences: []app.ExportedOccurrence{
+			{ItemID: 42, Instance: rollbar.ItemInstance{ID: 100, Timestamp: &timestamp, Raw: json.RawMessage(`{"id":100,"note":"o'k"}`)}},
+			{ItemID: 43, Instance: rollbar.ItemInstance{ID: 101}},
+		},
+	}
+
+	var script strings.Builder
+	if err := WriteSQLiteScript(&script, data); err != nil {
+		t.Fatalf("WriteSQLiteScript() error = %v", err)
+	}
+	got := script.String()
+
+	for _, want := range []string{
+		"BEGIN TRANSACTION;\n",
+		"CREATE TABLE IF NOT EXISTS items (",
+		"INSERT OR REPLACE INTO items VALUES (42, 9, 3, 'it''s broken', 'active', '', '', 7, NULL, 1700000000);",
+		"INSERT OR REPLACE INTO items VALUES (43, NULL, 4, 'plain', '', '', '', NULL, NULL, NULL);",
+		`INSERT OR REPLACE INTO occurrences VALUES (100, 42, 1700000000, '{"id":100,"note":"o''k"}');`,
+		"INSERT OR REPLACE INTO occurrences VALUES (101, 43, NULL, NULL);",
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("script missing %q:\n%s", want, got)
+		}
+	}
+	if !strings.HasSuffix(got, "COMMIT;\n") {
+		t.Fatalf("script should end with COMMIT, got %q", got[len(got)-20:])
+	}
+}
+
+func TestWriteSQLiteScriptWriteError(t *testing.T) {
+	t.Parallel()
+
+	if err := WriteSQLiteScript(&limitedWriter{}, app.ExportData{}); err == nil {
+		t.Fatalf("expected write error")
+	}
+}
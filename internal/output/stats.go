@@ -0,0 +1,55 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const statsBarWidth = 40
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+func RenderItemStats(stats app.ItemStats) string {
+	lines := []string{
+		fmt.Sprintf("Occurrences of #%s per %s, %s to %s UTC", stats.Counter.String(), stats.Bucket, statsTime(stats.Since, stats.Bucket), statsTime(stats.Until, stats.Bucket)),
+	}
+	if stats.Peak == 0 {
+		return strings.Join(append(lines, "No occurrences in this window."), "\n")
+	}
+
+	lines = append(lines, fmt.Sprintf("Total %s, peak %s per %s", formatting.count(stats.Total), formatting.count(stats.Peak), stats.Bucket), "", sparkline(stats.Series, stats.Peak), "")
+	for _, point := range stats.Series {
+		width := int(point.Count * statsBarWidth / stats.Peak)
+		if point.Count > 0 && width == 0 {
+			width = 1
+		}
+		lines = append(lines, strings.TrimRight(fmt.Sprintf("%s %8s %s", statsTime(point.Time, stats.Bucket), formatting.count(point.Count), strings.Repeat("█", width)), " "))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func sparkline(series []app.StatsPoint, peak uint64) string {
+	line := make([]rune, 0, len(series))
+	for _, point := range series {
+		if point.Count == 0 || peak == 0 {
+			line = append(line, ' ')
+			continue
+		}
+		level := (point.Count*uint64(len(sparkBlocks)) + peak - 1) / peak
+		line = append(line, sparkBlocks[min(level, uint64(len(sparkBlocks)))-1])
+	}
+
+	return string(line)
+}
+
+func statsTime(moment time.Time, bucket string) string {
+	if bucket == "day" {
+		return moment.UTC().Format(time.DateOnly)
+	}
+
+	return moment.UTC().Format("2006-01-02 15:04")
+}
@@ -0,0 +1,53 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderItemStats(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	stats := app.ItemStats{
+		Counter: 274,
+		Bucket:  "hour",
+		Since:   since,
+		Until:   since.Add(3 * time.Hour),
+		Total:   9,
+		Peak:    8,
+		Series: []app.StatsPoint{
+			{Time: since, Count: 8},
+			{Time: since.Add(time.Hour)},
+			{Time: since.Add(2 * time.Hour), Count: 1},
+		},
+	}
+
+	lines := strings.Split(RenderItemStats(stats), "\n")
+	want := []string{
+		"Occurrences of #274 per hour, 2024-03-01 10:00 to 2024-03-01 13:00 UTC",
+		"Total 9, peak 8 per hour",
+		"",
+		"█ ▁",
+		"",
+		"2024-03-01 10:00        8 " + strings.Repeat("█", statsBarWidth),
+		"2024-03-01 11:00        0",
+		"2024-03-01 12:00        1 " + strings.Repeat("█", statsBarWidth/8),
+	}
+	if strings.Join(lines, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("unexpected stats:\n%s\nwant:\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestRenderItemStatsEmpty(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	got := RenderItemStats(app.ItemStats{Counter: 7, Bucket: "day", Since: since, Until: since.Add(48 * time.Hour)})
+	if got != "Occurrences of #7 per day, 2024-03-01 to 2024-03-03 UTC\nNo occurrences in this window." {
+		t.Fatalf("unexpected empty stats: %q", got)
+	}
+}
@@ -0,0 +1,33 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func WriteIssueListChunks(w io.Writer, issues []app.IssueSummary, chunkSize int, separator string, render func(chunk []app.IssueSummary, first bool) string) error {
+	if len(issues) == 0 || chunkSize <= 0 {
+		chunkSize = max(len(issues), 1)
+	}
+
+	for start := 0; start == 0 || start < len(issues); start += chunkSize {
+		if start > 0 {
+			if _, err := io.WriteString(w, separator); err != nil {
+				return fmt.Errorf("write issue list: %w", err)
+			}
+		}
+		end := min(start+chunkSize, len(issues))
+		if _, err := fmt.Fprintln(w, render(issues[start:end], start == 0)); err != nil {
+			return fmt.Errorf("write issue list: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func RenderIssueListPlainRows(issues []app.IssueSummary, columns []string, now time.Time) string {
+	return renderIssueListPlain(issues, columns, false, now)
+}
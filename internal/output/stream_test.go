@@ -0,0 +1,99 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestWriteIssueListChunks(t *testing.T) {
+	t.Parallel()
+
+	issues := syntheticIssues(5)
+	tests := []struct {
+		name      string
+		issues    []app.IssueSummary
+		chunkSize int
+		want      string
+	}{
+		{name: "splits into chunks", issues: issues, chunkSize: 2, want: "first:2\n--rest:2\n--rest:1\n"},
+		{name: "single chunk when size is zero", issues: issues, chunkSize: 0, want: "first:5\n"},
+		{name: "renders empty lists once", issues: nil, chunkSize: 2, want: "first:0\n"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var buffer bytes.Buffer
+			err := WriteIssueListChunks(&buffer, tc.issues, tc.chunkSize, "--", func(chunk []app.IssueSummary, first bool) string {
+				if first {
+					return "first:" + strconv.Itoa(len(chunk))
+				}
+				return "rest:" + strconv.Itoa(len(chunk))
+			})
+			if err != nil {
+				t.Fatalf("WriteIssueListChunks() error = %v", err)
+			}
+			if buffer.String() != tc.want {
+				t.Fatalf("WriteIssueListChunks() = %q, want %q", buffer.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestWriteIssueListChunksWriteError(t *testing.T) {
+	t.Parallel()
+
+	render := func(chunk []app.IssueSummary, first bool) string { return "x" }
+	if err := WriteIssueListChunks(failingWriter{}, syntheticIssues(3), 1, "", render); err == nil {
+		t.Fatalf("expected write error")
+	}
+	if err := WriteIssueListChunks(&limitedWriter{remaining: 1}, syntheticIssues(3), 1, "--", render); err == nil {
+		t.Fatalf("expected separator write error")
+	}
+}
+
+func TestRenderIssueListPlainRows(t *testing.T) {
+	t.Parallel()
+
+	rows := RenderIssueListPlainRows(syntheticIssues(2), []string{"counter", "status"}, testNow)
+	if strings.Contains(rows, "COUNTER") || strings.Count(rows, "\n") != 1 || !strings.HasPrefix(rows, "1\tactive") {
+		t.Fatalf("unexpected plain rows: %q", rows)
+	}
+}
+
+func BenchmarkWriteIssueListChunks(b *testing.B) {
+	issues := syntheticIssues(5000)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = WriteIssueListChunks(&bytes.Buffer{}, issues, 500, "", func(chunk []app.IssueSummary, _ bool) string {
+			return RenderIssueListHumanWithWidth(chunk, 120, testNow)
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("closed")
+}
+
+type limitedWriter struct {
+	remaining int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.remaining <= 0 {
+		return 0, errors.New("closed")
+	}
+	w.remaining--
+
+	return len(p), nil
+}
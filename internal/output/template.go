@@ -0,0 +1,207 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+)
+
+var templateInitialisms = map[string]string{"id": "ID", "url": "URL", "uuid": "UUID", "api": "API", "ip": "IP", "http": "HTTP", "json": "JSON", "rql": "RQL", "uri": "URI"}
+
+func ParseTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("--format template needs --template")
+	}
+	tmpl, err := template.New("output").Funcs(template.FuncMap{
+		"timestamp":  templateTimestamp,
+		"formatTime": templateFormatTime,
+		"ago":        templateAgo,
+		"json":       templateJSON,
+		"default":    templateDefault,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse --template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func RenderTemplate(tmpl *template.Template, payload any) (string, error) {
+	data, err := templateData(payload)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	entries, isList := templateEntries(data)
+	if !isList {
+		if err := tmpl.Execute(&out, data); err != nil {
+			return "", fmt.Errorf("execute template: %w", err)
+		}
+		return strings.TrimRight(out.String(), "\n"), nil
+	}
+
+	lines := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		out.Reset()
+		if err := tmpl.Execute(&out, entry); err != nil {
+			return "", fmt.Errorf("execute template: %w", err)
+		}
+		lines = append(lines, strings.TrimRight(out.String(), "\n"))
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func templateData(payload any) (any, error) {
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("encode template data: %w", err)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("decode template data: %w", err)
+	}
+
+	data := templateValue(decoded)
+	if object, ok := data.(map[string]any); ok {
+		if issue, ok := object["Issue"].(map[string]any); ok {
+			for key, value := range issue {
+				if _, taken := object[key]; !taken {
+					object[key] = value
+				}
+			}
+		}
+	}
+
+	return data, nil
+}
+
+func templateValue(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		renamed := make(map[string]any, len(typed))
+		for key, nested := range typed {
+			renamed[templateKey(key)] = templateValue(nested)
+		}
+		return renamed
+	case []any:
+		for index := range typed {
+			typed[index] = templateValue(typed[index])
+		}
+		return typed
+	case json.Number:
+		if integer, err := typed.Int64(); err == nil {
+			return integer
+		}
+		if float, err := typed.Float64(); err == nil {
+			return float
+		}
+		return typed.String()
+	default:
+		return value
+	}
+}
+
+func templateKey(key string) string {
+	segments := strings.FieldsFunc(key, func(r rune) bool { return r == '_' || r == '-' })
+	var name strings.Builder
+	for _, segment := range segments {
+		if initialism, ok := templateInitialisms[strings.ToLower(segment)]; ok {
+			name.WriteString(initialism)
+			continue
+		}
+		runes := []rune(segment)
+		runes[0] = unicode.ToUpper(runes[0])
+		name.WriteString(string(runes))
+	}
+	if name.Len() == 0 {
+		return key
+	}
+
+	return name.String()
+}
+
+func templateEntries(data any) ([]any, bool) {
+	object, ok := data.(map[string]any)
+	if !ok {
+		entries, isList := data.([]any)
+		return entries, isList
+	}
+	if issues, ok := object["Issues"].([]any); ok {
+		return issues, true
+	}
+	if len(object) != 1 {
+		return nil, false
+	}
+	for _, value := range object {
+		entries, isList := value.([]any)
+		return entries, isList
+	}
+
+	return nil, false
+}
+
+func templateTime(value any) (time.Time, bool) {
+	switch typed := value.(type) {
+	case int64:
+		return time.Unix(typed, 0).UTC(), true
+	case float64:
+		if typed > math.MaxInt64 || typed < math.MinInt64 {
+			return time.Time{}, false
+		}
+		return time.Unix(int64(typed), 0).UTC(), true
+	case string:
+		parsed, err := time.Parse(time.RFC3339, typed)
+		return parsed, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+func templateTimestamp(value any) string {
+	return templateFormatTime(value, time.RFC3339)
+}
+
+func templateFormatTime(value any, layout string) string {
+	moment, ok := templateTime(value)
+	if !ok {
+		return "unknown"
+	}
+
+	return moment.Format(layout)
+}
+
+func templateAgo(value any) string {
+	moment, ok := templateTime(value)
+	if !ok {
+		return "unknown"
+	}
+
+	return relativeTime(moment, time.Now())
+}
+
+func templateJSON(value any) (string, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("encode json: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+func templateDefault(fallback any, value any) any {
+	if value == nil || value == "" {
+		return fallback
+	}
+
+	return value
+}
@@ -0,0 +1,65 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	occurrences, lastSeen := uint64(1234), uint64(1700000000)
+	issues := []app.IssueSummary{
+		{ItemID: 90, Counter: 274, Title: "checkout failed", Environment: "production", Occurrences: &occurrences, LastOccurrenceTimestamp: &lastSeen},
+		{ItemID: 91, Counter: 275, Title: "nil map"},
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		payload  any
+		want     string
+	}{
+		{name: "one line per issue", template: "{{.Counter}} {{.Title}}", payload: map[string]any{"issues": issues}, want: "274 checkout failed\n275 nil map"},
+		{name: "initialisms and numbers", template: "{{.ItemID}} {{.Occurrences}}", payload: map[string]any{"issues": issues[:1]}, want: "90 1234"},
+		{name: "helpers", template: `{{timestamp .LastOccurrenceTimestamp}} {{formatTime .LastOccurrenceTimestamp "2006-01-02"}} {{default "-" .Environment}}`, payload: map[string]any{"issues": issues}, want: "2023-11-14T22:13:20Z 2023-11-14 production\nunknown unknown -"},
+		{name: "empty list", template: "{{.Counter}}", payload: map[string]any{"issues": []app.IssueSummary{}}, want: ""},
+		{name: "action promotes issue", template: "{{.Action}} #{{.Counter}}", payload: map[string]any{"action": "resolved", "issue": issues[1]}, want: "resolved #275"},
+		{name: "other single list", template: "{{.Revision}}", payload: map[string]any{"deploys": []map[string]string{{"revision": "abc"}, {"revision": "def"}}}, want: "abc\ndef"},
+		{name: "object", template: "{{.MainError}} {{json .Issue.Counter}}", payload: map[string]any{"issue": issues[0], "main_error": "boom", "traces": []int{1}}, want: "boom 274"},
+	}
+
+	for _, tt := range tests {
+		tmpl, err := ParseTemplate(tt.template)
+		if err != nil {
+			t.Fatalf("%s: ParseTemplate() error = %v", tt.name, err)
+		}
+		got, err := RenderTemplate(tmpl, tt.payload)
+		if err != nil {
+			t.Fatalf("%s: RenderTemplate() error = %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Fatalf("%s: RenderTemplate() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseTemplateErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, text := range []string{"", "  ", "{{.Counter"} {
+		if _, err := ParseTemplate(text); err == nil {
+			t.Fatalf("ParseTemplate(%q) expected an error", text)
+		}
+	}
+	tmpl, err := ParseTemplate(`{{ago .Missing}} {{.Counter}}`)
+	if err != nil {
+		t.Fatalf("ParseTemplate() error = %v", err)
+	}
+	if got, err := RenderTemplate(tmpl, map[string]any{"counter": domain.ItemCounter(3)}); err != nil || !strings.HasPrefix(got, "unknown 3") {
+		t.Fatalf("RenderTemplate() = %q, %v", got, err)
+	}
+}
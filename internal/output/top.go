@@ -0,0 +1,55 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+const topNonTitleWidth = 60
+
+func RenderTopReportWithWidth(report app.TopReport, maxWidth int) string {
+	heading := fmt.Sprintf("Top issues by occurrences in the last %dh, compared with the %dh before", report.WindowHours, report.WindowHours)
+	if len(report.Issues) == 0 {
+		return heading + "\nno occurrences in this window"
+	}
+
+	targetWidth := normalizeWidth(maxWidth, defaultListRowWidth)
+	titleWidth := clampInt(targetWidth-topNonTitleWidth, minListTitleWidth, maxListTitleWidth)
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.SetAllowedRowLength(targetWidth)
+	tw.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 6, WidthMax: titleWidth, WidthMaxEnforcer: formatting.truncate},
+	})
+	tw.AppendHeader(table.Row{"RANK", "COUNTER", "OCCURRENCES", "PREVIOUS", "DELTA", "TITLE"})
+	for index, issue := range report.Issues {
+		tw.AppendRow(table.Row{
+			index + 1,
+			"#" + issue.Counter.String(),
+			formatting.count(issue.WindowOccurrences),
+			formatting.count(issue.PreviousOccurrences),
+			topDelta(issue),
+			fallback(issue.Title),
+		})
+	}
+
+	return heading + "\n" + strings.TrimRight(tw.Render(), "\n")
+}
+
+func topDelta(issue app.TopIssue) string {
+	switch {
+	case issue.PreviousOccurrences == 0:
+		return "new"
+	case issue.Delta > 0:
+		return "+" + formatting.count(uint64(issue.Delta))
+	case issue.Delta < 0:
+		return "-" + formatting.count(uint64(-issue.Delta))
+	default:
+		return "0"
+	}
+}
@@ -0,0 +1,36 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func TestRenderTopReport(t *testing.T) {
+	t.Parallel()
+
+	report := app.TopReport{WindowHours: 24, Issues: []app.TopIssue{
+		{IssueSummary: app.IssueSummary{Counter: 2, Title: "spiking"}, WindowOccurrences: 30, PreviousOccurrences: 1, Delta: 29},
+		{IssueSummary: app.IssueSummary{Counter: 7, Title: "brand new"}, WindowOccurrences: 4},
+		{IssueSummary: app.IssueSummary{Counter: 3, Title: "calming"}, WindowOccurrences: 2, PreviousOccurrences: 18, Delta: -16},
+	}}
+
+	got := RenderTopReportWithWidth(report, 120)
+	lines := strings.Split(got, "\n")
+	if lines[0] != "Top issues by occurrences in the last 24h, compared with the 24h before" {
+		t.Fatalf("unexpected heading: %q", lines[0])
+	}
+	for _, want := range []string{"RANK", "DELTA", "#2", "+29", "new", "-16", "brand new"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "#2") > strings.Index(got, "#7") || strings.Index(got, "#7") > strings.Index(got, "#3") {
+		t.Fatalf("expected ranking order kept:\n%s", got)
+	}
+
+	if empty := RenderTopReportWithWidth(app.TopReport{WindowHours: 6}, 120); !strings.HasSuffix(empty, "\nno occurrences in this window") {
+		t.Fatalf("unexpected empty report: %q", empty)
+	}
+}
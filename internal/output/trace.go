@@ -0,0 +1,52 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+func RenderTraces(traces []summary.Trace, maxWidth int) string {
+	width := normalizeWidth(maxWidth, defaultDetailRowWidth)
+	lines := []string{"Stack Trace (most recent call last):"}
+	for index, trace := range traces {
+		heading := traceHeading(trace)
+		if index > 0 {
+			heading = "Caused by: " + heading
+		}
+		lines = append(lines, formatting.truncateLine(heading, width))
+		if trace.Omitted > 0 {
+			lines = append(lines, fmt.Sprintf("  ... %d earlier frames", trace.Omitted))
+		}
+		for _, frame := range trace.Frames {
+			lines = append(lines, formatting.truncateLine("  "+formatFrame(frame), width))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func traceHeading(trace summary.Trace) string {
+	switch {
+	case trace.Exception != "" && trace.Message != "":
+		return trace.Exception + ": " + trace.Message
+	case trace.Exception != "":
+		return trace.Exception
+	default:
+		return fallback(trace.Message)
+	}
+}
+
+func formatFrame(frame summary.Frame) string {
+	location := fallback(frame.Filename)
+	if frame.Line > 0 {
+		location += ":" + strconv.Itoa(frame.Line)
+	}
+	if frame.Method != "" {
+		location += " in " + frame.Method
+	}
+
+	return location
+}
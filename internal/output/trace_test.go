@@ -0,0 +1,29 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+func TestRenderTraces(t *testing.T) {
+	t.Parallel()
+
+	traces := []summary.Trace{
+		{Exception: "TimeoutError", Message: "db timeout", Omitted: 3, Frames: []summary.Frame{{Filename: "app.py", Line: 10, Method: "main"}, {Filename: "db.py"}}},
+		{Exception: "OSError", Frames: []summary.Frame{{Filename: "socket.py", Line: 2}}},
+		{Message: "no class"},
+	}
+
+	want := "Stack Trace (most recent call last):\n" +
+		"TimeoutError: db timeout\n" +
+		"  ... 3 earlier frames\n" +
+		"  app.py:10 in main\n" +
+		"  db.py\n" +
+		"Caused by: OSError\n" +
+		"  socket.py:2\n" +
+		"Caused by: no class"
+	if got := RenderTraces(traces, 120); got != want {
+		t.Fatalf("RenderTraces() = %q, want %q", got, want)
+	}
+}
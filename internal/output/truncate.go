@@ -0,0 +1,80 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	prettytext "github.com/jedib0t/go-pretty/v6/text"
+)
+
+type TruncationStyle string
+
+const (
+	TruncateEnd    TruncationStyle = "end"
+	TruncateMiddle TruncationStyle = "middle"
+	TruncateWrap   TruncationStyle = "wrap"
+)
+
+const truncationEllipsis = "…"
+
+func ParseTruncationStyle(value string) (TruncationStyle, error) {
+	switch style := TruncationStyle(strings.ToLower(strings.TrimSpace(value))); style {
+	case "":
+		return TruncateEnd, nil
+	case TruncateEnd, TruncateMiddle, TruncateWrap:
+		return style, nil
+	default:
+		return "", fmt.Errorf("unknown truncation %q: use end, middle, or wrap", value)
+	}
+}
+
+func (f Formatting) truncate(value string, width int) string {
+	switch f.Truncation {
+	case TruncateMiddle:
+		return trimMiddle(value, width)
+	case TruncateWrap:
+		return prettytext.WrapSoft(value, width)
+	default:
+		return prettytext.Trim(value, width)
+	}
+}
+
+func (f Formatting) truncateLine(value string, width int) string {
+	if f.Truncation == TruncateWrap {
+		return prettytext.Trim(value, width)
+	}
+
+	return f.truncate(value, width)
+}
+
+func trimMiddle(value string, width int) string {
+	if prettytext.StringWidthWithoutEscSequences(value) <= width {
+		return value
+	}
+	if width <= 1 {
+		return prettytext.Trim(value, width)
+	}
+
+	runes := []rune(value)
+	headWidth := (width - 1) / 2
+	tailWidth := width - 1 - headWidth
+
+	head := strings.Builder{}
+	used := 0
+	for _, r := range runes {
+		if used+prettytext.RuneWidth(r) > headWidth {
+			break
+		}
+		used += prettytext.RuneWidth(r)
+		head.WriteRune(r)
+	}
+
+	tailStart := len(runes)
+	used = 0
+	for tailStart > 0 && used+prettytext.RuneWidth(runes[tailStart-1]) <= tailWidth {
+		tailStart--
+		used += prettytext.RuneWidth(runes[tailStart])
+	}
+
+	return head.String() + truncationEllipsis + string(runes[tailStart:])
+}
@@ -0,0 +1,56 @@
+package output
+
+import (
+	"testing"
+)
+
+func TestParseTruncationStyle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    TruncationStyle
+		wantErr bool
+	}{
+		{input: "", want: TruncateEnd},
+		{input: " Middle ", want: TruncateMiddle},
+		{input: "wrap", want: TruncateWrap},
+		{input: "fold", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTruncationStyle(tt.input)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Fatalf("ParseTruncationStyle(%q) = %q, %v", tt.input, got, err)
+		}
+	}
+}
+
+func TestFormattingTruncate(t *testing.T) {
+	t.Parallel()
+
+	title := "Timeout calling /api/orders/48213"
+	tests := []struct {
+		name  string
+		style TruncationStyle
+		value string
+		width int
+		want  string
+	}{
+		{name: "end", style: TruncateEnd, value: title, width: 16, want: "Timeout calling "},
+		{name: "middle keeps suffix", style: TruncateMiddle, value: title, width: 16, want: "Timeout…rs/48213"},
+		{name: "middle fits", style: TruncateMiddle, value: title, width: 40, want: title},
+		{name: "middle wide runes", style: TruncateMiddle, value: "日本語日本語", width: 7, want: "日…語"},
+		{name: "wrap", style: TruncateWrap, value: title, width: 16, want: "Timeout calling \n/api/orders/4821\n3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := (Formatting{Truncation: tt.style}).truncate(tt.value, tt.width); got != tt.want {
+				t.Fatalf("truncate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	prettytext "github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func RenderWatchHumanWithWidth(deltas []app.IssueDelta, maxWidth int, columns []string, highlight bool, now time.Time) string {
+	if len(deltas) == 0 {
+		return "no issues found"
+	}
+
+	selected := selectListColumns(columns)
+	header := make(table.Row, 0, len(selected))
+	for _, column := range selected {
+		header = append(header, column.header)
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	configureListTable(tw, maxWidth, selected)
+	tw.AppendHeader(header)
+
+	format := formatting.at(now)
+	for _, delta := range deltas {
+		row := make(table.Row, 0, len(selected))
+		for _, column := range selected {
+			value := column.value(delta.IssueSummary, format)
+			if column.header == "OCCURRENCES" {
+				value = formatOccurrenceDelta(delta)
+			}
+			if highlight && delta.Changed {
+				value = prettytext.Colors{prettytext.Bold, prettytext.FgYellow}.Sprint(value)
+			}
+			row = append(row, value)
+		}
+		tw.AppendRow(row)
+	}
+
+	return strings.TrimRight(tw.Render(), "\n")
+}
+
+func RenderWatchEvents(deltas []app.IssueDelta, at time.Time, highlight bool) string {
+	lines := make([]string, 0, len(deltas))
+	for _, delta := range app.Changes(deltas) {
+		event, colors := "UPDATED", prettytext.Colors{prettytext.FgYellow}
+		if delta.New {
+			event, colors = "NEW", prettytext.Colors{prettytext.Bold, prettytext.FgGreen}
+		}
+		label := fmt.Sprintf("%-7s", event)
+		if highlight {
+			label = colors.Sprint(label)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s #%s %s %s · %s", at.Format(time.TimeOnly), label, delta.Counter.String(),
+			fallback(delta.Environment.String()), formatOccurrenceDelta(delta), fallback(delta.Title)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatOccurrenceDelta(delta app.IssueDelta) string {
+	occurrences := formatting.occurrences(delta.Occurrences)
+	switch {
+	case delta.New:
+		return occurrences + " (new)"
+	case delta.Delta > 0:
+		return fmt.Sprintf("%s (+%s)", occurrences, formatting.count(delta.Delta))
+	default:
+		return occurrences
+	}
+}
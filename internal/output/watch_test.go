@@ -0,0 +1,81 @@
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	prettytext "github.com/jedib0t/go-pretty/v6/text"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestRenderWatchHumanWithWidth(t *testing.T) {
+	t.Parallel()
+
+	if got := RenderWatchHumanWithWidth(nil, 120, nil, false, testNow); got != "no issues found" {
+		t.Fatalf("unexpected empty output: %q", got)
+	}
+
+	count := func(value uint64) *uint64 { return &value }
+	deltas := []app.IssueDelta{
+		{IssueSummary: app.IssueSummary{Counter: domain.ItemCounter(1), Title: "grew", Occurrences: count(12)}, Delta: 3, Changed: true},
+		{IssueSummary: app.IssueSummary{Counter: domain.ItemCounter(2), Title: "fresh", Occurrences: count(1)}, New: true, Changed: true},
+		{IssueSummary: app.IssueSummary{Counter: domain.ItemCounter(3), Title: "quiet", Occurrences: count(4)}},
+	}
+
+	plain := RenderWatchHumanWithWidth(deltas, 120, DefaultListColumns, false, testNow)
+	for _, want := range []string{"12 (+3)", "1 (new)", "quiet"} {
+		if !strings.Contains(plain, want) {
+			t.Fatalf("expected %q in output, got %q", want, plain)
+		}
+	}
+	if strings.Contains(plain, "\x1b[") {
+		t.Fatalf("expected no color codes without highlight, got %q", plain)
+	}
+
+	highlighted := RenderWatchHumanWithWidth(deltas, 120, DefaultListColumns, true, testNow)
+	if !strings.Contains(highlighted, "\x1b[") {
+		t.Fatalf("expected color codes for changed rows, got %q", highlighted)
+	}
+
+	deltas[0].Title = strings.Repeat("a very long watched title ", 10)
+	narrow := RenderWatchHumanWithWidth(deltas, 100, DefaultListColumns, false, testNow)
+	for _, line := range strings.Split(narrow, "\n") {
+		if width := prettytext.RuneWidthWithoutEscSequences(line); width > 100 || strings.HasSuffix(line, "≈") {
+			t.Fatalf("expected rows to fit in 100 columns with the title truncated, got %d: %q", width, line)
+		}
+	}
+	if !strings.Contains(narrow, "LEVEL") {
+		t.Fatalf("expected the default LEVEL column, got %q", narrow)
+	}
+
+	custom := RenderWatchHumanWithWidth(deltas, 120, []string{"counter", "occurrences", "title"}, false, testNow)
+	if strings.Contains(custom, "STATUS") || !strings.Contains(custom, "12 (+3)") {
+		t.Fatalf("expected only the chosen columns with deltas, got %q", custom)
+	}
+}
+
+func TestRenderWatchEvents(t *testing.T) {
+	t.Parallel()
+
+	count := func(value uint64) *uint64 { return &value }
+	deltas := []app.IssueDelta{
+		{IssueSummary: app.IssueSummary{Counter: domain.ItemCounter(1), Environment: "production", Title: "grew", Occurrences: count(12)}, Delta: 3, Changed: true},
+		{IssueSummary: app.IssueSummary{Counter: domain.ItemCounter(2), Title: "fresh", Occurrences: count(1)}, New: true, Changed: true},
+		{IssueSummary: app.IssueSummary{Counter: domain.ItemCounter(3), Title: "quiet", Occurrences: count(4)}},
+	}
+	at := time.Date(2026, 3, 1, 14, 5, 9, 0, time.UTC)
+
+	want := "14:05:09 UPDATED #1 production 12 (+3) · grew\n14:05:09 NEW     #2 unknown 1 (new) · fresh"
+	if got := RenderWatchEvents(deltas, at, false); got != want {
+		t.Fatalf("RenderWatchEvents() = %q, want %q", got, want)
+	}
+	if got := RenderWatchEvents(deltas[2:], at, false); got != "" {
+		t.Fatalf("expected no events for unchanged issues, got %q", got)
+	}
+	if got := RenderWatchEvents(deltas, at, true); !strings.Contains(got, "\x1b[") {
+		t.Fatalf("expected color codes with highlight, got %q", got)
+	}
+}
@@ -0,0 +1,49 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+)
+
+func RenderWorkload(workload app.Workload) string {
+	if len(workload.Entries) == 0 {
+		return "no issues found"
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleLight)
+	tw.AppendHeader(table.Row{"ASSIGNEE", "ISSUES", "SHARE", "OCCURRENCES", "SHARE"})
+	for _, entry := range workload.Entries {
+		assignee := "(unassigned)"
+		if entry.Assignee != nil {
+			assignee = UserName(*entry.Assignee)
+		}
+		tw.AppendRow(table.Row{
+			assignee,
+			formatting.count(uint64(entry.Items)),
+			workloadShare(uint64(entry.Items), uint64(workload.Items)),
+			formatting.count(entry.Occurrences),
+			workloadShare(entry.Occurrences, workload.Occurrences),
+		})
+	}
+	tw.AppendFooter(table.Row{"TOTAL", formatting.count(uint64(workload.Items)), "", formatting.count(workload.Occurrences), ""})
+
+	rendered := strings.TrimRight(tw.Render(), "\n")
+	if !workload.Complete {
+		rendered += "\nStopped paging early; counts are lower bounds."
+	}
+
+	return rendered
+}
+
+func workloadShare(part uint64, total uint64) string {
+	if total == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.0f%%", float64(part)*100/float64(total))
+}
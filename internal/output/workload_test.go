@@ -0,0 +1,43 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func TestRenderWorkload(t *testing.T) {
+	t.Parallel()
+
+	workload := app.Workload{
+		Entries: []app.WorkloadEntry{
+			{Assignee: &rollbar.User{ID: 1, Username: "alice"}, Items: 3, Occurrences: 75},
+			{Items: 1, Occurrences: 25},
+		},
+		Items:       4,
+		Occurrences: 100,
+		Complete:    true,
+	}
+	got := RenderWorkload(workload)
+	for _, want := range []string{"ASSIGNEE", "alice (user 1)", "(unassigned)", "75%", "25%", "TOTAL", "100"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("expected %q in workload output, got %q", want, got)
+		}
+	}
+	if strings.Contains(got, "lower bounds") {
+		t.Fatalf("complete workload should not warn: %q", got)
+	}
+
+	workload.Complete = false
+	if got := RenderWorkload(workload); !strings.Contains(got, "lower bounds") {
+		t.Fatalf("expected partial note, got %q", got)
+	}
+	if got := RenderWorkload(app.Workload{}); got != "no issues found" {
+		t.Fatalf("empty workload = %q", got)
+	}
+	if got := workloadShare(3, 0); got != "-" {
+		t.Fatalf("workloadShare() with no total = %q", got)
+	}
+}
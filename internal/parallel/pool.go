@@ -0,0 +1,62 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+func ForEach(parent context.Context, limit int, count int, fn func(context.Context, int) error) error {
+	if limit <= 0 {
+		limit = 1
+	}
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	errs := make([]error, count)
+	var cancelErr error
+	slots := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+
+dispatch:
+	for index := 0; index < count; index++ {
+		if ctx.Err() != nil {
+			cancelErr = parent.Err()
+			break
+		}
+		select {
+		case <-ctx.Done():
+			cancelErr = parent.Err()
+			break dispatch
+		case slots <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := fn(ctx, index); err != nil {
+				errs[index] = err
+				cancel()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(append(errs, cancelErr)...)
+}
+
+func Map[T any, R any](ctx context.Context, limit int, inputs []T, fn func(context.Context, T) (R, error)) ([]R, error) {
+	results := make([]R, len(inputs))
+	err := ForEach(ctx, limit, len(inputs), func(ctx context.Context, index int) error {
+		result, err := fn(ctx, inputs[index])
+		if err != nil {
+			return err
+		}
+		results[index] = result
+		return nil
+	})
+
+	return results, err
+}
@@ -0,0 +1,155 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapPreservesOrder(t *testing.T) {
+	t.Parallel()
+
+	inputs := []int{1, 2, 3, 4, 5}
+	results, err := Map(context.Background(), 2, inputs, func(ctx context.Context, value int) (string, error) {
+		time.Sleep(time.Duration(5-value) * time.Millisecond)
+		return fmt.Sprintf("v%d", value), nil
+	})
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	for index, value := range inputs {
+		if results[index] != fmt.Sprintf("v%d", value) {
+			t.Fatalf("results[%d] = %q", index, results[index])
+		}
+	}
+}
+
+func TestForEachRespectsLimit(t *testing.T) {
+	t.Parallel()
+
+	var running, peak atomic.Int32
+	err := ForEach(context.Background(), 3, 20, func(ctx context.Context, index int) error {
+		current := running.Add(1)
+		for {
+			observed := peak.Load()
+			if current <= observed || peak.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		running.Add(-1)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ForEach() error = %v", err)
+	}
+	if peak.Load() > 3 {
+		t.Fatalf("peak concurrency = %d, want <= 3", peak.Load())
+	}
+}
+
+func TestForEachStopsOnFirstError(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd")
+	var calls atomic.Int32
+	err := ForEach(context.Background(), 0, 4, func(ctx context.Context, index int) error {
+		calls.Add(1)
+		if index%2 == 1 {
+			return fmt.Errorf("item %d: %w", index, errOdd)
+		}
+		return nil
+	})
+	if !errors.Is(err, errOdd) || errors.Is(err, context.Canceled) {
+		t.Fatalf("expected only the odd error, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("expected dispatch to stop after the first error, got %d calls", calls.Load())
+	}
+}
+
+func TestForEachCancelsInFlightOnError(t *testing.T) {
+	t.Parallel()
+
+	errBoom := errors.New("boom")
+	err := ForEach(context.Background(), 2, 10, func(ctx context.Context, index int) error {
+		if index == 0 {
+			return errBoom
+		}
+		<-ctx.Done()
+		return nil
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestForEachStopsWaitingForSlotOnCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{}, 3)
+	release := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- ForEach(ctx, 1, 3, func(ctx context.Context, index int) error {
+			started <- struct{}{}
+			<-release
+			return nil
+		})
+	}()
+
+	<-started
+	cancel()
+	select {
+	case err := <-done:
+		t.Fatalf("ForEach returned before the in-flight call finished: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(release)
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation error, got %v", err)
+	}
+	if len(started) != 0 {
+		t.Fatalf("expected no calls after cancellation, got %d more", len(started))
+	}
+}
+
+func TestForEachStopsOnCancellation(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls atomic.Int32
+	err := ForEach(ctx, 1, 10, func(ctx context.Context, index int) error {
+		if calls.Add(1) == 2 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation error, got %v", err)
+	}
+	if calls.Load() >= 10 {
+		t.Fatalf("expected dispatch to stop after cancellation, got %d calls", calls.Load())
+	}
+}
+
+func TestMapReturnsPartialResults(t *testing.T) {
+	t.Parallel()
+
+	results, err := Map(context.Background(), 2, []int{1, 2}, func(ctx context.Context, value int) (int, error) {
+		if value == 2 {
+			return 0, errors.New("boom")
+		}
+		return value * 10, nil
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if results[0] != 10 {
+		t.Fatalf("expected partial result, got %+v", results)
+	}
+}
@@ -1,7 +1,11 @@
 package redact
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"reflect"
 	"regexp"
 	"strings"
@@ -32,6 +36,8 @@ func Value(value any, token string) any {
 		return redactSlice(typed, token)
 	case string:
 		return String(typed, token)
+	case json.RawMessage:
+		return RawJSON(typed, token)
 	default:
 		return redactStructured(value, token)
 	}
@@ -81,6 +87,132 @@ func redactStructured(value any, token string) any {
 	return Value(decoded, token)
 }
 
+func RawJSON(raw json.RawMessage, token string) json.RawMessage {
+	if len(raw) == 0 {
+		return raw
+	}
+
+	writer := rawWriter{decoder: json.NewDecoder(bytes.NewReader(raw)), token: token}
+	writer.decoder.UseNumber()
+	if err := writer.copy(); err != nil {
+		return json.RawMessage(`"[REDACTED]"`)
+	}
+
+	return writer.out.Bytes()
+}
+
+type rawFrame struct {
+	object bool
+	count  int
+}
+
+type rawWriter struct {
+	decoder *json.Decoder
+	token   string
+	out     bytes.Buffer
+	stack   []rawFrame
+}
+
+func (w *rawWriter) copy() error {
+	for {
+		tok, err := w.decoder.Token()
+		if errors.Is(err, io.EOF) && len(w.stack) == 0 {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read token: %w", err)
+		}
+		if err := w.write(tok); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *rawWriter) write(tok json.Token) error {
+	if delim, ok := tok.(json.Delim); ok && (delim == '}' || delim == ']') {
+		w.closeContainer(delim)
+		return nil
+	}
+	if key, ok := tok.(string); ok && w.expectingKey() {
+		return w.writeKey(key)
+	}
+
+	w.separate()
+	switch typed := tok.(type) {
+	case json.Delim:
+		w.out.WriteRune(rune(typed))
+		w.stack = append(w.stack, rawFrame{object: typed == '{'})
+		return nil
+	case string:
+		encoded, _ := json.Marshal(String(typed, w.token))
+		w.out.Write(encoded)
+	case json.Number:
+		w.out.WriteString(typed.String())
+	case bool:
+		encoded, _ := json.Marshal(typed)
+		w.out.Write(encoded)
+	case nil:
+		w.out.WriteString("null")
+	}
+	w.valueDone()
+
+	return nil
+}
+
+func (w *rawWriter) writeKey(key string) error {
+	frame := &w.stack[len(w.stack)-1]
+	if frame.count > 0 {
+		w.out.WriteByte(',')
+	}
+	encoded, _ := json.Marshal(key)
+	w.out.Write(encoded)
+	w.out.WriteByte(':')
+	if !isSensitiveKey(key) {
+		frame.count++
+		return nil
+	}
+
+	var skipped json.RawMessage
+	if err := w.decoder.Decode(&skipped); err != nil {
+		return fmt.Errorf("skip sensitive value: %w", err)
+	}
+	w.out.WriteString(`"[REDACTED]"`)
+	frame.count += 2
+
+	return nil
+}
+
+func (w *rawWriter) closeContainer(delim json.Delim) {
+	w.out.WriteRune(rune(delim))
+	w.stack = w.stack[:len(w.stack)-1]
+	w.valueDone()
+}
+
+func (w *rawWriter) expectingKey() bool {
+	if len(w.stack) == 0 {
+		return false
+	}
+	frame := w.stack[len(w.stack)-1]
+
+	return frame.object && frame.count%2 == 0
+}
+
+func (w *rawWriter) separate() {
+	if len(w.stack) == 0 {
+		return
+	}
+	frame := w.stack[len(w.stack)-1]
+	if !frame.object && frame.count > 0 {
+		w.out.WriteByte(',')
+	}
+}
+
+func (w *rawWriter) valueDone() {
+	if len(w.stack) > 0 {
+		w.stack[len(w.stack)-1].count++
+	}
+}
+
 func isSensitiveKey(key string) bool {
 	lower := strings.ToLower(key)
 	for _, keyword := range sensitiveKeyWords {
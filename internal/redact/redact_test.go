@@ -1,6 +1,11 @@
 package redact
 
-import "testing"
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
 
 func TestString(t *testing.T) {
 	t.Parallel()
@@ -100,3 +105,73 @@ func TestValueStruct(t *testing.T) {
 		t.Fatalf("expected struct token redacted, got %v", got["access_token"])
 	}
 }
+
+func TestRawJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "redacts keys and token values in nested documents",
+			input: `{"a": [1, 2.50, true, null, "x abc"], "secret": {"deep": [1]}, "b": {"password": "p", "url": "https://x?access_token=abc"}, "c": []}`,
+			want:  `{"a":[1,2.50,true,null,"x [REDACTED]"],"secret":"[REDACTED]","b":{"password":"[REDACTED]","url":"https://x?access_token=[REDACTED]"},"c":[]}`,
+		},
+		{name: "keeps scalars", input: `42`, want: `42`},
+		{name: "keeps empty input", input: ``, want: ``},
+		{name: "replaces invalid json", input: `{"a":`, want: `"[REDACTED]"`},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := RawJSON(json.RawMessage(tc.input), "abc")
+			if string(got) != tc.want {
+				t.Fatalf("RawJSON() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValueRedactsNestedRawMessages(t *testing.T) {
+	t.Parallel()
+
+	got := Value(map[string]any{"raw": json.RawMessage(`{"token":"abc"}`)}, "abc").(map[string]any)
+	if string(got["raw"].(json.RawMessage)) != `{"token":"[REDACTED]"}` {
+		t.Fatalf("expected nested raw message redacted, got %s", got["raw"])
+	}
+}
+
+func largeRedactPayload(frameCount int) json.RawMessage {
+	frames := make([]string, 0, frameCount)
+	for index := range frameCount {
+		frames = append(frames, fmt.Sprintf(`{"filename":"app.go","lineno":%d,"vars":{"access_token":"abc","user":"u%d"}}`, index, index))
+	}
+
+	return json.RawMessage(`{"trace":{"frames":[` + strings.Join(frames, ",") + `]}}`)
+}
+
+func BenchmarkRawJSONLargePayload(b *testing.B) {
+	payload := largeRedactPayload(5000)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = RawJSON(payload, "abc")
+	}
+}
+
+func BenchmarkValueLargePayload(b *testing.B) {
+	var decoded any
+	if err := json.Unmarshal(largeRedactPayload(5000), &decoded); err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = Value(decoded, "abc")
+	}
+}
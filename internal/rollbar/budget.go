@@ -0,0 +1,56 @@
+package rollbar
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+const DefaultRequestBudget = 1000
+
+var ErrRequestBudgetExhausted = errors.New("request budget exhausted")
+
+type requestBudget struct {
+	limit     atomic.Int64
+	used      atomic.Int64
+	exhausted atomic.Bool
+}
+
+func (b *requestBudget) reset(limit int) {
+	b.limit.Store(int64(limit))
+	b.used.Store(0)
+	b.exhausted.Store(false)
+}
+
+func (b *requestBudget) take(op string) error {
+	limit := b.limit.Load()
+	if limit <= 0 {
+		b.used.Add(1)
+		return nil
+	}
+	if b.used.Add(1) > limit {
+		b.used.Add(-1)
+		b.exhausted.Store(true)
+		return fmt.Errorf("rollbar %s: %w after %d requests", op, ErrRequestBudgetExhausted, limit)
+	}
+
+	return nil
+}
+
+type RequestBudgetUsage struct {
+	Limit     int
+	Used      int
+	Exhausted bool
+}
+
+func (b *requestBudget) usage() RequestBudgetUsage {
+	return RequestBudgetUsage{Limit: int(b.limit.Load()), Used: int(b.used.Load()), Exhausted: b.exhausted.Load()}
+}
+
+func SetDefaultRequestBudget(limit int) {
+	defaultClientFactory().SetRequestBudget(limit)
+}
+
+func DefaultRequestBudgetUsage() RequestBudgetUsage {
+	return defaultClientFactory().RequestBudgetUsage()
+}
@@ -0,0 +1,55 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientFactoryRequestBudget(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(0)
+	client, err := factory.New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	factory.SetRequestBudget(2)
+	for range 2 {
+		if _, err := client.GetProject(context.Background(), 1); err != nil {
+			t.Fatalf("GetProject() error = %v", err)
+		}
+	}
+	_, err = client.GetProject(context.Background(), 1)
+	if !errors.Is(err, ErrRequestBudgetExhausted) {
+		t.Fatalf("expected budget error, got %v", err)
+	}
+	if got := factory.RequestBudgetUsage(); got != (RequestBudgetUsage{Limit: 2, Used: 2, Exhausted: true}) {
+		t.Fatalf("unexpected usage: %+v", got)
+	}
+	if served.Load() != 2 {
+		t.Fatalf("expected refused request not to reach the server, served %d", served.Load())
+	}
+
+	factory.SetRequestBudget(0)
+	for range 3 {
+		if _, err := client.GetProject(context.Background(), 1); err != nil {
+			t.Fatalf("GetProject() with budget disabled error = %v", err)
+		}
+	}
+	if got := factory.RequestBudgetUsage(); got != (RequestBudgetUsage{Used: 3}) {
+		t.Fatalf("unexpected usage after reset: %+v", got)
+	}
+}
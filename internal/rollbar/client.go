@@ -2,16 +2,16 @@ package rollbar
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
-	"net"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/kevinsheth/rollbaz/internal/domain"
@@ -24,6 +24,13 @@ const maxResponseBodyBytes = 4 << 20
 
 type Client struct {
 	http        *http.Client
+	budget      *requestBudget
+	retry       *retryPolicy
+	quota       *quotaTracker
+	responses   *responseCacheSlot
+	strict      *atomic.Bool
+	shapes      *shapeLog
+	headers     http.Header
 	baseURL     string
 	accessToken string
 }
@@ -39,34 +46,18 @@ func New(accessToken string) (*Client, error) {
 }
 
 func NewWithBaseURL(accessToken string, baseURL string) (*Client, error) {
-	if strings.TrimSpace(accessToken) == "" {
-		return nil, errors.New("rollbar access token is required")
-	}
-
-	transport := &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
-		DialContext:           (&net.Dialer{Timeout: 3 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
-		MaxIdleConns:          100,
-		MaxIdleConnsPerHost:   100,
-		IdleConnTimeout:       90 * time.Second,
-		TLSHandshakeTimeout:   5 * time.Second,
-		ExpectContinueTimeout: 1 * time.Second,
-	}
+	return defaultClientFactory().New(accessToken, baseURL)
+}
 
-	httpClient := &http.Client{
-		Timeout:   8 * time.Second,
-		Transport: transport,
+func (c *Client) SetHeaders(headers map[string]string) {
+	c.headers = make(http.Header, len(headers))
+	for name, value := range headers {
+		c.headers.Set(name, value)
 	}
-
-	return &Client{
-		http:        httpClient,
-		baseURL:     baseURL,
-		accessToken: accessToken,
-	}, nil
 }
 
 func (c *Client) ResolveItemIDByCounter(ctx context.Context, counter domain.ItemCounter) (domain.ItemID, error) {
-	raw, err := c.getResult(ctx, "/item_by_counter/"+counter.String(), "item_by_counter")
+	raw, err := c.getCachedResult(ctx, "/item_by_counter/"+counter.String(), "item_by_counter")
 	if err != nil {
 		return 0, err
 	}
@@ -80,12 +71,19 @@ func (c *Client) ResolveItemIDByCounter(ctx context.Context, counter domain.Item
 	if err != nil {
 		return 0, c.wrap(err, "resolve item_id")
 	}
+	observed := shapeItemIDField
+	if result.ItemID == 0 {
+		observed = shapeIDField
+	}
+	if err := c.observeShape("item_by_counter", "id field", shapeItemIDField, observed); err != nil {
+		return 0, err
+	}
 
 	return resolvedID, nil
 }
 
 func (c *Client) GetItem(ctx context.Context, itemID domain.ItemID) (Item, error) {
-	raw, err := c.getResult(ctx, "/item/"+itemID.String()+"/", "item")
+	raw, err := c.getCachedResult(ctx, "/item/"+itemID.String()+"/", "item")
 	if err != nil {
 		return Item{}, err
 	}
@@ -94,6 +92,9 @@ func (c *Client) GetItem(ctx context.Context, itemID domain.ItemID) (Item, error
 	if err := json.Unmarshal(raw, &item); err != nil {
 		return Item{}, c.wrap(err, "decode item response")
 	}
+	if err := c.checkItems("item", []Item{item}); err != nil {
+		return Item{}, err
+	}
 	item.Raw = append(json.RawMessage(nil), raw...)
 
 	return item, nil
@@ -115,18 +116,36 @@ func (c *Client) UpdateItem(ctx context.Context, itemID domain.ItemID, patch Ite
 		return c.wrap(err, "decode update item envelope")
 	}
 	if envelope.Err != 0 {
-		message := envelope.Message
-		if message == "" {
-			message = "unknown error from Rollbar"
-		}
-		return c.wrap(errors.New(message), "rollbar update item")
+		return c.apiError("update item", 0, envelope.Err, envelope.Message)
+	}
+
+	return nil
+}
+
+func (c *Client) AddItemComment(ctx context.Context, itemID domain.ItemID, text string) error {
+	body, err := json.Marshal(map[string]string{"text": text})
+	if err != nil {
+		return c.wrap(err, "encode item comment request")
+	}
+
+	raw, err := c.doPost(ctx, "/item/"+itemID.String()+"/comments", body, "add item comment")
+	if err != nil {
+		return err
+	}
+
+	var envelope apiEnvelope
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return c.wrap(err, "decode item comment envelope")
+	}
+	if envelope.Err != 0 {
+		return c.apiError("add item comment", 0, envelope.Err, envelope.Message)
 	}
 
 	return nil
 }
 
 func (c *Client) ListActiveItems(ctx context.Context, limit int) ([]Item, error) {
-	raw, err := c.getResult(ctx, "/reports/top_active_items", "top active items")
+	raw, err := c.getCachedResult(ctx, "/reports/top_active_items", "top active items")
 	if err != nil {
 		return nil, err
 	}
@@ -135,102 +154,126 @@ func (c *Client) ListActiveItems(ctx context.Context, limit int) ([]Item, error)
 	if err := json.Unmarshal(raw, &wrapped); err == nil {
 		items := make([]Item, 0, len(wrapped))
 		for _, entry := range wrapped {
-			items = append(items, hydrateItem(entry.Item, "active"))
+			items = append(items, hydrateItem(entry.Item, domain.StatusActive))
+		}
+		if err := c.observeShape("top active items", "envelope", shapeWrappedItems, shapeWrappedItems); err != nil {
+			return nil, err
+		}
+		if err := c.checkItems("top active items", items); err != nil {
+			return nil, err
 		}
 		return trimItems(items, limit), nil
 	}
 
-	items, err := parseItems(raw)
+	items, bare, err := parseItems(raw)
 	if err != nil {
 		return nil, c.wrap(err, "decode top active items")
 	}
+	if err := c.observeShape("top active items", "envelope", shapeWrappedItems, listShape(bare, shapeItemsObject)); err != nil {
+		return nil, err
+	}
 
 	return trimItems(items, limit), nil
 }
 
-func (c *Client) ListItems(ctx context.Context, status string, page int) ([]Item, error) {
-	query := "/items"
-	params := make([]string, 0, 2)
-	if status != "" {
-		params = append(params, "status="+url.QueryEscape(status))
+type TopActiveQuery struct {
+	Hours        int
+	Environments []string
+}
+
+type TopActiveItem struct {
+	Item   Item
+	Counts []uint64
+}
+
+func (c *Client) GetTopActiveItems(ctx context.Context, query TopActiveQuery) ([]TopActiveItem, error) {
+	params := url.Values{}
+	if query.Hours > 0 {
+		params.Set("hours", strconv.Itoa(query.Hours))
 	}
-	if page > 0 {
-		params = append(params, "page="+strconv.Itoa(page))
+	if len(query.Environments) > 0 {
+		params.Set("environments", strings.Join(query.Environments, ","))
 	}
+	endpoint := "/reports/top_active_items"
 	if len(params) > 0 {
-		query += "?" + strings.Join(params, "&")
+		endpoint += "?" + params.Encode()
 	}
 
-	raw, err := c.getResult(ctx, query, "items")
+	raw, err := c.getCachedResult(ctx, endpoint, "top active items")
 	if err != nil {
 		return nil, err
 	}
+	var wrapped []topActiveItem
+	if err := json.Unmarshal(raw, &wrapped); err != nil {
+		return nil, c.wrap(err, "decode top active items")
+	}
 
-	items, err := parseItems(raw)
-	if err != nil {
-		return nil, c.wrap(err, "decode items response")
+	entries := make([]TopActiveItem, 0, len(wrapped))
+	items := make([]Item, 0, len(wrapped))
+	for _, entry := range wrapped {
+		item := hydrateItem(entry.Item, domain.StatusActive)
+		entries = append(entries, TopActiveItem{Item: item, Counts: entry.Counts})
+		items = append(items, item)
+	}
+	if err := c.checkItems("top active items", items); err != nil {
+		return nil, err
 	}
 
-	return items, nil
+	return entries, nil
 }
 
-func (c *Client) GetLatestInstance(ctx context.Context, itemID domain.ItemID) (*ItemInstance, error) {
-	raw, err := c.getResult(ctx, "/item/"+itemID.String()+"/instances?per_page=1", "item instances")
-	if err != nil {
-		return nil, err
-	}
+const ItemsPageSize = 100
 
-	instances, err := parseInstances(raw)
-	if err != nil {
-		return nil, c.wrap(err, "decode instances response")
+func (c *Client) ListItems(ctx context.Context, status domain.Status, page int) ([]Item, error) {
+	query := "/items"
+	params := make([]string, 0, 2)
+	if status != "" {
+		params = append(params, "status="+url.QueryEscape(status.String()))
 	}
-
-	if len(instances) == 0 {
-		return nil, nil
+	if page > 0 {
+		params = append(params, "page="+strconv.Itoa(page))
+	}
+	if len(params) > 0 {
+		query += "?" + strings.Join(params, "&")
 	}
 
-	last := instances[len(instances)-1]
-	return &last, nil
-}
-
-func parseInstances(raw json.RawMessage) ([]ItemInstance, error) {
-	var list []ItemInstance
-	if err := json.Unmarshal(raw, &list); err == nil {
-		for index := range list {
-			list[index] = hydrateInstance(list[index])
-		}
-		return list, nil
+	raw, err := c.getCachedResult(ctx, query, "items")
+	if err != nil {
+		return nil, err
 	}
 
-	var wrapped instancesEnvelope
-	if err := json.Unmarshal(raw, &wrapped); err != nil {
-		return nil, fmt.Errorf("decode wrapped instances: %w", err)
+	items, bare, err := parseItems(raw)
+	if err != nil {
+		return nil, c.wrap(err, "decode items response")
 	}
-	for index := range wrapped.Instances {
-		wrapped.Instances[index] = hydrateInstance(wrapped.Instances[index])
+	if err := c.observeShape("items", "envelope", shapeItemsObject, listShape(bare, shapeItemsObject)); err != nil {
+		return nil, err
+	}
+	if err := c.checkItems("items", items); err != nil {
+		return nil, err
 	}
 
-	return wrapped.Instances, nil
+	return items, nil
 }
 
-func parseItems(raw json.RawMessage) ([]Item, error) {
+func parseItems(raw json.RawMessage) ([]Item, bool, error) {
 	var list []Item
 	if err := json.Unmarshal(raw, &list); err == nil {
 		for index := range list {
 			list[index] = hydrateItem(list[index], "")
 		}
-		return list, nil
+		return list, true, nil
 	}
 
 	var wrapped itemsEnvelope
 	if err := json.Unmarshal(raw, &wrapped); err != nil {
-		return nil, fmt.Errorf("decode wrapped items: %w", err)
+		return nil, false, fmt.Errorf("decode wrapped items: %w", err)
 	}
 	for index := range wrapped.Items {
 		wrapped.Items[index] = hydrateItem(wrapped.Items[index], "")
 	}
 
-	return wrapped.Items, nil
+	return wrapped.Items, false, nil
 }
 
 func trimItems(items []Item, limit int) []Item {
@@ -241,7 +284,7 @@ func trimItems(items []Item, limit int) []Item {
 	return items[:limit]
 }
 
-func hydrateItem(item Item, defaultStatus string) Item {
+func hydrateItem(item Item, defaultStatus domain.Status) Item {
 	if defaultStatus != "" && item.Status == "" {
 		item.Status = defaultStatus
 	}
@@ -272,21 +315,21 @@ func (c *Client) getResult(ctx context.Context, endpointPath string, op string)
 		return nil, err
 	}
 
+	return c.decodeResult(body, op)
+}
+
+func (c *Client) decodeResult(body []byte, op string) (json.RawMessage, error) {
 	var envelope apiEnvelope
 	if err := json.Unmarshal(body, &envelope); err != nil {
 		return nil, c.wrap(err, "decode "+op+" envelope")
 	}
 
 	if envelope.Err != 0 {
-		message := envelope.Message
-		if message == "" {
-			message = "unknown error from Rollbar"
-		}
-		return nil, c.wrap(errors.New(message), "rollbar "+op)
+		return nil, c.apiError(op, 0, envelope.Err, envelope.Message)
 	}
 
 	if len(envelope.Result) == 0 || string(envelope.Result) == "null" {
-		return nil, c.wrap(errors.New("missing result"), op+" response")
+		return nil, &APIError{Op: op, Message: "missing result", Kind: ErrNoData}
 	}
 
 	return envelope.Result, nil
@@ -300,22 +343,54 @@ func (c *Client) doPatch(ctx context.Context, endpointPath string, body []byte,
 	return c.doRequest(ctx, http.MethodPatch, endpointPath, bytes.NewReader(body), "application/json", op)
 }
 
+func (c *Client) doPost(ctx context.Context, endpointPath string, body []byte, op string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, endpointPath, bytes.NewReader(body), "application/json", op)
+}
+
 func (c *Client) doRequest(ctx context.Context, method string, endpointPath string, requestBody io.Reader, contentType string, op string) ([]byte, error) {
 	requestURL, err := buildURL(c.baseURL, endpointPath)
 	if err != nil {
 		return nil, c.wrap(err, "build "+op+" URL")
 	}
 
+	if method != http.MethodGet {
+		defer c.responses.invalidate()
+	}
+
+	attempts := c.retry.attempts(method)
+	for attempt := 0; ; attempt++ {
+		body, err := c.send(ctx, method, requestURL, requestBody, contentType, op)
+		if err == nil || attempt+1 >= attempts || !retryable(ctx, err) {
+			return body, err
+		}
+		if c.retry.wait(ctx, c.retry.retryDelay(err, attempt)) != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, method string, requestURL string, requestBody io.Reader, contentType string, op string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, method, requestURL, requestBody)
 	if err != nil {
 		return nil, c.wrap(err, "build "+op+" request")
 	}
 
+	for name, values := range c.headers {
+		req.Header[name] = values
+	}
 	req.Header.Set("X-Rollbar-Access-Token", c.accessToken)
+	req.Header.Set("Accept-Encoding", "gzip")
 	if contentType != "" {
 		req.Header.Set("Content-Type", contentType)
 	}
 
+	if err := c.awaitQuota(ctx, op); err != nil {
+		return nil, err
+	}
+	if err := c.budget.take(op); err != nil {
+		return nil, err
+	}
+
 	response, err := c.http.Do(req)
 	if err != nil {
 		return nil, c.wrap(err, "request "+op)
@@ -323,13 +398,39 @@ func (c *Client) doRequest(ctx context.Context, method string, endpointPath stri
 	defer func() {
 		_ = response.Body.Close()
 	}()
+	c.quota.observe(c.accessToken, response.Header)
+
+	return c.readResponse(response, op)
+}
+
+func (c *Client) awaitQuota(ctx context.Context, op string) error {
+	delay := c.quota.delay(c.accessToken)
+	if delay == 0 {
+		return nil
+	}
+	if c.retry.wait(ctx, delay) != nil {
+		apiErr := c.apiError(op, http.StatusTooManyRequests, 0, fmt.Sprintf("rate limit window spent for another %s", delay.Round(time.Second)))
+		apiErr.RetryAfter = delay
+		return apiErr
+	}
+
+	return nil
+}
+
+func (c *Client) readResponse(response *http.Response, op string) ([]byte, error) {
+	body, err := decodedBody(response)
+	if err != nil {
+		return nil, c.wrap(err, "read "+op+" response")
+	}
 
 	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
-		limited, _ := io.ReadAll(io.LimitReader(response.Body, 2048))
-		return nil, c.wrap(fmt.Errorf("status %d: %s", response.StatusCode, strings.TrimSpace(string(limited))), op+" returned non-success status")
+		limited, _ := io.ReadAll(io.LimitReader(body, 2048))
+		apiErr := c.apiError(op, response.StatusCode, 0, errorMessageFromBody(limited))
+		apiErr.RetryAfter = retryAfter(response.Header, time.Now())
+		return nil, apiErr
 	}
 
-	responseBody, err := io.ReadAll(io.LimitReader(response.Body, maxResponseBodyBytes+1))
+	responseBody, err := io.ReadAll(io.LimitReader(body, maxResponseBodyBytes+1))
 	if err != nil {
 		return nil, c.wrap(err, "read "+op+" response")
 	}
@@ -340,6 +441,21 @@ func (c *Client) doRequest(ctx context.Context, method string, endpointPath stri
 	return responseBody, nil
 }
 
+// Setting Accept-Encoding turns off the transport's transparent
+// decompression, so the size cap applies to the decoded payload here.
+func decodedBody(response *http.Response) (io.Reader, error) {
+	if !strings.EqualFold(strings.TrimSpace(response.Header.Get("Content-Encoding")), "gzip") {
+		return response.Body, nil
+	}
+
+	reader, err := gzip.NewReader(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("decompress gzip body: %w", err)
+	}
+
+	return reader, nil
+}
+
 func buildURL(baseURL string, endpointPath string) (string, error) {
 	parsed, err := url.Parse(baseURL)
 	if err != nil {
@@ -373,5 +489,9 @@ func (c *Client) wrap(err error, operation string) error {
 		return nil
 	}
 
-	return fmt.Errorf("%s: %s", operation, redact.String(err.Error(), c.accessToken))
+	return fmt.Errorf("%s: %s", operation, c.redact(err.Error()))
+}
+
+func (c *Client) redact(value string) string {
+	return redact.String(value, c.accessToken)
 }
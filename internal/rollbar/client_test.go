@@ -1,12 +1,16 @@
 package rollbar
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -131,6 +135,35 @@ func TestUpdateItemEnvelopeError(t *testing.T) {
 	}
 }
 
+func TestAddItemComment(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/item/1755568172/comments" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if body["text"] != "GitHub issue: https://github.com/acme/api/issues/42" {
+			t.Fatalf("unexpected comment payload: %v", body)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{}}`)
+	})
+
+	if err := client.AddItemComment(context.Background(), domain.ItemID(1755568172), "GitHub issue: https://github.com/acme/api/issues/42"); err != nil {
+		t.Fatalf("AddItemComment() error = %v", err)
+	}
+
+	denied := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":1,"message":"denied"}`)
+	})
+	if err := denied.AddItemComment(context.Background(), domain.ItemID(1), "note"); err == nil {
+		t.Fatalf("expected envelope error")
+	}
+}
+
 func TestGetLatestInstanceSupportsListAndWrapped(t *testing.T) {
 	t.Parallel()
 
@@ -294,7 +327,7 @@ func TestBuildURLInvalidBase(t *testing.T) {
 func TestParseInstancesInvalid(t *testing.T) {
 	t.Parallel()
 
-	if _, err := parseInstances([]byte(`123`)); err == nil {
+	if _, _, err := parseInstances([]byte(`123`)); err == nil {
 		t.Fatalf("expected parse error")
 	}
 }
@@ -397,7 +430,7 @@ func TestListItemsStatusAndPageQuery(t *testing.T) {
 func TestParseItemsInvalid(t *testing.T) {
 	t.Parallel()
 
-	if _, err := parseItems([]byte(`123`)); err == nil {
+	if _, _, err := parseItems([]byte(`123`)); err == nil {
 		t.Fatalf("expected parse error")
 	}
 }
@@ -438,3 +471,200 @@ func newTestClientWithHandler(t *testing.T, handler func(http.ResponseWriter, *h
 
 	return newTestClient(t, server.URL)
 }
+
+func TestListInstancesPageQuery(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/item/7/instances" || r.URL.RawQuery != "page=2&per_page=50" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"instances":[{"id":1},{"id":2}]}}`)
+	})
+
+	instances, err := client.ListInstances(context.Background(), domain.ItemID(7), InstanceListOptions{Page: 2, PerPage: 50})
+	if err != nil {
+		t.Fatalf("ListInstances() error = %v", err)
+	}
+	if len(instances) != 2 {
+		t.Fatalf("unexpected instances: %+v", instances)
+	}
+}
+
+func TestAPIErrorsAreTyped(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{name: "unauthorized status", status: http.StatusUnauthorized, body: `{"err":1,"message":"invalid access token"}`, want: ErrUnauthorized},
+		{name: "forbidden status", status: http.StatusForbidden, body: `nope`, want: ErrForbidden},
+		{name: "not found status", status: http.StatusNotFound, body: `{"err":1,"message":"Not found"}`, want: ErrNotFound},
+		{name: "rate limited status", status: http.StatusTooManyRequests, body: ``, want: ErrRateLimited},
+		{name: "envelope invalid token", status: http.StatusOK, body: `{"err":1,"message":"invalid access token"}`, want: ErrUnauthorized},
+		{name: "envelope insufficient scope", status: http.StatusOK, body: `{"err":1,"message":"insufficient privileges"}`, want: ErrForbidden},
+		{name: "missing result", status: http.StatusOK, body: `{"err":0,"result":null}`, want: ErrNoData},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = fmt.Fprint(w, tc.body)
+			})
+			_, err := client.GetItem(context.Background(), domain.ItemID(1))
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("GetItem() error = %v, want %v", err, tc.want)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) || apiErr.Op != "item" {
+				t.Fatalf("expected APIError for item op, got %#v", err)
+			}
+		})
+	}
+}
+
+func TestAPIErrorRedactsToken(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = fmt.Fprint(w, `{"err":1,"message":"invalid access token token"}`)
+	})
+	_, err := client.GetItem(context.Background(), domain.ItemID(1))
+	if err == nil || strings.Contains(err.Error(), "token token") {
+		t.Fatalf("expected redacted error, got %v", err)
+	}
+}
+
+func gzipBytes(t *testing.T, value string) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(value)); err != nil {
+		t.Fatalf("gzip write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip close: %v", err)
+	}
+
+	return buffer.Bytes()
+}
+
+func TestClientDecompressesGzipResponses(t *testing.T) {
+	t.Parallel()
+
+	compressed := gzipBytes(t, `{"err":0,"result":{"items":[{"id":1,"counter":7,"title":"zipped"}]}}`)
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Fatalf("expected Accept-Encoding: gzip, got %q", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		_, _ = w.Write(compressed)
+	})
+
+	items, err := client.ListItems(context.Background(), "active", 1)
+	if err != nil {
+		t.Fatalf("ListItems() error = %v", err)
+	}
+	if len(items) != 1 || items[0].Title != "zipped" {
+		t.Fatalf("unexpected items: %+v", items)
+	}
+}
+
+func TestClientGzipErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		status  int
+		body    func(t *testing.T) []byte
+		wantErr error
+		wantMsg string
+	}{
+		{
+			name:    "decompresses error bodies",
+			status:  http.StatusNotFound,
+			body:    func(t *testing.T) []byte { return gzipBytes(t, `{"err":1,"message":"Item not found"}`) },
+			wantErr: ErrNotFound,
+			wantMsg: "Item not found",
+		},
+		{
+			name:    "rejects corrupt gzip",
+			status:  http.StatusOK,
+			body:    func(t *testing.T) []byte { return []byte("not gzip") },
+			wantMsg: "decompress gzip body",
+		},
+		{
+			name:    "caps decompressed size",
+			status:  http.StatusOK,
+			body:    func(t *testing.T) []byte { return gzipBytes(t, strings.Repeat(" ", maxResponseBodyBytes+1)) },
+			wantMsg: "response exceeds",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			body := tc.body(t)
+			client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Encoding", "gzip")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write(body)
+			})
+
+			_, err := client.GetItem(context.Background(), domain.ItemID(1))
+			if err == nil || !strings.Contains(err.Error(), tc.wantMsg) {
+				t.Fatalf("GetItem() error = %v, want message containing %q", err, tc.wantMsg)
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Fatalf("GetItem() error = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestClientSendsConfiguredHeaders(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "acme" || r.Header.Get("X-Rollbar-Access-Token") != "token" {
+			t.Fatalf("unexpected headers %v", r.Header)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+	})
+	client.SetHeaders(map[string]string{"x-tenant": "acme", "X-Rollbar-Access-Token": "spoofed"})
+
+	if _, err := client.GetProject(context.Background(), 1); err != nil {
+		t.Fatalf("GetProject() error = %v", err)
+	}
+}
+
+func TestGetTopActiveItems(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/top_active_items" || r.URL.RawQuery != "environments=production%2Cstaging&hours=48" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":[{"item":{"id":1,"counter":7,"title":"boom"},"counts":[1,2,3]}]}`)
+	})
+
+	entries, err := client.GetTopActiveItems(context.Background(), TopActiveQuery{Hours: 48, Environments: []string{"production", "staging"}})
+	if err != nil {
+		t.Fatalf("GetTopActiveItems() error = %v", err)
+	}
+	if len(entries) != 1 || entries[0].Item.Counter != 7 || entries[0].Item.Status != domain.StatusActive || !reflect.DeepEqual(entries[0].Counts, []uint64{1, 2, 3}) {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
@@ -0,0 +1,112 @@
+package rollbar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+type conditionalEntry struct {
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+type conditionalTransport struct {
+	base    http.RoundTripper
+	enabled atomic.Bool
+	mu      sync.Mutex
+	entries map[string]conditionalEntry
+}
+
+func newConditionalTransport(base http.RoundTripper) *conditionalTransport {
+	return &conditionalTransport{base: base, entries: map[string]conditionalEntry{}}
+}
+
+func (t *conditionalTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.enabled.Load() || req.Method != http.MethodGet {
+		return t.base.RoundTrip(req) //nolint:wrapcheck // RoundTrippers must return transport errors unchanged
+	}
+
+	key := conditionalKey(req)
+	t.mu.Lock()
+	entry, cached := t.entries[key]
+	t.mu.Unlock()
+
+	if cached {
+		req = req.Clone(req.Context())
+		if entry.etag != "" {
+			req.Header.Set("If-None-Match", entry.etag)
+		}
+		if entry.lastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.lastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err //nolint:wrapcheck // RoundTrippers must return transport errors unchanged
+	}
+	if cached && resp.StatusCode == http.StatusNotModified {
+		_ = resp.Body.Close()
+		return replayResponse(req, entry), nil
+	}
+
+	return t.store(key, resp), nil
+}
+
+func (t *conditionalTransport) store(key string, resp *http.Response) *http.Response {
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "") {
+		return resp
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyBytes+1))
+	if err != nil || len(body) > maxResponseBodyBytes {
+		resp.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), resp.Body), Closer: resp.Body}
+		return resp
+	}
+	_ = resp.Body.Close()
+
+	t.mu.Lock()
+	t.entries[key] = conditionalEntry{etag: etag, lastModified: lastModified, header: resp.Header.Clone(), body: body}
+	t.mu.Unlock()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return resp
+}
+
+func replayResponse(req *http.Request, entry conditionalEntry) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        entry.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(entry.body)),
+		ContentLength: int64(len(entry.body)),
+		Request:       req,
+	}
+}
+
+func conditionalKey(req *http.Request) string {
+	tokenHash := sha256.Sum256([]byte(req.Header.Get("X-Rollbar-Access-Token")))
+
+	return hex.EncodeToString(tokenHash[:]) + " " + req.URL.String()
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func SetDefaultConditionalRequests(enabled bool) {
+	defaultClientFactory().SetConditionalRequests(enabled)
+}
@@ -0,0 +1,85 @@
+package rollbar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestConditionalTransportRevalidates(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		validator string
+		request   string
+	}{
+		{name: "etag", validator: "ETag", request: "If-None-Match"},
+		{name: "last modified", validator: "Last-Modified", request: "If-Modified-Since"},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var notModified atomic.Int32
+			client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get(tc.request) == "v1" {
+					notModified.Add(1)
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				w.Header().Set(tc.validator, "v1")
+				_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1,"title":"cached"}}`)
+			})
+			factory := NewClientFactory(0)
+			factory.SetConditionalRequests(true)
+			client.http = factory.http
+
+			for range 3 {
+				item, err := client.GetItem(context.Background(), domain.ItemID(1))
+				if err != nil || item.Title != "cached" {
+					t.Fatalf("GetItem() = %+v, %v", item, err)
+				}
+			}
+			if got := notModified.Load(); got != 2 {
+				t.Fatalf("expected two 304 revalidations, got %d", got)
+			}
+		})
+	}
+}
+
+func TestConditionalTransportPassThrough(t *testing.T) {
+	t.Parallel()
+
+	var conditional atomic.Int32
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") != "" {
+			conditional.Add(1)
+		}
+		w.Header().Set("ETag", "v1")
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+	})
+	factory := NewClientFactory(0)
+	client.http = factory.http
+
+	for range 2 {
+		if _, err := client.GetItem(context.Background(), domain.ItemID(1)); err != nil {
+			t.Fatalf("GetItem() error = %v", err)
+		}
+	}
+	factory.SetConditionalRequests(true)
+	for range 2 {
+		if err := client.UpdateItem(context.Background(), domain.ItemID(1), ItemPatch{Status: "active"}); err != nil {
+			t.Fatalf("UpdateItem() error = %v", err)
+		}
+	}
+	if got := conditional.Load(); got != 0 {
+		t.Fatalf("expected no conditional requests when disabled or for writes, got %d", got)
+	}
+}
@@ -0,0 +1,110 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type Deploy struct {
+	ID            uint64           `json:"id"`
+	ProjectID     domain.ProjectID `json:"project_id"`
+	Environment   string           `json:"environment"`
+	Revision      string           `json:"revision"`
+	LocalUsername string           `json:"local_username,omitempty"`
+	Comment       string           `json:"comment,omitempty"`
+	Status        string           `json:"status,omitempty"`
+	StartTime     *uint64          `json:"start_time,omitempty"`
+	FinishTime    *uint64          `json:"finish_time,omitempty"`
+}
+
+type deploysEnvelope struct {
+	Deploys []Deploy `json:"deploys"`
+}
+
+func (c *Client) ListDeploys(ctx context.Context, page int) ([]Deploy, error) {
+	query := "/deploys/"
+	if page > 0 {
+		query += "?page=" + strconv.Itoa(page)
+	}
+
+	raw, err := c.getResult(ctx, query, "deploys")
+	if err != nil {
+		return nil, err
+	}
+
+	deploys, bare, err := parseDeploys(raw)
+	if err != nil {
+		return nil, c.wrap(err, "decode deploys response")
+	}
+	if err := c.observeShape("deploys", "envelope", shapeDeploysObject, listShape(bare, shapeDeploysObject)); err != nil {
+		return nil, err
+	}
+
+	return deploys, nil
+}
+
+func parseDeploys(raw json.RawMessage) ([]Deploy, bool, error) {
+	var list []Deploy
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return list, true, nil
+	}
+
+	var wrapped deploysEnvelope
+	if err := json.Unmarshal(raw, &wrapped); err != nil {
+		return nil, false, fmt.Errorf("decode wrapped deploys: %w", err)
+	}
+
+	return wrapped.Deploys, false, nil
+}
+
+type NewDeploy struct {
+	Environment   string `json:"environment"`
+	Revision      string `json:"revision"`
+	LocalUsername string `json:"local_username,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	Status        string `json:"status,omitempty"`
+}
+
+type recordDeployEnvelope struct {
+	Err     int                `json:"err"`
+	Message string             `json:"message"`
+	Data    *recordDeployReply `json:"data"`
+	Result  *recordDeployReply `json:"result"`
+}
+
+type recordDeployReply struct {
+	DeployID uint64 `json:"deploy_id"`
+}
+
+func (c *Client) RecordDeploy(ctx context.Context, deploy NewDeploy) (uint64, error) {
+	body, err := json.Marshal(deploy)
+	if err != nil {
+		return 0, c.wrap(err, "encode record deploy request")
+	}
+
+	raw, err := c.doPost(ctx, "/deploy", body, "record deploy")
+	if err != nil {
+		return 0, err
+	}
+
+	var envelope recordDeployEnvelope
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return 0, c.wrap(err, "decode record deploy envelope")
+	}
+	if envelope.Err != 0 {
+		return 0, c.apiError("record deploy", 0, envelope.Err, envelope.Message)
+	}
+	reply := envelope.Data
+	if reply == nil {
+		reply = envelope.Result
+	}
+	if reply == nil || reply.DeployID == 0 {
+		return 0, &APIError{Op: "record deploy", Message: "missing deploy_id", Kind: ErrNoData}
+	}
+
+	return reply.DeployID, nil
+}
@@ -0,0 +1,88 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestListDeploys(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/deploys/" || r.URL.RawQuery != "page=2" {
+			t.Fatalf("unexpected request: %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"deploys":[{"id":9,"environment":"production","revision":"abc123","start_time":1700000000}]}}`)
+	})
+
+	deploys, err := client.ListDeploys(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("ListDeploys() error = %v", err)
+	}
+	if len(deploys) != 1 || deploys[0].Revision != "abc123" || deploys[0].StartTime == nil {
+		t.Fatalf("unexpected deploys: %+v", deploys)
+	}
+}
+
+func TestParseDeploysShapes(t *testing.T) {
+	t.Parallel()
+
+	list, bare, err := parseDeploys([]byte(`[{"id":1,"revision":"a"}]`))
+	if err != nil || len(list) != 1 || !bare {
+		t.Fatalf("parseDeploys(list) = %+v, bare=%v, err=%v", list, bare, err)
+	}
+	if _, _, err := parseDeploys([]byte(`123`)); err == nil {
+		t.Fatalf("expected parse error")
+	}
+}
+
+func TestRecordDeploy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		reply   string
+		want    uint64
+		wantErr string
+	}{
+		{name: "data envelope", reply: `{"data":{"deploy_id":41}}`, want: 41},
+		{name: "result envelope", reply: `{"err":0,"result":{"deploy_id":42}}`, want: 42},
+		{name: "api error", reply: `{"err":1,"message":"invalid environment"}`, wantErr: "invalid environment"},
+		{name: "missing id", reply: `{"err":0,"result":{}}`, wantErr: "missing deploy_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]any
+				if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+					t.Fatalf("decode body: %v", err)
+				}
+				if r.Method != http.MethodPost || r.URL.Path != "/deploy" || body["revision"] != "abc123" || body["environment"] != "production" {
+					t.Fatalf("unexpected request: %s %s %v", r.Method, r.URL.Path, body)
+				}
+				if _, ok := body["comment"]; ok {
+					t.Fatalf("expected empty comment to be omitted: %v", body)
+				}
+				_, _ = fmt.Fprint(w, tt.reply)
+			})
+
+			id, err := client.RecordDeploy(context.Background(), NewDeploy{Environment: "production", Revision: "abc123"})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("RecordDeploy() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || id != tt.want {
+				t.Fatalf("RecordDeploy() = %d, %v, want %d", id, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package rollbar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnauthorized = errors.New("access token rejected")
+	ErrForbidden    = errors.New("access token lacks required scope")
+	ErrNotFound     = errors.New("resource not found")
+	ErrNoData       = errors.New("no data returned")
+	ErrRateLimited  = errors.New("rate limited")
+)
+
+type APIError struct {
+	Op         string
+	StatusCode int
+	Code       int
+	Message    string
+	Kind       error
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("rollbar %s: status %d: %s", e.Op, e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("rollbar %s: %s", e.Op, e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Kind
+}
+
+func classifyAPIError(statusCode int, message string) error {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	}
+
+	return classifyAPIMessage(message)
+}
+
+func classifyAPIMessage(message string) error {
+	lower := strings.ToLower(message)
+	switch {
+	case strings.Contains(lower, "invalid access token"), strings.Contains(lower, "access token not found"):
+		return ErrUnauthorized
+	case strings.Contains(lower, "insufficient"), strings.Contains(lower, "not authorized"), strings.Contains(lower, "scope"):
+		return ErrForbidden
+	case strings.Contains(lower, "not found"):
+		return ErrNotFound
+	case strings.Contains(lower, "rate limit"):
+		return ErrRateLimited
+	}
+
+	return nil
+}
+
+func (c *Client) apiError(op string, statusCode int, code int, message string) *APIError {
+	if strings.TrimSpace(message) == "" {
+		message = "unknown error from Rollbar"
+	}
+
+	return &APIError{
+		Op:         op,
+		StatusCode: statusCode,
+		Code:       code,
+		Message:    c.redact(message),
+		Kind:       classifyAPIError(statusCode, message),
+	}
+}
+
+func errorMessageFromBody(body []byte) string {
+	var envelope apiEnvelope
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Message != "" {
+		return envelope.Message
+	}
+
+	return strings.TrimSpace(string(body))
+}
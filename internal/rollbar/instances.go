@@ -0,0 +1,151 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type InstanceListOptions struct {
+	Page    int
+	PerPage int
+	LastID  domain.OccurrenceID
+}
+
+type InstanceLister interface {
+	ListInstances(ctx context.Context, itemID domain.ItemID, opts InstanceListOptions) ([]ItemInstance, error)
+}
+
+func (c *Client) GetLatestInstance(ctx context.Context, itemID domain.ItemID) (*ItemInstance, error) {
+	instances, err := c.ListInstances(ctx, itemID, InstanceListOptions{PerPage: 1})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(instances) == 0 {
+		return nil, nil
+	}
+
+	last := instances[len(instances)-1]
+	return &last, nil
+}
+
+func (c *Client) GetInstance(ctx context.Context, occurrenceID domain.OccurrenceID) (ItemInstance, error) {
+	raw, err := c.getCachedResult(ctx, "/instance/"+occurrenceID.String(), "instance")
+	if err != nil {
+		return ItemInstance{}, err
+	}
+
+	var instance ItemInstance
+	if err := json.Unmarshal(raw, &instance); err != nil {
+		return ItemInstance{}, c.wrap(err, "decode instance response")
+	}
+	if err := c.checkInstances("instance", []ItemInstance{instance}); err != nil {
+		return ItemInstance{}, err
+	}
+
+	return hydrateInstance(instance), nil
+}
+
+func (c *Client) ListInstances(ctx context.Context, itemID domain.ItemID, opts InstanceListOptions) ([]ItemInstance, error) {
+	query := "/item/" + itemID.String() + "/instances"
+	if params := opts.queryParams(); len(params) > 0 {
+		query += "?" + strings.Join(params, "&")
+	}
+
+	raw, err := c.getResult(ctx, query, "item instances")
+	if err != nil {
+		return nil, err
+	}
+
+	instances, bare, err := parseInstances(raw)
+	if err != nil {
+		return nil, c.wrap(err, "decode instances response")
+	}
+	if err := c.observeShape("item instances", "envelope", shapeInstancesObject, listShape(bare, shapeInstancesObject)); err != nil {
+		return nil, err
+	}
+	if err := c.checkInstances("item instances", instances); err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
+func (c *Client) Instances(ctx context.Context, itemID domain.ItemID, opts InstanceListOptions) iter.Seq2[ItemInstance, error] {
+	return IterateInstances(ctx, c, itemID, opts)
+}
+
+func IterateInstances(ctx context.Context, lister InstanceLister, itemID domain.ItemID, opts InstanceListOptions) iter.Seq2[ItemInstance, error] {
+	return func(yield func(ItemInstance, error) bool) {
+		if opts.LastID == 0 && opts.Page <= 0 {
+			opts.Page = 1
+		}
+		for {
+			instances, err := lister.ListInstances(ctx, itemID, opts)
+			if err != nil {
+				yield(ItemInstance{}, err)
+				return
+			}
+			for _, instance := range instances {
+				if !yield(instance, nil) {
+					return
+				}
+			}
+			if len(instances) == 0 || (opts.PerPage > 0 && len(instances) < opts.PerPage) {
+				return
+			}
+			opts = opts.next(instances[len(instances)-1].ID)
+		}
+	}
+}
+
+func (o InstanceListOptions) next(lastID domain.OccurrenceID) InstanceListOptions {
+	if o.LastID > 0 {
+		o.LastID = lastID
+		return o
+	}
+	o.Page++
+
+	return o
+}
+
+func (o InstanceListOptions) queryParams() []string {
+	params := make([]string, 0, 3)
+	if o.Page > 0 {
+		params = append(params, "page="+strconv.Itoa(o.Page))
+	}
+	if o.PerPage > 0 {
+		params = append(params, "per_page="+strconv.Itoa(o.PerPage))
+	}
+	if o.LastID > 0 {
+		params = append(params, "lastId="+o.LastID.String())
+	}
+
+	return params
+}
+
+func parseInstances(raw json.RawMessage) ([]ItemInstance, bool, error) {
+	var list []ItemInstance
+	if err := json.Unmarshal(raw, &list); err == nil {
+		for index := range list {
+			list[index] = hydrateInstance(list[index])
+		}
+		return list, true, nil
+	}
+
+	var wrapped instancesEnvelope
+	if err := json.Unmarshal(raw, &wrapped); err != nil {
+		return nil, false, fmt.Errorf("decode wrapped instances: %w", err)
+	}
+	for index := range wrapped.Instances {
+		wrapped.Instances[index] = hydrateInstance(wrapped.Instances[index])
+	}
+
+	return wrapped.Instances, false, nil
+}
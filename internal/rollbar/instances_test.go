@@ -0,0 +1,117 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestGetInstance(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/instance/412" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":412,"timestamp":1700000000,"data":{"code_version":"v2"}}}`)
+	})
+
+	instance, err := client.GetInstance(context.Background(), 412)
+	if err != nil {
+		t.Fatalf("GetInstance() error = %v", err)
+	}
+	if instance.ID != 412 || string(instance.Data) != `{"code_version":"v2"}` || len(instance.Raw) == 0 {
+		t.Fatalf("unexpected instance: %+v", instance)
+	}
+}
+
+func TestInstancesIteratesPages(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]string{
+		"page=1&per_page=2": `{"err":0,"result":{"instances":[{"id":10},{"id":9}]}}`,
+		"page=2&per_page=2": `{"err":0,"result":{"instances":[{"id":8},{"id":7}]}}`,
+		"page=3&per_page=2": `{"err":0,"result":{"instances":[{"id":6}]}}`,
+	}
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := pages[r.URL.RawQuery]
+		if !ok {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, body)
+	})
+
+	got := collectInstanceIDs(t, client.Instances(context.Background(), domain.ItemID(1), InstanceListOptions{PerPage: 2}))
+	if want := []domain.OccurrenceID{10, 9, 8, 7, 6}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ids = %v, want %v", got, want)
+	}
+}
+
+func TestInstancesIteratesLastIDCursor(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]string{
+		"per_page=2&lastId=100": `{"err":0,"result":[{"id":99},{"id":98}]}`,
+		"per_page=2&lastId=98":  `{"err":0,"result":[]}`,
+	}
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := pages[r.URL.RawQuery]
+		if !ok {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, body)
+	})
+
+	got := collectInstanceIDs(t, client.Instances(context.Background(), domain.ItemID(1), InstanceListOptions{PerPage: 2, LastID: 100}))
+	if want := []domain.OccurrenceID{99, 98}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("ids = %v, want %v", got, want)
+	}
+}
+
+func TestInstancesStopsEarlyAndSurfacesErrors(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"instances":[{"id":2},{"id":1}]}}`)
+	})
+
+	for range client.Instances(context.Background(), domain.ItemID(1), InstanceListOptions{PerPage: 2}) {
+		break
+	}
+	if requests != 1 {
+		t.Fatalf("expected a single request after break, got %d", requests)
+	}
+
+	var iterErr error
+	for _, err := range client.Instances(context.Background(), domain.ItemID(1), InstanceListOptions{PerPage: 2}) {
+		iterErr = err
+	}
+	if iterErr == nil || errors.Is(iterErr, ErrNoData) {
+		t.Fatalf("expected request error, got %v", iterErr)
+	}
+}
+
+func collectInstanceIDs(t *testing.T, seq func(func(ItemInstance, error) bool)) []domain.OccurrenceID {
+	t.Helper()
+
+	ids := make([]domain.OccurrenceID, 0)
+	for instance, err := range seq {
+		if err != nil {
+			t.Fatalf("iterate instances: %v", err)
+		}
+		ids = append(ids, instance.ID)
+	}
+
+	return ids
+}
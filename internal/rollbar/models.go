@@ -9,40 +9,90 @@ import (
 )
 
 type Item struct {
-	ID                      domain.ItemID   `json:"id"`
-	ProjectID               uint64          `json:"project_id"`
-	Counter                 uint64          `json:"counter"`
-	Title                   string          `json:"title"`
-	Status                  string          `json:"status"`
-	Environment             string          `json:"environment"`
-	Level                   string          `json:"level"`
-	LastOccurrenceID        *uint64         `json:"last_occurrence_id"`
-	LastOccurrenceTimestamp *uint64         `json:"last_occurrence_timestamp"`
-	Occurrences             *uint64         `json:"occurrences"`
-	TotalOccurrences        *uint64         `json:"total_occurrences"`
-	Raw                     json.RawMessage `json:"-"`
+	ID                        domain.ItemID        `json:"id"`
+	ProjectID                 domain.ProjectID     `json:"project_id"`
+	Counter                   uint64               `json:"counter"`
+	Title                     string               `json:"title"`
+	Status                    domain.Status        `json:"status"`
+	Environment               string               `json:"environment"`
+	Level                     domain.Level         `json:"level"`
+	LastOccurrenceID          *domain.OccurrenceID `json:"last_occurrence_id"`
+	LastOccurrenceTimestamp   *uint64              `json:"last_occurrence_timestamp"`
+	FirstOccurrenceTimestamp  *uint64              `json:"first_occurrence_timestamp"`
+	LastActivatedTimestamp    *uint64              `json:"last_activated_timestamp"`
+	Occurrences               *uint64              `json:"occurrences"`
+	TotalOccurrences          *uint64              `json:"total_occurrences"`
+	SnoozeEnabled             bool                 `json:"snooze_enabled"`
+	SnoozeEnabledTimestamp    *uint64              `json:"snooze_enabled_timestamp"`
+	SnoozeExpirationInSeconds *uint64              `json:"snooze_expiration_in_seconds"`
+	AssignedUserID            *uint64              `json:"assigned_user_id"`
+	Raw                       json.RawMessage      `json:"-"`
+
+	idAsString bool
+}
+
+func (i Item) SnoozeExpiresAt() *uint64 {
+	if !i.SnoozeEnabled || i.SnoozeEnabledTimestamp == nil || i.SnoozeExpirationInSeconds == nil {
+		return nil
+	}
+
+	expiresAt := *i.SnoozeEnabledTimestamp + *i.SnoozeExpirationInSeconds
+	return &expiresAt
 }
 
 type ItemPatch struct {
-	Status                    string `json:"status,omitempty"`
-	ResolvedInVersion         string `json:"resolved_in_version,omitempty"`
-	SnoozeEnabled             *bool  `json:"snooze_enabled,omitempty"`
-	SnoozeExpirationInSeconds *int64 `json:"snooze_expiration_in_seconds,omitempty"`
+	Status                    domain.Status `json:"status,omitempty"`
+	Level                     domain.Level  `json:"level,omitempty"`
+	ResolvedInVersion         string        `json:"resolved_in_version,omitempty"`
+	SnoozeEnabled             *bool         `json:"snooze_enabled,omitempty"`
+	SnoozeExpirationInSeconds *int64        `json:"snooze_expiration_in_seconds,omitempty"`
+	AssignedUserID            *uint64       `json:"assigned_user_id,omitempty"`
+	// Unassign sends "assigned_user_id": null, which omitempty cannot.
+	Unassign bool `json:"-"`
+}
+
+func (p ItemPatch) MarshalJSON() ([]byte, error) {
+	type plainPatch ItemPatch
+	body, err := json.Marshal(plainPatch(p))
+	if err != nil {
+		return nil, fmt.Errorf("encode item patch: %w", err)
+	}
+	if !p.Unassign {
+		return body, nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("decode item patch: %w", err)
+	}
+	fields["assigned_user_id"] = json.RawMessage("null")
+	body, err = json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("encode item patch: %w", err)
+	}
+
+	return body, nil
 }
 
 func (i *Item) UnmarshalJSON(data []byte) error {
 	type itemDTO struct {
-		ID                      flexibleUint64 `json:"id"`
-		ProjectID               uint64         `json:"project_id"`
-		Counter                 uint64         `json:"counter"`
-		Title                   string         `json:"title"`
-		Status                  string         `json:"status"`
-		Environment             string         `json:"environment"`
-		Level                   flexibleLevel  `json:"level"`
-		LastOccurrenceID        *uint64        `json:"last_occurrence_id"`
-		LastOccurrenceTimestamp *uint64        `json:"last_occurrence_timestamp"`
-		Occurrences             *uint64        `json:"occurrences"`
-		TotalOccurrences        *uint64        `json:"total_occurrences"`
+		ID                        flexibleUint64       `json:"id"`
+		ProjectID                 domain.ProjectID     `json:"project_id"`
+		Counter                   uint64               `json:"counter"`
+		Title                     string               `json:"title"`
+		Status                    string               `json:"status"`
+		Environment               string               `json:"environment"`
+		Level                     flexibleLevel        `json:"level"`
+		LastOccurrenceID          *domain.OccurrenceID `json:"last_occurrence_id"`
+		LastOccurrenceTimestamp   *uint64              `json:"last_occurrence_timestamp"`
+		FirstOccurrenceTimestamp  *uint64              `json:"first_occurrence_timestamp"`
+		LastActivatedTimestamp    *uint64              `json:"last_activated_timestamp"`
+		Occurrences               *uint64              `json:"occurrences"`
+		TotalOccurrences          *uint64              `json:"total_occurrences"`
+		SnoozeEnabled             bool                 `json:"snooze_enabled"`
+		SnoozeEnabledTimestamp    *uint64              `json:"snooze_enabled_timestamp"`
+		SnoozeExpirationInSeconds *uint64              `json:"snooze_expiration_in_seconds"`
+		AssignedUserID            *uint64              `json:"assigned_user_id"`
 	}
 
 	var dto itemDTO
@@ -50,27 +100,34 @@ func (i *Item) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("decode item json: %w", err)
 	}
 
-	i.ID = domain.ItemID(dto.ID)
+	i.ID = domain.ItemID(dto.ID.value)
+	i.idAsString = dto.ID.quoted
 	i.ProjectID = dto.ProjectID
 	i.Counter = dto.Counter
 	i.Title = dto.Title
-	i.Status = dto.Status
+	i.Status = domain.Status(dto.Status)
 	i.Environment = dto.Environment
-	i.Level = string(dto.Level)
+	i.Level = domain.Level(dto.Level)
 	i.LastOccurrenceID = dto.LastOccurrenceID
 	i.LastOccurrenceTimestamp = dto.LastOccurrenceTimestamp
+	i.FirstOccurrenceTimestamp = dto.FirstOccurrenceTimestamp
+	i.LastActivatedTimestamp = dto.LastActivatedTimestamp
 	i.Occurrences = dto.Occurrences
 	i.TotalOccurrences = dto.TotalOccurrences
+	i.SnoozeEnabled = dto.SnoozeEnabled
+	i.SnoozeEnabledTimestamp = dto.SnoozeEnabledTimestamp
+	i.SnoozeExpirationInSeconds = dto.SnoozeExpirationInSeconds
+	i.AssignedUserID = dto.AssignedUserID
 
 	return nil
 }
 
 type ItemInstance struct {
-	ID        uint64          `json:"id"`
-	Timestamp *uint64         `json:"timestamp"`
-	Body      json.RawMessage `json:"body"`
-	Data      json.RawMessage `json:"data"`
-	Raw       json.RawMessage `json:"-"`
+	ID        domain.OccurrenceID `json:"id"`
+	Timestamp *uint64             `json:"timestamp"`
+	Body      json.RawMessage     `json:"body"`
+	Data      json.RawMessage     `json:"data"`
+	Raw       json.RawMessage     `json:"-"`
 }
 
 type itemByCounterResult struct {
@@ -99,10 +156,14 @@ type itemsEnvelope struct {
 }
 
 type topActiveItem struct {
-	Item Item `json:"item"`
+	Item   Item     `json:"item"`
+	Counts []uint64 `json:"counts"`
 }
 
-type flexibleUint64 uint64
+type flexibleUint64 struct {
+	value  uint64
+	quoted bool
+}
 
 type flexibleLevel string
 
@@ -123,27 +184,14 @@ func (v *flexibleLevel) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("decode level: %w", err)
 	}
 
-	switch levelNumber {
-	case 10:
-		*v = "debug"
-	case 20:
-		*v = "info"
-	case 30:
-		*v = "warning"
-	case 40:
-		*v = "error"
-	case 50:
-		*v = "critical"
-	default:
-		*v = flexibleLevel(strconv.Itoa(levelNumber))
-	}
+	*v = flexibleLevel(domain.LevelFromNumber(levelNumber))
 
 	return nil
 }
 
 func (v *flexibleUint64) UnmarshalJSON(data []byte) error {
 	if len(data) == 0 || string(data) == "null" {
-		*v = 0
+		*v = flexibleUint64{}
 		return nil
 	}
 
@@ -152,7 +200,7 @@ func (v *flexibleUint64) UnmarshalJSON(data []byte) error {
 		if err != nil {
 			return fmt.Errorf("parse string uint64: %w", err)
 		}
-		*v = flexibleUint64(parsed)
+		*v = flexibleUint64{value: parsed, quoted: true}
 		return nil
 	}
 
@@ -161,6 +209,6 @@ func (v *flexibleUint64) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("decode uint64: %w", err)
 	}
 
-	*v = flexibleUint64(parsed)
+	*v = flexibleUint64{value: parsed}
 	return nil
 }
@@ -30,9 +30,9 @@ func TestFlexibleUnmarshalJSON(t *testing.T) {
 		input string
 		want  flexibleUint64
 	}{
-		"string uint":  {input: `"123"`, want: 123},
-		"numeric uint": {input: `456`, want: 456},
-		"null uint":    {input: `null`, want: 0},
+		"string uint":  {input: `"123"`, want: flexibleUint64{value: 123, quoted: true}},
+		"numeric uint": {input: `456`, want: flexibleUint64{value: 456}},
+		"null uint":    {input: `null`, want: flexibleUint64{}},
 	}
 	for name, tc := range uintCases {
 		var value flexibleUint64
@@ -40,7 +40,7 @@ func TestFlexibleUnmarshalJSON(t *testing.T) {
 			t.Fatalf("%s: unmarshal error = %v", name, err)
 		}
 		if value != tc.want {
-			t.Fatalf("%s: value = %d, want %d", name, value, tc.want)
+			t.Fatalf("%s: value = %+v, want %+v", name, value, tc.want)
 		}
 	}
 }
@@ -53,3 +53,46 @@ func TestFlexibleUint64UnmarshalJSONInvalid(t *testing.T) {
 		t.Fatalf("expected parse error")
 	}
 }
+
+func TestItemSnoozeExpiresAt(t *testing.T) {
+	t.Parallel()
+
+	var item Item
+	if err := json.Unmarshal([]byte(`{"id":1,"snooze_enabled":true,"snooze_enabled_timestamp":100,"snooze_expiration_in_seconds":50}`), &item); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	expiresAt := item.SnoozeExpiresAt()
+	if expiresAt == nil || *expiresAt != 150 {
+		t.Fatalf("SnoozeExpiresAt() = %v, want 150", expiresAt)
+	}
+
+	item.SnoozeEnabled = false
+	if item.SnoozeExpiresAt() != nil {
+		t.Fatalf("expected nil expiry when snooze disabled")
+	}
+}
+
+func TestItemPatchMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	userID := uint64(7)
+	tests := []struct {
+		name  string
+		patch ItemPatch
+		want  string
+	}{
+		{name: "assign", patch: ItemPatch{AssignedUserID: &userID}, want: `{"assigned_user_id":7}`},
+		{name: "unassign", patch: ItemPatch{Unassign: true}, want: `{"assigned_user_id":null}`},
+		{name: "status only", patch: ItemPatch{Status: "resolved"}, want: `{"status":"resolved"}`},
+	}
+
+	for _, tt := range tests {
+		body, err := json.Marshal(tt.patch)
+		if err != nil {
+			t.Fatalf("%s: Marshal() error = %v", tt.name, err)
+		}
+		if string(body) != tt.want {
+			t.Fatalf("%s: Marshal() = %s, want %s", tt.name, body, tt.want)
+		}
+	}
+}
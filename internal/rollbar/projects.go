@@ -0,0 +1,29 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type Project struct {
+	ID          domain.ProjectID `json:"id"`
+	Name        string           `json:"name"`
+	AccountID   uint64           `json:"account_id"`
+	AccountSlug string           `json:"account_slug,omitempty"`
+}
+
+func (c *Client) GetProject(ctx context.Context, projectID domain.ProjectID) (Project, error) {
+	raw, err := c.getResult(ctx, "/project/"+projectID.String(), "project")
+	if err != nil {
+		return Project{}, err
+	}
+
+	var project Project
+	if err := json.Unmarshal(raw, &project); err != nil {
+		return Project{}, c.wrap(err, "decode project response")
+	}
+
+	return project, nil
+}
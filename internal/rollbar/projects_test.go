@@ -0,0 +1,40 @@
+package rollbar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetProject(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/project/42" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":42,"name":"checkout","account_id":7,"account_slug":"acme"}}`)
+	})
+
+	project, err := client.GetProject(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("GetProject() error = %v", err)
+	}
+	if project != (Project{ID: 42, Name: "checkout", AccountID: 7, AccountSlug: "acme"}) {
+		t.Fatalf("unexpected project: %+v", project)
+	}
+}
+
+func TestGetProjectErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, body := range []string{`{"err":1,"message":"nope"}`, `{"err":0,"result":"not an object"}`} {
+		client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprint(w, body)
+		})
+		if _, err := client.GetProject(context.Background(), 1); err == nil {
+			t.Fatalf("expected error for %s", body)
+		}
+	}
+}
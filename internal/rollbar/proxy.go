@@ -0,0 +1,65 @@
+package rollbar
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+)
+
+type proxySlot struct {
+	url atomic.Pointer[url.URL]
+}
+
+func (p *proxySlot) proxy(req *http.Request) (*url.URL, error) {
+	if proxyURL := p.url.Load(); proxyURL != nil {
+		return proxyURL, nil
+	}
+
+	return http.ProxyFromEnvironment(req)
+}
+
+func ParseProxyURL(raw string) (*url.URL, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	proxyURL, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", redactProxyError(err))
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q: use http, https, socks5, or socks5h", proxyURL.Scheme)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("proxy URL %q has no host", proxyURL.Redacted())
+	}
+
+	return proxyURL, nil
+}
+
+func redactProxyError(err error) error {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return urlErr.Err
+	}
+
+	return err
+}
+
+func (f *ClientFactory) SetProxy(proxyURL *url.URL) {
+	f.proxy.url.Store(proxyURL)
+}
+
+func SetDefaultProxy(proxyURL *url.URL) {
+	defaultClientFactory().SetProxy(proxyURL)
+}
+
+func DefaultProxy(req *http.Request) (*url.URL, error) {
+	return defaultClientFactory().proxy.proxy(req)
+}
@@ -0,0 +1,78 @@
+package rollbar
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Guards against the local clock and X-Rate-Limit-Reset disagreeing.
+const maxQuotaWait = time.Minute
+
+type RateLimitQuota struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+type quotaTracker struct {
+	mu     sync.Mutex
+	tokens map[string]RateLimitQuota
+	last   RateLimitQuota
+	known  bool
+	now    func() time.Time
+}
+
+func newQuotaTracker() *quotaTracker {
+	return &quotaTracker{tokens: map[string]RateLimitQuota{}, now: time.Now}
+}
+
+func (q *quotaTracker) observe(token string, header http.Header) {
+	remaining, err := strconv.Atoi(strings.TrimSpace(header.Get("X-Rate-Limit-Remaining")))
+	if err != nil {
+		return
+	}
+	quota := RateLimitQuota{Remaining: remaining}
+	if limit, err := strconv.Atoi(strings.TrimSpace(header.Get("X-Rate-Limit-Limit"))); err == nil {
+		quota.Limit = limit
+	}
+	if reset, err := strconv.ParseInt(strings.TrimSpace(header.Get("X-Rate-Limit-Reset")), 10, 64); err == nil {
+		quota.Reset = time.Unix(reset, 0)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.tokens[token] = quota
+	q.last = quota
+	q.known = true
+}
+
+func (q *quotaTracker) delay(token string) time.Duration {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	quota, ok := q.tokens[token]
+	if !ok || quota.Remaining > 0 || quota.Reset.IsZero() {
+		return 0
+	}
+
+	return min(max(quota.Reset.Sub(q.now()), 0), maxQuotaWait)
+}
+
+func (q *quotaTracker) latest() (RateLimitQuota, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.last, q.known
+}
+
+func (f *ClientFactory) RateLimitQuota() (RateLimitQuota, bool) {
+	return f.quota.latest()
+}
+
+func DefaultRateLimitQuota() (RateLimitQuota, bool) {
+	return defaultClientFactory().RateLimitQuota()
+}
@@ -0,0 +1,133 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestQuotaTracker(t *testing.T) {
+	t.Parallel()
+
+	clock := time.Unix(1_700_000_000, 0)
+	tracker := newQuotaTracker()
+	tracker.now = func() time.Time { return clock }
+
+	if _, ok := tracker.latest(); ok {
+		t.Fatalf("expected no quota before any response")
+	}
+	tracker.observe("a", http.Header{"X-Rate-Limit-Remaining": {"oops"}})
+	if _, ok := tracker.latest(); ok {
+		t.Fatalf("expected unparsable headers to be ignored")
+	}
+
+	reset := strconv.FormatInt(clock.Add(20*time.Second).Unix(), 10)
+	tracker.observe("a", http.Header{"X-Rate-Limit-Limit": {"5000"}, "X-Rate-Limit-Remaining": {"0"}, "X-Rate-Limit-Reset": {reset}})
+	tracker.observe("b", http.Header{"X-Rate-Limit-Remaining": {"12"}, "X-Rate-Limit-Reset": {reset}})
+
+	tests := []struct {
+		token string
+		want  time.Duration
+	}{
+		{token: "a", want: 20 * time.Second},
+		{token: "b"},
+		{token: "unseen"},
+	}
+	for _, tc := range tests {
+		if got := tracker.delay(tc.token); got != tc.want {
+			t.Fatalf("delay(%q) = %v, want %v", tc.token, got, tc.want)
+		}
+	}
+
+	quota, ok := tracker.latest()
+	if !ok || quota.Remaining != 12 || quota.Limit != 0 || !quota.Reset.Equal(clock.Add(20*time.Second)) {
+		t.Fatalf("unexpected latest quota %+v", quota)
+	}
+
+	far := strconv.FormatInt(clock.Add(time.Hour).Unix(), 10)
+	tracker.observe("a", http.Header{"X-Rate-Limit-Remaining": {"0"}, "X-Rate-Limit-Reset": {far}})
+	if got := tracker.delay("a"); got != maxQuotaWait {
+		t.Fatalf("expected the wait capped at %v, got %v", maxQuotaWait, got)
+	}
+}
+
+func TestClientWaitsOutSpentRateLimitWindow(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		w.Header().Set("X-Rate-Limit-Limit", "100")
+		w.Header().Set("X-Rate-Limit-Remaining", "0")
+		w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(time.Now().Add(30*time.Second).Unix(), 10))
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(0)
+	client, err := factory.New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := client.GetProject(context.Background(), 1); err != nil {
+		t.Fatalf("GetProject() error = %v", err)
+	}
+	if quota, ok := factory.RateLimitQuota(); !ok || quota.Limit != 100 || quota.Remaining != 0 {
+		t.Fatalf("unexpected quota %+v", quota)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := client.GetProject(ctx, 1); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected a spent window to fail fast, got %v", err)
+	}
+	if served.Load() != 1 {
+		t.Fatalf("expected no request while the window is spent, served %d", served.Load())
+	}
+
+	other, err := factory.New("other-token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := other.GetProject(ctx, 1); err != nil {
+		t.Fatalf("expected another token's window to stay open, got %v", err)
+	}
+}
+
+func TestClientRequestsPerMinute(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(0)
+	factory.SetRetries(0)
+	factory.SetRequestsPerMinute(6)
+	client, err := factory.New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := client.GetProject(context.Background(), 1); err != nil {
+		t.Fatalf("GetProject() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.GetProject(ctx, 1); err == nil || !strings.Contains(err.Error(), "wait for rate limiter") {
+		t.Fatalf("expected the second request to wait for the per-minute bucket, got %v", err)
+	}
+
+	factory.SetRequestsPerMinute(0)
+	if _, err := client.GetProject(context.Background(), 1); err != nil {
+		t.Fatalf("expected no per-minute cap once disabled, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package rollbar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const DefaultRequestsPerSecond = 20
+
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	limiter := &rateLimiter{now: time.Now}
+	limiter.setRate(requestsPerSecond)
+
+	return limiter
+}
+
+func (l *rateLimiter) setRate(requestsPerSecond float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	rate := max(requestsPerSecond, 0)
+	if rate == l.rate && !l.last.IsZero() {
+		return
+	}
+	l.rate = rate
+	l.tokens = l.burst()
+	l.last = l.now()
+}
+
+func (l *rateLimiter) burst() float64 {
+	return max(l.rate, 1)
+}
+
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("wait for rate limiter: %w", ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate == 0 {
+		return 0
+	}
+
+	now := l.now()
+	l.tokens = min(l.burst(), l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+type rateLimitedTransport struct {
+	base      http.RoundTripper
+	limiter   *rateLimiter
+	perMinute *rateLimiter
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	if t.perMinute != nil {
+		if err := t.perMinute.wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.base.RoundTrip(req) //nolint:wrapcheck // RoundTrippers must return transport errors unchanged
+}
+
+func SetDefaultRequestsPerMinute(requestsPerMinute int) {
+	defaultClientFactory().SetRequestsPerMinute(requestsPerMinute)
+}
+
+func SetDefaultRequestRate(requestsPerSecond float64) {
+	defaultClientFactory().SetRequestRate(requestsPerSecond)
+}
@@ -0,0 +1,87 @@
+package rollbar
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestRateLimiter(requestsPerSecond float64) (*rateLimiter, *time.Time) {
+	clock := time.Unix(1_700_000_000, 0)
+	limiter := &rateLimiter{now: func() time.Time { return clock }}
+	limiter.setRate(requestsPerSecond)
+
+	return limiter, &clock
+}
+
+func TestRateLimiterReserve(t *testing.T) {
+	t.Parallel()
+
+	limiter, clock := newTestRateLimiter(2)
+	for index := range 2 {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("burst request %d delayed by %v", index, delay)
+		}
+	}
+	if delay := limiter.reserve(); delay != 500*time.Millisecond {
+		t.Fatalf("reserve() after burst = %v, want 500ms", delay)
+	}
+
+	*clock = clock.Add(500 * time.Millisecond)
+	if delay := limiter.reserve(); delay != 0 {
+		t.Fatalf("reserve() after refill = %v, want 0", delay)
+	}
+
+	limiter.setRate(2)
+	if delay := limiter.reserve(); delay == 0 {
+		t.Fatalf("setting the same rate must not refill the bucket")
+	}
+
+	limiter.setRate(0)
+	for range 5 {
+		if delay := limiter.reserve(); delay != 0 {
+			t.Fatalf("disabled limiter delayed request by %v", delay)
+		}
+	}
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	t.Parallel()
+
+	limiter := newRateLimiter(1000)
+	start := time.Now()
+	for range 1005 {
+		if err := limiter.wait(context.Background()); err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 4*time.Millisecond {
+		t.Fatalf("expected requests beyond the burst to be delayed, took %v", elapsed)
+	}
+
+	slow := newRateLimiter(0.001)
+	_ = slow.wait(context.Background())
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := slow.wait(ctx); err == nil {
+		t.Fatalf("expected context error while throttled")
+	}
+}
+
+func TestRateLimitedTransportSurfacesWaitErrors(t *testing.T) {
+	t.Parallel()
+
+	transport := &rateLimitedTransport{base: http.DefaultTransport, limiter: newRateLimiter(0.001)}
+	_ = transport.limiter.wait(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:1", nil)
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Fatalf("expected rate limiter error")
+	}
+
+	factory := NewClientFactory(0)
+	factory.SetRequestRate(0)
+}
@@ -0,0 +1,83 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type OccurrenceCountsQuery struct {
+	ItemID       domain.ItemID
+	Environment  string
+	MinTimestamp int64
+	MaxTimestamp int64
+	BucketSize   int
+}
+
+type OccurrenceCount struct {
+	Timestamp uint64 `json:"timestamp"`
+	Count     uint64 `json:"count"`
+}
+
+func (c *OccurrenceCount) UnmarshalJSON(data []byte) error {
+	var pair []uint64
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return fmt.Errorf("decode occurrence count: %w", err)
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("occurrence count must have 2 values, got %d", len(pair))
+	}
+
+	c.Timestamp = pair[0]
+	c.Count = pair[1]
+
+	return nil
+}
+
+func (c *Client) GetOccurrenceCounts(ctx context.Context, query OccurrenceCountsQuery) ([]OccurrenceCount, error) {
+	raw, err := c.getResult(ctx, "/reports/occurrence_counts?"+query.encode(), "occurrence counts")
+	if err != nil {
+		return nil, err
+	}
+
+	var counts []OccurrenceCount
+	if err := json.Unmarshal(raw, &counts); err != nil {
+		return nil, c.wrap(err, "decode occurrence counts")
+	}
+
+	return counts, nil
+}
+
+func (q OccurrenceCountsQuery) encode() string {
+	values := url.Values{}
+	for key, value := range q.Params() {
+		values.Set(key, value)
+	}
+
+	return values.Encode()
+}
+
+func (q OccurrenceCountsQuery) Params() map[string]string {
+	params := map[string]string{}
+	if q.ItemID != 0 {
+		params["item_id"] = q.ItemID.String()
+	}
+	if q.Environment != "" {
+		params["environment"] = q.Environment
+	}
+	if q.MinTimestamp > 0 {
+		params["min_ts"] = strconv.FormatInt(q.MinTimestamp, 10)
+	}
+	if q.MaxTimestamp > 0 {
+		params["max_ts"] = strconv.FormatInt(q.MaxTimestamp, 10)
+	}
+	if q.BucketSize > 0 {
+		params["bucket_size"] = strconv.Itoa(q.BucketSize)
+	}
+
+	return params
+}
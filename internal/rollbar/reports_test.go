@@ -0,0 +1,44 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGetOccurrenceCounts(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/occurrence_counts" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		want := "bucket_size=3600&environment=production&item_id=7&min_ts=100"
+		if r.URL.RawQuery != want {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":[[100,2],[3700,5]]}`)
+	})
+
+	counts, err := client.GetOccurrenceCounts(context.Background(), OccurrenceCountsQuery{ItemID: 7, Environment: "production", MinTimestamp: 100, BucketSize: 3600})
+	if err != nil {
+		t.Fatalf("GetOccurrenceCounts() error = %v", err)
+	}
+	if len(counts) != 2 || counts[1].Timestamp != 3700 || counts[1].Count != 5 {
+		t.Fatalf("unexpected counts: %+v", counts)
+	}
+}
+
+func TestOccurrenceCountUnmarshalJSONInvalid(t *testing.T) {
+	t.Parallel()
+
+	var count OccurrenceCount
+	if err := json.Unmarshal([]byte(`[1]`), &count); err == nil {
+		t.Fatalf("expected pair length error")
+	}
+	if err := json.Unmarshal([]byte(`{"x":1}`), &count); err == nil {
+		t.Fatalf("expected decode error")
+	}
+}
@@ -0,0 +1,90 @@
+package rollbar
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+type ResponseCache interface {
+	Get(key string, now time.Time) ([]byte, bool)
+	Put(key string, body []byte, now time.Time) error
+	Clear() (int, error)
+}
+
+type responseCacheSlot struct {
+	mu    sync.RWMutex
+	cache ResponseCache
+}
+
+type bypassCacheKey struct{}
+
+func WithoutResponseCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func (s *responseCacheSlot) get() ResponseCache {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.cache
+}
+
+func (s *responseCacheSlot) set(cache ResponseCache) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache = cache
+}
+
+func (s *responseCacheSlot) invalidate() {
+	if cache := s.get(); cache != nil {
+		_, _ = cache.Clear()
+	}
+}
+
+func (c *Client) getCachedResult(ctx context.Context, endpointPath string, op string) (json.RawMessage, error) {
+	cache := c.responses.get()
+	if cache == nil {
+		return c.getResult(ctx, endpointPath, op)
+	}
+
+	key := c.responseCacheKey(endpointPath)
+	// A bypassing read still refreshes the entry for later reads.
+	if bypass, _ := ctx.Value(bypassCacheKey{}).(bool); !bypass {
+		if body, ok := cache.Get(key, time.Now()); ok {
+			if raw, err := c.decodeResult(body, op); err == nil {
+				return raw, nil
+			}
+		}
+	}
+
+	body, err := c.doGet(ctx, endpointPath, op)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.decodeResult(body, op)
+	if err != nil {
+		return nil, err
+	}
+	_ = cache.Put(key, body, time.Now())
+
+	return raw, nil
+}
+
+func (c *Client) responseCacheKey(endpointPath string) string {
+	tokenHash := sha256.Sum256([]byte(c.accessToken))
+
+	return hex.EncodeToString(tokenHash[:]) + " " + c.baseURL + endpointPath
+}
+
+func (f *ClientFactory) SetResponseCache(cache ResponseCache) {
+	f.responses.set(cache)
+}
+
+func SetDefaultResponseCache(cache ResponseCache) {
+	defaultClientFactory().SetResponseCache(cache)
+}
@@ -0,0 +1,144 @@
+package rollbar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type memoryResponseCache struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+}
+
+func (m *memoryResponseCache) Get(key string, _ time.Time) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	body, ok := m.entries[key]
+
+	return body, ok
+}
+
+func (m *memoryResponseCache) Put(key string, body []byte, _ time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries[key] = body
+
+	return nil
+}
+
+func (m *memoryResponseCache) Clear() (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	removed := len(m.entries)
+	m.entries = map[string][]byte{}
+
+	return removed, nil
+}
+
+func TestClientServesItemReadsFromResponseCache(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		switch r.Method {
+		case http.MethodPatch:
+			_, _ = fmt.Fprint(w, `{"err":0}`)
+		case http.MethodGet:
+			if r.URL.Path == "/item/7/" && served.Load() == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":7,"counter":3,"title":"cached"}}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(0)
+	factory.SetRetries(0)
+	responses := &memoryResponseCache{entries: map[string][]byte{}}
+	factory.SetResponseCache(responses)
+	client, err := factory.New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := client.GetItem(context.Background(), domain.ItemID(7)); err == nil {
+		t.Fatalf("expected first GetItem() to fail")
+	}
+	if len(responses.entries) != 0 {
+		t.Fatalf("expected failed response not to be cached")
+	}
+	for range 2 {
+		if _, err := client.GetItem(context.Background(), domain.ItemID(7)); err != nil {
+			t.Fatalf("GetItem() error = %v", err)
+		}
+	}
+	if served.Load() != 2 {
+		t.Fatalf("expected the repeat read to come from cache, served %d", served.Load())
+	}
+
+	if err := client.UpdateItem(context.Background(), domain.ItemID(7), ItemPatch{Status: domain.StatusResolved}); err != nil {
+		t.Fatalf("UpdateItem() error = %v", err)
+	}
+	if len(responses.entries) != 0 {
+		t.Fatalf("expected a write to clear the cache, got %d entries", len(responses.entries))
+	}
+	if _, err := client.GetItem(context.Background(), domain.ItemID(7)); err != nil {
+		t.Fatalf("GetItem() after write error = %v", err)
+	}
+	if served.Load() != 4 {
+		t.Fatalf("expected a fresh read after the write, served %d", served.Load())
+	}
+
+	other, err := factory.New("other-token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := other.GetItem(context.Background(), domain.ItemID(7)); err != nil {
+		t.Fatalf("GetItem() with other token error = %v", err)
+	}
+	if served.Load() != 5 {
+		t.Fatalf("expected tokens not to share entries, served %d", served.Load())
+	}
+}
+
+func TestClientBypassesResponseCacheOnRequest(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"err":0,"result":{"id":7,"counter":3,"title":"read %d"}}`, served.Add(1))
+	}))
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(0)
+	factory.SetResponseCache(&memoryResponseCache{entries: map[string][]byte{}})
+	client, err := factory.New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	tests := []struct {
+		ctx  context.Context
+		want string
+	}{
+		{ctx: context.Background(), want: "read 1"},
+		{ctx: WithoutResponseCache(context.Background()), want: "read 2"},
+		{ctx: context.Background(), want: "read 2"},
+	}
+	for index, tc := range tests {
+		item, err := client.GetItem(tc.ctx, domain.ItemID(7))
+		if err != nil || item.Title != tc.want {
+			t.Fatalf("read %d: got %q, %v; want %q", index, item.Title, err, tc.want)
+		}
+	}
+}
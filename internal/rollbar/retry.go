@@ -0,0 +1,113 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const DefaultRetries = 2
+
+const (
+	defaultRetryDelay = 250 * time.Millisecond
+	maxRetryDelay     = 30 * time.Second
+)
+
+// Writes are never retried because Rollbar may already have applied them.
+type retryPolicy struct {
+	retries atomic.Int64
+	delay   time.Duration
+}
+
+func (p *retryPolicy) attempts(method string) int {
+	if method != http.MethodGet {
+		return 1
+	}
+
+	return 1 + int(max(p.retries.Load(), 0))
+}
+
+func (p *retryPolicy) backoff(attempt int) time.Duration {
+	delay := maxRetryDelay
+	if attempt < 16 {
+		delay = min(p.delay<<attempt, maxRetryDelay)
+	}
+	if delay <= 1 {
+		return delay
+	}
+	half := delay / 2
+
+	return half + rand.N(delay-half) //nolint:gosec // jitter needs no cryptographic randomness
+}
+
+func (p *retryPolicy) wait(ctx context.Context, delay time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return fmt.Errorf("wait %s to retry: %w", delay, context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait to retry: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (p *retryPolicy) retryDelay(err error, attempt int) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return min(apiErr.RetryAfter, maxRetryDelay)
+	}
+
+	return p.backoff(attempt)
+}
+
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, ErrRequestBudgetExhausted) {
+		return false
+	}
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+func retryAfter(header http.Header, now time.Time) time.Duration {
+	if value := strings.TrimSpace(header.Get("Retry-After")); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return time.Duration(max(seconds, 0)) * time.Second
+		}
+		if at, err := http.ParseTime(value); err == nil {
+			return max(at.Sub(now), 0)
+		}
+	}
+
+	if strings.TrimSpace(header.Get("X-Rate-Limit-Remaining")) != "0" {
+		return 0
+	}
+	reset, err := strconv.ParseInt(strings.TrimSpace(header.Get("X-Rate-Limit-Reset")), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return max(time.Unix(reset, 0).Sub(now), 0)
+}
+
+func (f *ClientFactory) SetRetries(retries int) {
+	f.retry.retries.Store(int64(retries))
+}
+
+func SetDefaultRetries(retries int) {
+	defaultClientFactory().SetRetries(retries)
+}
@@ -0,0 +1,205 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func newRetryTestClient(t *testing.T, retries int, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(0)
+	factory.retry.delay = time.Millisecond
+	factory.SetRetries(retries)
+	client, err := factory.New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return client
+}
+
+func TestClientRetriesTransientGetFailures(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	client := newRetryTestClient(t, 3, func(w http.ResponseWriter, r *http.Request) {
+		switch served.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+		}
+	})
+
+	if _, err := client.GetProject(context.Background(), 1); err != nil {
+		t.Fatalf("GetProject() error = %v", err)
+	}
+	if served.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", served.Load())
+	}
+}
+
+func TestClientRetriesGiveUp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		retries int
+		status  int
+		want    int32
+	}{
+		{name: "retries disabled", retries: 0, status: http.StatusServiceUnavailable, want: 1},
+		{name: "retries exhausted", retries: 2, status: http.StatusServiceUnavailable, want: 3},
+		{name: "client error", retries: 2, status: http.StatusNotFound, want: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var served atomic.Int32
+			client := newRetryTestClient(t, tc.retries, func(w http.ResponseWriter, r *http.Request) {
+				served.Add(1)
+				w.WriteHeader(tc.status)
+			})
+
+			var apiErr *APIError
+			if _, err := client.GetProject(context.Background(), 1); !errors.As(err, &apiErr) || apiErr.StatusCode != tc.status {
+				t.Fatalf("expected status %d error, got %v", tc.status, err)
+			}
+			if served.Load() != tc.want {
+				t.Fatalf("expected %d attempts, got %d", tc.want, served.Load())
+			}
+		})
+	}
+}
+
+func TestClientNeverRetriesWrites(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	client := newRetryTestClient(t, 3, func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if err := client.UpdateItem(context.Background(), domain.ItemID(1), ItemPatch{}); err == nil {
+		t.Fatalf("expected update error")
+	}
+	if served.Load() != 1 {
+		t.Fatalf("expected a single attempt, got %d", served.Load())
+	}
+}
+
+func TestClientHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	var retriedAt atomic.Int64
+	start := time.Now()
+	client := newRetryTestClient(t, 1, func(w http.ResponseWriter, r *http.Request) {
+		if served.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		retriedAt.Store(int64(time.Since(start)))
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+	})
+
+	if _, err := client.GetProject(context.Background(), 1); err != nil {
+		t.Fatalf("GetProject() error = %v", err)
+	}
+	if waited := time.Duration(retriedAt.Load()); waited < time.Second {
+		t.Fatalf("expected the retry to wait for Retry-After, waited %v", waited)
+	}
+}
+
+func TestClientGivesUpWhenRetryWouldOutliveContext(t *testing.T) {
+	t.Parallel()
+
+	var served atomic.Int32
+	client := newRetryTestClient(t, 3, func(w http.ResponseWriter, r *http.Request) {
+		served.Add(1)
+		w.Header().Set("Retry-After", "20")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	started := time.Now()
+	_, err := client.GetProject(ctx, 1)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("expected the rate limit error, got %v", err)
+	}
+	if served.Load() != 1 || time.Since(started) > time.Second {
+		t.Fatalf("expected an immediate give-up, served %d after %v", served.Load(), time.Since(started))
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		header map[string]string
+		want   time.Duration
+	}{
+		{name: "none"},
+		{name: "seconds", header: map[string]string{"Retry-After": "7"}, want: 7 * time.Second},
+		{name: "http date", header: map[string]string{"Retry-After": now.Add(90 * time.Second).Format(http.TimeFormat)}, want: 90 * time.Second},
+		{name: "past date", header: map[string]string{"Retry-After": now.Add(-time.Minute).Format(http.TimeFormat)}},
+		{name: "garbage", header: map[string]string{"Retry-After": "soon"}},
+		{name: "rate limit window spent", header: map[string]string{"X-Rate-Limit-Remaining": "0", "X-Rate-Limit-Reset": strconv.FormatInt(now.Add(12*time.Second).Unix(), 10)}, want: 12 * time.Second},
+		{name: "rate limit window open", header: map[string]string{"X-Rate-Limit-Remaining": "40", "X-Rate-Limit-Reset": strconv.FormatInt(now.Add(12*time.Second).Unix(), 10)}},
+		{name: "retry-after wins", header: map[string]string{"Retry-After": "3", "X-Rate-Limit-Remaining": "0", "X-Rate-Limit-Reset": strconv.FormatInt(now.Add(12*time.Second).Unix(), 10)}, want: 3 * time.Second},
+	}
+
+	for _, tc := range tests {
+		header := http.Header{}
+		for key, value := range tc.header {
+			header.Set(key, value)
+		}
+		if got := retryAfter(header, now); got != tc.want {
+			t.Fatalf("%s: retryAfter() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRetryBackoffIsJitteredAndCapped(t *testing.T) {
+	t.Parallel()
+
+	policy := &retryPolicy{delay: 100 * time.Millisecond}
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond} {
+		for range 20 {
+			if got := policy.backoff(attempt); got < want/2 || got >= want {
+				t.Fatalf("backoff(%d) = %v, want in [%v, %v)", attempt, got, want/2, want)
+			}
+		}
+	}
+	if got := policy.backoff(20); got >= maxRetryDelay || got < maxRetryDelay/2 {
+		t.Fatalf("expected backoff capped at %v, got %v", maxRetryDelay, got)
+	}
+	if got := policy.retryDelay(&APIError{StatusCode: http.StatusTooManyRequests, RetryAfter: time.Hour}, 0); got != maxRetryDelay {
+		t.Fatalf("expected Retry-After capped at %v, got %v", maxRetryDelay, got)
+	}
+	if got := NewClientFactory(0).retry.attempts(http.MethodGet); got != 1+DefaultRetries {
+		t.Fatalf("expected %d attempts by default, got %d", 1+DefaultRetries, got)
+	}
+}
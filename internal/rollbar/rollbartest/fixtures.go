@@ -0,0 +1,45 @@
+package rollbartest
+
+import (
+	"encoding/json"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func NewDefaultServer() *Server {
+	return NewServer(DefaultItems(), DefaultInstances())
+}
+
+func DefaultItems() []rollbar.Item {
+	return []rollbar.Item{
+		fixtureItem(1755568172, 269, "RST_STREAM closed stream", domain.StatusActive, "production", domain.LevelError, 1520, 1700000000),
+		fixtureItem(1755568200, 270, "nil map write", domain.StatusActive, "staging", domain.LevelCritical, 12, 1699990000),
+		fixtureItem(1755568300, 7, "timeout talking to billing", domain.StatusResolved, "production", domain.LevelWarning, 3, 1690000000),
+	}
+}
+
+func DefaultInstances() map[domain.ItemID][]rollbar.ItemInstance {
+	timestamp := uint64(1700000000)
+	return map[domain.ItemID][]rollbar.ItemInstance{
+		1755568172: {
+			{ID: 9001, Timestamp: &timestamp, Data: json.RawMessage(`{"body":{"trace":{"exception":{"class":"Error","message":"RST_STREAM closed stream"}}}}`)},
+			{ID: 9000, Timestamp: &timestamp, Data: json.RawMessage(`{"body":{"message":{"body":"RST_STREAM closed stream"}}}`)},
+		},
+	}
+}
+
+func fixtureItem(id domain.ItemID, counter uint64, title string, status domain.Status, environment string, level domain.Level, occurrences uint64, lastSeen uint64) rollbar.Item {
+	return rollbar.Item{
+		ID:                      id,
+		ProjectID:               12345,
+		Counter:                 counter,
+		Title:                   title,
+		Status:                  status,
+		Environment:             environment,
+		Level:                   level,
+		Occurrences:             &occurrences,
+		TotalOccurrences:        &occurrences,
+		LastOccurrenceTimestamp: &lastSeen,
+	}
+}
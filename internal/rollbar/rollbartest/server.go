@@ -0,0 +1,154 @@
+package rollbartest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+const Token = "rollbartest-token"
+
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	items     []rollbar.Item
+	instances map[domain.ItemID][]rollbar.ItemInstance
+}
+
+func NewServer(items []rollbar.Item, instances map[domain.ItemID][]rollbar.ItemInstance) *Server {
+	server := &Server{items: items, instances: instances}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+
+	return server
+}
+
+func (s *Server) APIURL() string {
+	return s.URL + "/api/1"
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Rollbar-Access-Token") != Token {
+		writeError(w, http.StatusUnauthorized, "invalid access token")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/1")
+	switch {
+	case path == "/items":
+		s.serveItems(w, r)
+	case path == "/reports/top_active_items":
+		s.serveTopActive(w)
+	case strings.HasPrefix(path, "/item_by_counter/"):
+		s.serveItemByCounter(w, strings.TrimPrefix(path, "/item_by_counter/"))
+	case strings.HasPrefix(path, "/item/"):
+		s.serveItem(w, r, strings.Trim(strings.TrimPrefix(path, "/item/"), "/"))
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+func (s *Server) serveItems(w http.ResponseWriter, r *http.Request) {
+	items := make([]rollbar.Item, 0, len(s.items))
+	if page := r.URL.Query().Get("page"); page == "" || page == "1" {
+		status := domain.Status(r.URL.Query().Get("status"))
+		for _, item := range s.items {
+			if status == "" || item.Status == status {
+				items = append(items, item)
+			}
+		}
+	}
+
+	writeResult(w, map[string]any{"items": items})
+}
+
+func (s *Server) serveTopActive(w http.ResponseWriter) {
+	entries := make([]map[string]rollbar.Item, 0, len(s.items))
+	for _, item := range s.items {
+		if item.Status == domain.StatusActive {
+			entries = append(entries, map[string]rollbar.Item{"item": item})
+		}
+	}
+
+	writeResult(w, entries)
+}
+
+func (s *Server) serveItemByCounter(w http.ResponseWriter, counter string) {
+	index := s.findItem(func(item rollbar.Item) bool { return strconv.FormatUint(item.Counter, 10) == counter })
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "item not found")
+		return
+	}
+
+	writeResult(w, map[string]domain.ItemID{"itemId": s.items[index].ID})
+}
+
+func (s *Server) serveItem(w http.ResponseWriter, r *http.Request, rest string) {
+	id, instances := strings.CutSuffix(rest, "/instances")
+	index := s.findItem(func(item rollbar.Item) bool { return item.ID.String() == id })
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "item not found")
+		return
+	}
+
+	switch {
+	case instances:
+		s.serveInstances(w, r, s.items[index].ID)
+	case r.Method == http.MethodPatch:
+		s.patchItem(w, r, index)
+	default:
+		writeResult(w, s.items[index])
+	}
+}
+
+func (s *Server) serveInstances(w http.ResponseWriter, r *http.Request, id domain.ItemID) {
+	instances := []rollbar.ItemInstance{}
+	if page := r.URL.Query().Get("page"); page == "" || page == "1" {
+		instances = append(instances, s.instances[id]...)
+	}
+
+	writeResult(w, instances)
+}
+
+func (s *Server) patchItem(w http.ResponseWriter, r *http.Request, index int) {
+	var patch rollbar.ItemPatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid patch body")
+		return
+	}
+	if patch.Status != "" {
+		s.items[index].Status = patch.Status
+	}
+
+	writeResult(w, s.items[index])
+}
+
+func (s *Server) findItem(match func(rollbar.Item) bool) int {
+	for index, item := range s.items {
+		if match(item) {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func writeResult(w http.ResponseWriter, result any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"err": 0, "result": result})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"err": 1, "message": message})
+}
@@ -0,0 +1,125 @@
+package rollbartest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+)
+
+func newClient(t *testing.T, token string) (*rollbar.Client, *Server) {
+	t.Helper()
+	server := NewDefaultServer()
+	t.Cleanup(server.Close)
+
+	client, err := rollbar.NewWithBaseURL(token, server.APIURL())
+	if err != nil {
+		t.Fatalf("NewWithBaseURL() error = %v", err)
+	}
+
+	return client, server
+}
+
+func TestServerItems(t *testing.T) {
+	t.Parallel()
+	client, _ := newClient(t, Token)
+	ctx := context.Background()
+
+	active, err := client.ListActiveItems(ctx, 10)
+	if err != nil || len(active) != 2 {
+		t.Fatalf("ListActiveItems() = %d items, err=%v", len(active), err)
+	}
+	resolved, err := client.ListItems(ctx, domain.StatusResolved, 1)
+	if err != nil || len(resolved) != 1 || resolved[0].Counter != 7 {
+		t.Fatalf("ListItems(resolved) = %+v, err=%v", resolved, err)
+	}
+	next, err := client.ListItems(ctx, "", 2)
+	if err != nil || len(next) != 0 {
+		t.Fatalf("ListItems(page 2) = %+v, err=%v", next, err)
+	}
+}
+
+func TestServerItemAndInstances(t *testing.T) {
+	t.Parallel()
+	client, _ := newClient(t, Token)
+	ctx := context.Background()
+
+	id, err := client.ResolveItemIDByCounter(ctx, 269)
+	if err != nil || id != 1755568172 {
+		t.Fatalf("ResolveItemIDByCounter() = %d, err=%v", id, err)
+	}
+	item, err := client.GetItem(ctx, id)
+	if err != nil || item.Title != "RST_STREAM closed stream" || item.Level != domain.LevelError {
+		t.Fatalf("GetItem() = %+v, err=%v", item, err)
+	}
+	instances, err := client.ListInstances(ctx, id, rollbar.InstanceListOptions{Page: 1})
+	if err != nil || len(instances) != 2 || instances[0].ID != 9001 {
+		t.Fatalf("ListInstances() = %+v, err=%v", instances, err)
+	}
+	later, err := client.ListInstances(ctx, id, rollbar.InstanceListOptions{Page: 2})
+	if err != nil || len(later) != 0 {
+		t.Fatalf("ListInstances(page 2) = %+v, err=%v", later, err)
+	}
+}
+
+func TestServerUpdateItem(t *testing.T) {
+	t.Parallel()
+	client, _ := newClient(t, Token)
+	ctx := context.Background()
+
+	if err := client.UpdateItem(ctx, 1755568172, rollbar.ItemPatch{Status: domain.StatusResolved}); err != nil {
+		t.Fatalf("UpdateItem() error = %v", err)
+	}
+	item, err := client.GetItem(ctx, 1755568172)
+	if err != nil || item.Status != domain.StatusResolved {
+		t.Fatalf("GetItem() = %+v, err=%v", item, err)
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	t.Parallel()
+	client, server := newClient(t, Token)
+	ctx := context.Background()
+
+	if _, err := client.ResolveItemIDByCounter(ctx, 999); err == nil {
+		t.Fatalf("expected unknown counter error")
+	}
+	if _, err := client.GetItem(ctx, 42); err == nil {
+		t.Fatalf("expected unknown item error")
+	}
+
+	bad, err := rollbar.NewWithBaseURL("wrong", server.APIURL())
+	if err != nil {
+		t.Fatalf("NewWithBaseURL() error = %v", err)
+	}
+	if _, err := bad.ListActiveItems(ctx, 1); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+
+	for _, tc := range []struct {
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{method: http.MethodGet, path: "/api/1/deploys", want: http.StatusNotFound},
+		{method: http.MethodPatch, path: "/api/1/item/1755568172", body: "{", want: http.StatusBadRequest},
+	} {
+		request, err := http.NewRequest(tc.method, server.URL+tc.path, strings.NewReader(tc.body))
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		request.Header.Set("X-Rollbar-Access-Token", Token)
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		_ = response.Body.Close()
+		if response.StatusCode != tc.want {
+			t.Fatalf("%s %s status = %d, want %d", tc.method, tc.path, response.StatusCode, tc.want)
+		}
+	}
+}
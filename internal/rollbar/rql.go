@@ -0,0 +1,95 @@
+package rollbar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+const (
+	RQLJobSuccess = "success"
+	RQLJobFailed  = "failed"
+)
+
+type RQLJob struct {
+	ID          uint64 `json:"id"`
+	Status      string `json:"status"`
+	QueryString string `json:"query_string"`
+}
+
+func (j RQLJob) Done() bool {
+	switch j.Status {
+	case RQLJobSuccess, RQLJobFailed, "cancelled", "timed_out":
+		return true
+	default:
+		return false
+	}
+}
+
+type RQLResult struct {
+	Columns []string `json:"columns"`
+	Rows    [][]any  `json:"rows"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+func (c *Client) CreateRQLJob(ctx context.Context, query string) (RQLJob, error) {
+	body, err := json.Marshal(map[string]any{"query_string": query})
+	if err != nil {
+		return RQLJob{}, c.wrap(err, "encode rql job request")
+	}
+
+	raw, err := c.postResult(ctx, "/rql/jobs/", body, "create rql job")
+	if err != nil {
+		return RQLJob{}, err
+	}
+
+	var job RQLJob
+	if err := json.Unmarshal(raw, &job); err != nil {
+		return RQLJob{}, c.wrap(err, "decode rql job")
+	}
+
+	return job, nil
+}
+
+func (c *Client) GetRQLJob(ctx context.Context, jobID uint64) (RQLJob, error) {
+	raw, err := c.getResult(ctx, "/rql/job/"+strconv.FormatUint(jobID, 10), "rql job")
+	if err != nil {
+		return RQLJob{}, err
+	}
+
+	var job RQLJob
+	if err := json.Unmarshal(raw, &job); err != nil {
+		return RQLJob{}, c.wrap(err, "decode rql job")
+	}
+
+	return job, nil
+}
+
+func (c *Client) GetRQLJobResult(ctx context.Context, jobID uint64) (RQLResult, error) {
+	raw, err := c.getResult(ctx, "/rql/job/"+strconv.FormatUint(jobID, 10)+"/result", "rql job result")
+	if err != nil {
+		return RQLResult{}, err
+	}
+
+	var envelope struct {
+		Result RQLResult `json:"result"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&envelope); err != nil {
+		return RQLResult{}, c.wrap(err, "decode rql job result")
+	}
+
+	return envelope.Result, nil
+}
+
+func (c *Client) postResult(ctx context.Context, endpointPath string, body []byte, op string) (json.RawMessage, error) {
+	response, err := c.doRequest(ctx, http.MethodPost, endpointPath, bytes.NewReader(body), "application/json", op)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.decodeResult(response, op)
+}
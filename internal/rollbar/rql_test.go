@@ -0,0 +1,58 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestRQLJobLifecycle(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rql/jobs/":
+			body, _ := io.ReadAll(r.Body)
+			if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" || string(body) != `{"query_string":"SELECT 1"}` {
+				t.Fatalf("unexpected create request: %s %s", r.Method, body)
+			}
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":55,"status":"new","query_string":"SELECT 1"}}`)
+		case "/rql/job/55":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":55,"status":"success"}}`)
+		case "/rql/job/55/result":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":55,"result":{"columns":["item.counter","timestamp"],"rows":[[269,1700000000123]]}}}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+
+	job, err := client.CreateRQLJob(context.Background(), "SELECT 1")
+	if err != nil || job.ID != 55 || job.Done() {
+		t.Fatalf("CreateRQLJob() = %+v, %v", job, err)
+	}
+	job, err = client.GetRQLJob(context.Background(), 55)
+	if err != nil || !job.Done() || job.Status != RQLJobSuccess {
+		t.Fatalf("GetRQLJob() = %+v, %v", job, err)
+	}
+	result, err := client.GetRQLJobResult(context.Background(), 55)
+	if err != nil {
+		t.Fatalf("GetRQLJobResult() error = %v", err)
+	}
+	if len(result.Columns) != 2 || result.Rows[0][1] != json.Number("1700000000123") {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestCreateRQLJobError(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":1,"message":"syntax error"}`)
+	})
+	if _, err := client.CreateRQLJob(context.Background(), "SELEC"); err == nil {
+		t.Fatalf("expected api error")
+	}
+}
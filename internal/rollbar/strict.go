@@ -0,0 +1,126 @@
+package rollbar
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var ErrUnexpectedShape = errors.New("unexpected response shape")
+
+const (
+	shapeItemsObject     = `{"items": [...]}`
+	shapeInstancesObject = `{"instances": [...]}`
+	shapeDeploysObject   = `{"deploys": [...]}`
+	shapeWrappedItems    = `[{"item": ...}]`
+	shapeBareList        = "bare list"
+	shapeItemIDField     = "itemId"
+	shapeIDField         = "id"
+	shapeNumericFields   = "numeric id and counter"
+	shapeStringID        = "id as a string"
+	shapeMissingFields   = "missing id or counter"
+	shapeOccurrenceID    = "numeric id"
+	shapeMissingID       = "missing id"
+)
+
+type ShapeObservation struct {
+	Endpoint string `json:"endpoint"`
+	Aspect   string `json:"aspect"`
+	Expected string `json:"expected"`
+	Observed string `json:"observed"`
+}
+
+func (o ShapeObservation) Matches() bool {
+	return o.Expected == o.Observed
+}
+
+type shapeLog struct {
+	mu      sync.Mutex
+	entries map[[2]string]ShapeObservation
+}
+
+func (l *shapeLog) record(observation ShapeObservation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.entries == nil {
+		l.entries = map[[2]string]ShapeObservation{}
+	}
+	l.entries[[2]string{observation.Endpoint, observation.Aspect}] = observation
+}
+
+func (c *Client) ShapeObservations() []ShapeObservation {
+	if c.shapes == nil {
+		return nil
+	}
+	c.shapes.mu.Lock()
+	defer c.shapes.mu.Unlock()
+
+	observations := make([]ShapeObservation, 0, len(c.shapes.entries))
+	for _, observation := range c.shapes.entries {
+		observations = append(observations, observation)
+	}
+	sort.Slice(observations, func(i int, j int) bool {
+		if observations[i].Endpoint != observations[j].Endpoint {
+			return observations[i].Endpoint < observations[j].Endpoint
+		}
+		return observations[i].Aspect < observations[j].Aspect
+	})
+
+	return observations
+}
+
+func SetDefaultStrict(enabled bool) {
+	defaultClientFactory().SetStrict(enabled)
+}
+
+func (c *Client) observeShape(endpoint string, aspect string, expected string, observed string) error {
+	if c.shapes != nil {
+		c.shapes.record(ShapeObservation{Endpoint: endpoint, Aspect: aspect, Expected: expected, Observed: observed})
+	}
+	if observed == expected || c.strict == nil || !c.strict.Load() {
+		return nil
+	}
+
+	return &APIError{
+		Op:      endpoint,
+		Message: fmt.Sprintf("unexpected response shape: %s is %s, expected %s", aspect, observed, expected),
+		Kind:    ErrUnexpectedShape,
+	}
+}
+
+func (c *Client) checkItems(endpoint string, items []Item) error {
+	observed := shapeNumericFields
+	for _, item := range items {
+		if item.ID == 0 || item.Counter == 0 {
+			observed = shapeMissingFields
+			break
+		}
+		if item.idAsString {
+			observed = shapeStringID
+		}
+	}
+
+	return c.observeShape(endpoint, "item fields", shapeNumericFields, observed)
+}
+
+func (c *Client) checkInstances(endpoint string, instances []ItemInstance) error {
+	observed := shapeOccurrenceID
+	for _, instance := range instances {
+		if instance.ID == 0 {
+			observed = shapeMissingID
+			break
+		}
+	}
+
+	return c.observeShape(endpoint, "occurrence fields", shapeOccurrenceID, observed)
+}
+
+func listShape(bare bool, documented string) string {
+	if bare {
+		return shapeBareList
+	}
+
+	return documented
+}
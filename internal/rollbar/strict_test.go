@@ -0,0 +1,163 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStrictClientRejectsDecodeFallbacks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		result string
+		call   func(*Client) error
+	}{
+		{
+			name:   "bare items list",
+			result: `[{"id":1,"counter":1}]`,
+			call: func(c *Client) error {
+				_, err := c.ListItems(context.Background(), "", 1)
+				return err
+			},
+		},
+		{
+			name:   "string item id",
+			result: `{"items":[{"id":"1","counter":1}]}`,
+			call: func(c *Client) error {
+				_, err := c.ListItems(context.Background(), "", 1)
+				return err
+			},
+		},
+		{
+			name:   "item missing counter",
+			result: `{"id":1,"title":"x"}`,
+			call: func(c *Client) error {
+				_, err := c.GetItem(context.Background(), 1)
+				return err
+			},
+		},
+		{
+			name:   "unwrapped top active items",
+			result: `{"items":[{"id":1,"counter":1}]}`,
+			call: func(c *Client) error {
+				_, err := c.ListActiveItems(context.Background(), 10)
+				return err
+			},
+		},
+		{
+			name:   "bare instances list",
+			result: `[{"id":5}]`,
+			call: func(c *Client) error {
+				_, err := c.ListInstances(context.Background(), 1, InstanceListOptions{})
+				return err
+			},
+		},
+		{
+			name:   "item_by_counter id",
+			result: `{"id":9}`,
+			call: func(c *Client) error {
+				_, err := c.ResolveItemIDByCounter(context.Background(), 1)
+				return err
+			},
+		},
+		{
+			name:   "bare deploys list",
+			result: `[{"id":1}]`,
+			call: func(c *Client) error {
+				_, err := c.ListDeploys(context.Background(), 1)
+				return err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = fmt.Fprintf(w, `{"err":0,"result":%s}`, tc.result)
+			}))
+			t.Cleanup(server.Close)
+
+			factory := NewClientFactory(0)
+			client, err := factory.New("token", server.URL)
+			if err != nil {
+				t.Fatalf("New() error = %v", err)
+			}
+			if err := tc.call(client); err != nil {
+				t.Fatalf("lenient call error = %v", err)
+			}
+
+			factory.SetStrict(true)
+			if err := tc.call(client); !errors.Is(err, ErrUnexpectedShape) {
+				t.Fatalf("strict call error = %v, want ErrUnexpectedShape", err)
+			}
+		})
+	}
+}
+
+func TestStrictClientAcceptsDocumentedShapes(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":1,"title":"ok"}]}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(0)
+	factory.SetStrict(true)
+	client, err := factory.New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if items, err := client.ListItems(context.Background(), "", 1); err != nil || len(items) != 1 {
+		t.Fatalf("ListItems() = %+v, %v", items, err)
+	}
+}
+
+func TestClientRecordsShapeObservations(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/items":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":{"items":[{"id":1,"counter":1}]}}`)
+		case "/deploys/":
+			_, _ = fmt.Fprint(w, `{"err":0,"result":[{"id":1}]}`)
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClientFactory(0).New("token", server.URL)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if _, err := client.ListItems(context.Background(), "", 1); err != nil {
+		t.Fatalf("ListItems() error = %v", err)
+	}
+	if _, err := client.ListDeploys(context.Background(), 1); err != nil {
+		t.Fatalf("ListDeploys() error = %v", err)
+	}
+
+	want := []ShapeObservation{
+		{Endpoint: "deploys", Aspect: "envelope", Expected: shapeDeploysObject, Observed: shapeBareList},
+		{Endpoint: "items", Aspect: "envelope", Expected: shapeItemsObject, Observed: shapeItemsObject},
+		{Endpoint: "items", Aspect: "item fields", Expected: shapeNumericFields, Observed: shapeNumericFields},
+	}
+	got := client.ShapeObservations()
+	if len(got) != len(want) {
+		t.Fatalf("ShapeObservations() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ShapeObservations()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package rollbar
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultMaxInFlightRequests = 8
+
+var defaultClientFactory = sync.OnceValue(func() *ClientFactory {
+	return NewClientFactory(defaultMaxInFlightRequests)
+})
+
+type ClientFactory struct {
+	http        *http.Client
+	limiter     *rateLimiter
+	perMinute   *rateLimiter
+	quota       *quotaTracker
+	conditional *conditionalTransport
+	budget      *requestBudget
+	retry       *retryPolicy
+	responses   *responseCacheSlot
+	proxy       *proxySlot
+	strict      atomic.Bool
+}
+
+func NewClientFactory(maxInFlight int) *ClientFactory {
+	proxy := &proxySlot{}
+	conditional := newConditionalTransport(&http.Transport{
+		Proxy:                 proxy.proxy,
+		DialContext:           (&net.Dialer{Timeout: 3 * time.Second, KeepAlive: 30 * time.Second}).DialContext,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	})
+	var transport http.RoundTripper = conditional
+	if maxInFlight > 0 {
+		transport = &inFlightLimiter{base: transport, slots: make(chan struct{}, maxInFlight)}
+	}
+	limiter := newRateLimiter(DefaultRequestsPerSecond)
+	perMinute := newRateLimiter(0)
+	transport = &rateLimitedTransport{base: transport, limiter: limiter, perMinute: perMinute}
+	budget := &requestBudget{}
+	budget.reset(DefaultRequestBudget)
+	retry := &retryPolicy{delay: defaultRetryDelay}
+	retry.retries.Store(DefaultRetries)
+
+	return &ClientFactory{
+		http:        &http.Client{Timeout: 8 * time.Second, Transport: transport},
+		limiter:     limiter,
+		perMinute:   perMinute,
+		quota:       newQuotaTracker(),
+		conditional: conditional,
+		budget:      budget,
+		retry:       retry,
+		responses:   &responseCacheSlot{},
+		proxy:       proxy,
+	}
+}
+
+func (f *ClientFactory) SetConditionalRequests(enabled bool) {
+	f.conditional.enabled.Store(enabled)
+}
+
+func (f *ClientFactory) SetRequestRate(requestsPerSecond float64) {
+	f.limiter.setRate(requestsPerSecond)
+}
+
+func (f *ClientFactory) SetRequestsPerMinute(requestsPerMinute int) {
+	f.perMinute.setRate(float64(max(requestsPerMinute, 0)) / 60)
+}
+
+func (f *ClientFactory) SetStrict(enabled bool) {
+	f.strict.Store(enabled)
+}
+
+func (f *ClientFactory) SetRequestBudget(limit int) {
+	f.budget.reset(limit)
+}
+
+func (f *ClientFactory) RequestBudgetUsage() RequestBudgetUsage {
+	return f.budget.usage()
+}
+
+func (f *ClientFactory) New(accessToken string, baseURL string) (*Client, error) {
+	if strings.TrimSpace(accessToken) == "" {
+		return nil, errors.New("rollbar access token is required")
+	}
+	if baseURL == "" {
+		baseURL = defaultBaseURL
+	}
+
+	return &Client{
+		http:        f.http,
+		budget:      f.budget,
+		retry:       f.retry,
+		quota:       f.quota,
+		responses:   f.responses,
+		strict:      &f.strict,
+		shapes:      &shapeLog{},
+		baseURL:     baseURL,
+		accessToken: accessToken,
+	}, nil
+}
+
+type inFlightLimiter struct {
+	base  http.RoundTripper
+	slots chan struct{}
+}
+
+func (l *inFlightLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case l.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, fmt.Errorf("wait for request slot: %w", req.Context().Err())
+	}
+
+	resp, err := l.base.RoundTrip(req)
+	if err != nil {
+		<-l.slots
+		return nil, err //nolint:wrapcheck // RoundTrippers must return transport errors unchanged
+	}
+	resp.Body = &slotReleasingBody{ReadCloser: resp.Body, release: func() { <-l.slots }}
+
+	return resp, nil
+}
+
+type slotReleasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *slotReleasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err //nolint:wrapcheck // io.Closer errors pass through untouched
+}
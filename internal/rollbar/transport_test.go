@@ -0,0 +1,102 @@
+package rollbar
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+func TestClientFactorySharesTransport(t *testing.T) {
+	t.Parallel()
+
+	factory := NewClientFactory(2)
+	first, err := factory.New("token-a", "")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	second, err := factory.New("token-b", "https://rollbar.example/api/1")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if first.http != second.http || first.baseURL != defaultBaseURL {
+		t.Fatalf("expected shared http client and default base URL")
+	}
+	if _, err := factory.New(" ", ""); err == nil {
+		t.Fatalf("expected missing token error")
+	}
+
+	viaDefault, _ := New("token-a")
+	alsoDefault, _ := NewWithBaseURL("token-b", "https://rollbar.example/api/1")
+	if viaDefault.http != alsoDefault.http {
+		t.Fatalf("expected package constructors to share the default transport")
+	}
+}
+
+func TestClientFactoryCapsInFlightRequests(t *testing.T) {
+	t.Parallel()
+
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		now := current.Add(1)
+		defer current.Add(-1)
+		for {
+			seen := peak.Load()
+			if now <= seen || peak.CompareAndSwap(seen, now) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":1}}`)
+	}))
+	t.Cleanup(server.Close)
+
+	factory := NewClientFactory(2)
+	var wg sync.WaitGroup
+	for project := range 6 {
+		client, err := factory.New(fmt.Sprintf("token-%d", project), server.URL)
+		if err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.GetItem(context.Background(), domain.ItemID(1)); err != nil {
+				t.Errorf("GetItem() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 || got == 0 {
+		t.Fatalf("peak in-flight requests = %d, want 1..2", got)
+	}
+}
+
+func TestInFlightLimiterHonorsContextWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	limiter := &inFlightLimiter{base: http.DefaultTransport, slots: make(chan struct{}, 1)}
+	limiter.slots <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://127.0.0.1:1", nil)
+	if _, err := limiter.RoundTrip(req); err == nil {
+		t.Fatalf("expected context error while waiting for a slot")
+	}
+
+	<-limiter.slots
+	if _, err := limiter.RoundTrip(req.WithContext(context.Background())); err == nil {
+		t.Fatalf("expected dial error")
+	}
+	if len(limiter.slots) != 0 {
+		t.Fatalf("expected slot released after transport error")
+	}
+}
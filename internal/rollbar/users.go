@@ -0,0 +1,49 @@
+package rollbar
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+type User struct {
+	ID       uint64 `json:"id"`
+	Username string `json:"username"`
+	Email    string `json:"email,omitempty"`
+}
+
+type usersEnvelope struct {
+	Users []User `json:"users"`
+}
+
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	raw, err := c.getResult(ctx, "/users", "users")
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapped usersEnvelope
+	if err := json.Unmarshal(raw, &wrapped); err == nil {
+		return wrapped.Users, nil
+	}
+	var users []User
+	if err := json.Unmarshal(raw, &users); err != nil {
+		return nil, c.wrap(err, "decode users response")
+	}
+
+	return users, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, userID uint64) (User, error) {
+	raw, err := c.getResult(ctx, "/user/"+strconv.FormatUint(userID, 10), "user")
+	if err != nil {
+		return User{}, err
+	}
+
+	var user User
+	if err := json.Unmarshal(raw, &user); err != nil {
+		return User{}, c.wrap(err, "decode user response")
+	}
+
+	return user, nil
+}
@@ -0,0 +1,62 @@
+package rollbar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestListUsers(t *testing.T) {
+	t.Parallel()
+
+	for _, result := range []string{
+		`{"users":[{"id":7,"username":"alice","email":"alice@example.com"},{"id":8,"username":"bob"}]}`,
+		`[{"id":7,"username":"alice","email":"alice@example.com"},{"id":8,"username":"bob"}]`,
+	} {
+		client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/users" {
+				t.Fatalf("unexpected path: %s", r.URL.Path)
+			}
+			_, _ = fmt.Fprintf(w, `{"err":0,"result":%s}`, result)
+		})
+
+		users, err := client.ListUsers(context.Background())
+		if err != nil {
+			t.Fatalf("ListUsers() error = %v", err)
+		}
+		want := []User{{ID: 7, Username: "alice", Email: "alice@example.com"}, {ID: 8, Username: "bob"}}
+		if !slices.Equal(users, want) {
+			t.Fatalf("unexpected users for %s: %+v", result, users)
+		}
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/7" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_, _ = fmt.Fprint(w, `{"err":0,"result":{"id":7,"username":"alice","email":"alice@example.com"}}`)
+	})
+
+	user, err := client.GetUser(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetUser() error = %v", err)
+	}
+	if user != (User{ID: 7, Username: "alice", Email: "alice@example.com"}) {
+		t.Fatalf("unexpected user: %+v", user)
+	}
+
+	denied := newTestClientWithHandler(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprint(w, `{"err":1,"message":"insufficient privileges"}`)
+	})
+	if _, err := denied.ListUsers(context.Background()); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden, got %v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type listParams struct {
+	Limit       int    `json:"limit"`
+	Environment string `json:"environment"`
+}
+
+type fileParams struct {
+	Path        string `json:"path"`
+	Scan        int    `json:"scan"`
+	Environment string `json:"environment"`
+}
+
+type issueParams struct {
+	Counter domain.ItemCounter `json:"counter"`
+	Version string             `json:"version"`
+	Seconds int64              `json:"seconds"`
+	User    string             `json:"user"`
+}
+
+func (s *server) methodTable() map[string]method {
+	return map[string]method{
+		"recent": {call: s.list(s.service.Recent)},
+		"active": {call: s.list(s.service.Active)},
+		"show": {call: s.issue(func(ctx context.Context, params issueParams) (any, error) {
+			detail, err := s.service.Show(ctx, params.Counter)
+			if err != nil {
+				return nil, err
+			}
+			detail.Raw, detail.ItemRaw, detail.InstanceRaw = nil, nil, nil
+			return map[string]any{"issue": detail}, nil
+		})},
+		"file_issues": {call: s.fileIssues},
+		"resolve": {write: true, call: s.issue(func(ctx context.Context, params issueParams) (any, error) {
+			return actionResult(s.service.Resolve(ctx, params.Counter, params.Version))
+		})},
+		"reopen": {write: true, call: s.issue(func(ctx context.Context, params issueParams) (any, error) {
+			return actionResult(s.service.Reopen(ctx, params.Counter))
+		})},
+		"mute": {write: true, call: s.issue(func(ctx context.Context, params issueParams) (any, error) {
+			var seconds *int64
+			if params.Seconds > 0 {
+				seconds = &params.Seconds
+			}
+			return actionResult(s.service.Mute(ctx, params.Counter, seconds))
+		})},
+		"assign": {write: true, call: s.issue(func(ctx context.Context, params issueParams) (any, error) {
+			return assignmentResult(s.service.Assign(ctx, params.Counter, params.User))
+		})},
+		"unassign": {write: true, call: s.issue(func(ctx context.Context, params issueParams) (any, error) {
+			return assignmentResult(s.service.Unassign(ctx, params.Counter))
+		})},
+	}
+}
+
+func (s *server) list(load func(context.Context, int, app.IssueFilters) ([]app.IssueSummary, error)) func(context.Context, json.RawMessage) (any, error) {
+	return func(ctx context.Context, raw json.RawMessage) (any, error) {
+		params := listParams{Limit: s.options.Limit}
+		if err := decodeParams(raw, &params); err != nil {
+			return nil, err
+		}
+		issues, err := load(ctx, params.Limit, s.filters(params.Environment))
+		if err != nil {
+			return nil, err
+		}
+		for index := range issues {
+			issues[index].Raw = nil
+		}
+		return map[string]any{"issues": issues}, nil
+	}
+}
+
+func (s *server) fileIssues(ctx context.Context, raw json.RawMessage) (any, error) {
+	var params fileParams
+	if err := decodeParams(raw, &params); err != nil {
+		return nil, err
+	}
+	if params.Path == "" {
+		return nil, &Error{Code: codeInvalidParams, Message: "invalid params: path is required"}
+	}
+	issues, err := s.service.IssuesForFile(ctx, params.Path, params.Scan, s.filters(params.Environment))
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{"issues": issues}, nil
+}
+
+func (s *server) issue(run func(context.Context, issueParams) (any, error)) func(context.Context, json.RawMessage) (any, error) {
+	return func(ctx context.Context, raw json.RawMessage) (any, error) {
+		var params issueParams
+		if err := decodeParams(raw, &params); err != nil {
+			return nil, err
+		}
+		if params.Counter == 0 {
+			return nil, &Error{Code: codeInvalidParams, Message: "invalid params: counter is required"}
+		}
+		return run(ctx, params)
+	}
+}
+
+func (s *server) filters(environment string) app.IssueFilters {
+	filters := s.options.Filters
+	if environment != "" {
+		filters.Environment = domain.Environment(environment)
+	}
+
+	return filters
+}
+
+func actionResult(result app.ItemActionResult, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	result.Issue.Raw = nil
+
+	return result, nil
+}
+
+func assignmentResult(assignment app.Assignment, err error) (any, error) {
+	if err != nil {
+		return nil, err
+	}
+	assignment.Issue.Raw = nil
+
+	return assignment, nil
+}
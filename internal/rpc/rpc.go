@@ -0,0 +1,190 @@
+package rpc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/redact"
+)
+
+const (
+	requestTimeout  = 30 * time.Second
+	maxMessageBytes = 1 << 20
+
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeServerError    = -32000
+	codeWritesDisabled = -32001
+)
+
+type Service interface {
+	Recent(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error)
+	Active(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error)
+	Show(ctx context.Context, counter domain.ItemCounter) (app.IssueDetail, error)
+	IssuesForFile(ctx context.Context, path string, scan int, filters app.IssueFilters) ([]app.FileIssue, error)
+	Resolve(ctx context.Context, counter domain.ItemCounter, resolvedInVersion string) (app.ItemActionResult, error)
+	Reopen(ctx context.Context, counter domain.ItemCounter) (app.ItemActionResult, error)
+	Mute(ctx context.Context, counter domain.ItemCounter, durationSeconds *int64) (app.ItemActionResult, error)
+	Assign(ctx context.Context, counter domain.ItemCounter, user string) (app.Assignment, error)
+	Unassign(ctx context.Context, counter domain.ItemCounter) (app.Assignment, error)
+}
+
+type Options struct {
+	Limit       int
+	Filters     app.IssueFilters
+	AllowWrites bool
+	Token       string
+	BeforeCall  func()
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+type server struct {
+	service Service
+	options Options
+	methods map[string]method
+}
+
+type method struct {
+	write bool
+	call  func(ctx context.Context, params json.RawMessage) (any, error)
+}
+
+func Serve(ctx context.Context, service Service, options Options, in io.Reader, out io.Writer) error {
+	s := &server{service: service, options: options}
+	s.methods = s.methodTable()
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageBytes)
+	encoder := json.NewEncoder(out)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("serve rpc: %w", err)
+		}
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		reply, ok := s.handle(ctx, line)
+		if !ok {
+			continue
+		}
+		if err := encoder.Encode(reply); err != nil {
+			return fmt.Errorf("write rpc response: %w", err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read rpc request: %w", err)
+	}
+
+	return nil
+}
+
+func (s *server) handle(parent context.Context, line []byte) (response, bool) {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return s.errorResponse(json.RawMessage("null"), &Error{Code: codeParseError, Message: "parse error: " + err.Error()}), true
+	}
+	notification := len(req.ID) == 0
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return s.errorResponse(idOrNull(req.ID), &Error{Code: codeInvalidRequest, Message: `invalid request: need "jsonrpc": "2.0" and a method`}), true
+	}
+
+	result, err := s.call(parent, req)
+	if notification {
+		return response{}, false
+	}
+	if err != nil {
+		return s.errorResponse(req.ID, asError(err)), true
+	}
+
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return s.errorResponse(req.ID, &Error{Code: codeServerError, Message: "encode result: " + err.Error()}), true
+	}
+
+	return response{JSONRPC: "2.0", ID: req.ID, Result: redact.RawJSON(encoded, s.options.Token)}, true
+}
+
+func (s *server) call(parent context.Context, req request) (any, error) {
+	m, ok := s.methods[req.Method]
+	if !ok {
+		return nil, &Error{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+	if m.write && !s.options.AllowWrites {
+		return nil, &Error{Code: codeWritesDisabled, Message: fmt.Sprintf("method %q changes issues; restart rpc with --yes to allow it", req.Method)}
+	}
+
+	if s.options.BeforeCall != nil {
+		s.options.BeforeCall()
+	}
+	ctx, cancel := context.WithTimeout(parent, requestTimeout)
+	defer cancel()
+
+	return m.call(ctx, req.Params)
+}
+
+func (s *server) errorResponse(id json.RawMessage, err *Error) response {
+	return response{JSONRPC: "2.0", ID: id, Error: &Error{Code: err.Code, Message: redact.String(err.Message, s.options.Token)}}
+}
+
+func idOrNull(id json.RawMessage) json.RawMessage {
+	if len(id) == 0 {
+		return json.RawMessage("null")
+	}
+
+	return id
+}
+
+func asError(err error) *Error {
+	var rpcErr *Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr
+	}
+
+	return &Error{Code: codeServerError, Message: err.Error()}
+}
+
+func decodeParams(params json.RawMessage, target any) error {
+	if len(params) == 0 || string(params) == "null" {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader(params))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(target); err != nil {
+		return &Error{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/rollbar"
+	"github.com/kevinsheth/rollbaz/internal/summary"
+)
+
+type fakeService struct {
+	calls *[]string
+}
+
+func (f fakeService) record(call string) {
+	*f.calls = append(*f.calls, call)
+}
+
+func (f fakeService) Recent(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error) {
+	f.record("recent " + filters.Environment.String())
+	issues := []app.IssueSummary{{Counter: 1, Title: "checkout failed", Raw: json.RawMessage(`{"secret":true}`)}, {Counter: 2}}
+
+	return issues[:min(limit, len(issues))], nil
+}
+
+func (f fakeService) Active(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error) {
+	return nil, errors.New("rollbar unavailable for tok_secret")
+}
+
+func (f fakeService) Show(ctx context.Context, counter domain.ItemCounter) (app.IssueDetail, error) {
+	return app.IssueDetail{IssueSummary: app.IssueSummary{Counter: counter}, MainError: "boom", ItemRaw: json.RawMessage(`{}`)}, nil
+}
+
+func (f fakeService) IssuesForFile(ctx context.Context, path string, scan int, filters app.IssueFilters) ([]app.FileIssue, error) {
+	f.record("file " + path)
+
+	return []app.FileIssue{{Issue: app.IssueSummary{Counter: 3}, Frames: []summary.Frame{{Filename: path, Line: 12}}}}, nil
+}
+
+func (f fakeService) Resolve(ctx context.Context, counter domain.ItemCounter, resolvedInVersion string) (app.ItemActionResult, error) {
+	f.record("resolve " + counter.String() + " " + resolvedInVersion)
+
+	return app.ItemActionResult{Action: "resolved", Issue: app.IssueSummary{Counter: counter}}, nil
+}
+
+func (f fakeService) Reopen(ctx context.Context, counter domain.ItemCounter) (app.ItemActionResult, error) {
+	return app.ItemActionResult{Action: "reopened", Issue: app.IssueSummary{Counter: counter}}, nil
+}
+
+func (f fakeService) Mute(ctx context.Context, counter domain.ItemCounter, durationSeconds *int64) (app.ItemActionResult, error) {
+	return app.ItemActionResult{Action: "muted", Issue: app.IssueSummary{Counter: counter}}, nil
+}
+
+func (f fakeService) Assign(ctx context.Context, counter domain.ItemCounter, user string) (app.Assignment, error) {
+	return app.Assignment{ItemActionResult: app.ItemActionResult{Action: "assigned", Issue: app.IssueSummary{Counter: counter}}, Assignee: &rollbar.User{ID: 7, Username: user}}, nil
+}
+
+func (f fakeService) Unassign(ctx context.Context, counter domain.ItemCounter) (app.Assignment, error) {
+	return app.Assignment{ItemActionResult: app.ItemActionResult{Action: "unassigned", Issue: app.IssueSummary{Counter: counter}}}, nil
+}
+
+func serve(t *testing.T, options Options, requests ...string) ([]map[string]any, []string) {
+	t.Helper()
+
+	var calls []string
+	var out bytes.Buffer
+	if err := Serve(context.Background(), fakeService{calls: &calls}, options, strings.NewReader(strings.Join(requests, "\n")), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	var replies []map[string]any
+	decoder := json.NewDecoder(&out)
+	for decoder.More() {
+		var reply map[string]any
+		if err := decoder.Decode(&reply); err != nil {
+			t.Fatalf("decode reply: %v", err)
+		}
+		replies = append(replies, reply)
+	}
+
+	return replies, calls
+}
+
+func TestServeReadMethods(t *testing.T) {
+	t.Parallel()
+
+	replies, calls := serve(t, Options{Limit: 10, Filters: app.IssueFilters{Environment: "production"}},
+		`{"jsonrpc":"2.0","id":1,"method":"recent","params":{"limit":1}}`,
+		``,
+		`{"jsonrpc":"2.0","id":"two","method":"recent","params":{"environment":"staging"}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"show","params":{"counter":274}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"file_issues","params":{"path":"app/billing/invoice.go"}}`,
+		`{"jsonrpc":"2.0","method":"recent"}`,
+	)
+
+	if len(replies) != 4 {
+		t.Fatalf("expected four replies and none for the notification, got %+v", replies)
+	}
+	issues := replies[0]["result"].(map[string]any)["issues"].([]any)
+	if replies[0]["id"] != float64(1) || len(issues) != 1 || issues[0].(map[string]any)["raw"] != nil {
+		t.Fatalf("unexpected recent reply: %+v", replies[0])
+	}
+	if replies[1]["id"] != "two" || len(replies[1]["result"].(map[string]any)["issues"].([]any)) != 2 {
+		t.Fatalf("expected the serve limit by default, got %+v", replies[1])
+	}
+	issue := replies[2]["result"].(map[string]any)["issue"].(map[string]any)
+	if issue["counter"] != float64(274) || issue["main_error"] != "boom" || issue["item_raw"] != nil {
+		t.Fatalf("unexpected show reply: %+v", replies[2])
+	}
+	frames := replies[3]["result"].(map[string]any)["issues"].([]any)[0].(map[string]any)["frames"].([]any)
+	if frames[0].(map[string]any)["lineno"] != float64(12) {
+		t.Fatalf("unexpected file_issues reply: %+v", replies[3])
+	}
+	want := []string{"recent production", "recent staging", "file app/billing/invoice.go", "recent production"}
+	if strings.Join(calls, "|") != strings.Join(want, "|") {
+		t.Fatalf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestServeWriteMethods(t *testing.T) {
+	t.Parallel()
+
+	request := `{"jsonrpc":"2.0","id":1,"method":"resolve","params":{"counter":5,"version":"v1.2.3"}}`
+	replies, calls := serve(t, Options{}, request)
+	if code := replies[0]["error"].(map[string]any)["code"]; code != float64(codeWritesDisabled) || len(calls) != 0 {
+		t.Fatalf("expected writes to be refused without --yes, got %+v, calls %v", replies[0], calls)
+	}
+
+	replies, calls = serve(t, Options{AllowWrites: true}, request,
+		`{"jsonrpc":"2.0","id":2,"method":"assign","params":{"counter":5,"user":"alice"}}`,
+	)
+	if replies[0]["result"].(map[string]any)["action"] != "resolved" || len(calls) != 1 || calls[0] != "resolve 5 v1.2.3" {
+		t.Fatalf("unexpected resolve reply: %+v, calls %v", replies[0], calls)
+	}
+	if assignee := replies[1]["result"].(map[string]any)["assignee"].(map[string]any); assignee["username"] != "alice" {
+		t.Fatalf("unexpected assign reply: %+v", replies[1])
+	}
+}
+
+func TestServeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		request string
+		code    int
+		message string
+	}{
+		{request: `{not json`, code: codeParseError, message: "parse error"},
+		{request: `{"id":1,"method":"recent"}`, code: codeInvalidRequest, message: "invalid request"},
+		{request: `{"jsonrpc":"2.0","id":1,"method":"nope"}`, code: codeMethodNotFound, message: `method "nope" not found`},
+		{request: `{"jsonrpc":"2.0","id":1,"method":"recent","params":{"limt":5}}`, code: codeInvalidParams, message: "unknown field"},
+		{request: `{"jsonrpc":"2.0","id":1,"method":"show","params":{}}`, code: codeInvalidParams, message: "counter is required"},
+		{request: `{"jsonrpc":"2.0","id":1,"method":"file_issues","params":{}}`, code: codeInvalidParams, message: "path is required"},
+		{request: `{"jsonrpc":"2.0","id":1,"method":"active"}`, code: codeServerError, message: "rollbar unavailable"},
+	}
+
+	for _, tt := range tests {
+		replies, _ := serve(t, Options{}, tt.request)
+		if len(replies) != 1 {
+			t.Fatalf("%s: expected one reply, got %+v", tt.request, replies)
+		}
+		rpcErr := replies[0]["error"].(map[string]any)
+		if rpcErr["code"] != float64(tt.code) || !strings.Contains(rpcErr["message"].(string), tt.message) {
+			t.Fatalf("%s: unexpected error %+v", tt.request, rpcErr)
+		}
+	}
+}
+
+func TestServeRedactsTokenAndStartsEachCall(t *testing.T) {
+	t.Parallel()
+
+	started := 0
+	replies, _ := serve(t, Options{Token: "tok_secret", BeforeCall: func() { started++ }},
+		`{"jsonrpc":"2.0","id":1,"method":"active"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"file_issues","params":{"path":"tok_secret/app.go"}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"nope"}`,
+	)
+
+	encoded, err := json.Marshal(replies)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if strings.Contains(string(encoded), "tok_secret") || !strings.Contains(string(encoded), "rollbar unavailable for [REDACTED]") {
+		t.Fatalf("expected the token redacted from errors and results, got %s", encoded)
+	}
+	if started != 2 {
+		t.Fatalf("expected BeforeCall once per dispatched method, got %d", started)
+	}
+}
@@ -34,52 +34,13 @@ func fromRawJSON(raw json.RawMessage) string {
 		return ""
 	}
 
-	var value any
-	if err := json.Unmarshal(raw, &value); err != nil {
-		return ""
-	}
-
 	for _, path := range preferredErrorPaths {
-		if message := stringAtPath(value, path); message != "" {
+		if message := StringAt(raw, path...); message != "" {
 			return message
 		}
 	}
 
-	if message, ok := value.(string); ok {
-		return message
-	}
-
-	return ""
-}
-
-func stringAtPath(value any, path []string) string {
-	current := value
-
-	for _, segment := range path {
-		switch typed := current.(type) {
-		case map[string]any:
-			next, ok := typed[segment]
-			if !ok {
-				return ""
-			}
-			current = next
-		case []any:
-			indexValue := toIndex(segment)
-			if indexValue < 0 || indexValue >= len(typed) {
-				return ""
-			}
-			current = typed[indexValue]
-		default:
-			return ""
-		}
-	}
-
-	message, ok := current.(string)
-	if !ok {
-		return ""
-	}
-
-	return message
+	return StringAt(raw)
 }
 
 func toIndex(value string) int {
@@ -0,0 +1,180 @@
+package summary
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+func StringAt(data json.RawMessage, path ...string) string {
+	value, ok := rawAtPath(data, path)
+	if !ok {
+		return ""
+	}
+
+	var message string
+	if err := json.Unmarshal(value, &message); err != nil {
+		return ""
+	}
+
+	return message
+}
+
+func rawAtPath(data []byte, path []string) ([]byte, bool) {
+	start := skipSpace(data, 0)
+	for _, segment := range path {
+		if start >= len(data) {
+			return nil, false
+		}
+		var ok bool
+		switch data[start] {
+		case '{':
+			start, ok = objectField(data, start, segment)
+		case '[':
+			start, ok = arrayElement(data, start, toIndex(segment))
+		default:
+			return nil, false
+		}
+		if !ok {
+			return nil, false
+		}
+	}
+
+	end, ok := skipValue(data, start)
+	if !ok {
+		return nil, false
+	}
+
+	return data[start:end], true
+}
+
+func objectField(data []byte, start int, name string) (int, bool) {
+	pos := skipSpace(data, start+1)
+	for pos < len(data) && data[pos] == '"' {
+		keyEnd, ok := skipString(data, pos)
+		if !ok {
+			return 0, false
+		}
+		key := data[pos:keyEnd]
+		pos = skipSpace(data, keyEnd)
+		if pos >= len(data) || data[pos] != ':' {
+			return 0, false
+		}
+		pos = skipSpace(data, pos+1)
+		if keyMatches(key, name) {
+			return pos, true
+		}
+		if pos, ok = skipValue(data, pos); !ok {
+			return 0, false
+		}
+		if pos = skipSpace(data, pos); pos < len(data) && data[pos] == ',' {
+			pos = skipSpace(data, pos+1)
+		}
+	}
+
+	return 0, false
+}
+
+func arrayElement(data []byte, start int, index int) (int, bool) {
+	if index < 0 {
+		return 0, false
+	}
+
+	pos := skipSpace(data, start+1)
+	for current := 0; pos < len(data) && data[pos] != ']'; current++ {
+		if current == index {
+			return pos, true
+		}
+		var ok bool
+		if pos, ok = skipValue(data, pos); !ok {
+			return 0, false
+		}
+		if pos = skipSpace(data, pos); pos < len(data) && data[pos] == ',' {
+			pos = skipSpace(data, pos+1)
+		}
+	}
+
+	return 0, false
+}
+
+func keyMatches(quoted []byte, name string) bool {
+	if bytes.IndexByte(quoted, '\\') < 0 {
+		return string(quoted[1:len(quoted)-1]) == name
+	}
+
+	unquoted, err := strconv.Unquote(string(quoted))
+	return err == nil && unquoted == name
+}
+
+func skipValue(data []byte, pos int) (int, bool) {
+	if pos >= len(data) {
+		return 0, false
+	}
+
+	switch data[pos] {
+	case '"':
+		return skipString(data, pos)
+	case '{', '[':
+		return skipContainer(data, pos)
+	default:
+		end := pos
+		for end < len(data) && !isValueTerminator(data[end]) {
+			end++
+		}
+		return end, end > pos
+	}
+}
+
+func skipContainer(data []byte, pos int) (int, bool) {
+	depth := 0
+	for pos < len(data) {
+		switch data[pos] {
+		case '"':
+			end, ok := skipString(data, pos)
+			if !ok {
+				return 0, false
+			}
+			pos = end
+			continue
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+			if depth == 0 {
+				return pos + 1, true
+			}
+		}
+		pos++
+	}
+
+	return 0, false
+}
+
+func skipString(data []byte, pos int) (int, bool) {
+	for index := pos + 1; index < len(data); index++ {
+		switch data[index] {
+		case '\\':
+			index++
+		case '"':
+			return index + 1, true
+		}
+	}
+
+	return 0, false
+}
+
+func skipSpace(data []byte, pos int) int {
+	for pos < len(data) && isSpace(data[pos]) {
+		pos++
+	}
+
+	return pos
+}
+
+func isSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+func isValueTerminator(ch byte) bool {
+	return ch == ',' || ch == '}' || ch == ']' || isSpace(ch)
+}
@@ -0,0 +1,86 @@
+package summary
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestStringAt(t *testing.T) {
+	t.Parallel()
+
+	payload := json.RawMessage(`{
+		"skip": {"nested": ["a", {"b": "}"}], "n": -1.5e3, "t": true, "z": null},
+		"esc\"aped": "quoted key",
+		"body": {"trace_chain": [{"x": 1}, {"exception": {"message": "second \"frame\""}}]},
+		"uuid": "abc"
+	}`)
+
+	tests := []struct {
+		path []string
+		want string
+	}{
+		{path: []string{"uuid"}, want: "abc"},
+		{path: []string{"esc\"aped"}, want: "quoted key"},
+		{path: []string{"body", "trace_chain", "1", "exception", "message"}, want: `second "frame"`},
+		{path: []string{"body", "trace_chain", "5"}, want: ""},
+		{path: []string{"body", "trace_chain", "x"}, want: ""},
+		{path: []string{"skip", "n"}, want: ""},
+		{path: []string{"missing"}, want: ""},
+		{path: []string{"uuid", "deeper"}, want: ""},
+	}
+
+	for _, tc := range tests {
+		if got := StringAt(payload, tc.path...); got != tc.want {
+			t.Fatalf("StringAt(%v) = %q, want %q", tc.path, got, tc.want)
+		}
+	}
+
+	if got := StringAt(json.RawMessage(`"plain"`)); got != "plain" {
+		t.Fatalf("StringAt(root string) = %q", got)
+	}
+	for _, broken := range []string{``, `{"a":`, `{"a" 1}`, `["unterminated`} {
+		if got := StringAt(json.RawMessage(broken), "a", "b"); got != "" {
+			t.Fatalf("StringAt(%q) = %q, want empty", broken, got)
+		}
+	}
+}
+
+func largeTracePayload(frameCount int) json.RawMessage {
+	frames := make([]string, 0, frameCount)
+	for range frameCount {
+		frames = append(frames, `{"filename":"app.go","lineno":42,"method":"handler","code":"return err"}`)
+	}
+
+	return json.RawMessage(`{"body":{"trace":{"frames":[` + strings.Join(frames, ",") + `],"exception":{"message":"boom"}}}}`)
+}
+
+func TestStringAtAllocationBudget(t *testing.T) {
+	payload := largeTracePayload(5000)
+	allocs := testing.AllocsPerRun(5, func() {
+		_ = StringAt(payload, "body", "trace", "exception", "message")
+	})
+	if allocs > 2 {
+		t.Fatalf("StringAt allocated %.0f times on a large payload, budget is 2", allocs)
+	}
+}
+
+func BenchmarkStringAtLargePayload(b *testing.B) {
+	payload := largeTracePayload(20000)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		if StringAt(payload, "body", "trace", "exception", "message") != "boom" {
+			b.Fatal("unexpected message")
+		}
+	}
+}
+
+func BenchmarkMainErrorLargePayload(b *testing.B) {
+	payload := largeTracePayload(20000)
+
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = MainError(nil, payload)
+	}
+}
@@ -0,0 +1,7 @@
+package summary
+
+import "encoding/json"
+
+func OccurrenceUUID(data json.RawMessage) string {
+	return firstStringAtPaths(data, [][]string{{"uuid"}})
+}
@@ -0,0 +1,17 @@
+package summary
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOccurrenceUUID(t *testing.T) {
+	t.Parallel()
+
+	if got := OccurrenceUUID(json.RawMessage(`{"uuid":"d4c7acef-55bf-4a0b-ab48-0cd9bc6a3a1b"}`)); got != "d4c7acef-55bf-4a0b-ab48-0cd9bc6a3a1b" {
+		t.Fatalf("OccurrenceUUID() = %q", got)
+	}
+	if got := OccurrenceUUID(json.RawMessage(`{"environment":"production"}`)); got != "" {
+		t.Fatalf("OccurrenceUUID(missing) = %q", got)
+	}
+}
@@ -0,0 +1,47 @@
+package summary
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var idSegment = regexp.MustCompile(`^(?:\d+|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}|[0-9a-fA-F]*\d[0-9a-fA-F]*)$`)
+
+const minHexIDLength = 12
+
+func Request(data json.RawMessage) (method string, route string) {
+	rawURL := StringAt(data, "request", "url")
+	if rawURL == "" {
+		return "", ""
+	}
+
+	return strings.ToUpper(StringAt(data, "request", "method")), Route(rawURL)
+}
+
+func Route(rawURL string) string {
+	path := rawURL
+	if parsed, err := url.Parse(rawURL); err == nil {
+		path = parsed.Path
+	} else if index := strings.IndexAny(path, "?#"); index >= 0 {
+		path = path[:index]
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for index, segment := range segments {
+		if isIDSegment(segment) {
+			segments[index] = ":id"
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func isIDSegment(segment string) bool {
+	if !idSegment.MatchString(segment) {
+		return false
+	}
+
+	return strings.Contains(segment, "-") || len(segment) >= minHexIDLength || strings.Trim(segment, "0123456789") == ""
+}
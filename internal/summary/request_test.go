@@ -0,0 +1,31 @@
+package summary
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		data       string
+		wantMethod string
+		wantRoute  string
+	}{
+		{name: "numeric id", data: `{"request":{"url":"https://shop.example.com/orders/17/items?page=2","method":"post"}}`, wantMethod: "POST", wantRoute: "/orders/:id/items"},
+		{name: "uuid", data: `{"request":{"url":"https://shop.example.com/carts/0f8fad5b-d9cb-469f-a165-70867728950e"}}`, wantRoute: "/carts/:id"},
+		{name: "hex id", data: `{"request":{"url":"/users/5f1d7a9c3e2b4a6d8c0e1f2a/profile","method":"GET"}}`, wantMethod: "GET", wantRoute: "/users/:id/profile"},
+		{name: "short words stay", data: `{"request":{"url":"https://shop.example.com/api/v2/checkout/"}}`, wantRoute: "/api/v2/checkout"},
+		{name: "root", data: `{"request":{"url":"https://shop.example.com"}}`, wantRoute: "/"},
+		{name: "no request", data: `{"environment":"production"}`},
+	}
+
+	for _, tt := range tests {
+		method, route := Request(json.RawMessage(tt.data))
+		if method != tt.wantMethod || route != tt.wantRoute {
+			t.Fatalf("%s: Request() = %q, %q, want %q, %q", tt.name, method, route, tt.wantMethod, tt.wantRoute)
+		}
+	}
+}
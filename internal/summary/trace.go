@@ -0,0 +1,117 @@
+package summary
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type Frame struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"lineno,omitempty"`
+	Method   string `json:"method,omitempty"`
+}
+
+type Trace struct {
+	Exception string  `json:"exception,omitempty"`
+	Message   string  `json:"message,omitempty"`
+	Frames    []Frame `json:"frames"`
+	Omitted   int     `json:"omitted_frames,omitempty"`
+}
+
+var traceRoots = [][]string{{}, {"body"}}
+
+type rawTrace struct {
+	Frames []struct {
+		Filename string          `json:"filename"`
+		Line     json.RawMessage `json:"lineno"`
+		Method   string          `json:"method"`
+	} `json:"frames"`
+	Exception struct {
+		Class       string `json:"class"`
+		Message     string `json:"message"`
+		Description string `json:"description"`
+	} `json:"exception"`
+}
+
+func Traces(body json.RawMessage, data json.RawMessage) []Trace {
+	for _, raw := range []json.RawMessage{data, body} {
+		if len(raw) == 0 {
+			continue
+		}
+		for _, root := range traceRoots {
+			if traces := tracesAt(raw, root); len(traces) > 0 {
+				return traces
+			}
+		}
+	}
+
+	return nil
+}
+
+func tracesAt(raw json.RawMessage, root []string) []Trace {
+	if chain, ok := rawAtPath(raw, append(append([]string{}, root...), "trace_chain")); ok {
+		var decoded []rawTrace
+		if json.Unmarshal(chain, &decoded) == nil {
+			return convertTraces(decoded)
+		}
+	}
+	if single, ok := rawAtPath(raw, append(append([]string{}, root...), "trace")); ok {
+		var decoded rawTrace
+		if json.Unmarshal(single, &decoded) == nil {
+			return convertTraces([]rawTrace{decoded})
+		}
+	}
+
+	return nil
+}
+
+func convertTraces(decoded []rawTrace) []Trace {
+	traces := make([]Trace, 0, len(decoded))
+	for _, raw := range decoded {
+		if len(raw.Frames) == 0 && raw.Exception.Class == "" {
+			continue
+		}
+		trace := Trace{Exception: raw.Exception.Class, Message: raw.Exception.Message, Frames: make([]Frame, 0, len(raw.Frames))}
+		if trace.Message == "" {
+			trace.Message = raw.Exception.Description
+		}
+		for _, frame := range raw.Frames {
+			trace.Frames = append(trace.Frames, Frame{Filename: frame.Filename, Line: lineNumber(frame.Line), Method: frame.Method})
+		}
+		traces = append(traces, trace)
+	}
+
+	return traces
+}
+
+func lineNumber(raw json.RawMessage) int {
+	var line int
+	if json.Unmarshal(raw, &line) == nil {
+		return line
+	}
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		if index := toIndex(strings.TrimSpace(text)); index > 0 {
+			return index
+		}
+	}
+
+	return 0
+}
+
+func Limit(traces []Trace, frames int) []Trace {
+	if frames <= 0 {
+		return traces
+	}
+
+	limited := make([]Trace, 0, len(traces))
+	for _, trace := range traces {
+		if dropped := len(trace.Frames) - frames; dropped > 0 {
+			trace.Frames = trace.Frames[dropped:]
+			trace.Omitted += dropped
+		}
+		limited = append(limited, trace)
+	}
+
+	return limited
+}
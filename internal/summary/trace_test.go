@@ -0,0 +1,70 @@
+package summary
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTraces(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		body string
+		data string
+		want []Trace
+	}{
+		{
+			name: "single trace in data",
+			data: `{"trace":{"frames":[{"filename":"app.py","lineno":10,"method":"main"},{"filename":"db.py","lineno":"42","method":"query"}],"exception":{"class":"TimeoutError","message":"db timeout"}}}`,
+			want: []Trace{{Exception: "TimeoutError", Message: "db timeout", Frames: []Frame{{Filename: "app.py", Line: 10, Method: "main"}, {Filename: "db.py", Line: 42, Method: "query"}}}},
+		},
+		{
+			name: "trace chain nested under body",
+			body: `{"body":{"trace_chain":[{"frames":[{"filename":"a.rb","lineno":1}],"exception":{"class":"Outer","description":"wrapped"}},{"frames":[],"exception":{"class":"Inner"}}]}}`,
+			want: []Trace{
+				{Exception: "Outer", Message: "wrapped", Frames: []Frame{{Filename: "a.rb", Line: 1}}},
+				{Exception: "Inner", Frames: []Frame{}},
+			},
+		},
+		{
+			name: "message payload has no trace",
+			data: `{"message":{"body":"plain log line"}}`,
+		},
+		{
+			name: "invalid json",
+			body: `{"trace":`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := Traces(json.RawMessage(tt.body), json.RawMessage(tt.data))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Traces() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimit(t *testing.T) {
+	t.Parallel()
+
+	traces := []Trace{{Frames: []Frame{{Filename: "a"}, {Filename: "b"}, {Filename: "c"}}}, {Frames: []Frame{{Filename: "d"}}}}
+
+	limited := Limit(traces, 2)
+	if want := []Frame{{Filename: "b"}, {Filename: "c"}}; !reflect.DeepEqual(limited[0].Frames, want) || limited[0].Omitted != 1 {
+		t.Fatalf("Limit() first trace = %+v", limited[0])
+	}
+	if len(limited[1].Frames) != 1 || limited[1].Omitted != 0 {
+		t.Fatalf("Limit() second trace = %+v", limited[1])
+	}
+	if len(traces[0].Frames) != 3 {
+		t.Fatalf("Limit() modified its input")
+	}
+	if got := Limit(traces, 0); !reflect.DeepEqual(got, traces) {
+		t.Fatalf("Limit(0) = %+v, want all frames", got)
+	}
+}
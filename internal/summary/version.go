@@ -0,0 +1,23 @@
+package summary
+
+import "encoding/json"
+
+var codeVersionPaths = [][]string{
+	{"code_version"},
+	{"client", "javascript", "code_version"},
+	{"server", "code_version"},
+}
+
+func CodeVersion(data json.RawMessage) string {
+	return firstStringAtPaths(data, codeVersionPaths)
+}
+
+func firstStringAtPaths(data json.RawMessage, paths [][]string) string {
+	for _, path := range paths {
+		if found := StringAt(data, path...); found != "" {
+			return found
+		}
+	}
+
+	return ""
+}
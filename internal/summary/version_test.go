@@ -0,0 +1,29 @@
+package summary
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCodeVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{name: "top level", data: `{"code_version":"v1.2.3"}`, want: "v1.2.3"},
+		{name: "javascript client", data: `{"client":{"javascript":{"code_version":"abc"}}}`, want: "abc"},
+		{name: "server", data: `{"server":{"code_version":"def"}}`, want: "def"},
+		{name: "missing", data: `{"environment":"production"}`, want: ""},
+		{name: "invalid", data: `{`, want: ""},
+		{name: "empty", data: ``, want: ""},
+	}
+
+	for _, tc := range tests {
+		if got := CodeVersion(json.RawMessage(tc.data)); got != tc.want {
+			t.Fatalf("%s: CodeVersion() = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,415 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+	"github.com/kevinsheth/rollbaz/internal/output"
+)
+
+const (
+	requestTimeout = 10 * time.Second
+	defaultWidth   = 100
+	defaultHeight  = 24
+	chromeLines    = 3
+)
+
+type Service interface {
+	Recent(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error)
+	Active(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error)
+	Show(ctx context.Context, counter domain.ItemCounter) (app.IssueDetail, error)
+	Resolve(ctx context.Context, counter domain.ItemCounter, resolvedInVersion string) (app.ItemActionResult, error)
+	Reopen(ctx context.Context, counter domain.ItemCounter) (app.ItemActionResult, error)
+	Mute(ctx context.Context, counter domain.ItemCounter, durationSeconds *int64) (app.ItemActionResult, error)
+}
+
+type Options struct {
+	Limit        int
+	Filters      app.IssueFilters
+	SkipConfirm  bool
+	BeforeAction func()
+}
+
+type screen int
+
+const (
+	screenList screen = iota
+	screenDetail
+)
+
+type issuesLoadedMsg struct {
+	issues []app.IssueSummary
+	err    error
+}
+
+type detailLoadedMsg struct {
+	detail app.IssueDetail
+	err    error
+}
+
+type actionDoneMsg struct {
+	result app.ItemActionResult
+	err    error
+}
+
+type Model struct {
+	ctx     context.Context
+	service Service
+	options Options
+
+	active  bool
+	issues  []app.IssueSummary
+	cursor  int
+	offset  int
+	loading bool
+
+	screen       screen
+	detail       *app.IssueDetail
+	detailOffset int
+
+	confirming string
+	status     string
+	width      int
+	height     int
+}
+
+func New(ctx context.Context, service Service, options Options) Model {
+	return Model{ctx: ctx, service: service, options: options, loading: true, width: defaultWidth, height: defaultHeight}
+}
+
+func Run(ctx context.Context, service Service, options Options, in io.Reader, out io.Writer) error {
+	program := tea.NewProgram(New(ctx, service, options), tea.WithContext(ctx), tea.WithInput(in), tea.WithOutput(out), tea.WithAltScreen())
+	if _, err := program.Run(); err != nil {
+		return fmt.Errorf("run tui: %w", err)
+	}
+
+	return nil
+}
+
+func (m Model) Init() tea.Cmd {
+	return m.loadIssues()
+}
+
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		m.scrollToCursor()
+		return m, nil
+	case issuesLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.status = "error: " + msg.err.Error()
+			return m, nil
+		}
+		m.issues = msg.issues
+		m.cursor = min(m.cursor, max(len(m.issues)-1, 0))
+		m.scrollToCursor()
+		m.status = fmt.Sprintf("%d %s issues", len(m.issues), m.listName())
+		return m, nil
+	case detailLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.status = "error: " + msg.err.Error()
+			return m, nil
+		}
+		m.detail = &msg.detail
+		m.detailOffset = 0
+		m.screen = screenDetail
+		m.status = ""
+		return m, nil
+	case actionDoneMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.status = "error: " + msg.err.Error()
+			return m, nil
+		}
+		m.applyAction(msg.result)
+		return m, nil
+	case tea.KeyMsg:
+		return m.handleKey(msg.String())
+	}
+
+	return m, nil
+}
+
+func (m Model) handleKey(key string) (tea.Model, tea.Cmd) {
+	if key == "ctrl+c" {
+		return m, tea.Quit
+	}
+	if m.confirming != "" {
+		action := m.confirming
+		m.confirming = ""
+		if key != "y" {
+			m.status = action + " cancelled"
+			return m, nil
+		}
+		return m.runAction(action)
+	}
+	if m.loading && key != "q" {
+		return m, nil
+	}
+
+	switch key {
+	case "q":
+		if m.screen == screenDetail {
+			m.screen = screenList
+			return m, nil
+		}
+		return m, tea.Quit
+	case "esc", "backspace":
+		m.screen = screenList
+		return m, nil
+	case "up", "k":
+		m.move(-1)
+	case "down", "j":
+		m.move(1)
+	case "enter":
+		if issue, ok := m.selected(); ok && m.screen == screenList {
+			m.loading = true
+			m.status = "loading #" + issue.Counter.String()
+			return m, m.loadDetail(issue.Counter)
+		}
+	case "a":
+		m.active = !m.active
+		m.cursor, m.offset = 0, 0
+		m.screen = screenList
+		m.loading = true
+		return m, m.loadIssues()
+	case "R":
+		m.loading = true
+		m.status = "refreshing"
+		return m, m.loadIssues()
+	case "r", "m", "o":
+		return m.requestAction(map[string]string{"r": "resolve", "m": "mute", "o": "reopen"}[key])
+	}
+
+	return m, nil
+}
+
+func (m *Model) move(delta int) {
+	if m.screen == screenDetail {
+		m.detailOffset = max(m.detailOffset+delta, 0)
+		return
+	}
+	if len(m.issues) == 0 {
+		return
+	}
+	m.cursor = min(max(m.cursor+delta, 0), len(m.issues)-1)
+	m.scrollToCursor()
+}
+
+func (m *Model) scrollToCursor() {
+	rows := m.listRows()
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+rows {
+		m.offset = m.cursor - rows + 1
+	}
+}
+
+func (m Model) listRows() int {
+	return max(m.height-chromeLines, 1)
+}
+
+func (m Model) selected() (app.IssueSummary, bool) {
+	if m.screen == screenDetail && m.detail != nil {
+		return m.detail.IssueSummary, true
+	}
+	if m.cursor < 0 || m.cursor >= len(m.issues) {
+		return app.IssueSummary{}, false
+	}
+
+	return m.issues[m.cursor], true
+}
+
+func (m Model) requestAction(action string) (tea.Model, tea.Cmd) {
+	issue, ok := m.selected()
+	if !ok {
+		return m, nil
+	}
+	if m.options.SkipConfirm {
+		return m.runAction(action)
+	}
+	m.confirming = action
+	m.status = fmt.Sprintf("%s #%s? [y/N]", action, issue.Counter.String())
+
+	return m, nil
+}
+
+func (m Model) runAction(action string) (tea.Model, tea.Cmd) {
+	issue, ok := m.selected()
+	if !ok {
+		return m, nil
+	}
+	m.loading = true
+	m.status = fmt.Sprintf("%s #%s...", action, issue.Counter.String())
+	service, ctx, counter, before := m.service, m.ctx, issue.Counter, m.options.BeforeAction
+
+	return m, func() tea.Msg {
+		beforeAction(before)
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		var result app.ItemActionResult
+		var err error
+		switch action {
+		case "resolve":
+			result, err = service.Resolve(ctx, counter, "")
+		case "mute":
+			result, err = service.Mute(ctx, counter, nil)
+		default:
+			result, err = service.Reopen(ctx, counter)
+		}
+		return actionDoneMsg{result: result, err: err}
+	}
+}
+
+func (m *Model) applyAction(result app.ItemActionResult) {
+	for index := range m.issues {
+		if m.issues[index].Counter == result.Issue.Counter {
+			m.issues[index] = result.Issue
+		}
+	}
+	if m.detail != nil && m.detail.Counter == result.Issue.Counter {
+		m.detail.IssueSummary = result.Issue
+	}
+	m.status = fmt.Sprintf("#%s: %s, now %s", result.Issue.Counter.String(), result.Action, fallback(result.Issue.Status.String()))
+	if result.Unchanged {
+		m.status = fmt.Sprintf("#%s: already %s", result.Issue.Counter.String(), fallback(result.Issue.Status.String()))
+	}
+}
+
+func (m Model) loadIssues() tea.Cmd {
+	service, ctx, options, active := m.service, m.ctx, m.options, m.active
+
+	return func() tea.Msg {
+		beforeAction(options.BeforeAction)
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		load := service.Recent
+		if active {
+			load = service.Active
+		}
+		issues, err := load(ctx, options.Limit, options.Filters)
+		return issuesLoadedMsg{issues: issues, err: err}
+	}
+}
+
+func (m Model) loadDetail(counter domain.ItemCounter) tea.Cmd {
+	service, ctx, before := m.service, m.ctx, m.options.BeforeAction
+
+	return func() tea.Msg {
+		beforeAction(before)
+		ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+
+		detail, err := service.Show(ctx, counter)
+		return detailLoadedMsg{detail: detail, err: err}
+	}
+}
+
+func beforeAction(hook func()) {
+	if hook != nil {
+		hook()
+	}
+}
+
+func (m Model) View() string {
+	var body []string
+	if m.screen == screenDetail && m.detail != nil {
+		body = m.detailLines()
+	} else {
+		body = m.listLines()
+	}
+
+	lines := append([]string{m.header()}, body...)
+	for len(lines) < m.height-1 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, clip(m.status, m.width))
+
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) listName() string {
+	if m.active {
+		return "active"
+	}
+
+	return "recent"
+}
+
+func (m Model) header() string {
+	keys := "↑/↓ move  enter show  r resolve  m mute  o reopen  a recent/active  R refresh  q quit"
+	if m.screen == screenDetail {
+		keys = "↑/↓ scroll  r resolve  m mute  o reopen  esc back  q back"
+	}
+
+	return clip(fmt.Sprintf("rollbaz: %s issues | %s", m.listName(), keys), m.width)
+}
+
+func (m Model) listLines() []string {
+	if len(m.issues) == 0 {
+		if m.loading {
+			return []string{"loading..."}
+		}
+		return []string{"no issues"}
+	}
+
+	rows := m.listRows()
+	end := min(m.offset+rows, len(m.issues))
+	lines := make([]string, 0, rows+1)
+	lines = append(lines, clip(fmt.Sprintf("  %-8s %-9s %-12s %11s  %s", "COUNTER", "STATUS", "ENV", "OCCURRENCES", "TITLE"), m.width))
+	for index := m.offset; index < end; index++ {
+		issue := m.issues[index]
+		occurrences := "unknown"
+		if issue.Occurrences != nil {
+			occurrences = fmt.Sprint(*issue.Occurrences)
+		}
+		line := clip(fmt.Sprintf("  %-8s %-9s %-12s %11s  %s", "#"+issue.Counter.String(), fallback(issue.Status.String()), clip(fallback(issue.Environment.String()), 12), occurrences, issue.Title), m.width)
+		if index == m.cursor {
+			line = "\x1b[7m" + ">" + line[1:] + "\x1b[0m"
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func (m Model) detailLines() []string {
+	lines := strings.Split(output.RenderIssueDetailHumanWithWidth(*m.detail, m.width), "\n")
+	start := min(m.detailOffset, max(len(lines)-1, 0))
+	end := min(start+m.listRows(), len(lines))
+
+	return lines[start:end]
+}
+
+func clip(value string, width int) string {
+	runes := []rune(value)
+	if width <= 0 || len(runes) <= width {
+		return value
+	}
+	if width == 1 {
+		return "…"
+	}
+
+	return string(runes[:width-1]) + "…"
+}
+
+func fallback(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return "-"
+	}
+
+	return value
+}
@@ -0,0 +1,211 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/kevinsheth/rollbaz/internal/app"
+	"github.com/kevinsheth/rollbaz/internal/domain"
+)
+
+type fakeService struct {
+	recent  []app.IssueSummary
+	active  []app.IssueSummary
+	actions *[]string
+	err     error
+}
+
+func (f fakeService) Recent(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error) {
+	return f.recent, f.err
+}
+
+func (f fakeService) Active(ctx context.Context, limit int, filters app.IssueFilters) ([]app.IssueSummary, error) {
+	return f.active, f.err
+}
+
+func (f fakeService) Show(ctx context.Context, counter domain.ItemCounter) (app.IssueDetail, error) {
+	for _, issue := range f.recent {
+		if issue.Counter == counter {
+			return app.IssueDetail{IssueSummary: issue, MainError: "boom in " + issue.Title}, f.err
+		}
+	}
+
+	return app.IssueDetail{}, errors.New("not found")
+}
+
+func (f fakeService) Resolve(ctx context.Context, counter domain.ItemCounter, resolvedInVersion string) (app.ItemActionResult, error) {
+	return f.act("resolve", counter, domain.StatusResolved)
+}
+
+func (f fakeService) Reopen(ctx context.Context, counter domain.ItemCounter) (app.ItemActionResult, error) {
+	return f.act("reopen", counter, domain.StatusActive)
+}
+
+func (f fakeService) Mute(ctx context.Context, counter domain.ItemCounter, durationSeconds *int64) (app.ItemActionResult, error) {
+	return f.act("mute", counter, domain.StatusMuted)
+}
+
+func (f fakeService) act(action string, counter domain.ItemCounter, status domain.Status) (app.ItemActionResult, error) {
+	*f.actions = append(*f.actions, action+" #"+counter.String())
+
+	return app.ItemActionResult{Action: action, Issue: app.IssueSummary{Counter: counter, Title: "checkout failed", Status: status}}, f.err
+}
+
+// press sends each key to m and runs the resulting command, the way the
+// bubbletea runtime would, returning the updated model.
+func press(t *testing.T, m tea.Model, keys ...string) tea.Model {
+	t.Helper()
+
+	for _, key := range keys {
+		var msg tea.KeyMsg
+		switch key {
+		case "enter":
+			msg = tea.KeyMsg{Type: tea.KeyEnter}
+		case "esc":
+			msg = tea.KeyMsg{Type: tea.KeyEsc}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+		}
+		m = run(m, msg)
+	}
+
+	return m
+}
+
+func run(m tea.Model, msg tea.Msg) tea.Model {
+	m, cmd := m.Update(msg)
+	if cmd == nil {
+		return m
+	}
+	if result := cmd(); result != nil {
+		if _, quit := result.(tea.QuitMsg); !quit {
+			m = run(m, result)
+		}
+	}
+
+	return m
+}
+
+func newTestModel(t *testing.T, options Options) (tea.Model, *[]string) {
+	t.Helper()
+
+	actions := []string{}
+	service := fakeService{
+		recent:  []app.IssueSummary{{Counter: 1, Title: "checkout failed", Status: domain.StatusActive}, {Counter: 2, Title: "timeout", Status: domain.StatusActive}},
+		active:  []app.IssueSummary{{Counter: 3, Title: "null pointer", Status: domain.StatusActive}},
+		actions: &actions,
+	}
+	m := New(context.Background(), service, options)
+
+	return run(m, m.Init()()), &actions
+}
+
+func TestModelListsAndShowsIssues(t *testing.T) {
+	t.Parallel()
+
+	m, _ := newTestModel(t, Options{})
+	view := m.View()
+	for _, want := range []string{"recent issues", "#1", "checkout failed", "#2", "2 recent issues"} {
+		if !strings.Contains(view, want) {
+			t.Fatalf("expected %q in list view, got:\n%s", want, view)
+		}
+	}
+
+	m = press(t, m, "down", "enter")
+	if view := m.View(); !strings.Contains(view, "boom in timeout") || !strings.Contains(view, "esc back") {
+		t.Fatalf("expected detail of #2, got:\n%s", view)
+	}
+
+	m = press(t, m, "esc", "a")
+	if view := m.View(); !strings.Contains(view, "null pointer") || strings.Contains(view, "timeout") {
+		t.Fatalf("expected active list, got:\n%s", view)
+	}
+}
+
+func TestModelConfirmsWriteActions(t *testing.T) {
+	t.Parallel()
+
+	m, actions := newTestModel(t, Options{})
+	m = press(t, m, "r")
+	if !strings.Contains(m.View(), "resolve #1? [y/N]") {
+		t.Fatalf("expected confirmation prompt, got:\n%s", m.View())
+	}
+	m = press(t, m, "n")
+	if len(*actions) != 0 || !strings.Contains(m.View(), "resolve cancelled") {
+		t.Fatalf("expected cancelled resolve, actions %v", *actions)
+	}
+
+	m = press(t, m, "r", "y", "down", "m", "y", "o", "y")
+	if got := strings.Join(*actions, ","); got != "resolve #1,mute #2,reopen #2" {
+		t.Fatalf("actions = %q", got)
+	}
+	if view := m.View(); !strings.Contains(view, "resolved") || !strings.Contains(view, "#2: reopen, now active") {
+		t.Fatalf("expected updated statuses, got:\n%s", view)
+	}
+}
+
+func TestModelRunsBeforeActionPerRequest(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	m, _ := newTestModel(t, Options{SkipConfirm: true, BeforeAction: func() { calls++ }})
+	press(t, m, "enter", "esc", "r", "a")
+	if calls != 4 {
+		t.Fatalf("expected BeforeAction for the initial load, detail, resolve, and reload, got %d calls", calls)
+	}
+}
+
+func TestModelReportsUnchangedActions(t *testing.T) {
+	t.Parallel()
+
+	m := &Model{}
+	m.applyAction(app.ItemActionResult{Action: "resolved", Issue: app.IssueSummary{Counter: 1, Status: "resolved"}, Unchanged: true})
+	if m.status != "#1: already resolved" {
+		t.Fatalf("unexpected status %q", m.status)
+	}
+}
+
+func TestModelSkipConfirmAndErrors(t *testing.T) {
+	t.Parallel()
+
+	m, actions := newTestModel(t, Options{SkipConfirm: true})
+	press(t, m, "m")
+	if got := strings.Join(*actions, ","); got != "mute #1" {
+		t.Fatalf("actions = %q", got)
+	}
+
+	failing := New(context.Background(), fakeService{err: errors.New("rate limited")}, Options{})
+	view := run(failing, failing.Init()()).View()
+	if !strings.Contains(view, "error: rate limited") {
+		t.Fatalf("expected load error in status line, got:\n%s", view)
+	}
+}
+
+func TestModelScrollsToCursor(t *testing.T) {
+	t.Parallel()
+
+	issues := make([]app.IssueSummary, 20)
+	for index := range issues {
+		issues[index] = app.IssueSummary{Counter: domain.ItemCounter(index + 1), Title: "issue"}
+	}
+	m := New(context.Background(), fakeService{recent: issues}, Options{})
+	var model tea.Model = run(m, m.Init()())
+	model = run(model, tea.WindowSizeMsg{Width: 80, Height: 8})
+	for range 10 {
+		model = press(t, model, "j")
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "#11") || strings.Contains(view, "#1 ") {
+		t.Fatalf("expected list scrolled to #11, got:\n%s", view)
+	}
+	if lines := strings.Count(view, "\n") + 1; lines != 8 {
+		t.Fatalf("view has %d lines, want 8", lines)
+	}
+}
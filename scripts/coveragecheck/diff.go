@@ -0,0 +1,264 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+type coverageBlock struct {
+	file       string
+	startLine  int
+	endLine    int
+	statements int64
+	count      int64
+}
+
+type changedLines map[string]map[int]bool
+
+func diffCoverage(profilePath string, changes changedLines) (covered int64, total int64, err error) {
+	blocks, err := readCoverageBlocks(profilePath)
+	if err != nil {
+		return 0, 0, err
+	}
+	modulePath, err := readModulePath()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, block := range mergeBlocks(blocks) {
+		if !block.touches(changes[strings.TrimPrefix(block.file, modulePath+"/")]) {
+			continue
+		}
+		total += block.statements
+		if block.count > 0 {
+			covered += block.statements
+		}
+	}
+
+	return covered, total, nil
+}
+
+func (b coverageBlock) touches(lines map[int]bool) bool {
+	for line := b.startLine; line <= b.endLine && len(lines) > 0; line++ {
+		if lines[line] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func mergeBlocks(blocks []coverageBlock) []coverageBlock {
+	merged := make([]coverageBlock, 0, len(blocks))
+	index := map[string]int{}
+	for _, block := range blocks {
+		key := fmt.Sprintf("%s:%d-%d", block.file, block.startLine, block.endLine)
+		if position, ok := index[key]; ok {
+			merged[position].count += block.count
+			continue
+		}
+		index[key] = len(merged)
+		merged = append(merged, block)
+	}
+
+	return merged
+}
+
+func readCoverageBlocks(filePath string) ([]coverageBlock, error) {
+	file, err := openCoverageFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("open coverage file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	if err := consumeModeLine(scanner); err != nil {
+		return nil, err
+	}
+
+	blocks := make([]coverageBlock, 0)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		block, err := parseCoverageBlock(line)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan coverage file: %w", err)
+	}
+
+	return blocks, nil
+}
+
+func parseCoverageBlock(line string) (coverageBlock, error) {
+	statements, count, err := parseCoverageLine(line)
+	if err != nil {
+		return coverageBlock{}, err
+	}
+
+	location := strings.Fields(line)[0]
+	file, span, ok := strings.Cut(location, ":")
+	start, end, okSpan := strings.Cut(span, ",")
+	if !ok || !okSpan {
+		return coverageBlock{}, fmt.Errorf("invalid coverage block: %q", line)
+	}
+	startLine, errStart := blockLine(start)
+	endLine, errEnd := blockLine(end)
+	if errStart != nil || errEnd != nil {
+		return coverageBlock{}, fmt.Errorf("invalid coverage block: %q", line)
+	}
+
+	return coverageBlock{file: file, startLine: startLine, endLine: endLine, statements: statements, count: count}, nil
+}
+
+func blockLine(position string) (int, error) {
+	line, _, _ := strings.Cut(position, ".")
+	parsed, err := strconv.Atoi(line)
+	if err != nil {
+		return 0, fmt.Errorf("parse line %q: %w", position, err)
+	}
+
+	return parsed, nil
+}
+
+func readModulePath() (string, error) {
+	body, err := os.ReadFile("go.mod")
+	if err != nil {
+		return "", fmt.Errorf("read go.mod: %w", err)
+	}
+	for _, line := range strings.Split(string(body), "\n") {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+
+	return "", errors.New("go.mod has no module line")
+}
+
+func loadChangedLines(baseRef string, diffPath string) (changedLines, error) {
+	switch diffPath {
+	case "":
+		//nolint:gosec // base ref is provided by local CI/hook command configuration.
+		output, err := exec.Command("git", "diff", "--unified=0", "--no-color", "--no-ext-diff", "--merge-base", baseRef, "--", "*.go").Output()
+		if err != nil {
+			return nil, fmt.Errorf("git diff against %q: %w", baseRef, err)
+		}
+		return parseUnifiedDiff(bytes.NewReader(output))
+	case "-":
+		return parseUnifiedDiff(os.Stdin)
+	default:
+		file, err := openCoverageFile(diffPath)
+		if err != nil {
+			return nil, fmt.Errorf("open diff file: %w", err)
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+		return parseUnifiedDiff(file)
+	}
+}
+
+func parseUnifiedDiff(reader io.Reader) (changedLines, error) {
+	changes := changedLines{}
+	current := ""
+	line, oldLeft, newLeft := 0, 0, 0
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+		if oldLeft > 0 || newLeft > 0 {
+			switch {
+			case strings.HasPrefix(text, "+"):
+				if current != "" {
+					if changes[current] == nil {
+						changes[current] = map[int]bool{}
+					}
+					changes[current][line] = true
+				}
+				line++
+				newLeft--
+			case strings.HasPrefix(text, "-"):
+				oldLeft--
+			case strings.HasPrefix(text, `\`):
+			default:
+				line++
+				oldLeft--
+				newLeft--
+			}
+			continue
+		}
+		if path, ok := strings.CutPrefix(text, "+++ "); ok {
+			current = diffTargetPath(path)
+			continue
+		}
+		if !strings.HasPrefix(text, "@@ ") {
+			continue
+		}
+		_, oldCount, err := parseHunkSource(text)
+		if err != nil {
+			return nil, err
+		}
+		start, newCount, err := parseHunkTarget(text)
+		if err != nil {
+			return nil, err
+		}
+		line, oldLeft, newLeft = start, oldCount, newCount
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan diff: %w", err)
+	}
+
+	return changes, nil
+}
+
+func diffTargetPath(path string) string {
+	path = strings.TrimSpace(path)
+	if path == "/dev/null" || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+		return ""
+	}
+
+	return strings.TrimPrefix(path, "b/")
+}
+
+func parseHunkSource(header string) (int, int, error) {
+	return parseHunkRange(header, 1, "-")
+}
+
+func parseHunkTarget(header string) (int, int, error) {
+	return parseHunkRange(header, 2, "+")
+}
+
+func parseHunkRange(header string, field int, prefix string) (int, int, error) {
+	fields := strings.Fields(header)
+	if len(fields) <= field || !strings.HasPrefix(fields[field], prefix) {
+		return 0, 0, fmt.Errorf("invalid hunk header: %q", header)
+	}
+
+	startText, countText, hasCount := strings.Cut(strings.TrimPrefix(fields[field], prefix), ",")
+	start, err := strconv.Atoi(startText)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid hunk header: %q", header)
+	}
+	count := 1
+	if hasCount {
+		if count, err = strconv.Atoi(countText); err != nil {
+			return 0, 0, fmt.Errorf("invalid hunk header: %q", header)
+		}
+	}
+
+	return start, count, nil
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseUnifiedDiff(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		diff string
+		want map[string][]int
+	}{
+		{
+			name: "only added lines inside a context hunk",
+			diff: `diff --git a/pkg/a.go b/pkg/a.go
+--- a/pkg/a.go
++++ b/pkg/a.go
+@@ -1,4 +1,5 @@
+ package pkg
+-var old = 1
++var renamed = 1
++var added = 2
+ 
+ func keep() {}
+`,
+			want: map[string][]int{"pkg/a.go": {2, 3}},
+		},
+		{
+			name: "zero context hunks",
+			diff: `--- a/pkg/a.go
++++ b/pkg/a.go
+@@ -3,0 +4,2 @@
++one
++two
+@@ -9 +10 @@
+-before
++after
+`,
+			want: map[string][]int{"pkg/a.go": {4, 5, 10}},
+		},
+		{
+			name: "added lines that look like file headers",
+			diff: `--- a/pkg/a.go
++++ b/pkg/a.go
+@@ -1,2 +1,3 @@
+ x := 1
++++ y
+--- z
+`,
+			want: map[string][]int{"pkg/a.go": {2}},
+		},
+		{
+			name: "skips tests, deletions and non-Go files",
+			diff: `--- a/pkg/a_test.go
++++ b/pkg/a_test.go
+@@ -1 +1 @@
+-a
++b
+--- a/pkg/gone.go
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+--- a/README.md
++++ b/README.md
+@@ -1 +1,2 @@
+ title
++more
+--- /dev/null
++++ b/pkg/new.go
+@@ -0,0 +1,2 @@
++package pkg
++
+\ No newline at end of file
+`,
+			want: map[string][]int{"pkg/new.go": {1, 2}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			changes, err := parseUnifiedDiff(strings.NewReader(tc.diff))
+			if err != nil {
+				t.Fatalf("parseUnifiedDiff() error = %v", err)
+			}
+			got := map[string][]int{}
+			for file, lines := range changes {
+				got[file] = slices.Sorted(maps.Keys(lines))
+			}
+			if !maps.EqualFunc(got, tc.want, slices.Equal) {
+				t.Fatalf("parseUnifiedDiff() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseUnifiedDiffRejectsBadHunk(t *testing.T) {
+	t.Parallel()
+
+	if _, err := parseUnifiedDiff(strings.NewReader("+++ b/a.go\n@@ -1 +x @@\n")); err == nil {
+		t.Fatalf("expected invalid hunk error")
+	}
+}
+
+func TestParseHunkTarget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header    string
+		start     int
+		count     int
+		expectErr bool
+	}{
+		{header: "@@ -1,4 +1,5 @@ func main() {", start: 1, count: 5},
+		{header: "@@ -3 +7 @@", start: 7, count: 1},
+		{header: "@@ -3,2 +2,0 @@", start: 2, count: 0},
+		{header: "@@ -1 @@", expectErr: true},
+		{header: "@@ -1 -2 @@", expectErr: true},
+		{header: "@@ -1 +a,2 @@", expectErr: true},
+		{header: "@@ -1 +2,b @@", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.header, func(t *testing.T) {
+			t.Parallel()
+
+			start, count, err := parseHunkTarget(tc.header)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected error for %q", tc.header)
+				}
+				return
+			}
+			if err != nil || start != tc.start || count != tc.count {
+				t.Fatalf("parseHunkTarget() = %d,%d err=%v, want %d,%d", start, count, err, tc.start, tc.count)
+			}
+		})
+	}
+}
+
+func TestMergeBlocks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		blocks []coverageBlock
+		want   []coverageBlock
+	}{
+		{
+			name: "empty",
+			want: []coverageBlock{},
+		},
+		{
+			name: "sums counts of the same block",
+			blocks: []coverageBlock{
+				{file: "m/a.go", startLine: 1, endLine: 3, statements: 2, count: 0},
+				{file: "m/a.go", startLine: 5, endLine: 6, statements: 1, count: 1},
+				{file: "m/a.go", startLine: 1, endLine: 3, statements: 2, count: 4},
+			},
+			want: []coverageBlock{
+				{file: "m/a.go", startLine: 1, endLine: 3, statements: 2, count: 4},
+				{file: "m/a.go", startLine: 5, endLine: 6, statements: 1, count: 1},
+			},
+		},
+		{
+			name: "keeps the same span in different files apart",
+			blocks: []coverageBlock{
+				{file: "m/a.go", startLine: 1, endLine: 3, statements: 2},
+				{file: "m/b.go", startLine: 1, endLine: 3, statements: 2, count: 1},
+			},
+			want: []coverageBlock{
+				{file: "m/a.go", startLine: 1, endLine: 3, statements: 2},
+				{file: "m/b.go", startLine: 1, endLine: 3, statements: 2, count: 1},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := mergeBlocks(tc.blocks); !slices.Equal(got, tc.want) {
+				t.Fatalf("mergeBlocks() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
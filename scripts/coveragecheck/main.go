@@ -12,8 +12,18 @@ import (
 func main() {
 	minimum := flag.Float64("min", 85, "minimum total coverage percentage")
 	file := flag.String("file", "coverage.out", "go coverage profile path")
+	base := flag.String("base", "", "git ref; check only lines changed since its merge base against -diff-min")
+	diff := flag.String("diff", "", "unified diff file (- for stdin) to use instead of git diff -base")
+	diffMinimum := flag.Float64("diff-min", 80, "minimum coverage percentage for changed lines")
+	history := flag.String("history", "", "JSON Lines file recording total and per-package coverage per run")
+	maxDrop := flag.Float64("max-drop", 1, "maximum percentage points coverage may drop below the best -history run")
 	flag.Parse()
 
+	if *base != "" || *diff != "" {
+		checkDiffCoverage(*file, *base, *diff, *diffMinimum)
+		return
+	}
+
 	total, err := totalCoverage(*file)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "coverage check failed: %v\n", err)
@@ -25,9 +35,45 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *history != "" {
+		if err := checkTrend(*history, *file, total, *maxDrop); err != nil {
+			fmt.Fprintf(os.Stderr, "coverage trend check failed: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	fmt.Printf("coverage %.2f%% (min %.2f%%)\n", total, *minimum)
 }
 
+func checkDiffCoverage(file string, base string, diff string, minimum float64) {
+	covered, total, err := changedCoverage(file, base, diff)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "diff coverage check failed: %v\n", err)
+		os.Exit(1)
+	}
+	if total == 0 {
+		fmt.Println("diff coverage: no changed statements")
+		return
+	}
+
+	percent := (float64(covered) / float64(total)) * 100
+	if percent < minimum {
+		fmt.Fprintf(os.Stderr, "diff coverage %.2f%% (%d/%d statements) is below required %.2f%%\n", percent, covered, total, minimum)
+		os.Exit(1)
+	}
+
+	fmt.Printf("diff coverage %.2f%% (%d/%d statements, min %.2f%%)\n", percent, covered, total, minimum)
+}
+
+func changedCoverage(file string, base string, diff string) (int64, int64, error) {
+	changes, err := loadChangedLines(base, diff)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return diffCoverage(file, changes)
+}
+
 func totalCoverage(filePath string) (float64, error) {
 	file, err := openCoverageFile(filePath)
 	if err != nil {
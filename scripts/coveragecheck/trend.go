@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+type coverageRun struct {
+	Time     time.Time          `json:"time"`
+	Total    float64            `json:"total"`
+	Packages map[string]float64 `json:"packages"`
+}
+
+func packageCoverage(profilePath string) (map[string]float64, error) {
+	blocks, err := readCoverageBlocks(profilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := map[string]int64{}
+	total := map[string]int64{}
+	for _, block := range mergeBlocks(blocks) {
+		pkg := path.Dir(block.file)
+		total[pkg] += block.statements
+		if block.count > 0 {
+			covered[pkg] += block.statements
+		}
+	}
+
+	percentages := make(map[string]float64, len(total))
+	for pkg, statements := range total {
+		if statements > 0 {
+			percentages[pkg] = (float64(covered[pkg]) / float64(statements)) * 100
+		}
+	}
+
+	return percentages, nil
+}
+
+func coverageDrops(best coverageRun, current coverageRun, maxDrop float64) []string {
+	drops := make([]string, 0)
+	if best.Total-current.Total > maxDrop {
+		drops = append(drops, fmt.Sprintf("total %.2f%% -> %.2f%%", best.Total, current.Total))
+	}
+
+	packages := make([]string, 0, len(current.Packages))
+	for pkg := range current.Packages {
+		packages = append(packages, pkg)
+	}
+	sort.Strings(packages)
+	for _, pkg := range packages {
+		before, ok := best.Packages[pkg]
+		if ok && before-current.Packages[pkg] > maxDrop {
+			drops = append(drops, fmt.Sprintf("%s %.2f%% -> %.2f%%", pkg, before, current.Packages[pkg]))
+		}
+	}
+
+	return drops
+}
+
+func bestCoverageRun(historyPath string) (coverageRun, bool, error) {
+	file, err := openCoverageFile(historyPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return coverageRun{}, false, nil
+	}
+	if err != nil {
+		return coverageRun{}, false, fmt.Errorf("open coverage history: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	best := coverageRun{Packages: map[string]float64{}}
+	found := false
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var run coverageRun
+		if err := json.Unmarshal([]byte(line), &run); err != nil {
+			return coverageRun{}, false, fmt.Errorf("parse coverage history: %w", err)
+		}
+		if !found || run.Total > best.Total {
+			best.Total = run.Total
+		}
+		for pkg, percent := range run.Packages {
+			if before, ok := best.Packages[pkg]; !ok || percent > before {
+				best.Packages[pkg] = percent
+			}
+		}
+		found = true
+	}
+	if err := scanner.Err(); err != nil {
+		return coverageRun{}, false, fmt.Errorf("scan coverage history: %w", err)
+	}
+
+	return best, found, nil
+}
+
+func appendCoverageRun(historyPath string, run coverageRun) error {
+	line, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("encode coverage run: %w", err)
+	}
+
+	//nolint:gosec // history path is provided by local CI/hook command configuration.
+	file, err := os.OpenFile(historyPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("open coverage history: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("write coverage history: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close coverage history: %w", err)
+	}
+
+	return nil
+}
+
+func checkTrend(historyPath string, profilePath string, total float64, maxDrop float64) error {
+	packages, err := packageCoverage(profilePath)
+	if err != nil {
+		return err
+	}
+	current := coverageRun{Time: time.Now().UTC(), Total: total, Packages: packages}
+
+	best, found, err := bestCoverageRun(historyPath)
+	if err != nil {
+		return err
+	}
+	if found {
+		if drops := coverageDrops(best, current, maxDrop); len(drops) > 0 {
+			return fmt.Errorf("coverage dropped more than %.2f points below the best recorded run: %s",
+				maxDrop, strings.Join(drops, "; "))
+		}
+	}
+
+	return appendCoverageRun(historyPath, current)
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestFile(t *testing.T, name string, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	return path
+}
+
+func TestPackageCoverageMergesDuplicateBlocks(t *testing.T) {
+	t.Parallel()
+
+	profile := writeTestFile(t, "coverage.out", `mode: set
+m/a/a.go:1.1,3.2 2 0
+m/a/a.go:5.1,6.2 2 1
+m/a/a.go:1.1,3.2 2 1
+m/b/b.go:1.1,2.2 4 0
+`)
+
+	packages, err := packageCoverage(profile)
+	if err != nil {
+		t.Fatalf("packageCoverage() error = %v", err)
+	}
+	if packages["m/a"] != 100 || packages["m/b"] != 0 || len(packages) != 2 {
+		t.Fatalf("packageCoverage() = %v", packages)
+	}
+}
+
+func TestBestCoverageRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		history   string
+		found     bool
+		total     float64
+		packages  map[string]float64
+		expectErr bool
+	}{
+		{name: "empty", history: "\n"},
+		{
+			name: "keeps the highest value of each",
+			history: `{"total":80,"packages":{"m/a":90}}
+
+{"total":85,"packages":{"m/a":70,"m/b":60}}
+{"total":84.5,"packages":{"m/b":65}}
+`,
+			found:    true,
+			total:    85,
+			packages: map[string]float64{"m/a": 90, "m/b": 65},
+		},
+		{name: "corrupt", history: "{\n", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			best, found, err := bestCoverageRun(writeTestFile(t, "history.jsonl", tc.history))
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected parse error")
+				}
+				return
+			}
+			if err != nil || found != tc.found || best.Total != tc.total {
+				t.Fatalf("bestCoverageRun() = %+v, found=%v, err=%v", best, found, err)
+			}
+			for pkg, percent := range tc.packages {
+				if best.Packages[pkg] != percent {
+					t.Fatalf("best %s = %v, want %v", pkg, best.Packages[pkg], percent)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckTrendComparesAgainstBestRun(t *testing.T) {
+	t.Parallel()
+
+	profile := writeTestFile(t, "coverage.out", "mode: set\nm/a/a.go:1.1,3.2 1 1\n")
+	history := filepath.Join(t.TempDir(), "history.jsonl")
+
+	for _, total := range []float64{90, 89.5, 89} {
+		if err := checkTrend(history, profile, total, 1); err != nil {
+			t.Fatalf("checkTrend(%v) error = %v", total, err)
+		}
+	}
+	err := checkTrend(history, profile, 88.5, 1)
+	if err == nil || !strings.Contains(err.Error(), "total 90.00% -> 88.50%") {
+		t.Fatalf("expected drop below best run, got %v", err)
+	}
+
+	best, found, err := bestCoverageRun(history)
+	if err != nil || !found || best.Total != 90 || best.Packages["m/a"] != 100 {
+		t.Fatalf("failing run must not be recorded: %+v, err=%v", best, err)
+	}
+}
+
+func TestCoverageDropsReportsPackages(t *testing.T) {
+	t.Parallel()
+
+	best := coverageRun{Total: 80, Packages: map[string]float64{"m/a": 90, "m/b": 50}}
+	current := coverageRun{Total: 80, Packages: map[string]float64{"m/a": 85, "m/b": 49.5, "m/c": 10}}
+
+	drops := coverageDrops(best, current, 1)
+	if len(drops) != 1 || drops[0] != "m/a 90.00% -> 85.00%" {
+		t.Fatalf("coverageDrops() = %v", drops)
+	}
+}